@@ -2,7 +2,6 @@ package main
 
 import (
 	"context"
-	"encoding/base64"
 	"flag"
 	"fmt"
 	"log"
@@ -15,10 +14,14 @@ import (
 	"berty.tech/berty/go/internal/banner"
 	"berty.tech/berty/go/internal/grpcutil"
 	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/internal/tinder"
 	"berty.tech/berty/go/pkg/bertydemo"
 	"berty.tech/berty/go/pkg/bertyprotocol"
 	"berty.tech/berty/go/pkg/errcode"
 	"berty.tech/go-orbit-db/cache/cacheleveldown"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	badger "github.com/ipfs/go-ds-badger"
 	"github.com/jinzhu/gorm"
 	_ "github.com/jinzhu/gorm/dialects/sqlite" // required by gorm
 	ma "github.com/multiformats/go-multiaddr"
@@ -45,13 +48,14 @@ func main() {
 		clientProtocolFlags     = flag.NewFlagSet("protocol client", flag.ExitOnError)
 		clientProtocolURN       = clientProtocolFlags.String("protocol-urn", ":memory:", "protocol sqlite URN")
 		clientProtocolListeners = clientProtocolFlags.String("l", "/ip4/127.0.0.1/tcp/9091/grpc", "client listeners")
+		clientProtocolDirectory = clientProtocolFlags.String("d", cacheleveldown.InMemoryDirectory, "protocol datastore directory")
 
 		clientDemoFlags     = flag.NewFlagSet("demo client", flag.ExitOnError)
 		clientDemoDirectory = clientDemoFlags.String("d", ":memory:", "orbit db directory")
 		clientDemoListeners = clientDemoFlags.String("l", "/ip4/127.0.0.1/tcp/9091/grpc", "client listeners")
 
 		miniClientDemoFlags = flag.NewFlagSet("mini demo client", flag.ExitOnError)
-		miniClientDemoGroup = miniClientDemoFlags.String("g", "", "group to join, leave empty to create a new group")
+		miniClientDemoGroup = miniClientDemoFlags.String("g", "", "group invitation to join, leave empty to create a new group")
 		miniClientDemoPath  = miniClientDemoFlags.String("d", cacheleveldown.InMemoryDirectory, "orbit db directory")
 		miniClientDemoPort  = miniClientDemoFlags.Uint("p", 0, "default IPFS listen port")
 	)
@@ -144,16 +148,59 @@ func main() {
 				}
 				defer db.Close()
 
-				api, node, err := ipfsutil.NewInMemoryCoreAPI(ctx)
+				// initialize datastore
+				var rootDS datastore.Batching = datastore.NewMapDatastore()
+				if *clientProtocolDirectory != cacheleveldown.InMemoryDirectory {
+					if err := os.MkdirAll(*clientProtocolDirectory, 0700); err != nil {
+						return errcode.TODO.Wrap(err)
+					}
+
+					rootDS, err = badger.NewDatastore(*clientProtocolDirectory, nil)
+					if err != nil {
+						return errcode.TODO.Wrap(err)
+					}
+				}
+				rootDS = ds_sync.MutexWrap(rootDS)
+				defer rootDS.Close()
+
+				ipfsDS := ipfsutil.NewNamespacedDatastore(rootDS, datastore.NewKey("ipfs"))
+				cfg, err := ipfsutil.CreateBuildConfigWithDatastore(nil, ipfsDS)
+				if err != nil {
+					return errcode.TODO.Wrap(err)
+				}
+
+				api, node, err := ipfsutil.NewConfigurableCoreAPI(ctx, cfg)
 				if err != nil {
 					return errcode.TODO.Wrap(err)
 				}
 				defer node.Close()
 
+				// initialize the discovery drivers used for contact requests,
+				// peers on the LAN are found using mDNS and the connected
+				// ones using pubsub
+				drivers := []tinder.Driver{tinder.NewMDNSDriver(ctx, node.PeerHost, 0)}
+				if node.DHT != nil {
+					drivers = append(drivers, tinder.NewDHTDriver(node.DHT))
+				}
+
+				if node.PubSub != nil {
+					psDriver, err := tinder.NewPubsubDriver(ctx, node.PeerHost, node.PubSub, 0)
+					if err != nil {
+						return errcode.TODO.Wrap(err)
+					}
+
+					drivers = append(drivers, psDriver)
+				}
+
+				disc := tinder.NewMultiDriver(drivers...)
+
 				// initialize new protocol client
 				opts := bertyprotocol.Opts{
-					IpfsCoreAPI: api,
-					Logger:      logger.Named("bertyprotocol"),
+					IpfsCoreAPI:   api,
+					Logger:        logger.Named("bertyprotocol"),
+					RootDatastore: rootDS,
+					Host:          node.PeerHost,
+					TinderDriver:  disc,
 				}
 				protocol, err = bertyprotocol.New(db, opts)
 				if err != nil {
@@ -272,31 +319,11 @@ func main() {
 		},
 	}
 
-	groupinit := &ffcli.Command{
-		Name:    "groupinit",
-		Usage:   "berty groupinit - initialize a new multi member group",
-		FlagSet: clientDemoFlags,
-		Exec: func(args []string) error {
-			g, _, err := bertyprotocol.NewGroupMultiMember()
-			if err != nil {
-				return err
-			}
-
-			gBytes, err := g.Marshal()
-			if err != nil {
-				return err
-			}
-
-			fmt.Print(base64.StdEncoding.EncodeToString(gBytes))
-			return nil
-		},
-	}
-
 	root := &ffcli.Command{
 		Usage:       "berty [global flags] <subcommand> [flags] [args...]",
 		FlagSet:     globalFlags,
 		Options:     []ff.Option{ff.WithEnvVarPrefix("BERTY")},
-		Subcommands: []*ffcli.Command{daemon, demo, banner, version, mini, groupinit},
+		Subcommands: []*ffcli.Command{daemon, demo, banner, version, mini},
 		Exec: func([]string) error {
 			globalFlags.Usage()
 			return flag.ErrHelp
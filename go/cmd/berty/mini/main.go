@@ -8,8 +8,11 @@ import (
 	ipfslogger "github.com/ipfs/go-log"
 	"github.com/rivo/tview"
 	"github.com/whyrusleeping/go-logging"
+	"go.uber.org/zap"
 
 	"berty.tech/berty/go/internal/orbitutil"
+	"berty.tech/berty/go/internal/tinder"
+	"berty.tech/berty/go/pkg/bertyprotocol"
 )
 
 type Opts struct {
@@ -24,7 +27,7 @@ func Main(opts *Opts) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	odb, ds, node, lock := initOrbitDB(ctx, opts)
+	odb, acc, ds, node, lock := initOrbitDB(ctx, opts)
 	defer unlockFS(lock)
 	defer ds.Close()
 	defer node.Close()
@@ -36,7 +39,12 @@ func Main(opts *Opts) {
 
 	app := tview.NewApplication()
 
-	tabbedView := newTabbedGroups(ctx, cg, odb, app)
+	// the join requests are sent to the admins found on the local network
+	invitations := bertyprotocol.NewGroupInvitationsManager(ctx, zap.NewNop(), node.PeerHost, tinder.NewMDNSDriver(ctx, node.PeerHost, 0), odb.GetContextGroupForID)
+	invitations.Start()
+	defer invitations.Close()
+
+	tabbedView := newTabbedGroups(ctx, cg, odb, acc, invitations, app)
 	if len(opts.GroupInvitation) > 0 {
 		for _, invit := range strings.Split(opts.GroupInvitation, ",") {
 			if err := groupJoinCommand(ctx, tabbedView.accountGroupView, invit); err != nil {
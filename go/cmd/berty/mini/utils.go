@@ -20,23 +20,23 @@ import (
 	"berty.tech/berty/go/internal/bertycrypto"
 	"berty.tech/berty/go/internal/ipfsutil"
 	"berty.tech/berty/go/internal/orbitutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
-func openGroupFromString(data string) (*bertyprotocol.Group, error) {
+func openInvitationFromString(data string) (*bertytypes.GroupInvitation, error) {
 	// Read invitation (as base64 on stdin)
 	iB64, err := base64.StdEncoding.DecodeString(strings.TrimSpace(data))
 	if err != nil {
 		return nil, err
 	}
 
-	grp := &bertyprotocol.Group{}
-	err = grp.Unmarshal(iB64)
+	invitation := &bertytypes.GroupInvitation{}
+	err = invitation.Unmarshal(iB64)
 	if err != nil {
 		return nil, err
 	}
 
-	return grp, nil
+	return invitation, nil
 }
 
 func unlockFS(l *fslock.Lock) {
@@ -55,7 +55,7 @@ func panicUnlockFS(err error, l *fslock.Lock) {
 	panic(err)
 }
 
-func initOrbitDB(ctx context.Context, opts *Opts) (orbitutil.BertyOrbitDB, datastore.Batching, *core.IpfsNode, *fslock.Lock) {
+func initOrbitDB(ctx context.Context, opts *Opts) (orbitutil.BertyOrbitDB, *account.Account, datastore.Batching, *core.IpfsNode, *fslock.Lock) {
 	var (
 		swarmAddresses []string = nil
 		lock           *fslock.Lock
@@ -117,12 +117,14 @@ func initOrbitDB(ctx context.Context, opts *Opts) (orbitutil.BertyOrbitDB, datas
 		panicUnlockFS(err, lock)
 	}
 
-	odb, err := orbitutil.NewBertyOrbitDB(ctx, api, account.New(accountKS), mk, &orbitdb.NewOrbitDBOptions{Cache: orbitdbCache})
+	acc := account.New(accountKS)
+
+	odb, err := orbitutil.NewBertyOrbitDB(ctx, api, acc, mk, &orbitdb.NewOrbitDBOptions{Cache: orbitdbCache})
 	if err != nil {
 		panicUnlockFS(err, lock)
 	}
 
-	return odb, baseDS, node, lock
+	return odb, acc, baseDS, node, lock
 }
 
 func pkAsShortID(pk []byte) string {
@@ -14,7 +14,7 @@ import (
 
 	"berty.tech/berty/go/internal/banner"
 	"berty.tech/berty/go/internal/orbitutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
 type groupView struct {
@@ -95,7 +95,7 @@ func (v *groupView) loop(ctx context.Context) {
 	// Watch for incoming new messages
 	go func() {
 		for e := range v.cg.MessageStore().Subscribe(ctx) {
-			evt, ok := e.(*bertyprotocol.GroupMessageEvent)
+			evt, ok := e.(*bertytypes.GroupMessageEvent)
 			if !ok {
 				continue
 			}
@@ -111,7 +111,7 @@ func (v *groupView) loop(ctx context.Context) {
 	// Watch for new incoming metadata
 	go func() {
 		for evt := range v.cg.MetadataStore().Subscribe(ctx) {
-			e, ok := evt.(*bertyprotocol.GroupMetadataEvent)
+			e, ok := evt.(*bertytypes.GroupMetadataEvent)
 			if !ok {
 				continue
 			}
@@ -4,12 +4,24 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/pkg/errors"
 
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
+)
+
+const (
+	// invitationValidity is the duration during which the created invites can
+	// be used
+	invitationValidity = time.Hour * 24
+
+	// groupJoinTimeout is the duration during which the admins of a group are
+	// looked up when using an invite
+	groupJoinTimeout = time.Minute * 2
 )
 
 type command struct {
@@ -32,7 +44,7 @@ func commandList() []*command {
 		},
 		{
 			title: "group invite",
-			help:  "Displays a invite for the current group",
+			help:  "Displays a invite valid for a day for the current group, a maximum number of uses can be supplied",
 			cmd:   groupInviteCommand,
 		},
 		{
@@ -40,6 +52,11 @@ func commandList() []*command {
 			help:  "Creates joins an existing group, a group invite must be supplied",
 			cmd:   groupJoinCommand,
 		},
+		{
+			title: "group revoke",
+			help:  "Revokes an invite of the current group, an invite id must be supplied",
+			cmd:   groupRevokeCommand,
+		},
 		{
 			title: "contact received",
 			help:  "Fakes an incoming contact request, a shareable contact must be supplied",
@@ -114,19 +131,45 @@ func aliasSendCommand(ctx context.Context, v *groupView, cmd string) error {
 	return nil
 }
 
-func groupInviteCommand(ctx context.Context, v *groupView, _ string) error {
-	if v.cg.Group().GroupType != bertyprotocol.GroupTypeMultiMember {
+func groupInviteCommand(ctx context.Context, v *groupView, cmd string) error {
+	if v.cg.Group().GroupType != bertytypes.GroupTypeMultiMember {
 		return errors.New("unsupported group type")
 	}
 
-	protoBytes, err := v.cg.Group().Marshal()
+	maxUses := uint64(0)
+	if cmd = strings.TrimSpace(cmd); cmd != "" {
+		var err error
+		if maxUses, err = strconv.ParseUint(cmd, 10, 32); err != nil {
+			return errors.Wrap(err, "invalid maximum number of uses")
+		}
+	}
+
+	invitation, err := v.cg.MetadataStore().InvitationCreate(time.Now().Add(invitationValidity), uint32(maxUses))
+	if err != nil {
+		return err
+	}
+
+	protoBytes, err := invitation.Marshal()
 	if err != nil {
 		return err
 	}
 
 	v.syncMessages <- &historyMessage{
 		messageType: messageTypeMeta,
-		payload:     []byte(base64.StdEncoding.EncodeToString(protoBytes)),
+		payload:     []byte(fmt.Sprintf("invite %s: %s", base64.StdEncoding.EncodeToString(invitation.ID), base64.StdEncoding.EncodeToString(protoBytes))),
+	}
+
+	return nil
+}
+
+func groupRevokeCommand(ctx context.Context, v *groupView, cmd string) error {
+	id, err := base64.StdEncoding.DecodeString(strings.TrimSpace(cmd))
+	if err != nil {
+		return err
+	}
+
+	if _, err := v.cg.MetadataStore().InvitationRevoke(ctx, id); err != nil {
+		return err
 	}
 
 	return nil
@@ -170,7 +213,7 @@ func contactReceivedCommand(ctx context.Context, v *groupView, cmd string) error
 		return err
 	}
 
-	contact := &bertyprotocol.ShareableContact{}
+	contact := &bertytypes.ShareableContact{}
 	if err := contact.Unmarshal(contactBytes); err != nil {
 		return err
 	}
@@ -224,18 +267,44 @@ func contactDiscardCommand(ctx context.Context, v *groupView, cmd string) error
 }
 
 func groupJoinCommand(ctx context.Context, v *groupView, cmd string) error {
-	g, err := openGroupFromString(cmd)
+	invitation, err := openInvitationFromString(cmd)
 	if err != nil {
 		return errors.Wrap(err, "Can't join group")
 	}
 
+	if err := invitation.CheckSignature(); err != nil {
+		return errors.Wrap(err, "Invalid invite")
+	}
+
+	if invitation.IsExpired(time.Now()) {
+		return errors.New("Expired invite")
+	}
+
+	md, err := v.v.acc.MemberDeviceForGroup(&bertytypes.Group{
+		PublicKey: invitation.GroupPK,
+		GroupType: bertytypes.GroupTypeMultiMember,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Can't join group")
+	}
+
+	// the group is sent by an admin once it recorded the use of the invite,
+	// it is opened when the join is replicated in the account group
+	ctx, cancel := context.WithTimeout(ctx, groupJoinTimeout)
+	defer cancel()
+
+	g, err := v.v.invitations.RequestGroup(ctx, invitation, md.Member)
+	if err != nil {
+		return errors.Wrap(err, "Can't use invite")
+	}
+
 	_, err = v.cg.MetadataStore().GroupJoin(ctx, g)
 
 	return err
 }
 
 func groupNewCommand(ctx context.Context, v *groupView, _ string) error {
-	g, sk, err := bertyprotocol.NewGroupMultiMember()
+	g, sk, err := bertytypes.NewGroupMultiMember()
 	if err != nil {
 		return errors.Wrap(err, "Can't create group")
 	}
@@ -262,7 +331,7 @@ func contactRequestCommand(ctx context.Context, v *groupView, cmd string) error
 		return err
 	}
 
-	contact := &bertyprotocol.ShareableContact{}
+	contact := &bertytypes.ShareableContact{}
 	if err := contact.Unmarshal(contactBytes); err != nil {
 		return err
 	}
@@ -272,7 +341,7 @@ func contactRequestCommand(ctx context.Context, v *groupView, cmd string) error
 		return err
 	}
 
-	if _, err = v.cg.MetadataStore().ContactRequestOutgoingEnqueue(ctx, contact); err != nil {
+	if _, err = v.cg.MetadataStore().ContactRequestOutgoingEnqueue(ctx, contact, nil); err != nil {
 		return err
 	}
 
@@ -7,14 +7,18 @@ import (
 	"github.com/gdamore/tcell"
 	"github.com/rivo/tview"
 
+	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/internal/orbitutil"
 	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
 type tabbedGroupsView struct {
 	ctx                    context.Context
 	app                    *tview.Application
 	odb                    orbitutil.BertyOrbitDB
+	acc                    *account.Account
+	invitations            *bertyprotocol.GroupInvitationsManager
 	topics                 *tview.Table
 	activeViewContainer    *tview.Flex
 	selectedGroupView      *groupView
@@ -97,7 +101,7 @@ func (v *tabbedGroupsView) recomputeChannelList(viewChanged bool) {
 func (v *tabbedGroupsView) AddContextGroup(cg orbitutil.ContextGroup) {
 	v.lock.Lock()
 
-	if cg.Group().GroupType == bertyprotocol.GroupTypeContact {
+	if cg.Group().GroupType == bertytypes.GroupTypeContact {
 		for _, vg := range v.contactGroupViews {
 			if vg.cg.Group() == cg.Group() {
 				return
@@ -110,7 +114,7 @@ func (v *tabbedGroupsView) AddContextGroup(cg orbitutil.ContextGroup) {
 
 		v.contactGroupViews = append(v.contactGroupViews, vg)
 
-	} else if cg.Group().GroupType == bertyprotocol.GroupTypeMultiMember {
+	} else if cg.Group().GroupType == bertytypes.GroupTypeMultiMember {
 		for _, vg := range v.multiMembersGroupViews {
 			if vg.cg.Group() == cg.Group() {
 				return
@@ -194,12 +198,14 @@ func (v *tabbedGroupsView) GetHistory() tview.Primitive {
 	return v.activeViewContainer
 }
 
-func newTabbedGroups(ctx context.Context, cg orbitutil.ContextGroup, odb orbitutil.BertyOrbitDB, app *tview.Application) *tabbedGroupsView {
+func newTabbedGroups(ctx context.Context, cg orbitutil.ContextGroup, odb orbitutil.BertyOrbitDB, acc *account.Account, invitations *bertyprotocol.GroupInvitationsManager, app *tview.Application) *tabbedGroupsView {
 	v := &tabbedGroupsView{
-		ctx:    ctx,
-		topics: tview.NewTable(),
-		odb:    odb,
-		app:    app,
+		ctx:         ctx,
+		topics:      tview.NewTable(),
+		odb:         odb,
+		acc:         acc,
+		invitations: invitations,
+		app:         app,
 	}
 
 	v.accountGroupView = newViewGroup(v, cg)
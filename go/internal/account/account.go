@@ -1,6 +1,7 @@
 package account
 
 import (
+	"bytes"
 	"crypto/ed25519"
 	"crypto/rand"
 	"encoding/hex"
@@ -8,16 +9,19 @@ import (
 	"sync"
 
 	"github.com/aead/ecdh"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
 	"github.com/ipfs/go-ipfs/keystore"
 	"github.com/libp2p/go-libp2p-core/crypto"
 
 	"berty.tech/berty/go/internal/cryptoutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
 type Account struct {
 	ks keystore.Keystore
+	ds datastore.Datastore
 	mu sync.Mutex
 }
 
@@ -30,6 +34,8 @@ const (
 	keyContactGroup = "contactGroupSK"
 )
 
+var sigChainKey = datastore.NewKey("sigChain")
+
 // AccountPrivKey returns the private key associated with the current account
 func (a *Account) AccountPrivKey() (crypto.PrivKey, error) {
 	a.mu.Lock()
@@ -54,6 +60,181 @@ func (a *Account) DevicePrivKey() (crypto.PrivKey, error) {
 	return a.getOrGenerateNamedKey(keyDevice)
 }
 
+// SigChain returns the sig chain of the account, it is initialized with the
+// current device if it doesn't exist yet
+func (a *Account) SigChain() (*SigChain, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.getOrInitSigChain()
+}
+
+// SigChainAddDevice adds a device to the sig chain of the account, the entry
+// is signed by the current device
+func (a *Account) SigChainAddDevice(devicePK crypto.PubKey) (*SigChainEntry, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.appendSigChainEntry(func(chain *SigChain, deviceSK crypto.PrivKey) (*SigChainEntry, error) {
+		return chain.AddDevice(deviceSK, devicePK)
+	})
+}
+
+// SigChainRevokeDevice revokes a device from the sig chain of the account,
+// the entry is signed by the current device
+func (a *Account) SigChainRevokeDevice(devicePK crypto.PubKey) (*SigChainEntry, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.appendSigChainEntry(func(chain *SigChain, deviceSK crypto.PrivKey) (*SigChainEntry, error) {
+		return chain.RevokeDevice(deviceSK, devicePK)
+	})
+}
+
+// SigChainMerge merges a sig chain received from another device of the
+// account with the local one, the entries of the current device dropped by
+// the merge are appended again if they still apply
+func (a *Account) SigChainMerge(remote *SigChain) (*SigChain, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	local, err := a.getOrInitSigChain()
+	if err != nil {
+		return nil, err
+	}
+
+	merged, err := MergeSigChains(local, remote)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceSK, err := a.getOrGenerateNamedKey(keyDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	devicePK, err := deviceSK.GetPublic().Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	state, err := merged.check()
+	if err != nil {
+		return nil, err
+	}
+
+	kept := map[string]struct{}{}
+	for _, e := range merged.Entries {
+		kept[string(e.Payload)] = struct{}{}
+	}
+
+	for _, e := range local.Entries {
+		if _, ok := kept[string(e.Payload)]; ok {
+			continue
+		}
+
+		payload := &SigChainEntryPayload{}
+		if err := payload.Unmarshal(e.Payload); err != nil {
+			return nil, errcode.ErrDeserialization.Wrap(err)
+		}
+
+		if !bytes.Equal(payload.SignerPK, devicePK) {
+			continue
+		}
+
+		subject, err := crypto.UnmarshalEd25519PublicKey(payload.SubjectPK)
+		if err != nil {
+			return nil, errcode.ErrDeserialization.Wrap(err)
+		}
+
+		// the operation may have been done by another device or the
+		// current device may have been revoked
+		_, err = merged.appendEntry(state, deviceSK, payload.EntryType, subject)
+		switch err {
+		case nil, errcode.ErrSigChainOperationAlreadyDone, errcode.ErrSigChainPermission, errcode.ErrInvalidInput:
+		default:
+			return nil, err
+		}
+	}
+
+	if err := a.putSigChain(merged); err != nil {
+		return nil, err
+	}
+
+	return merged, nil
+}
+
+func (a *Account) appendSigChainEntry(appendFunc func(chain *SigChain, deviceSK crypto.PrivKey) (*SigChainEntry, error)) (*SigChainEntry, error) {
+	chain, err := a.getOrInitSigChain()
+	if err != nil {
+		return nil, err
+	}
+
+	deviceSK, err := a.getOrGenerateNamedKey(keyDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	entry, err := appendFunc(chain, deviceSK)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.putSigChain(chain); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+func (a *Account) getOrInitSigChain() (*SigChain, error) {
+	data, err := a.ds.Get(sigChainKey)
+	if err == nil {
+		chain := &SigChain{}
+		if err := chain.Unmarshal(data); err != nil {
+			return nil, errcode.ErrDeserialization.Wrap(err)
+		}
+
+		return chain, nil
+	} else if err != datastore.ErrNotFound {
+		return nil, errcode.ErrPersistenceGet.Wrap(err)
+	}
+
+	accountSK, err := a.getOrGenerateNamedKey(keyAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceSK, err := a.getOrGenerateNamedKey(keyDevice)
+	if err != nil {
+		return nil, err
+	}
+
+	chain := &SigChain{}
+	if _, err := chain.Init(accountSK, deviceSK.GetPublic()); err != nil {
+		return nil, err
+	}
+
+	if err := a.putSigChain(chain); err != nil {
+		return nil, err
+	}
+
+	return chain, nil
+}
+
+func (a *Account) putSigChain(chain *SigChain) error {
+	data, err := chain.Marshal()
+	if err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	if err := a.ds.Put(sigChainKey, data); err != nil {
+		return errcode.ErrPersistencePut.Wrap(err)
+	}
+
+	return nil
+}
+
 // ContactGroupPrivKey retrieves the account signing key associated with the supplied contact pub key
 func (a *Account) ContactGroupPrivKey(pk crypto.PubKey) (crypto.PrivKey, error) {
 	accountSK, err := a.AccountPrivKey()
@@ -82,14 +263,14 @@ func (a *Account) memberDeviceForMultiMemberGroup(groupPK crypto.PubKey) (*OwnMe
 	}, nil
 }
 
-func (a *Account) MemberDeviceForGroup(g *bertyprotocol.Group) (*OwnMemberDevice, error) {
+func (a *Account) MemberDeviceForGroup(g *bertytypes.Group) (*OwnMemberDevice, error) {
 	pk, err := g.GetPubKey()
 	if err != nil {
 		return nil, errcode.ErrInvalidInput.Wrap(err)
 	}
 
 	switch g.GroupType {
-	case bertyprotocol.GroupTypeAccount, bertyprotocol.GroupTypeContact:
+	case bertytypes.GroupTypeAccount, bertytypes.GroupTypeContact:
 		memberSK, err := a.AccountPrivKey()
 		if err != nil {
 			return nil, err
@@ -105,7 +286,7 @@ func (a *Account) MemberDeviceForGroup(g *bertyprotocol.Group) (*OwnMemberDevice
 			Device: deviceSK,
 		}, nil
 
-	case bertyprotocol.GroupTypeMultiMember:
+	case bertytypes.GroupTypeMultiMember:
 		return a.memberDeviceForMultiMemberGroup(pk)
 	}
 
@@ -132,6 +313,9 @@ func (a *Account) getOrGenerateNamedKey(name string) (crypto.PrivKey, error) {
 	return sk, nil
 }
 
+// getOrGenerateDeviceKeyForGroupDevice returns the device key used in a
+// multi-member group, it is generated by the device and can't be computed
+// by the other devices of the account
 func (a *Account) getOrGenerateDeviceKeyForGroupDevice(pk crypto.PubKey) (crypto.PrivKey, error) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
@@ -192,16 +376,20 @@ func (a *Account) getOrComputeDeviceKeyForGroupMember(pk crypto.PubKey) (crypto.
 
 // New creates a new Account instance, if the keystore does not hold an account key, one will be created when required
 func New(ks keystore.Keystore) *Account {
+	return NewWithDatastore(ks, ds_sync.MutexWrap(datastore.NewMapDatastore()))
+}
+
+// NewWithDatastore creates a new Account instance persisting its sig chain in the supplied datastore
+func NewWithDatastore(ks keystore.Keystore, ds datastore.Datastore) *Account {
 	return &Account{
 		ks: ks,
+		ds: ds,
 	}
 }
 
 // NewWithExistingKeys creates a new Account instance and registers the supplied secret key, useful when migrating account to another device
 func NewWithExistingKeys(ks keystore.Keystore, sk crypto.PrivKey, proofSK crypto.PrivKey) (*Account, error) {
-	acc := &Account{
-		ks: ks,
-	}
+	acc := New(ks)
 
 	if err := ks.Put(keyAccount, sk); err != nil {
 		return nil, err
@@ -213,3 +401,29 @@ func NewWithExistingKeys(ks keystore.Keystore, sk crypto.PrivKey, proofSK crypto
 
 	return acc, nil
 }
+
+// NewLinkedDevice creates a new Account instance for a device joining an existing account, the account keys and the sig chain are
+// the ones sent by another device of the account, the sig chain must include the current device
+func NewLinkedDevice(ks keystore.Keystore, ds datastore.Datastore, sk crypto.PrivKey, proofSK crypto.PrivKey, deviceSK crypto.PrivKey, chain *SigChain) (*Account, error) {
+	if err := chain.VerifyDevice(sk.GetPublic(), deviceSK.GetPublic()); err != nil {
+		return nil, err
+	}
+
+	acc := NewWithDatastore(ks, ds)
+
+	for name, key := range map[string]crypto.PrivKey{
+		keyAccount:      sk,
+		keyAccountProof: proofSK,
+		keyDevice:       deviceSK,
+	} {
+		if err := ks.Put(name, key); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := acc.putSigChain(chain); err != nil {
+		return nil, err
+	}
+
+	return acc, nil
+}
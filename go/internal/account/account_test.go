@@ -6,7 +6,7 @@ import (
 	keystore "github.com/ipfs/go-ipfs-keystore"
 	"github.com/stretchr/testify/assert"
 
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
 func Test_New_AccountPrivKey_AccountProofPrivKey(t *testing.T) {
@@ -149,7 +149,7 @@ func Test_MemberDeviceForGroup_multimember(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotNil(t, acc2)
 
-	g, _, err := bertyprotocol.NewGroupMultiMember()
+	g, _, err := bertytypes.NewGroupMultiMember()
 	assert.NoError(t, err)
 
 	omd1, err := acc1.MemberDeviceForGroup(g)
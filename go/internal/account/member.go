@@ -7,7 +7,7 @@ import (
 
 	"github.com/libp2p/go-libp2p-core/crypto"
 
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
@@ -28,10 +28,10 @@ func (d *OwnMemberDevice) Public() *MemberDevice {
 type MemberDevice struct {
 	Member crypto.PubKey
 	Device crypto.PubKey
-	Secret *bertyprotocol.DeviceSecret
+	Secret *bertytypes.DeviceSecret
 }
 
-func NewDeviceSecret() (*bertyprotocol.DeviceSecret, error) {
+func NewDeviceSecret() (*bertytypes.DeviceSecret, error) {
 	counter, err := rand.Int(rand.Reader, big.NewInt(0).SetUint64(math.MaxUint64))
 	if err != nil {
 		return nil, errcode.ErrRandomGenerationFailed.Wrap(err)
@@ -43,7 +43,7 @@ func NewDeviceSecret() (*bertyprotocol.DeviceSecret, error) {
 		return nil, errcode.ErrRandomGenerationFailed.Wrap(err)
 	}
 
-	return &bertyprotocol.DeviceSecret{
+	return &bertytypes.DeviceSecret{
 		ChainKey: chainKey,
 		Counter:  counter.Uint64(),
 	}, nil
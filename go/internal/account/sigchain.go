@@ -0,0 +1,442 @@
+package account
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+// sigChainState is the state of a sig chain after its entries have been
+// replayed
+type sigChainState struct {
+	accountPK crypto.PubKey
+	devices   map[string]crypto.PubKey
+	revoked   map[string]struct{}
+	lastHash  []byte
+	count     uint64
+}
+
+func newSigChainState() *sigChainState {
+	return &sigChainState{
+		devices: map[string]crypto.PubKey{},
+		revoked: map[string]struct{}{},
+	}
+}
+
+// clone returns a copy of the state which can be updated independently
+func (s *sigChainState) clone() *sigChainState {
+	c := &sigChainState{
+		accountPK: s.accountPK,
+		devices:   make(map[string]crypto.PubKey, len(s.devices)),
+		revoked:   make(map[string]struct{}, len(s.revoked)),
+		lastHash:  s.lastHash,
+		count:     s.count,
+	}
+
+	for k, v := range s.devices {
+		c.devices[k] = v
+	}
+
+	for k := range s.revoked {
+		c.revoked[k] = struct{}{}
+	}
+
+	return c
+}
+
+// canSign checks whether the key can sign a new entry, which is only the
+// case of the devices which haven't been revoked, the account key is shared
+// with every linked device so it only signs the first entry
+func (s *sigChainState) canSign(signerPK []byte) bool {
+	if s.accountPK == nil {
+		return false
+	}
+
+	_, ok := s.devices[string(signerPK)]
+
+	return ok
+}
+
+// apply checks a payload against the current state and updates it
+func (s *sigChainState) apply(payload *SigChainEntryPayload) error {
+	if payload.Index != s.count || !bytes.Equal(payload.ParentHash, s.lastHash) {
+		return errcode.ErrInvalidInput
+	}
+
+	subject, err := crypto.UnmarshalEd25519PublicKey(payload.SubjectPK)
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	switch payload.EntryType {
+	case SigChainEntryPayload_InitChain:
+		if s.count != 0 {
+			return errcode.ErrSigChainAlreadyInitialized
+		}
+
+		s.accountPK, err = crypto.UnmarshalEd25519PublicKey(payload.SignerPK)
+		if err != nil {
+			return errcode.ErrDeserialization.Wrap(err)
+		}
+
+		s.devices[string(payload.SubjectPK)] = subject
+
+	case SigChainEntryPayload_AddDevice:
+		if !s.canSign(payload.SignerPK) {
+			return errcode.ErrSigChainPermission
+		}
+
+		if _, ok := s.devices[string(payload.SubjectPK)]; ok {
+			return errcode.ErrSigChainOperationAlreadyDone
+		}
+
+		if _, ok := s.revoked[string(payload.SubjectPK)]; ok {
+			return errcode.ErrSigChainOperationAlreadyDone
+		}
+
+		s.devices[string(payload.SubjectPK)] = subject
+
+	case SigChainEntryPayload_RevokeDevice:
+		// any current device can revoke any other device of the account,
+		// including the first one, so a lost device can be revoked from
+		// the remaining ones, but the account must keep a device
+		if !s.canSign(payload.SignerPK) {
+			return errcode.ErrSigChainPermission
+		}
+
+		if _, ok := s.revoked[string(payload.SubjectPK)]; ok {
+			return errcode.ErrSigChainOperationAlreadyDone
+		}
+
+		if _, ok := s.devices[string(payload.SubjectPK)]; !ok {
+			return errcode.ErrInvalidInput
+		}
+
+		if len(s.devices) == 1 {
+			return errcode.ErrSigChainPermission
+		}
+
+		delete(s.devices, string(payload.SubjectPK))
+		s.revoked[string(payload.SubjectPK)] = struct{}{}
+
+	default:
+		return errcode.ErrSigChainInvalidEntryType
+	}
+
+	return nil
+}
+
+// check verifies the signatures and the links of every entry of the chain
+// and returns its resulting state
+func (m *SigChain) check() (*sigChainState, error) {
+	if len(m.Entries) == 0 {
+		return nil, errcode.ErrSigChainNoEntries
+	}
+
+	state := newSigChainState()
+
+	for _, e := range m.Entries {
+		payload := &SigChainEntryPayload{}
+		if err := payload.Unmarshal(e.Payload); err != nil {
+			return nil, errcode.ErrDeserialization.Wrap(err)
+		}
+
+		if state.count == 0 && payload.EntryType != SigChainEntryPayload_InitChain {
+			return nil, errcode.ErrSigChainInvalidEntryType
+		}
+
+		signer, err := crypto.UnmarshalEd25519PublicKey(payload.SignerPK)
+		if err != nil {
+			return nil, errcode.ErrDeserialization.Wrap(err)
+		}
+
+		if ok, err := signer.Verify(e.Payload, e.Signature); err != nil {
+			return nil, errcode.ErrSignatureVerificationFailed.Wrap(err)
+		} else if !ok {
+			return nil, errcode.ErrSignatureVerificationFailed
+		}
+
+		if err := state.apply(payload); err != nil {
+			return nil, err
+		}
+
+		hash := sha256.Sum256(e.Payload)
+		state.lastHash = hash[:]
+		state.count++
+	}
+
+	return state, nil
+}
+
+// appendEntry signs a new entry and appends it to the chain, state is
+// updated accordingly
+func (m *SigChain) appendEntry(state *sigChainState, signer crypto.PrivKey, entryType SigChainEntryPayload_Type, subject crypto.PubKey) (*SigChainEntry, error) {
+	signerPK, err := signer.GetPublic().Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	subjectPK, err := subject.Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	payload := &SigChainEntryPayload{
+		EntryType:  entryType,
+		Index:      state.count,
+		ParentHash: state.lastHash,
+		CreatedAt:  time.Now().UnixNano(),
+		SignerPK:   signerPK,
+		SubjectPK:  subjectPK,
+	}
+
+	if err := state.apply(payload); err != nil {
+		return nil, err
+	}
+
+	data, err := payload.Marshal()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	sig, err := signer.Sign(data)
+	if err != nil {
+		return nil, errcode.ErrSignatureFailed.Wrap(err)
+	}
+
+	entry := &SigChainEntry{
+		Payload:   data,
+		Signature: sig,
+	}
+
+	m.Entries = append(m.Entries, entry)
+
+	hash := sha256.Sum256(data)
+	state.lastHash = hash[:]
+	state.count++
+
+	return entry, nil
+}
+
+// Init creates the first entry of the chain, signed by the account key and
+// adding the first device of the account
+func (m *SigChain) Init(accountSK crypto.PrivKey, devicePK crypto.PubKey) (*SigChainEntry, error) {
+	if len(m.Entries) > 0 {
+		return nil, errcode.ErrSigChainAlreadyInitialized
+	}
+
+	return m.appendEntry(newSigChainState(), accountSK, SigChainEntryPayload_InitChain, devicePK)
+}
+
+// AddDevice appends an entry adding a device to the account, the signer must
+// be one of its current devices
+func (m *SigChain) AddDevice(signer crypto.PrivKey, devicePK crypto.PubKey) (*SigChainEntry, error) {
+	state, err := m.check()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.appendEntry(state, signer, SigChainEntryPayload_AddDevice, devicePK)
+}
+
+// RevokeDevice appends an entry revoking a device of the account, the signer
+// must be one of its current devices
+func (m *SigChain) RevokeDevice(signer crypto.PrivKey, devicePK crypto.PubKey) (*SigChainEntry, error) {
+	state, err := m.check()
+	if err != nil {
+		return nil, err
+	}
+
+	return m.appendEntry(state, signer, SigChainEntryPayload_RevokeDevice, devicePK)
+}
+
+// sigChainNode is an entry of the tree formed by the entries of several
+// chains of the same account
+type sigChainNode struct {
+	entry   *SigChainEntry
+	payload *SigChainEntryPayload
+	hash    []byte
+}
+
+// MergeSigChains merges chains of the same account appended concurrently by
+// several devices. The chains share their entries until they fork, at each
+// fork the branch revoking a device which signed an entry of the other one is
+// kept, so a device can't undo its revocation, otherwise the branch whose
+// first entry has the lowest hash is kept. The result only depends on the
+// entries of the chains, the dropped entries must be appended again by their
+// signers.
+func MergeSigChains(chains ...*SigChain) (*SigChain, error) {
+	var initHash []byte
+
+	children := map[string][]*sigChainNode{}
+	known := map[string]struct{}{}
+
+	for _, chain := range chains {
+		if _, err := chain.check(); err != nil {
+			return nil, err
+		}
+
+		parent := ""
+
+		for _, e := range chain.Entries {
+			sum := sha256.Sum256(e.Payload)
+			hash := sum[:]
+
+			if parent == "" {
+				if initHash == nil {
+					initHash = hash
+				} else if !bytes.Equal(initHash, hash) {
+					return nil, errcode.ErrSigChainPermission
+				}
+			}
+
+			if _, ok := known[string(hash)]; !ok {
+				payload := &SigChainEntryPayload{}
+				if err := payload.Unmarshal(e.Payload); err != nil {
+					return nil, errcode.ErrDeserialization.Wrap(err)
+				}
+
+				known[string(hash)] = struct{}{}
+				children[parent] = append(children[parent], &sigChainNode{entry: e, payload: payload, hash: hash})
+			}
+
+			parent = string(hash)
+		}
+	}
+
+	if initHash == nil {
+		return nil, errcode.ErrSigChainNoEntries
+	}
+
+	branch := bestSigChainBranch(children, "", newSigChainState())
+
+	merged := &SigChain{Entries: make([]*SigChainEntry, len(branch))}
+	for i, n := range branch {
+		merged.Entries[i] = n.entry
+	}
+
+	return merged, nil
+}
+
+// bestSigChainBranch returns the branch kept by MergeSigChains after the
+// given entry
+func bestSigChainBranch(children map[string][]*sigChainNode, parent string, state *sigChainState) []*sigChainNode {
+	nodes := children[parent]
+	sort.Slice(nodes, func(i, j int) bool {
+		return bytes.Compare(nodes[i].hash, nodes[j].hash) < 0
+	})
+
+	var best []*sigChainNode
+
+	for _, n := range nodes {
+		next := state.clone()
+		if err := next.apply(n.payload); err != nil {
+			continue
+		}
+
+		next.lastHash = n.hash
+		next.count++
+
+		branch := append([]*sigChainNode{n}, bestSigChainBranch(children, string(n.hash), next)...)
+		if best == nil || (revokesSigner(branch, best) && !revokesSigner(best, branch)) {
+			best = branch
+		}
+	}
+
+	return best
+}
+
+// revokesSigner returns whether a branch revokes a device which signed an
+// entry of another branch
+func revokesSigner(branch []*sigChainNode, other []*sigChainNode) bool {
+	for _, n := range branch {
+		if n.payload.EntryType != SigChainEntryPayload_RevokeDevice {
+			continue
+		}
+
+		for _, o := range other {
+			if bytes.Equal(n.payload.SubjectPK, o.payload.SignerPK) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// IsPrefixOf returns whether every entry of the chain is also an entry of the
+// other chain, at the same position, both chains are expected to be valid
+func (m *SigChain) IsPrefixOf(other *SigChain) bool {
+	if len(m.Entries) == 0 || len(m.Entries) > len(other.Entries) {
+		return false
+	}
+
+	// each entry holds the hash of the previous one
+	last := len(m.Entries) - 1
+
+	return bytes.Equal(m.Entries[last].Payload, other.Entries[last].Payload)
+}
+
+// Verify checks the integrity of the chain
+func (m *SigChain) Verify() error {
+	_, err := m.check()
+
+	return err
+}
+
+// AccountPubKey returns the public key of the account owning the chain
+func (m *SigChain) AccountPubKey() (crypto.PubKey, error) {
+	state, err := m.check()
+	if err != nil {
+		return nil, err
+	}
+
+	return state.accountPK, nil
+}
+
+// ListDevices returns the devices of the account which haven't been revoked
+func (m *SigChain) ListDevices() ([]crypto.PubKey, error) {
+	state, err := m.check()
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make([]crypto.PubKey, len(state.devices))
+	i := 0
+
+	for _, d := range state.devices {
+		devices[i] = d
+		i++
+	}
+
+	return devices, nil
+}
+
+// VerifyDevice checks that the chain belongs to the account and that the
+// device is one of its current devices
+func (m *SigChain) VerifyDevice(accountPK crypto.PubKey, devicePK crypto.PubKey) error {
+	state, err := m.check()
+	if err != nil {
+		return err
+	}
+
+	if !state.accountPK.Equals(accountPK) {
+		return errcode.ErrSigChainPermission
+	}
+
+	devicePKBytes, err := devicePK.Raw()
+	if err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	if _, ok := state.devices[string(devicePKBytes)]; !ok {
+		return errcode.ErrSigChainPermission
+	}
+
+	return nil
+}
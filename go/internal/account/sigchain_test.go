@@ -0,0 +1,320 @@
+package account
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	keystore "github.com/ipfs/go-ipfs-keystore"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+func Test_SigChain_Init_AddDevice_RevokeDevice(t *testing.T) {
+	accountSK, accountPK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	device1SK, device1PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	device2SK, device2PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	_, otherPK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	chain := &SigChain{}
+
+	assert.Equal(t, errcode.ErrSigChainNoEntries, chain.Verify())
+
+	_, err = chain.Init(accountSK, device1PK)
+	require.NoError(t, err)
+
+	_, err = chain.Init(accountSK, device1PK)
+	assert.Equal(t, errcode.ErrSigChainAlreadyInitialized, err)
+
+	pk, err := chain.AccountPubKey()
+	require.NoError(t, err)
+	assert.True(t, pk.Equals(accountPK))
+
+	_, err = chain.AddDevice(device2SK, otherPK)
+	assert.Equal(t, errcode.ErrSigChainPermission, err)
+
+	_, err = chain.AddDevice(device1SK, device2PK)
+	require.NoError(t, err)
+
+	_, err = chain.AddDevice(device1SK, device2PK)
+	assert.Equal(t, errcode.ErrSigChainOperationAlreadyDone, err)
+
+	// the account key is held by every device, it can't sign once the chain
+	// is initialized
+	_, err = chain.AddDevice(accountSK, otherPK)
+	assert.Equal(t, errcode.ErrSigChainPermission, err)
+
+	devices, err := chain.ListDevices()
+	require.NoError(t, err)
+	assert.Len(t, devices, 2)
+
+	assert.NoError(t, chain.VerifyDevice(accountPK, device2PK))
+	assert.Equal(t, errcode.ErrSigChainPermission, chain.VerifyDevice(otherPK, device2PK))
+
+	_, err = chain.RevokeDevice(device2SK, device1PK)
+	require.NoError(t, err)
+
+	assert.Equal(t, errcode.ErrSigChainPermission, chain.VerifyDevice(accountPK, device1PK))
+
+	_, err = chain.AddDevice(device1SK, otherPK)
+	assert.Equal(t, errcode.ErrSigChainPermission, err)
+
+	_, err = chain.AddDevice(device2SK, device1PK)
+	assert.Equal(t, errcode.ErrSigChainOperationAlreadyDone, err)
+
+	assert.Len(t, chain.Entries, 3)
+	assert.NoError(t, chain.Verify())
+
+	// tampering with an entry breaks the chain
+	chain.Entries[1].Signature[0] ^= 1
+	assert.Error(t, chain.Verify())
+	chain.Entries[1].Signature[0] ^= 1
+
+	chain.Entries = append(chain.Entries[:1], chain.Entries[2:]...)
+	assert.Error(t, chain.Verify())
+}
+
+func Test_Account_SigChain(t *testing.T) {
+	ks := keystore.NewMemKeystore()
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+
+	acc := NewWithDatastore(ks, ds)
+
+	accountSK, err := acc.AccountPrivKey()
+	require.NoError(t, err)
+
+	deviceSK, err := acc.DevicePrivKey()
+	require.NoError(t, err)
+
+	chain, err := acc.SigChain()
+	require.NoError(t, err)
+	assert.NoError(t, chain.VerifyDevice(accountSK.GetPublic(), deviceSK.GetPublic()))
+
+	_, otherDevicePK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	_, err = acc.SigChainAddDevice(otherDevicePK)
+	require.NoError(t, err)
+
+	// the chain is persisted in the datastore
+	chain, err = NewWithDatastore(ks, ds).SigChain()
+	require.NoError(t, err)
+	assert.Len(t, chain.Entries, 2)
+	assert.NoError(t, chain.VerifyDevice(accountSK.GetPublic(), otherDevicePK))
+
+	_, err = acc.SigChainRevokeDevice(otherDevicePK)
+	require.NoError(t, err)
+
+	chain, err = acc.SigChain()
+	require.NoError(t, err)
+	assert.Error(t, chain.VerifyDevice(accountSK.GetPublic(), otherDevicePK))
+}
+
+func Test_SigChain_RevokeDevice_Permissions(t *testing.T) {
+	accountSK, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	device1SK, device1PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	device2SK, device2PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	_, device3PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	chain := &SigChain{}
+
+	_, err = chain.Init(accountSK, device1PK)
+	require.NoError(t, err)
+
+	// the account must keep a device
+	_, err = chain.RevokeDevice(device1SK, device1PK)
+	assert.Equal(t, errcode.ErrSigChainPermission, err)
+
+	_, err = chain.AddDevice(device1SK, device2PK)
+	require.NoError(t, err)
+
+	_, err = chain.AddDevice(device2SK, device3PK)
+	require.NoError(t, err)
+
+	// any current device can revoke another one, even the first device
+	_, err = chain.RevokeDevice(device2SK, device1PK)
+	require.NoError(t, err)
+
+	// a revoked device can't revoke the remaining ones
+	_, err = chain.RevokeDevice(device1SK, device3PK)
+	assert.Equal(t, errcode.ErrSigChainPermission, err)
+
+	_, err = chain.RevokeDevice(device2SK, device3PK)
+	require.NoError(t, err)
+
+	_, err = chain.RevokeDevice(device2SK, device2PK)
+	assert.Equal(t, errcode.ErrSigChainPermission, err)
+
+	devices, err := chain.ListDevices()
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	assert.True(t, devices[0].Equals(device2PK))
+}
+
+func copySigChain(t *testing.T, chain *SigChain) *SigChain {
+	t.Helper()
+
+	data, err := chain.Marshal()
+	require.NoError(t, err)
+
+	c := &SigChain{}
+	require.NoError(t, c.Unmarshal(data))
+
+	return c
+}
+
+func Test_MergeSigChains(t *testing.T) {
+	accountSK, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	device1SK, device1PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	device2SK, device2PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	_, device3PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	_, device4PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	base := &SigChain{}
+
+	_, err = base.Init(accountSK, device1PK)
+	require.NoError(t, err)
+
+	_, err = base.AddDevice(device1SK, device2PK)
+	require.NoError(t, err)
+
+	_, err = MergeSigChains()
+	assert.Equal(t, errcode.ErrSigChainNoEntries, err)
+
+	// concurrent additions, the same branch is kept whatever the order of
+	// the chains
+	chain1 := copySigChain(t, base)
+	_, err = chain1.AddDevice(device1SK, device3PK)
+	require.NoError(t, err)
+
+	chain2 := copySigChain(t, base)
+	_, err = chain2.AddDevice(device2SK, device4PK)
+	require.NoError(t, err)
+
+	merged, err := MergeSigChains(chain1, chain2, base)
+	require.NoError(t, err)
+	require.NoError(t, merged.Verify())
+	require.Len(t, merged.Entries, 3)
+	assert.True(t, base.IsPrefixOf(merged))
+
+	reversed, err := MergeSigChains(chain2, base, chain1)
+	require.NoError(t, err)
+	assert.Equal(t, merged.Entries, reversed.Entries)
+	assert.True(t, merged.IsPrefixOf(chain1) != merged.IsPrefixOf(chain2))
+
+	// a revoked device can't undo its revocation by appending concurrently
+	revocation := copySigChain(t, base)
+	_, err = revocation.RevokeDevice(device1SK, device2PK)
+	require.NoError(t, err)
+
+	for _, chains := range [][]*SigChain{{revocation, chain2}, {chain2, revocation}} {
+		merged, err = MergeSigChains(chains...)
+		require.NoError(t, err)
+		assert.Equal(t, revocation.Entries, merged.Entries)
+	}
+
+	// the chains of another account can't be merged
+	otherAccountSK, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	other := &SigChain{}
+	_, err = other.Init(otherAccountSK, device1PK)
+	require.NoError(t, err)
+
+	_, err = MergeSigChains(base, other)
+	assert.Equal(t, errcode.ErrSigChainPermission, err)
+}
+
+func Test_Account_SigChainMerge(t *testing.T) {
+	acc1 := NewWithDatastore(keystore.NewMemKeystore(), ds_sync.MutexWrap(datastore.NewMapDatastore()))
+
+	accountSK, err := acc1.AccountPrivKey()
+	require.NoError(t, err)
+
+	accountProofSK, err := acc1.AccountProofPrivKey()
+	require.NoError(t, err)
+
+	device2SK, device2PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	_, err = acc1.SigChainAddDevice(device2PK)
+	require.NoError(t, err)
+
+	chain, err := acc1.SigChain()
+	require.NoError(t, err)
+
+	acc2, err := NewLinkedDevice(keystore.NewMemKeystore(), ds_sync.MutexWrap(datastore.NewMapDatastore()), accountSK, accountProofSK, device2SK, chain)
+	require.NoError(t, err)
+
+	_, device3PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	_, device4PK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	_, err = acc1.SigChainAddDevice(device3PK)
+	require.NoError(t, err)
+
+	_, err = acc2.SigChainAddDevice(device4PK)
+	require.NoError(t, err)
+
+	chain1, err := acc1.SigChain()
+	require.NoError(t, err)
+
+	chain2, err := acc2.SigChain()
+	require.NoError(t, err)
+
+	// one of the concurrent entries is dropped by the merge, its signer
+	// appends it again
+	merged1, err := acc1.SigChainMerge(chain2)
+	require.NoError(t, err)
+
+	merged2, err := acc2.SigChainMerge(chain1)
+	require.NoError(t, err)
+
+	// the devices converge once they have exchanged their chains
+	merged1, err = acc1.SigChainMerge(merged2)
+	require.NoError(t, err)
+
+	merged2, err = acc2.SigChainMerge(merged1)
+	require.NoError(t, err)
+
+	assert.Equal(t, merged1.Entries, merged2.Entries)
+	require.NoError(t, merged1.Verify())
+
+	devices, err := merged1.ListDevices()
+	require.NoError(t, err)
+	assert.Len(t, devices, 4)
+
+	// the result is persisted
+	chain1, err = acc1.SigChain()
+	require.NoError(t, err)
+	assert.Equal(t, merged1.Entries, chain1.Entries)
+}
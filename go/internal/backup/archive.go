@@ -0,0 +1,266 @@
+package backup
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const (
+	// ArchiveVersion is the version of the archives created by NewWriter
+	ArchiveVersion = 2
+
+	// ChunkSize is the maximum size of the plaintext of an encrypted chunk
+	ChunkSize = 64 * 1024
+
+	saltSize        = 32
+	nonceSize       = 24
+	noncePrefixSize = nonceSize - 8
+	keySize         = 32
+	maxHeaderSize   = 1024
+
+	chunkFlagLast = 1
+
+	// scrypt parameters recommended for interactive logins
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// Writer encrypts an archive using a key derived from a passphrase, the
+// plaintext is split in chunks sealed separately so the archive can be
+// streamed, Close must be called to write the last chunk
+type Writer struct {
+	w       io.Writer
+	key     *[keySize]byte
+	prefix  []byte
+	counter uint64
+	buf     []byte
+	closed  bool
+}
+
+// NewWriter writes the header of an archive to w and returns a writer
+// encrypting the content written to it
+func NewWriter(w io.Writer, passphrase []byte) (*Writer, error) {
+	if len(passphrase) == 0 {
+		return nil, errcode.ErrMissingInput
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errcode.ErrRandomGenerationFailed.Wrap(err)
+	}
+
+	prefix := make([]byte, noncePrefixSize)
+	if _, err := rand.Read(prefix); err != nil {
+		return nil, errcode.ErrRandomGenerationFailed.Wrap(err)
+	}
+
+	key, err := deriveKey(passphrase, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := (&Archive{
+		Version: ArchiveVersion,
+		Salt:    salt,
+		Nonce:   prefix,
+	}).Marshal()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	if err := writeDelimited(w, header); err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		w:      w,
+		key:    key,
+		prefix: prefix,
+		buf:    make([]byte, 0, ChunkSize+1),
+	}, nil
+}
+
+// Write buffers p and writes the full chunks
+func (a *Writer) Write(p []byte) (int, error) {
+	if a.closed {
+		return 0, errcode.ErrInvalidInput
+	}
+
+	n := len(p)
+	for len(p) > 0 {
+		// a chunk is only flushed once more data is available so the last
+		// one is never empty unless the whole content is
+		if len(a.buf) == ChunkSize {
+			if err := a.flush(false); err != nil {
+				return 0, err
+			}
+		}
+
+		written := copy(a.buf[len(a.buf):ChunkSize], p)
+		a.buf = a.buf[:len(a.buf)+written]
+		p = p[written:]
+	}
+
+	return n, nil
+}
+
+// Close writes the last chunk, it does not close the underlying writer
+func (a *Writer) Close() error {
+	if a.closed {
+		return nil
+	}
+
+	a.closed = true
+
+	return a.flush(true)
+}
+
+func (a *Writer) flush(last bool) error {
+	flag := byte(0)
+	if last {
+		flag = chunkFlagLast
+	}
+
+	plaintext := append([]byte{flag}, a.buf...)
+	sealed := secretbox.Seal(nil, plaintext, chunkNonce(a.prefix, a.counter), a.key)
+
+	a.counter++
+	a.buf = a.buf[:0]
+
+	return writeDelimited(a.w, sealed)
+}
+
+// Reader decrypts an archive created by a Writer, a missing or truncated
+// chunk is reported as an error
+type Reader struct {
+	r       io.Reader
+	key     *[keySize]byte
+	prefix  []byte
+	counter uint64
+	buf     []byte
+	last    bool
+}
+
+// NewReader reads the header of an archive from r and returns a reader
+// decrypting its content
+func NewReader(r io.Reader, passphrase []byte) (*Reader, error) {
+	if len(passphrase) == 0 {
+		return nil, errcode.ErrMissingInput
+	}
+
+	header, err := readDelimited(r, maxHeaderSize)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &Archive{}
+	if err := archive.Unmarshal(header); err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if archive.Version != ArchiveVersion || len(archive.Nonce) != noncePrefixSize || len(archive.Salt) != saltSize {
+		return nil, errcode.ErrInvalidInput
+	}
+
+	key, err := deriveKey(passphrase, archive.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		r:      r,
+		key:    key,
+		prefix: archive.Nonce,
+	}, nil
+}
+
+// Read decrypts the chunks of the archive as needed
+func (a *Reader) Read(p []byte) (int, error) {
+	for len(a.buf) == 0 {
+		if a.last {
+			return 0, io.EOF
+		}
+
+		if err := a.next(); err != nil {
+			return 0, err
+		}
+	}
+
+	n := copy(p, a.buf)
+	a.buf = a.buf[n:]
+
+	return n, nil
+}
+
+func (a *Reader) next() error {
+	sealed, err := readDelimited(a.r, ChunkSize+1+secretbox.Overhead)
+	if err == io.EOF {
+		return errcode.ErrCryptoDecrypt.Wrap(io.ErrUnexpectedEOF)
+	} else if err != nil {
+		return err
+	}
+
+	plaintext, ok := secretbox.Open(nil, sealed, chunkNonce(a.prefix, a.counter), a.key)
+	if !ok || len(plaintext) == 0 {
+		return errcode.ErrCryptoDecrypt
+	}
+
+	a.counter++
+	a.last = plaintext[0] == chunkFlagLast
+	a.buf = plaintext[1:]
+
+	return nil
+}
+
+// WriteContent writes the datastore entries of the archive content, the CAR
+// file holding the group logs is expected to follow
+func WriteContent(w io.Writer, content *ArchiveContent) error {
+	data, err := content.Marshal()
+	if err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	return writeDelimited(w, data)
+}
+
+// ReadContent reads the datastore entries written by WriteContent
+func ReadContent(r io.Reader, maxSize uint64) (*ArchiveContent, error) {
+	data, err := readDelimited(r, maxSize)
+	if err != nil {
+		return nil, err
+	}
+
+	content := &ArchiveContent{}
+	if err := content.Unmarshal(data); err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	return content, nil
+}
+
+func chunkNonce(prefix []byte, counter uint64) *[nonceSize]byte {
+	nonce := [nonceSize]byte{}
+	copy(nonce[:], prefix)
+	binary.BigEndian.PutUint64(nonce[noncePrefixSize:], counter)
+
+	return &nonce
+}
+
+func deriveKey(passphrase, salt []byte) (*[keySize]byte, error) {
+	derived, err := scrypt.Key(passphrase, salt, scryptN, scryptR, scryptP, keySize)
+	if err != nil {
+		return nil, errcode.ErrCryptoKeyConversion.Wrap(err)
+	}
+
+	key := [keySize]byte{}
+	copy(key[:], derived)
+
+	return &key, nil
+}
@@ -0,0 +1,130 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriterReader(t *testing.T) {
+	content := &ArchiveContent{
+		Entries: []*DatastoreEntry{{Key: "/account/key", Value: []byte("value")}},
+	}
+
+	logs := make([]byte, 3*ChunkSize+42)
+	_, err := rand.Read(logs)
+	require.NoError(t, err)
+
+	archive := &bytes.Buffer{}
+	w, err := NewWriter(archive, []byte("passphrase"))
+	require.NoError(t, err)
+	require.NoError(t, WriteContent(w, content))
+	_, err = w.Write(logs)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r, err := NewReader(bytes.NewReader(archive.Bytes()), []byte("passphrase"))
+	require.NoError(t, err)
+
+	opened, err := ReadContent(r, ChunkSize)
+	require.NoError(t, err)
+	require.Equal(t, content.Entries, opened.Entries)
+
+	read, err := ioutil.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, logs, read)
+
+	r, err = NewReader(bytes.NewReader(archive.Bytes()), []byte("wrong passphrase"))
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(r)
+	require.Error(t, err)
+
+	// dropping the last chunk must not go unnoticed
+	r, err = NewReader(bytes.NewReader(archive.Bytes()[:archive.Len()-10]), []byte("passphrase"))
+	require.NoError(t, err)
+	_, err = ioutil.ReadAll(r)
+	require.Error(t, err)
+
+	_, err = NewWriter(archive, nil)
+	require.Error(t, err)
+}
+
+func TestCAR(t *testing.T) {
+	blocks := map[cid.Cid][]byte{}
+	roots := []cid.Cid(nil)
+
+	for _, data := range []string{"a", "b", "c"} {
+		id, err := cid.Prefix{
+			Version:  1,
+			Codec:    cid.DagCBOR,
+			MhType:   multihash.SHA2_256,
+			MhLength: -1,
+		}.Sum([]byte(data))
+		require.NoError(t, err)
+
+		blocks[id] = []byte(data)
+		roots = append(roots, id)
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := NewCARWriter(buf, roots[:2])
+	require.NoError(t, err)
+
+	for id, data := range blocks {
+		require.NoError(t, w.Put(id, data))
+	}
+
+	r, err := NewCARReader(buf, ChunkSize)
+	require.NoError(t, err)
+	require.Equal(t, roots[:2], r.Roots())
+
+	read := map[cid.Cid][]byte{}
+	for {
+		id, data, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+
+		require.NoError(t, err)
+		read[id] = data
+	}
+
+	require.Equal(t, blocks, read)
+}
+
+func TestExportImportDatastore(t *testing.T) {
+	src := datastore.NewMapDatastore()
+	require.NoError(t, src.Put(datastore.NewKey("/account/a"), []byte("a")))
+	require.NoError(t, src.Put(datastore.NewKey("/account/b"), []byte("b")))
+	require.NoError(t, src.Put(datastore.NewKey("/other/c"), []byte("c")))
+
+	entries, err := ExportDatastore(src, datastore.NewKey("account"))
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	dst := datastore.NewMapDatastore()
+	require.NoError(t, dst.Put(datastore.NewKey("/account/stale"), []byte("stale")))
+	require.NoError(t, ClearDatastore(dst, datastore.NewKey("account")))
+	require.NoError(t, ImportDatastore(dst, datastore.NewKey("account"), entries))
+
+	value, err := dst.Get(datastore.NewKey("/account/a"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("a"), value)
+
+	has, err := dst.Has(datastore.NewKey("/account/stale"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.NoError(t, CheckEntries(entries, datastore.NewKey("account"), datastore.NewKey("messages")))
+	require.Error(t, CheckEntries(entries, datastore.NewKey("messages"), datastore.NewKey("account")))
+
+	entries = append(entries, &DatastoreEntry{Key: "/other/c", Value: []byte("c")})
+	require.Error(t, CheckEntries(entries, datastore.NewKey("account"), datastore.NewKey("messages")))
+}
@@ -0,0 +1,334 @@
+package backup
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/ipfs/go-cid"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const (
+	carVersion       = 1
+	maxCARHeaderSize = 1 << 20
+
+	cborMajorUint  = 0
+	cborMajorBytes = 2
+	cborMajorText  = 3
+	cborMajorArray = 4
+	cborMajorMap   = 5
+	cborMajorTag   = 6
+
+	// cborTagCID is the tag used by DAG-CBOR for links
+	cborTagCID = 42
+)
+
+// CARWriter writes ipfs blocks using the CAR v1 format
+type CARWriter struct {
+	w io.Writer
+}
+
+// NewCARWriter writes the header of a CAR file listing the given roots
+func NewCARWriter(w io.Writer, roots []cid.Cid) (*CARWriter, error) {
+	if err := writeDelimited(w, encodeCARHeader(roots)); err != nil {
+		return nil, err
+	}
+
+	return &CARWriter{w: w}, nil
+}
+
+// Put writes a block, the caller is responsible for the data matching the CID
+func (c *CARWriter) Put(id cid.Cid, data []byte) error {
+	section := append(id.Bytes(), data...)
+
+	return writeDelimited(c.w, section)
+}
+
+// CARReader reads the blocks of a CAR v1 file
+type CARReader struct {
+	r            io.Reader
+	roots        []cid.Cid
+	maxBlockSize uint64
+}
+
+// NewCARReader reads the header of a CAR file
+func NewCARReader(r io.Reader, maxBlockSize uint64) (*CARReader, error) {
+	header, err := readDelimited(r, maxCARHeaderSize)
+	if err == io.EOF {
+		return nil, errcode.ErrDeserialization.Wrap(io.ErrUnexpectedEOF)
+	} else if err != nil {
+		return nil, err
+	}
+
+	roots, err := decodeCARHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CARReader{r: r, roots: roots, maxBlockSize: maxBlockSize}, nil
+}
+
+// Roots returns the roots listed in the header
+func (c *CARReader) Roots() []cid.Cid {
+	return c.roots
+}
+
+// Next returns the next block, io.EOF is returned once every block has been
+// read, the data is not checked against the CID
+func (c *CARReader) Next() (cid.Cid, []byte, error) {
+	section, err := readDelimited(c.r, c.maxBlockSize)
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+
+	n, id, err := cid.CidFromBytes(section)
+	if err != nil {
+		return cid.Undef, nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	return id, section[n:], nil
+}
+
+func encodeCARHeader(roots []cid.Cid) []byte {
+	// keys are sorted as required by DAG-CBOR: shortest first
+	header := cborHead(cborMajorMap, 2)
+	header = append(header, cborText("roots")...)
+	header = append(header, cborHead(cborMajorArray, uint64(len(roots)))...)
+
+	for _, root := range roots {
+		// links are prefixed by the multibase identity prefix
+		link := append([]byte{0}, root.Bytes()...)
+
+		header = append(header, cborHead(cborMajorTag, cborTagCID)...)
+		header = append(header, cborHead(cborMajorBytes, uint64(len(link)))...)
+		header = append(header, link...)
+	}
+
+	header = append(header, cborText("version")...)
+	header = append(header, cborHead(cborMajorUint, carVersion)...)
+
+	return header
+}
+
+func decodeCARHeader(data []byte) ([]cid.Cid, error) {
+	d := &cborDecoder{data: data}
+
+	entries, err := d.head(cborMajorMap)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		roots   []cid.Cid
+		version uint64
+	)
+
+	for i := uint64(0); i < entries; i++ {
+		key, err := d.text()
+		if err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case "roots":
+			count, err := d.head(cborMajorArray)
+			if err != nil {
+				return nil, err
+			}
+
+			for j := uint64(0); j < count; j++ {
+				root, err := d.link()
+				if err != nil {
+					return nil, err
+				}
+
+				roots = append(roots, root)
+			}
+
+		case "version":
+			if version, err = d.head(cborMajorUint); err != nil {
+				return nil, err
+			}
+
+		default:
+			return nil, errcode.ErrDeserialization
+		}
+	}
+
+	if version != carVersion || len(d.data) != 0 {
+		return nil, errcode.ErrDeserialization
+	}
+
+	return roots, nil
+}
+
+func cborHead(major byte, value uint64) []byte {
+	major <<= 5
+
+	switch {
+	case value < 24:
+		return []byte{major | byte(value)}
+	case value <= 0xff:
+		return []byte{major | 24, byte(value)}
+	case value <= 0xffff:
+		buf := []byte{major | 25, 0, 0}
+		binary.BigEndian.PutUint16(buf[1:], uint16(value))
+		return buf
+	case value <= 0xffffffff:
+		buf := []byte{major | 26, 0, 0, 0, 0}
+		binary.BigEndian.PutUint32(buf[1:], uint32(value))
+		return buf
+	default:
+		buf := []byte{major | 27, 0, 0, 0, 0, 0, 0, 0, 0}
+		binary.BigEndian.PutUint64(buf[1:], value)
+		return buf
+	}
+}
+
+func cborText(value string) []byte {
+	return append(cborHead(cborMajorText, uint64(len(value))), value...)
+}
+
+// cborDecoder only handles the subset of DAG-CBOR used by CAR headers
+type cborDecoder struct {
+	data []byte
+}
+
+func (d *cborDecoder) head(major byte) (uint64, error) {
+	if len(d.data) == 0 || d.data[0]>>5 != major {
+		return 0, errcode.ErrDeserialization
+	}
+
+	info := d.data[0] & 0x1f
+	d.data = d.data[1:]
+
+	size := 0
+	switch {
+	case info < 24:
+		return uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, errcode.ErrDeserialization
+	}
+
+	if len(d.data) < size {
+		return 0, errcode.ErrDeserialization
+	}
+
+	value := uint64(0)
+	for _, b := range d.data[:size] {
+		value = value<<8 | uint64(b)
+	}
+
+	d.data = d.data[size:]
+
+	return value, nil
+}
+
+func (d *cborDecoder) bytes(major byte) ([]byte, error) {
+	size, err := d.head(major)
+	if err != nil {
+		return nil, err
+	}
+
+	if uint64(len(d.data)) < size {
+		return nil, errcode.ErrDeserialization
+	}
+
+	value := d.data[:size]
+	d.data = d.data[size:]
+
+	return value, nil
+}
+
+func (d *cborDecoder) text() (string, error) {
+	value, err := d.bytes(cborMajorText)
+	if err != nil {
+		return "", err
+	}
+
+	return string(value), nil
+}
+
+func (d *cborDecoder) link() (cid.Cid, error) {
+	if tag, err := d.head(cborMajorTag); err != nil {
+		return cid.Undef, err
+	} else if tag != cborTagCID {
+		return cid.Undef, errcode.ErrDeserialization
+	}
+
+	value, err := d.bytes(cborMajorBytes)
+	if err != nil {
+		return cid.Undef, err
+	}
+
+	if len(value) == 0 || value[0] != 0 {
+		return cid.Undef, errcode.ErrDeserialization
+	}
+
+	id, err := cid.Cast(value[1:])
+	if err != nil {
+		return cid.Undef, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	return id, nil
+}
+
+func writeDelimited(w io.Writer, data []byte) error {
+	size := make([]byte, binary.MaxVarintLen64)
+	size = size[:binary.PutUvarint(size, uint64(len(data)))]
+
+	if _, err := w.Write(append(size, data...)); err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	return nil
+}
+
+// readDelimited returns io.EOF if r is empty, a truncated value is reported
+// as an error
+func readDelimited(r io.Reader, maxSize uint64) ([]byte, error) {
+	size, err := binary.ReadUvarint(byteReader{r})
+	if err == io.EOF {
+		return nil, io.EOF
+	} else if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if size > maxSize {
+		return nil, errcode.ErrDeserialization
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	return data, nil
+}
+
+// byteReader reads the bytes one by one so nothing is read past the varint
+type byteReader struct {
+	io.Reader
+}
+
+func (b byteReader) ReadByte() (byte, error) {
+	buf := [1]byte{}
+	if _, err := io.ReadFull(b.Reader, buf[:]); err != nil {
+		return 0, err
+	}
+
+	return buf[0], nil
+}
@@ -0,0 +1,111 @@
+package backup
+
+import (
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+// ExportDatastore returns every entry of the datastore stored under the
+// given prefix, keys are kept unchanged
+func ExportDatastore(ds datastore.Datastore, prefix datastore.Key) ([]*DatastoreEntry, error) {
+	results, err := ds.Query(query.Query{Prefix: prefix.String()})
+	if err != nil {
+		return nil, errcode.ErrPersistenceGet.Wrap(err)
+	}
+
+	entries, err := results.Rest()
+	if err != nil {
+		return nil, errcode.ErrPersistenceGet.Wrap(err)
+	}
+
+	ret := make([]*DatastoreEntry, len(entries))
+	for i, e := range entries {
+		ret[i] = &DatastoreEntry{Key: e.Key, Value: e.Value}
+	}
+
+	return ret, nil
+}
+
+// ClearDatastore removes every entry of the datastore stored under the given
+// prefix
+func ClearDatastore(ds datastore.Datastore, prefix datastore.Key) error {
+	results, err := ds.Query(query.Query{Prefix: prefix.String(), KeysOnly: true})
+	if err != nil {
+		return errcode.ErrPersistenceGet.Wrap(err)
+	}
+
+	entries, err := results.Rest()
+	if err != nil {
+		return errcode.ErrPersistenceGet.Wrap(err)
+	}
+
+	for _, e := range entries {
+		if err := ds.Delete(datastore.NewKey(e.Key)); err != nil {
+			return errcode.ErrPersistencePut.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// ImportDatastore writes the exported entries stored under the given prefix
+// to the datastore in a single batch, other entries are ignored
+func ImportDatastore(ds datastore.Batching, prefix datastore.Key, entries []*DatastoreEntry) error {
+	batch, err := ds.Batch()
+	if err != nil {
+		return errcode.ErrPersistencePut.Wrap(err)
+	}
+
+	for _, e := range entries {
+		key := datastore.NewKey(e.Key)
+		if !prefix.IsAncestorOf(key) {
+			continue
+		}
+
+		if err := batch.Put(key, e.Value); err != nil {
+			return errcode.ErrPersistencePut.Wrap(err)
+		}
+	}
+
+	if err := batch.Commit(); err != nil {
+		return errcode.ErrPersistencePut.Wrap(err)
+	}
+
+	return nil
+}
+
+// CheckEntries checks that every exported entry is stored under the required
+// prefix or one of the allowed ones, and that the required prefix holds at
+// least one entry
+func CheckEntries(entries []*DatastoreEntry, required datastore.Key, allowed ...datastore.Key) error {
+	found := false
+
+	for _, e := range entries {
+		key := datastore.NewKey(e.Key)
+
+		if required.IsAncestorOf(key) {
+			found = true
+			continue
+		}
+
+		known := false
+		for _, prefix := range allowed {
+			if prefix.IsAncestorOf(key) {
+				known = true
+				break
+			}
+		}
+
+		if !known {
+			return errcode.ErrInvalidInput
+		}
+	}
+
+	if !found {
+		return errcode.ErrInvalidInput
+	}
+
+	return nil
+}
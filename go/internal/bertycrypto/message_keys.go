@@ -18,16 +18,16 @@ import (
 	"golang.org/x/crypto/nacl/secretbox"
 
 	"berty.tech/berty/go/internal/account"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
 type MessageKeys interface {
 	// GetDeviceChainKey gets a device key chain from the key holder
-	GetDeviceChainKey(ctx context.Context, pk crypto.PubKey) (*bertyprotocol.DeviceSecret, error)
+	GetDeviceChainKey(ctx context.Context, pk crypto.PubKey) (*bertytypes.DeviceSecret, error)
 
 	// PutDeviceChainKey puts a key chain into the key holder
-	PutDeviceChainKey(ctx context.Context, device crypto.PubKey, ds *bertyprotocol.DeviceSecret) error
+	PutDeviceChainKey(ctx context.Context, device crypto.PubKey, ds *bertytypes.DeviceSecret) error
 
 	// GetPrecomputedKey gets a precomputed key for a device and its message counter value
 	GetPrecomputedKey(ctx context.Context, device crypto.PubKey, counter uint64) (*[32]byte, error)
@@ -48,7 +48,7 @@ type MessageKeys interface {
 	GetPrecomputedKeyExpectedCount() int
 }
 
-func OpenPayload(ctx context.Context, m MessageKeys, id cid.Cid, payload []byte, headers *bertyprotocol.MessageHeaders) ([]byte, *DecryptInfo, error) {
+func OpenPayload(ctx context.Context, m MessageKeys, id cid.Cid, payload []byte, headers *bertytypes.MessageHeaders) ([]byte, *DecryptInfo, error) {
 	var (
 		err error
 		di  = &DecryptInfo{
@@ -81,13 +81,17 @@ func OpenPayload(ctx context.Context, m MessageKeys, id cid.Cid, payload []byte,
 	return msg, di, nil
 }
 
-func updateCurrentKey(ctx context.Context, m MessageKeys, pk crypto.PubKey, ds *bertyprotocol.DeviceSecret) error {
+func updateCurrentKey(ctx context.Context, m MessageKeys, pk crypto.PubKey, ds *bertytypes.DeviceSecret) error {
 	currentCK, err := m.GetDeviceChainKey(ctx, pk)
 	if err != nil {
 		return errcode.ErrInternal.Wrap(err)
 	}
 
-	if ds.Counter < currentCK.Counter {
+	if ds.Generation < currentCK.Generation {
+		return nil
+	}
+
+	if ds.Generation == currentCK.Generation && ds.Counter < currentCK.Counter {
 		return nil
 	}
 
@@ -98,7 +102,7 @@ func updateCurrentKey(ctx context.Context, m MessageKeys, pk crypto.PubKey, ds *
 	return nil
 }
 
-func SealPayload(payload []byte, ds *bertyprotocol.DeviceSecret, deviceSK crypto.PrivKey, g *bertyprotocol.Group) ([]byte, []byte, error) {
+func SealPayload(payload []byte, ds *bertytypes.DeviceSecret, deviceSK crypto.PrivKey, g *bertytypes.Group) ([]byte, []byte, error) {
 	var (
 		msgKey [32]byte
 		err    error
@@ -116,7 +120,7 @@ func SealPayload(payload []byte, ds *bertyprotocol.DeviceSecret, deviceSK crypto
 	return secretbox.Seal(nil, payload, uint64AsNonce(ds.Counter+1), &msgKey), sig, nil
 }
 
-func SealEnvelopeInternal(payload []byte, ds *bertyprotocol.DeviceSecret, deviceSK crypto.PrivKey, g *bertyprotocol.Group) ([]byte, error) {
+func SealEnvelopeInternal(payload []byte, ds *bertytypes.DeviceSecret, deviceSK crypto.PrivKey, g *bertytypes.Group) ([]byte, error) {
 	encryptedPayload, sig, err := SealPayload(payload, ds, deviceSK, g)
 	if err != nil {
 		return nil, errcode.ErrCryptoEncrypt.Wrap(err)
@@ -127,7 +131,7 @@ func SealEnvelopeInternal(payload []byte, ds *bertyprotocol.DeviceSecret, device
 		return nil, errcode.ErrSerialization.Wrap(err)
 	}
 
-	headers, err := proto.Marshal(&bertyprotocol.MessageHeaders{
+	headers, err := proto.Marshal(&bertytypes.MessageHeaders{
 		Counter:  ds.Counter + 1,
 		DevicePK: devicePKRaw,
 		Sig:      sig,
@@ -148,7 +152,7 @@ func SealEnvelopeInternal(payload []byte, ds *bertyprotocol.DeviceSecret, device
 
 	encryptedHeaders := secretbox.Seal(nil, headers, nonce, sk)
 
-	env, err := proto.Marshal(&bertyprotocol.MessageEnvelope{
+	env, err := proto.Marshal(&bertytypes.MessageEnvelope{
 		MessageHeaders: encryptedHeaders,
 		Message:        encryptedPayload,
 		Nonce:          nonceSlice,
@@ -160,7 +164,7 @@ func SealEnvelopeInternal(payload []byte, ds *bertyprotocol.DeviceSecret, device
 	return env, nil
 }
 
-func SealEnvelope(ctx context.Context, mkh MessageKeys, g *bertyprotocol.Group, deviceSK crypto.PrivKey, payload []byte) ([]byte, error) {
+func SealEnvelope(ctx context.Context, mkh MessageKeys, g *bertytypes.Group, deviceSK crypto.PrivKey, payload []byte) ([]byte, error) {
 	if deviceSK == nil || g == nil || mkh == nil {
 		return nil, errcode.ErrInvalidInput
 	}
@@ -182,7 +186,7 @@ func SealEnvelope(ctx context.Context, mkh MessageKeys, g *bertyprotocol.Group,
 	return env, nil
 }
 
-func DeriveDeviceSecret(ctx context.Context, mkh MessageKeys, g *bertyprotocol.Group, deviceSK crypto.PrivKey) error {
+func DeriveDeviceSecret(ctx context.Context, mkh MessageKeys, g *bertytypes.Group, deviceSK crypto.PrivKey) error {
 	if mkh == nil || deviceSK == nil {
 		return errcode.ErrInvalidInput
 	}
@@ -197,9 +201,10 @@ func DeriveDeviceSecret(ctx context.Context, mkh MessageKeys, g *bertyprotocol.G
 		return errcode.ErrSecretKeyGenerationFailed.Wrap(err)
 	}
 
-	if err = mkh.PutDeviceChainKey(ctx, deviceSK.GetPublic(), &bertyprotocol.DeviceSecret{
-		ChainKey: ck,
-		Counter:  ds.Counter + 1,
+	if err = mkh.PutDeviceChainKey(ctx, deviceSK.GetPublic(), &bertytypes.DeviceSecret{
+		ChainKey:   ck,
+		Counter:    ds.Counter + 1,
+		Generation: ds.Generation,
 	}); err != nil {
 		return errcode.ErrSecretKeyGenerationFailed.Wrap(err)
 	}
@@ -217,7 +222,7 @@ type DecryptInfo struct {
 	Cid            cid.Cid
 }
 
-func OpenEnvelope(ctx context.Context, m MessageKeys, g *bertyprotocol.Group, data []byte, id cid.Cid) (*bertyprotocol.MessageHeaders, []byte, *DecryptInfo, error) {
+func OpenEnvelope(ctx context.Context, m MessageKeys, g *bertytypes.Group, data []byte, id cid.Cid) (*bertytypes.MessageHeaders, []byte, *DecryptInfo, error) {
 	if m == nil || g == nil {
 		return nil, nil, nil, errcode.ErrInvalidInput
 	}
@@ -235,8 +240,8 @@ func OpenEnvelope(ctx context.Context, m MessageKeys, g *bertyprotocol.Group, da
 	return headers, msg, decryptInfo, nil
 }
 
-func OpenEnvelopeHeaders(data []byte, g *bertyprotocol.Group) (*bertyprotocol.MessageEnvelope, *bertyprotocol.MessageHeaders, error) {
-	env := &bertyprotocol.MessageEnvelope{}
+func OpenEnvelopeHeaders(data []byte, g *bertytypes.Group) (*bertytypes.MessageEnvelope, *bertytypes.MessageHeaders, error) {
+	env := &bertytypes.MessageEnvelope{}
 	err := env.Unmarshal(data)
 	if err != nil {
 		return nil, nil, errcode.ErrDeserialization.Wrap(err)
@@ -257,7 +262,7 @@ func OpenEnvelopeHeaders(data []byte, g *bertyprotocol.Group) (*bertyprotocol.Me
 		return nil, nil, errcode.ErrCryptoDecrypt
 	}
 
-	headers := &bertyprotocol.MessageHeaders{}
+	headers := &bertytypes.MessageHeaders{}
 	if err := headers.Unmarshal(headersBytes); err != nil {
 		return nil, nil, errcode.ErrDeserialization.Wrap(err)
 	}
@@ -279,7 +284,7 @@ func nonceAsArr(nonceSl []byte) (*[24]byte, error) {
 	return &out, nil
 }
 
-func PostDecryptActions(ctx context.Context, m MessageKeys, di *DecryptInfo, g *bertyprotocol.Group, ownPK crypto.PubKey, headers *bertyprotocol.MessageHeaders) error {
+func PostDecryptActions(ctx context.Context, m MessageKeys, di *DecryptInfo, g *bertytypes.Group, ownPK crypto.PubKey, headers *bertytypes.MessageHeaders) error {
 	// Message was newly decrypted, we can save the message key and derive
 	// future keys if necessary.
 	if di == nil || !di.NewlyDecrypted {
@@ -287,7 +292,7 @@ func PostDecryptActions(ctx context.Context, m MessageKeys, di *DecryptInfo, g *
 	}
 
 	var (
-		ds  *bertyprotocol.DeviceSecret
+		ds  *bertytypes.DeviceSecret
 		err error
 	)
 
@@ -374,7 +379,7 @@ func deriveNextKeys(ck []byte, salt []byte, groupID []byte) ([]byte, [32]byte, e
 	return nextCK, nextMsg, nil
 }
 
-func PreComputeKeys(ctx context.Context, m MessageKeys, device crypto.PubKey, g *bertyprotocol.Group, ds *bertyprotocol.DeviceSecret) (*bertyprotocol.DeviceSecret, error) {
+func PreComputeKeys(ctx context.Context, m MessageKeys, device crypto.PubKey, g *bertytypes.Group, ds *bertytypes.DeviceSecret) (*bertytypes.DeviceSecret, error) {
 	ck := ds.ChainKey
 	counter := ds.Counter
 
@@ -411,13 +416,14 @@ func PreComputeKeys(ctx context.Context, m MessageKeys, device crypto.PubKey, g
 		ck = newCK
 	}
 
-	return &bertyprotocol.DeviceSecret{
-		Counter:  counter,
-		ChainKey: ck,
+	return &bertytypes.DeviceSecret{
+		Counter:    counter,
+		ChainKey:   ck,
+		Generation: ds.Generation,
 	}, nil
 }
 
-func DeviceSecret(ctx context.Context, g *bertyprotocol.Group, mk MessageKeys, acc *account.Account) (*bertyprotocol.DeviceSecret, error) {
+func DeviceSecret(ctx context.Context, g *bertytypes.Group, mk MessageKeys, acc *account.Account) (*bertytypes.DeviceSecret, error) {
 	md, err := acc.MemberDeviceForGroup(g)
 	if err != nil {
 		return nil, errcode.ErrInternal.Wrap(err)
@@ -445,11 +451,11 @@ func DeviceSecret(ctx context.Context, g *bertyprotocol.Group, mk MessageKeys, a
 	return ds, nil
 }
 
-func RegisterChainKey(ctx context.Context, mk MessageKeys, g *bertyprotocol.Group, devicePK crypto.PubKey, ds *bertyprotocol.DeviceSecret, isOwnPK bool) error {
+func RegisterChainKey(ctx context.Context, mk MessageKeys, g *bertytypes.Group, devicePK crypto.PubKey, ds *bertytypes.DeviceSecret, isOwnPK bool) error {
 	var err error
 
-	if _, err := mk.GetDeviceChainKey(ctx, devicePK); err == nil {
-		// Device is already registered, ignore it
+	if known, err := mk.GetDeviceChainKey(ctx, devicePK); err == nil && known.Generation >= ds.Generation {
+		// Device is already registered for this generation, ignore it
 		return nil
 	}
 
@@ -473,6 +479,24 @@ func RegisterChainKey(ctx context.Context, mk MessageKeys, g *bertyprotocol.Grou
 	return nil
 }
 
+// RotateDeviceSecret replaces the secret of the current device for the given
+// group by a new one of the given generation, the previous secret will not be
+// used anymore to encrypt messages.
+func RotateDeviceSecret(ctx context.Context, g *bertytypes.Group, mk MessageKeys, devicePK crypto.PubKey, generation uint64) (*bertytypes.DeviceSecret, error) {
+	ds, err := account.NewDeviceSecret()
+	if err != nil {
+		return nil, errcode.ErrSecretKeyGenerationFailed.Wrap(err)
+	}
+
+	ds.Generation = generation
+
+	if err = RegisterChainKey(ctx, mk, g, devicePK, ds, true); err != nil {
+		return nil, errcode.ErrPersistencePut.Wrap(err)
+	}
+
+	return ds, nil
+}
+
 func idForCachedKey(pk []byte, counter uint64) datastore.Key {
 	return datastore.KeyWithNamespaces([]string{"cachedCKs", hex.EncodeToString(pk), fmt.Sprintf("%d", counter)})
 }
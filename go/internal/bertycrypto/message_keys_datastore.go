@@ -9,7 +9,7 @@ import (
 	dssync "github.com/ipfs/go-datastore/sync"
 	"github.com/libp2p/go-libp2p-core/crypto"
 
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
@@ -19,7 +19,7 @@ type DatastoreMessageKeys struct {
 	store                datastore.Datastore
 }
 
-func (m *DatastoreMessageKeys) GetDeviceChainKey(ctx context.Context, pk crypto.PubKey) (*bertyprotocol.DeviceSecret, error) {
+func (m *DatastoreMessageKeys) GetDeviceChainKey(ctx context.Context, pk crypto.PubKey) (*bertytypes.DeviceSecret, error) {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 
@@ -37,7 +37,7 @@ func (m *DatastoreMessageKeys) GetDeviceChainKey(ctx context.Context, pk crypto.
 		return nil, errcode.ErrPersistenceGet.Wrap(err)
 	}
 
-	ds := &bertyprotocol.DeviceSecret{}
+	ds := &bertytypes.DeviceSecret{}
 	if err := ds.Unmarshal(dsBytes); err != nil {
 		return nil, errcode.ErrInvalidInput
 	}
@@ -145,7 +145,7 @@ func (m *DatastoreMessageKeys) GetPrecomputedKeyExpectedCount() int {
 	return m.preComputedKeysCount
 }
 
-func (m *DatastoreMessageKeys) PutDeviceChainKey(ctx context.Context, device crypto.PubKey, ds *bertyprotocol.DeviceSecret) error {
+func (m *DatastoreMessageKeys) PutDeviceChainKey(ctx context.Context, device crypto.PubKey, ds *bertytypes.DeviceSecret) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
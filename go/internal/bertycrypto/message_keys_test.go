@@ -18,10 +18,10 @@ import (
 	"berty.tech/berty/go/internal/bertycrypto"
 	"berty.tech/berty/go/internal/orbitutil"
 	"berty.tech/berty/go/internal/testutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
-func addDummyMemberInMetadataStore(ctx context.Context, t testing.TB, ms orbitutil.MetadataStore, g *bertyprotocol.Group, memberPK crypto.PubKey, join bool) (crypto.PubKey, *bertyprotocol.DeviceSecret) {
+func addDummyMemberInMetadataStore(ctx context.Context, t testing.TB, ms orbitutil.MetadataStore, g *bertytypes.Group, memberPK crypto.PubKey, join bool) (crypto.PubKey, *bertytypes.DeviceSecret) {
 	t.Helper()
 
 	acc := account.New(keystore.NewMemKeystore())
@@ -42,8 +42,8 @@ func addDummyMemberInMetadataStore(ctx context.Context, t testing.TB, ms orbitut
 	return md.Device.GetPublic(), ds
 }
 
-func mustDeviceSecret(t testing.TB) func(ds *bertyprotocol.DeviceSecret, err error) *bertyprotocol.DeviceSecret {
-	return func(ds *bertyprotocol.DeviceSecret, err error) *bertyprotocol.DeviceSecret {
+func mustDeviceSecret(t testing.TB) func(ds *bertytypes.DeviceSecret, err error) *bertytypes.DeviceSecret {
+	return func(ds *bertytypes.DeviceSecret, err error) *bertytypes.DeviceSecret {
 		t.Helper()
 
 		if err != nil {
@@ -54,7 +54,7 @@ func mustDeviceSecret(t testing.TB) func(ds *bertyprotocol.DeviceSecret, err err
 	}
 }
 
-func mustMessageHeaders(t testing.TB, pk crypto.PubKey, counter uint64) *bertyprotocol.MessageHeaders {
+func mustMessageHeaders(t testing.TB, pk crypto.PubKey, counter uint64) *bertytypes.MessageHeaders {
 	t.Helper()
 
 	pkB, err := pk.Raw()
@@ -62,7 +62,7 @@ func mustMessageHeaders(t testing.TB, pk crypto.PubKey, counter uint64) *bertypr
 		t.Fatal(err)
 	}
 
-	return &bertyprotocol.MessageHeaders{
+	return &bertytypes.MessageHeaders{
 		Counter:  counter,
 		DevicePK: pkB,
 		Sig:      nil,
@@ -73,7 +73,7 @@ func Test_EncryptMessagePayload(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	g, gSK, err := bertyprotocol.NewGroupMultiMember()
+	g, gSK, err := bertytypes.NewGroupMultiMember()
 	assert.NoError(t, err)
 
 	_ = gSK
@@ -256,7 +256,7 @@ func Test_EncryptMessageEnvelope(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	g, _, err := bertyprotocol.NewGroupMultiMember()
+	g, _, err := bertytypes.NewGroupMultiMember()
 	assert.NoError(t, err)
 
 	acc1 := account.New(keystore.NewMemKeystore())
@@ -306,7 +306,7 @@ func Test_EncryptMessageEnvelopeAndDerive(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	g, _, err := bertyprotocol.NewGroupMultiMember()
+	g, _, err := bertytypes.NewGroupMultiMember()
 	assert.NoError(t, err)
 
 	acc1 := account.New(keystore.NewMemKeystore())
@@ -389,7 +389,7 @@ func testMessageKeyHolderCatchUp(t *testing.T, expectedNewDevices int, isSlow bo
 	ms1 := peer.GC.MetadataStore()
 
 	devicesPK := make([]crypto.PubKey, expectedNewDevices)
-	deviceSecrets := make([]*bertyprotocol.DeviceSecret, expectedNewDevices)
+	deviceSecrets := make([]*bertytypes.DeviceSecret, expectedNewDevices)
 
 	for i := 0; i < expectedNewDevices; i++ {
 		devicesPK[i], deviceSecrets[i] = addDummyMemberInMetadataStore(ctx, t, ms1, peer.GC.Group(), peer.GC.MemberPubKey(), true)
@@ -448,7 +448,7 @@ func testMessageKeyHolderSubscription(t *testing.T, expectedNewDevices int, isSl
 	ms1 := peer.GC.MetadataStore()
 
 	devicesPK := make([]crypto.PubKey, expectedNewDevices)
-	deviceSecrets := make([]*bertyprotocol.DeviceSecret, expectedNewDevices)
+	deviceSecrets := make([]*bertytypes.DeviceSecret, expectedNewDevices)
 
 	go orbitutil.FillMessageKeysHolderUsingNewData(ctx, peer.GC)
 
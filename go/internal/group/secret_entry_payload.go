@@ -1,25 +1,27 @@
 package group
 
 import (
+	"encoding/binary"
+
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"golang.org/x/crypto/nacl/box"
 
 	"berty.tech/berty/go/internal/cryptoutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
-func OpenDeviceSecret(m *bertyprotocol.GroupMetadata, localMemberPrivateKey crypto.PrivKey, group *bertyprotocol.Group) (crypto.PubKey, *bertyprotocol.DeviceSecret, error) {
-	if m == nil || m.EventType != bertyprotocol.EventTypeGroupDeviceSecretAdded {
+func OpenDeviceSecret(m *bertytypes.GroupMetadata, localMemberPrivateKey crypto.PrivKey, group *bertytypes.Group) (crypto.PubKey, *bertytypes.DeviceSecret, error) {
+	if m == nil || m.EventType != bertytypes.EventTypeGroupDeviceSecretAdded {
 		return nil, nil, errcode.ErrInvalidInput
 	}
 
-	s := &bertyprotocol.GroupAddDeviceSecret{}
+	s := &bertytypes.GroupAddDeviceSecret{}
 	if err := s.Unmarshal(m.Payload); err != nil {
 		return nil, nil, errcode.ErrDeserialization.Wrap(err)
 	}
 
-	nonce, err := groupIDToNonce(group)
+	nonce, err := groupIDToNonce(group, s.Generation)
 	if err != nil {
 		return nil, nil, errcode.ErrSerialization.Wrap(err)
 	}
@@ -34,7 +36,7 @@ func OpenDeviceSecret(m *bertyprotocol.GroupMetadata, localMemberPrivateKey cryp
 		return nil, nil, errcode.ErrCryptoKeyConversion.Wrap(err)
 	}
 
-	decryptedSecret := &bertyprotocol.DeviceSecret{}
+	decryptedSecret := &bertytypes.DeviceSecret{}
 	decryptedMessage, ok := box.Open(nil, s.Payload, nonce, mongPub, mongPriv)
 	if !ok {
 		return nil, nil, errcode.ErrCryptoDecrypt
@@ -45,16 +47,20 @@ func OpenDeviceSecret(m *bertyprotocol.GroupMetadata, localMemberPrivateKey cryp
 		return nil, nil, errcode.ErrDeserialization
 	}
 
+	if decryptedSecret.Generation != s.Generation {
+		return nil, nil, errcode.ErrInvalidInput
+	}
+
 	return senderDevicePubKey, decryptedSecret, nil
 }
 
-func NewSecretEntryPayload(localDevicePrivKey crypto.PrivKey, remoteMemberPubKey crypto.PubKey, secret *bertyprotocol.DeviceSecret, group *bertyprotocol.Group) ([]byte, error) {
+func NewSecretEntryPayload(localDevicePrivKey crypto.PrivKey, remoteMemberPubKey crypto.PubKey, secret *bertytypes.DeviceSecret, group *bertytypes.Group) ([]byte, error) {
 	message, err := secret.Marshal()
 	if err != nil {
 		return nil, errcode.ErrSerialization.Wrap(err)
 	}
 
-	nonce, err := groupIDToNonce(group)
+	nonce, err := groupIDToNonce(group, secret.Generation)
 	if err != nil {
 		return nil, errcode.ErrSerialization.Wrap(err)
 	}
@@ -69,12 +75,13 @@ func NewSecretEntryPayload(localDevicePrivKey crypto.PrivKey, remoteMemberPubKey
 	return encryptedSecret, nil
 }
 
-func groupIDToNonce(group *bertyprotocol.Group) (*[24]byte, error) {
+func groupIDToNonce(group *bertytypes.Group, generation uint64) (*[24]byte, error) {
 	// Nonce doesn't need to be secret, random nor unpredictable, it just needs
 	// to be used only once for a given {sender, receiver} set and we will send
 	// only one SecretEntryPayload per {localDevicePrivKey, remoteMemberPubKey}
-	// So we can reuse groupID as nonce for all SecretEntryPayload and save
-	// 24 bytes of storage and bandwidth for each of them.
+	// and secret generation. So we can reuse groupID mixed with the generation
+	// as nonce for all SecretEntryPayload and save 24 bytes of storage and
+	// bandwidth for each of them.
 	//
 	// See https://pynacl.readthedocs.io/en/stable/secret/#nonce
 	// See Security Model here: https://nacl.cr.yp.to/box.html
@@ -84,5 +91,12 @@ func groupIDToNonce(group *bertyprotocol.Group) (*[24]byte, error) {
 
 	copy(nonce[:], gid)
 
+	gen := make([]byte, 8)
+	binary.BigEndian.PutUint64(gen, generation)
+
+	for i, b := range gen {
+		nonce[16+i] ^= b
+	}
+
 	return &nonce, nil
 }
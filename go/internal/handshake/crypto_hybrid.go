@@ -0,0 +1,130 @@
+package handshake
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+
+	"github.com/cloudflare/circl/kem/mlkem/mlkem768"
+	"golang.org/x/crypto/nacl/box"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const hybridSessionKeyInfo = "berty handshake hybrid session"
+
+// KEM is a key encapsulation mechanism, such as a post-quantum one, used
+// along X25519 by the hybrid version of the handshake
+type KEM interface {
+	// GenerateKeyPair returns a new ephemeral key pair
+	GenerateKeyPair() (pk []byte, sk []byte, err error)
+
+	// Encapsulate returns a new shared secret and its ciphertext for pk
+	Encapsulate(pk []byte) (ciphertext []byte, secret []byte, err error)
+
+	// Decapsulate returns the shared secret of a ciphertext
+	Decapsulate(sk []byte, ciphertext []byte) (secret []byte, err error)
+}
+
+// DefaultKEM is the KEM used by the hybrid version unless another one is set
+// using WithHybridKEM, it is ML-KEM-768 as implemented by circl
+var DefaultKEM KEM = mlkem768KEM{}
+
+type mlkem768KEM struct{}
+
+func (mlkem768KEM) GenerateKeyPair() ([]byte, []byte, error) {
+	pk, sk, err := mlkem768.GenerateKeyPair(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pkBytes, err := pk.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	skBytes, err := sk.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pkBytes, skBytes, nil
+}
+
+func (mlkem768KEM) Encapsulate(pk []byte) ([]byte, []byte, error) {
+	// the encapsulation key is rejected if it isn't properly encoded
+	key, err := mlkem768.Scheme().UnmarshalBinaryPublicKey(pk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mlkem768.Scheme().Encapsulate(key)
+}
+
+func (mlkem768KEM) Decapsulate(sk []byte, ciphertext []byte) ([]byte, error) {
+	key, err := mlkem768.Scheme().UnmarshalBinaryPrivateKey(sk)
+	if err != nil {
+		return nil, err
+	}
+
+	// a ciphertext of the expected size always gives a secret, the implicit
+	// rejection makes an altered one give a secret the other peer won't share
+	return mlkem768.Scheme().Decapsulate(key, ciphertext)
+}
+
+type hybridKEMCtxKey struct{}
+
+type hybridKEMOption struct {
+	kem KEM
+}
+
+// WithHybridKEM returns a context making the handshakes performed using it
+// offer the hybrid version with the supplied KEM instead of DefaultKEM, a nil
+// KEM disables the hybrid version. The keys of the session are derived from
+// both the X25519 and the KEM shared secrets if the other peer supports it.
+func WithHybridKEM(ctx context.Context, kem KEM) context.Context {
+	return context.WithValue(ctx, hybridKEMCtxKey{}, hybridKEMOption{kem: kem})
+}
+
+func hybridKEMFromContext(ctx context.Context) KEM {
+	if opt, ok := ctx.Value(hybridKEMCtxKey{}).(hybridKEMOption); ok {
+		return opt.kem
+	}
+
+	return DefaultKEM
+}
+
+// setHybridSecret derives the key of the session from both the X25519 shared
+// key and the KEM shared secret, it is then used instead of the X25519 one
+func (h *handshakeSession) setHybridSecret(kemSecret []byte) error {
+	if h.otherBoxPK == nil || h.selfBoxPrivateKey == nil || len(kemSecret) == 0 {
+		return errcode.ErrHandshakeSessionInvalid
+	}
+
+	var shared [32]byte
+	box.Precompute(&shared, h.otherBoxPK, h.selfBoxPrivateKey)
+
+	mac := hmac.New(sha256.New, shared[:])
+	_, _ = mac.Write([]byte(hybridSessionKeyInfo))
+	_, _ = mac.Write(kemSecret)
+
+	var key [32]byte
+	copy(key[:], mac.Sum(nil))
+
+	h.hybridKey = &key
+
+	return nil
+}
+
+// sharedKey returns the symmetric key of the session
+func (h *handshakeSession) sharedKey() *[32]byte {
+	if h.hybridKey != nil {
+		return h.hybridKey
+	}
+
+	var shared [32]byte
+	box.Precompute(&shared, h.otherBoxPK, h.selfBoxPrivateKey)
+
+	return &shared
+}
@@ -0,0 +1,280 @@
+package handshake
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cloudflare/circl/kem/mlkem/mlkem768"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+func Test_request_response_Hybrid(t *testing.T) {
+	reqSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	resSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		reqKEM   KEM
+		resKEM   KEM
+		expected uint32
+	}{
+		{name: "both peers are hybrid", reqKEM: DefaultKEM, resKEM: DefaultKEM, expected: handshakeVersion2Hybrid},
+		{name: "the responder is not hybrid", reqKEM: DefaultKEM, expected: handshakeVersion1},
+		{name: "the requester is not hybrid", resKEM: DefaultKEM, expected: handshakeVersion1},
+		{name: "no peer is hybrid", expected: handshakeVersion1},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+
+			// a nil KEM disables the hybrid version
+			reqCtx, resCtx := WithHybridKEM(ctx, c.reqKEM), WithHybridKEM(ctx, c.resKEM)
+
+			reqConn, resConn := net.Pipe()
+			defer reqConn.Close()
+			defer resConn.Close()
+
+			resCh := make(chan *flow, 1)
+
+			go func() {
+				f, err := response(resCtx, resConn, resSK, nil)
+				assert.NoError(t, err)
+				resCh <- f
+			}()
+
+			req, err := request(reqCtx, reqConn, reqSK, resSK.GetPublic(), nil)
+			require.NoError(t, err)
+
+			res := <-resCh
+			require.NotNil(t, res)
+
+			assert.Equal(t, c.expected, req.version)
+			assert.Equal(t, c.expected, res.version)
+			assert.True(t, req.otherPK.Equals(resSK.GetPublic()))
+			assert.True(t, res.otherPK.Equals(reqSK.GetPublic()))
+
+			if c.expected == handshakeVersion2Hybrid {
+				require.NotNil(t, req.session.hybridKey)
+				assert.Equal(t, req.session.hybridKey, res.session.hybridKey)
+			} else {
+				assert.Nil(t, req.session.hybridKey)
+				assert.Nil(t, res.session.hybridKey)
+			}
+
+			reqSend, reqReceive, err := req.session.channelKeys()
+			require.NoError(t, err)
+
+			resSend, resReceive, err := res.session.channelKeys()
+			require.NoError(t, err)
+
+			assert.Equal(t, reqSend, resReceive)
+			assert.Equal(t, reqReceive, resSend)
+		})
+	}
+}
+
+func Test_request_response_HybridDowngrade(t *testing.T) {
+	reqSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	resSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name        string
+		tamper      func(frame *HandshakeFrame)
+		reqExpected error
+		resExpected error
+	}{
+		{
+			name: "the hybrid version is not advertised",
+			tamper: func(frame *HandshakeFrame) {
+				frame.Versions = []uint32{handshakeVersion1}
+				frame.KemPublicKey = nil
+			},
+			reqExpected: errcode.ErrHandshakeUnsupportedVersion,
+		},
+		{
+			name: "the KEM key is missing",
+			tamper: func(frame *HandshakeFrame) {
+				frame.KemPublicKey = nil
+			},
+			reqExpected: errcode.ErrHandshakeUnsupportedVersion,
+			resExpected: errcode.ErrHandshakeUnsupportedVersion,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+			defer cancel()
+
+			reqConn, resConn := newTamperingRelay(func(frame *HandshakeFrame) {
+				if frame.Step == HandshakeFrame_STEP_1_KEY_AGREEMENT {
+					c.tamper(frame)
+				}
+			})
+
+			resErr := make(chan error, 1)
+
+			go func() {
+				_, err := response(ctx, resConn, resSK, nil)
+				resErr <- err
+			}()
+
+			// both peers are hybrid, they fail instead of using another version
+			_, err := request(ctx, reqConn, reqSK, resSK.GetPublic(), nil)
+			testSameErrcodes(t, c.reqExpected, err)
+
+			err = <-resErr
+			require.Error(t, err)
+
+			if c.resExpected != nil {
+				testSameErrcodes(t, c.resExpected, err)
+			}
+		})
+	}
+}
+
+// acvpHex is a byte string hex encoded in the ACVP vectors
+type acvpHex []byte
+
+func (h *acvpHex) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return err
+	}
+
+	*h = b
+
+	return nil
+}
+
+// TestDefaultKEM_KnownAnswer checks DefaultKEM against the ML-KEM-768 vectors
+// of the NIST ACVP server, their source is included in testdata
+func TestDefaultKEM_KnownAnswer(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/mlkem768_acvp.json")
+	require.NoError(t, err)
+
+	var vectors struct {
+		KeyGen struct {
+			Tests []struct {
+				TcID int     `json:"tcId"`
+				D    acvpHex `json:"d"`
+				Z    acvpHex `json:"z"`
+				EK   acvpHex `json:"ek"`
+				DK   acvpHex `json:"dk"`
+			} `json:"tests"`
+		} `json:"keyGen"`
+		Encapsulation struct {
+			Tests []struct {
+				TcID int     `json:"tcId"`
+				EK   acvpHex `json:"ek"`
+				M    acvpHex `json:"m"`
+				C    acvpHex `json:"c"`
+				K    acvpHex `json:"k"`
+			} `json:"tests"`
+		} `json:"encapsulation"`
+		Decapsulation struct {
+			DK    acvpHex `json:"dk"`
+			Tests []struct {
+				TcID int     `json:"tcId"`
+				C    acvpHex `json:"c"`
+				K    acvpHex `json:"k"`
+			} `json:"tests"`
+		} `json:"decapsulation"`
+	}
+
+	require.NoError(t, json.Unmarshal(data, &vectors))
+	require.NotEmpty(t, vectors.KeyGen.Tests)
+	require.NotEmpty(t, vectors.Encapsulation.Tests)
+	require.NotEmpty(t, vectors.Decapsulation.Tests)
+
+	scheme := mlkem768.Scheme()
+
+	for _, tc := range vectors.KeyGen.Tests {
+		t.Run(fmt.Sprintf("keyGen tcId %d", tc.TcID), func(t *testing.T) {
+			pk, sk := scheme.DeriveKeyPair(append(append([]byte{}, tc.D...), tc.Z...))
+
+			ek, err := pk.MarshalBinary()
+			require.NoError(t, err)
+			assert.Equal(t, []byte(tc.EK), ek)
+
+			dk, err := sk.MarshalBinary()
+			require.NoError(t, err)
+			assert.Equal(t, []byte(tc.DK), dk)
+
+			ciphertext, secret, err := DefaultKEM.Encapsulate(tc.EK)
+			require.NoError(t, err)
+
+			decapsulated, err := DefaultKEM.Decapsulate(tc.DK, ciphertext)
+			require.NoError(t, err)
+			assert.Equal(t, secret, decapsulated)
+		})
+	}
+
+	for _, tc := range vectors.Encapsulation.Tests {
+		t.Run(fmt.Sprintf("encapsulation tcId %d", tc.TcID), func(t *testing.T) {
+			pk, err := scheme.UnmarshalBinaryPublicKey(tc.EK)
+			require.NoError(t, err)
+
+			ciphertext, secret, err := scheme.EncapsulateDeterministically(pk, tc.M)
+			require.NoError(t, err)
+			assert.Equal(t, []byte(tc.C), ciphertext)
+			assert.Equal(t, []byte(tc.K), secret)
+		})
+	}
+
+	// the vectors mix valid ciphertexts and malformed ones, for which the
+	// implicit rejection gives J(z‖c), z being the end of the decapsulation key
+	dk := vectors.Decapsulation.DK
+	z := dk[len(dk)-32:]
+	rejected := 0
+
+	for _, tc := range vectors.Decapsulation.Tests {
+		t.Run(fmt.Sprintf("decapsulation tcId %d", tc.TcID), func(t *testing.T) {
+			secret, err := DefaultKEM.Decapsulate(dk, tc.C)
+			require.NoError(t, err)
+			assert.Equal(t, []byte(tc.K), secret)
+
+			rejection := make([]byte, mlkem768.SharedKeySize)
+			sha3.ShakeSum256(rejection, append(append([]byte{}, z...), tc.C...))
+
+			if bytes.Equal(rejection, tc.K) {
+				rejected++
+			}
+		})
+	}
+
+	assert.NotZero(t, rejected)
+	assert.NotEqual(t, len(vectors.Decapsulation.Tests), rejected)
+
+	_, err = DefaultKEM.Decapsulate(dk, vectors.Decapsulation.Tests[0].C[1:])
+	assert.Error(t, err)
+
+	_, _, err = DefaultKEM.Encapsulate(vectors.KeyGen.Tests[0].EK[1:])
+	assert.Error(t, err)
+}
@@ -1,16 +1,22 @@
 package handshake
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/binary"
+	"hash"
 
 	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
 	"golang.org/x/crypto/nacl/box"
+	"golang.org/x/crypto/nacl/secretbox"
 
 	"berty.tech/berty/go/pkg/errcode"
 )
 
 const SupportedKeyType = p2pcrypto.Ed25519
 
+const secureChannelKeyInfo = "berty handshake secure channel"
+
 type handshakeSession struct {
 	ownAccountSK      p2pcrypto.PrivKey
 	selfBoxPrivateKey *[32]byte
@@ -20,6 +26,57 @@ type handshakeSession struct {
 	ownSignSK         p2pcrypto.PrivKey
 	otherSignPK       p2pcrypto.PubKey
 	otherAccountPK    p2pcrypto.PubKey
+
+	// kemSK is the ephemeral KEM key of the requester, otherKEMPK the one
+	// received by the responder and hybridKey the key derived from both
+	// shared secrets when the hybrid version is used
+	kemSK      []byte
+	otherKEMPK []byte
+	hybridKey  *[32]byte
+
+	// transcript hashes the frames of steps 1 and 2, which are sent in
+	// clear, as they have been sent and received. Since the hybrid version it
+	// is bound to every proof so the negotiated version, the capabilities and
+	// the keys can't be altered by a third party, the proofs of the first
+	// version don't include it
+	transcript       hash.Hash
+	transcriptFrames int
+	bindTranscript   bool
+}
+
+// addTranscriptFrame appends the bytes of a frame of the key agreement to
+// the transcript
+func (h *handshakeSession) addTranscriptFrame(data []byte) error {
+	if h.transcriptFrames >= 2 {
+		return errcode.ErrHandshakeSessionInvalid
+	}
+
+	if h.transcript == nil {
+		h.transcript = sha256.New()
+	}
+
+	var size [4]byte
+	binary.BigEndian.PutUint32(size[:], uint32(len(data)))
+
+	_, _ = h.transcript.Write(size[:])
+	_, _ = h.transcript.Write(data)
+	h.transcriptFrames++
+
+	return nil
+}
+
+// proofTranscript returns the hash of the frames of steps 1 and 2 if it is
+// bound to the proofs, it fails until both of them have been exchanged
+func (h *handshakeSession) proofTranscript() ([]byte, error) {
+	if !h.bindTranscript {
+		return nil, nil
+	}
+
+	if h.transcriptFrames != 2 {
+		return nil, errcode.ErrHandshakeSessionInvalid
+	}
+
+	return h.transcript.Sum(nil), nil
 }
 
 func (h *handshakeSession) SetOtherKeys(sign p2pcrypto.PubKey, box []byte) error {
@@ -46,7 +103,7 @@ func (h *handshakeSession) GetPublicKeys() (sign p2pcrypto.PubKey, box []byte) {
 	return h.ownSignSK.GetPublic(), b32Slice(h.selfBoxPublicKey)
 }
 
-func computeValueToProvePubKey(keyToProve p2pcrypto.PubKey, receiverSigKey *[32]byte) ([]byte, error) {
+func computeValueToProvePubKey(keyToProve p2pcrypto.PubKey, receiverSigKey *[32]byte, transcript []byte) ([]byte, error) {
 	if keyToProve == nil || receiverSigKey == nil {
 		return nil, errcode.ErrHandshakeParams
 	}
@@ -57,11 +114,12 @@ func computeValueToProvePubKey(keyToProve p2pcrypto.PubKey, receiverSigKey *[32]
 	}
 
 	signedValue := append(keyToProveBytes, b32Slice(receiverSigKey)...)
+	signedValue = append(signedValue, transcript...)
 
 	return signedValue, nil
 }
 
-func computeValueToProveAccountPK(tempKey *[32]byte, pk p2pcrypto.PubKey) ([]byte, error) {
+func computeValueToProveAccountPK(tempKey *[32]byte, pk p2pcrypto.PubKey, transcript []byte) ([]byte, error) {
 	if tempKey == nil || pk == nil {
 		return nil, errcode.ErrHandshakeParams
 	}
@@ -72,18 +130,24 @@ func computeValueToProveAccountPK(tempKey *[32]byte, pk p2pcrypto.PubKey) ([]byt
 	}
 
 	signedValue := append(pkBytes, b32Slice(tempKey)...)
+	signedValue = append(signedValue, transcript...)
 
 	return signedValue, nil
 }
 
 func (h *handshakeSession) ProveOtherKey() ([]byte, error) {
-	// Step 3a (out) : sig_a(B·b)
+	// Step 3a (out) : sig_a(B·b·t), t being the transcript of steps 1 and 2
+	// when it is bound to the proofs
 	if h.otherAccountPK == nil {
 		return nil, errcode.ErrHandshakeSessionInvalid
 	}
 
-	signedValue, err := computeValueToProvePubKey(h.otherAccountPK, h.otherBoxPK)
+	transcript, err := h.proofTranscript()
+	if err != nil {
+		return nil, err
+	}
 
+	signedValue, err := computeValueToProvePubKey(h.otherAccountPK, h.otherBoxPK, transcript)
 	if err != nil {
 		return nil, err
 	}
@@ -97,8 +161,13 @@ func (h *handshakeSession) ProveOtherKey() ([]byte, error) {
 }
 
 func (h *handshakeSession) CheckOwnKeyProof(sig []byte) error {
-	// Step 3a (in) : ensure sig_a(B·b) is valid
-	signedValue, err := computeValueToProvePubKey(h.ownAccountSK.GetPublic(), h.selfBoxPublicKey)
+	// Step 3a (in) : ensure sig_a(B·b·t) is valid
+	transcript, err := h.proofTranscript()
+	if err != nil {
+		return err
+	}
+
+	signedValue, err := computeValueToProvePubKey(h.ownAccountSK.GetPublic(), h.selfBoxPublicKey, transcript)
 	if err != nil {
 		return err
 	}
@@ -116,8 +185,13 @@ func (h *handshakeSession) CheckOwnKeyProof(sig []byte) error {
 }
 
 func (h *handshakeSession) ProveOwnAccountKey() ([]byte, error) {
-	// Step 4a : sig_B(a)
-	signedValue, err := computeValueToProveAccountPK(h.otherBoxPK, h.ownAccountSK.GetPublic())
+	// Step 4a : sig_B(a·t)
+	transcript, err := h.proofTranscript()
+	if err != nil {
+		return nil, err
+	}
+
+	signedValue, err := computeValueToProveAccountPK(h.otherBoxPK, h.ownAccountSK.GetPublic(), transcript)
 	if err != nil {
 		return nil, err
 	}
@@ -130,9 +204,74 @@ func (h *handshakeSession) ProveOwnAccountKey() ([]byte, error) {
 	return sig, nil
 }
 
+func (h *handshakeSession) ProveOwnDeviceKey(deviceSK p2pcrypto.PrivKey) ([]byte, error) {
+	// Step 4a : sig_d(d·B·t), d being a device of the account
+	transcript, err := h.proofTranscript()
+	if err != nil {
+		return nil, err
+	}
+
+	signedValue, err := computeValueToProveAccountPK(h.otherBoxPK, deviceSK.GetPublic(), transcript)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := deviceSK.Sign(signedValue)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+func (h *handshakeSession) ProveLinkSecret(secret []byte, devicePK p2pcrypto.PubKey) ([]byte, error) {
+	// Step 3b (out) : hmac_s(d·B·t), s being the link secret
+	transcript, err := h.proofTranscript()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := computeValueToProveAccountPK(h.otherBoxPK, devicePK, transcript)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(value)
+
+	return mac.Sum(nil), nil
+}
+
+func (h *handshakeSession) CheckLinkSecretProof(proof []byte, secret []byte, devicePK p2pcrypto.PubKey) error {
+	// Step 3b (in) : ensure hmac_s(d·B·t) is valid
+	transcript, err := h.proofTranscript()
+	if err != nil {
+		return err
+	}
+
+	value, err := computeValueToProveAccountPK(h.selfBoxPublicKey, devicePK, transcript)
+	if err != nil {
+		return err
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	_, _ = mac.Write(value)
+
+	if len(secret) == 0 || !hmac.Equal(mac.Sum(nil), proof) {
+		return errcode.ErrHandshakeInvalidSignature
+	}
+
+	return nil
+}
+
 func (h *handshakeSession) CheckOtherKeyProof(sig []byte, pk p2pcrypto.PubKey) error {
-	// Step 4a : ensure sig_B(a) is valid
-	signedValue, err := computeValueToProveAccountPK(h.selfBoxPublicKey, pk)
+	// Step 4a : ensure sig_B(a·t) is valid
+	transcript, err := h.proofTranscript()
+	if err != nil {
+		return err
+	}
+
+	signedValue, err := computeValueToProveAccountPK(h.selfBoxPublicKey, pk, transcript)
 	if err != nil {
 		return err
 	}
@@ -157,7 +296,12 @@ func (h *handshakeSession) Encrypt(data []byte) ([]byte, error) {
 
 	nonce := h.getNonce()
 
-	out := box.Seal(nil, data, &nonce, h.otherBoxPK, h.selfBoxPrivateKey)
+	var out []byte
+	if h.hybridKey != nil {
+		out = secretbox.Seal(nil, data, &nonce, h.hybridKey)
+	} else {
+		out = box.Seal(nil, data, &nonce, h.otherBoxPK, h.selfBoxPrivateKey)
+	}
 
 	h.incrementNonce()
 
@@ -171,7 +315,17 @@ func (h *handshakeSession) Decrypt(data []byte) ([]byte, error) {
 
 	nonce := h.getNonce()
 
-	out, ok := box.Open(nil, data, &nonce, h.otherBoxPK, h.selfBoxPrivateKey)
+	var (
+		out []byte
+		ok  bool
+	)
+
+	if h.hybridKey != nil {
+		out, ok = secretbox.Open(nil, data, &nonce, h.hybridKey)
+	} else {
+		out, ok = box.Open(nil, data, &nonce, h.otherBoxPK, h.selfBoxPrivateKey)
+	}
+
 	if !ok {
 		return nil, errcode.ErrHandshakeDecrypt
 	}
@@ -198,6 +352,31 @@ func (h *handshakeSession) getNonce() [24]byte {
 	return out
 }
 
+// channelKeys derives the keys of the encrypted channel opened once the
+// handshake is done, a distinct key is used for each direction so the nonces
+// of both peers never collide, the hybrid key is used if one has been agreed
+func (h *handshakeSession) channelKeys() (send *[32]byte, receive *[32]byte, err error) {
+	if h.otherBoxPK == nil || h.selfBoxPrivateKey == nil || h.selfBoxPublicKey == nil {
+		return nil, nil, errcode.ErrHandshakeSessionInvalid
+	}
+
+	shared := h.sharedKey()
+
+	derive := func(from, to *[32]byte) *[32]byte {
+		mac := hmac.New(sha256.New, shared[:])
+		_, _ = mac.Write([]byte(secureChannelKeyInfo))
+		_, _ = mac.Write(from[:])
+		_, _ = mac.Write(to[:])
+
+		var key [32]byte
+		copy(key[:], mac.Sum(nil))
+
+		return &key
+	}
+
+	return derive(h.selfBoxPublicKey, h.otherBoxPK), derive(h.otherBoxPK, h.selfBoxPublicKey), nil
+}
+
 func (h *handshakeSession) Close() error {
 	return nil
 }
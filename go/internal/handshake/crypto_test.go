@@ -19,9 +19,26 @@ func createNewIdentity(t *testing.T) crypto.PrivKey {
 	return sk
 }
 
+// testTranscript are the frames of steps 1 and 2 exchanged by the sessions
+// of createTwoDevices
+var testTranscript = []*HandshakeFrame{
+	{Step: HandshakeFrame_STEP_1_KEY_AGREEMENT, Versions: supportedVersions, Capabilities: supportedCapabilities},
+	{Step: HandshakeFrame_STEP_2_KEY_AGREEMENT, Versions: supportedVersions, Capabilities: supportedCapabilities, Version: HandshakeVersion},
+}
+
 func createTwoDevices(t *testing.T) (*handshakeSession, *handshakeSession) {
 	t.Helper()
 
+	return createTwoDevicesWithTranscripts(t, testTranscript, testTranscript)
+}
+
+// createTwoDevicesWithTranscripts returns the sessions of a requester and of
+// a responder which have respectively seen the frames of reqFrames and
+// resFrames during the key agreement, the transcript is bound to their
+// proofs as in the hybrid version
+func createTwoDevicesWithTranscripts(t *testing.T, reqFrames, resFrames []*HandshakeFrame) (*handshakeSession, *handshakeSession) {
+	t.Helper()
+
 	sk1 := createNewIdentity(t)
 	sk2 := createNewIdentity(t)
 
@@ -42,6 +59,19 @@ func createTwoDevices(t *testing.T) (*handshakeSession, *handshakeSession) {
 	err = hss1.SetOtherKeys(sign, box)
 	require.NoError(t, err, "can't set other keys on hss1")
 
+	for _, hss := range []struct {
+		session *handshakeSession
+		frames  []*HandshakeFrame
+	}{{hss1, reqFrames}, {hss2, resFrames}} {
+		for _, frame := range hss.frames {
+			data, err := frame.Marshal()
+			require.NoError(t, err)
+			require.NoError(t, hss.session.addTranscriptFrame(data))
+		}
+
+		hss.session.bindTranscript = true
+	}
+
 	return hss1, hss2
 }
 
@@ -111,6 +141,83 @@ func TestHandshakeSession_ProveOtherKey_CheckOwnKeyProof(t *testing.T) {
 	testSameErrcodes(t, errcode.ErrHandshakeInvalidSignature, err)
 }
 
+func TestHandshakeSession_TranscriptMismatch(t *testing.T) {
+	// the versions advertised by the requester have been stripped before
+	// reaching the responder
+	downgraded := []*HandshakeFrame{
+		{Step: HandshakeFrame_STEP_1_KEY_AGREEMENT},
+		testTranscript[1],
+	}
+
+	hss1, hss2 := createTwoDevicesWithTranscripts(t, testTranscript, downgraded)
+
+	proof, err := hss1.ProveOtherKey()
+	require.NoError(t, err)
+
+	err = hss2.CheckOwnKeyProof(proof)
+	testSameErrcodes(t, errcode.ErrHandshakeInvalidSignature, err)
+
+	proof, err = hss2.ProveOwnAccountKey()
+	require.NoError(t, err)
+
+	err = hss1.CheckOtherKeyProof(proof, hss2.ownAccountSK.GetPublic())
+	testSameErrcodes(t, errcode.ErrHandshakeInvalidSignature, err)
+}
+
+func TestHandshakeSession_IncompleteTranscript(t *testing.T) {
+	hss1, _ := createTwoDevicesWithTranscripts(t, testTranscript[:1], testTranscript[:1])
+
+	_, err := hss1.ProveOtherKey()
+	testSameErrcodes(t, errcode.ErrHandshakeSessionInvalid, err)
+
+	_, err = hss1.ProveOwnAccountKey()
+	testSameErrcodes(t, errcode.ErrHandshakeSessionInvalid, err)
+}
+
+func TestHandshakeSession_TranscriptUnknownField(t *testing.T) {
+	// a field unknown to both peers has been added to the advertisement of
+	// the requester before reaching the responder
+	altered := *testTranscript[0]
+	altered.XXX_unrecognized = []byte{0x98, 0x06, 0x01}
+
+	hss1, hss2 := createTwoDevicesWithTranscripts(t, testTranscript, []*HandshakeFrame{&altered, testTranscript[1]})
+
+	proof, err := hss1.ProveOtherKey()
+	require.NoError(t, err)
+
+	err = hss2.CheckOwnKeyProof(proof)
+	testSameErrcodes(t, errcode.ErrHandshakeInvalidSignature, err)
+}
+
+func TestHandshakeSession_BaselineProofs(t *testing.T) {
+	// the transcript is not bound to the proofs of the first version, they
+	// are the ones of the peers predating the version negotiation
+	hss1, hss2 := createTwoDevicesWithTranscripts(t, testTranscript, testTranscript[:1])
+	hss1.bindTranscript, hss2.bindTranscript = false, false
+
+	proof, err := hss1.ProveOtherKey()
+	require.NoError(t, err)
+	require.NoError(t, hss2.CheckOwnKeyProof(proof))
+
+	accountKey, err := hss2.ownAccountSK.GetPublic().Raw()
+	require.NoError(t, err)
+
+	ok, err := hss1.ownSignSK.GetPublic().Verify(append(accountKey, hss2.selfBoxPublicKey[:]...), proof)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	proof, err = hss2.ProveOwnAccountKey()
+	require.NoError(t, err)
+	require.NoError(t, hss1.CheckOtherKeyProof(proof, hss2.ownAccountSK.GetPublic()))
+
+	accountKey, err = crypto.MarshalPublicKey(hss2.ownAccountSK.GetPublic())
+	require.NoError(t, err)
+
+	ok, err = hss2.ownAccountSK.GetPublic().Verify(append(accountKey, hss1.selfBoxPublicKey[:]...), proof)
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
 func TestHandshakeSession_ProveOwnDeviceKey_CheckOtherKeyProof(t *testing.T) {
 	hss1, hss2 := createTwoDevices(t)
 	proof, err := hss1.ProveOwnAccountKey()
@@ -70,14 +70,53 @@ func (HandshakeFrame_HandshakeStep) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7dc780342ca42053, []int{0, 0}
 }
 
+// Capability is an optional feature of the handshake
+type HandshakeFrame_Capability int32
+
+const (
+	HandshakeFrame_CAPABILITY_UNDEFINED HandshakeFrame_Capability = 0
+	// CAPABILITY_KNOWN_DEVICE is the disclosure of the device performing the handshake along with the sig chain of its account
+	HandshakeFrame_CAPABILITY_KNOWN_DEVICE HandshakeFrame_Capability = 1
+	// CAPABILITY_DEVICE_LINK is the link of a new device to an account
+	HandshakeFrame_CAPABILITY_DEVICE_LINK HandshakeFrame_Capability = 2
+)
+
+var HandshakeFrame_Capability_name = map[int32]string{
+	0: "CAPABILITY_UNDEFINED",
+	1: "CAPABILITY_KNOWN_DEVICE",
+	2: "CAPABILITY_DEVICE_LINK",
+}
+
+var HandshakeFrame_Capability_value = map[string]int32{
+	"CAPABILITY_UNDEFINED":    0,
+	"CAPABILITY_KNOWN_DEVICE": 1,
+	"CAPABILITY_DEVICE_LINK":  2,
+}
+
+func (x HandshakeFrame_Capability) String() string {
+	return proto.EnumName(HandshakeFrame_Capability_name, int32(x))
+}
+
+func (HandshakeFrame_Capability) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7dc780342ca42053, []int{0, 1}
+}
+
 type HandshakeFrame struct {
-	Step                 HandshakeFrame_HandshakeStep `protobuf:"varint,1,opt,name=step,proto3,enum=handshake.HandshakeFrame_HandshakeStep" json:"step,omitempty"`
-	SignatureKey         []byte                       `protobuf:"bytes,2,opt,name=signatureKey,proto3" json:"signatureKey,omitempty"`
-	EncryptionKey        []byte                       `protobuf:"bytes,3,opt,name=encryptionKey,proto3" json:"encryptionKey,omitempty"`
-	EncryptedPayload     []byte                       `protobuf:"bytes,4,opt,name=encryptedPayload,proto3" json:"encryptedPayload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
-	XXX_unrecognized     []byte                       `json:"-"`
-	XXX_sizecache        int32                        `json:"-"`
+	Step             HandshakeFrame_HandshakeStep `protobuf:"varint,1,opt,name=step,proto3,enum=handshake.HandshakeFrame_HandshakeStep" json:"step,omitempty"`
+	SignatureKey     []byte                       `protobuf:"bytes,2,opt,name=signatureKey,proto3" json:"signatureKey,omitempty"`
+	EncryptionKey    []byte                       `protobuf:"bytes,3,opt,name=encryptionKey,proto3" json:"encryptionKey,omitempty"`
+	EncryptedPayload []byte                       `protobuf:"bytes,4,opt,name=encryptedPayload,proto3" json:"encryptedPayload,omitempty"`
+	// versions and capabilities are advertised in the first two steps, peers advertising none are assumed to use the first version
+	Versions     []uint32                    `protobuf:"varint,5,rep,packed,name=versions,proto3" json:"versions,omitempty"`
+	Capabilities []HandshakeFrame_Capability `protobuf:"varint,6,rep,packed,name=capabilities,proto3,enum=handshake.HandshakeFrame_Capability" json:"capabilities,omitempty"`
+	// version is the version selected by the responder among the ones advertised by the requester, zero if none is supported
+	Version uint32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// kemPublicKey is the ephemeral KEM key of the requester and kemCiphertext the secret encapsulated for it by the responder, they are only used by the hybrid version
+	KemPublicKey         []byte   `protobuf:"bytes,8,opt,name=kemPublicKey,proto3" json:"kemPublicKey,omitempty"`
+	KemCiphertext        []byte   `protobuf:"bytes,9,opt,name=kemCiphertext,proto3" json:"kemCiphertext,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *HandshakeFrame) Reset()         { *m = HandshakeFrame{} }
@@ -141,12 +180,54 @@ func (m *HandshakeFrame) GetEncryptedPayload() []byte {
 	return nil
 }
 
+func (m *HandshakeFrame) GetVersions() []uint32 {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+func (m *HandshakeFrame) GetCapabilities() []HandshakeFrame_Capability {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *HandshakeFrame) GetVersion() uint32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *HandshakeFrame) GetKemPublicKey() []byte {
+	if m != nil {
+		return m.KemPublicKey
+	}
+	return nil
+}
+
+func (m *HandshakeFrame) GetKemCiphertext() []byte {
+	if m != nil {
+		return m.KemCiphertext
+	}
+	return nil
+}
+
 type HandshakePayload struct {
-	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
-	AccountKey           []byte   `protobuf:"bytes,2,opt,name=accountKey,proto3" json:"accountKey,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Signature  []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	AccountKey []byte `protobuf:"bytes,2,opt,name=accountKey,proto3" json:"accountKey,omitempty"`
+	// sigChain, deviceKey and deviceSignature are optional, they prove that the device performing the handshake belongs to the account
+	SigChain        []byte `protobuf:"bytes,3,opt,name=sigChain,proto3" json:"sigChain,omitempty"`
+	DeviceKey       []byte `protobuf:"bytes,4,opt,name=deviceKey,proto3" json:"deviceKey,omitempty"`
+	DeviceSignature []byte `protobuf:"bytes,5,opt,name=deviceSignature,proto3" json:"deviceSignature,omitempty"`
+	// linkProof and linkSecrets are used when linking a new device to an account
+	LinkProof            []byte             `protobuf:"bytes,6,opt,name=linkProof,proto3" json:"linkProof,omitempty"`
+	LinkSecrets          *DeviceLinkSecrets `protobuf:"bytes,7,opt,name=linkSecrets,proto3" json:"linkSecrets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *HandshakePayload) Reset()         { *m = HandshakePayload{} }
@@ -196,43 +277,213 @@ func (m *HandshakePayload) GetAccountKey() []byte {
 	return nil
 }
 
+func (m *HandshakePayload) GetSigChain() []byte {
+	if m != nil {
+		return m.SigChain
+	}
+	return nil
+}
+
+func (m *HandshakePayload) GetDeviceKey() []byte {
+	if m != nil {
+		return m.DeviceKey
+	}
+	return nil
+}
+
+func (m *HandshakePayload) GetDeviceSignature() []byte {
+	if m != nil {
+		return m.DeviceSignature
+	}
+	return nil
+}
+
+func (m *HandshakePayload) GetLinkProof() []byte {
+	if m != nil {
+		return m.LinkProof
+	}
+	return nil
+}
+
+func (m *HandshakePayload) GetLinkSecrets() *DeviceLinkSecrets {
+	if m != nil {
+		return m.LinkSecrets
+	}
+	return nil
+}
+
+// DeviceLinkSecrets are the secrets sent by a device to a new device of its account
+type DeviceLinkSecrets struct {
+	// accountKey and accountProofKey are serialized private keys
+	AccountKey      []byte `protobuf:"bytes,1,opt,name=accountKey,proto3" json:"accountKey,omitempty"`
+	AccountProofKey []byte `protobuf:"bytes,2,opt,name=accountProofKey,proto3" json:"accountProofKey,omitempty"`
+	// sigChain is the serialized sig chain of the account, including the new device
+	SigChain             []byte   `protobuf:"bytes,3,opt,name=sigChain,proto3" json:"sigChain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeviceLinkSecrets) Reset()         { *m = DeviceLinkSecrets{} }
+func (m *DeviceLinkSecrets) String() string { return proto.CompactTextString(m) }
+func (*DeviceLinkSecrets) ProtoMessage()    {}
+func (*DeviceLinkSecrets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dc780342ca42053, []int{2}
+}
+func (m *DeviceLinkSecrets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeviceLinkSecrets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeviceLinkSecrets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeviceLinkSecrets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLinkSecrets.Merge(m, src)
+}
+func (m *DeviceLinkSecrets) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeviceLinkSecrets) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLinkSecrets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeviceLinkSecrets proto.InternalMessageInfo
+
+func (m *DeviceLinkSecrets) GetAccountKey() []byte {
+	if m != nil {
+		return m.AccountKey
+	}
+	return nil
+}
+
+func (m *DeviceLinkSecrets) GetAccountProofKey() []byte {
+	if m != nil {
+		return m.AccountProofKey
+	}
+	return nil
+}
+
+func (m *DeviceLinkSecrets) GetSigChain() []byte {
+	if m != nil {
+		return m.SigChain
+	}
+	return nil
+}
+
+// SecureChannelFrame is a frame of the encrypted channel opened once a handshake is done
+type SecureChannelFrame struct {
+	// encryptedPayload is the data sent on the channel, sealed using the key of its direction
+	EncryptedPayload     []byte   `protobuf:"bytes,1,opt,name=encryptedPayload,proto3" json:"encryptedPayload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SecureChannelFrame) Reset()         { *m = SecureChannelFrame{} }
+func (m *SecureChannelFrame) String() string { return proto.CompactTextString(m) }
+func (*SecureChannelFrame) ProtoMessage()    {}
+func (*SecureChannelFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7dc780342ca42053, []int{3}
+}
+func (m *SecureChannelFrame) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecureChannelFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecureChannelFrame.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecureChannelFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecureChannelFrame.Merge(m, src)
+}
+func (m *SecureChannelFrame) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecureChannelFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecureChannelFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecureChannelFrame proto.InternalMessageInfo
+
+func (m *SecureChannelFrame) GetEncryptedPayload() []byte {
+	if m != nil {
+		return m.EncryptedPayload
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("handshake.HandshakeFrame_HandshakeStep", HandshakeFrame_HandshakeStep_name, HandshakeFrame_HandshakeStep_value)
+	proto.RegisterEnum("handshake.HandshakeFrame_Capability", HandshakeFrame_Capability_name, HandshakeFrame_Capability_value)
 	proto.RegisterType((*HandshakeFrame)(nil), "handshake.HandshakeFrame")
 	proto.RegisterType((*HandshakePayload)(nil), "handshake.HandshakePayload")
+	proto.RegisterType((*DeviceLinkSecrets)(nil), "handshake.DeviceLinkSecrets")
+	proto.RegisterType((*SecureChannelFrame)(nil), "handshake.SecureChannelFrame")
 }
 
 func init() { proto.RegisterFile("go-internal/handshake.proto", fileDescriptor_7dc780342ca42053) }
 
 var fileDescriptor_7dc780342ca42053 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
-	0x14, 0x87, 0xc9, 0x36, 0x81, 0x76, 0xe8, 0x86, 0x65, 0x06, 0xaa, 0xc6, 0x94, 0x95, 0xf2, 0x6f,
-	0x42, 0x5a, 0x23, 0xb6, 0x71, 0x81, 0xb8, 0xea, 0x1a, 0x8f, 0x45, 0x85, 0x24, 0x4a, 0x02, 0x68,
-	0xdc, 0x58, 0x6e, 0x6a, 0xd2, 0x88, 0x2d, 0x8e, 0x52, 0xe7, 0x22, 0x4f, 0xc3, 0xeb, 0x70, 0xc9,
-	0x23, 0xa0, 0x4a, 0x88, 0xd7, 0x40, 0x38, 0x21, 0x59, 0x5a, 0x69, 0x77, 0x3e, 0xdf, 0xf9, 0x4e,
-	0x7e, 0x91, 0x8f, 0xe1, 0x51, 0x24, 0x0e, 0xe3, 0x44, 0xf2, 0x2c, 0x61, 0x97, 0xc6, 0x8c, 0x25,
-	0xd3, 0xf9, 0x8c, 0x7d, 0xe3, 0x83, 0x34, 0x13, 0x52, 0xe0, 0xcd, 0x1a, 0xec, 0x1e, 0x46, 0xb1,
-	0x9c, 0xe5, 0x93, 0x41, 0x28, 0xae, 0x8c, 0x48, 0x44, 0xc2, 0x50, 0xc6, 0x24, 0xff, 0xaa, 0x2a,
-	0x55, 0xa8, 0x53, 0x39, 0xd9, 0xff, 0xbd, 0x0e, 0xdb, 0xe7, 0xff, 0x87, 0xcf, 0x32, 0x76, 0xc5,
-	0xf1, 0x5b, 0xd8, 0x98, 0x4b, 0x9e, 0x76, 0xb5, 0x9e, 0x76, 0xb0, 0x7d, 0xf4, 0x62, 0xd0, 0x84,
-	0xb5, 0xc5, 0xa6, 0xf4, 0x25, 0x4f, 0x3d, 0x35, 0x84, 0xfb, 0xd0, 0x99, 0xc7, 0x51, 0xc2, 0x64,
-	0x9e, 0xf1, 0x31, 0x2f, 0xba, 0x6b, 0x3d, 0xed, 0xa0, 0xe3, 0xb5, 0x18, 0x7e, 0x0a, 0x5b, 0x3c,
-	0x09, 0xb3, 0x22, 0x95, 0xb1, 0x48, 0xfe, 0x49, 0xeb, 0x4a, 0x6a, 0x43, 0xfc, 0x12, 0x50, 0x05,
-	0xf8, 0xd4, 0x65, 0xc5, 0xa5, 0x60, 0xd3, 0xee, 0x86, 0x12, 0x57, 0x78, 0xff, 0xfb, 0x1a, 0x6c,
-	0xb5, 0xfe, 0x06, 0x77, 0x61, 0xc7, 0x0f, 0x88, 0x4b, 0x5f, 0xd1, 0x31, 0xb9, 0xa0, 0xc3, 0x77,
-	0x1e, 0x21, 0x1f, 0x88, 0x1d, 0xa0, 0x5b, 0x75, 0xe7, 0x68, 0xa9, 0xa3, 0xe1, 0xfb, 0x70, 0x4f,
-	0x75, 0x8e, 0xa9, 0x69, 0xf9, 0xee, 0x30, 0x18, 0x9d, 0x23, 0xc0, 0x3d, 0xd8, 0x2b, 0xe1, 0x90,
-	0x8e, 0x6d, 0xe7, 0xb3, 0x4d, 0x2d, 0x93, 0xd8, 0x81, 0x15, 0x5c, 0x50, 0xd7, 0x73, 0x9c, 0x33,
-	0xb4, 0x83, 0x9f, 0xc1, 0x63, 0x65, 0x9c, 0xac, 0x18, 0xa6, 0xe5, 0x8f, 0xde, 0x3b, 0xfe, 0x47,
-	0x8f, 0xa0, 0x07, 0xb5, 0xf6, 0xfa, 0x26, 0xed, 0x21, 0xd6, 0x61, 0xb7, 0xcc, 0x3b, 0xad, 0x34,
-	0x93, 0x7c, 0xb2, 0x46, 0xa4, 0x4a, 0xd3, 0xf1, 0x13, 0xd8, 0x2f, 0xd3, 0x96, 0xfa, 0xd7, 0x3e,
-	0xb2, 0x8f, 0x11, 0xdc, 0x55, 0xd2, 0x1b, 0x6a, 0x3a, 0x36, 0x41, 0x7f, 0xee, 0xf4, 0x5d, 0x40,
-	0xf5, 0x05, 0x55, 0xb7, 0x86, 0xf7, 0x60, 0xb3, 0xde, 0x8b, 0xda, 0x76, 0xc7, 0x6b, 0x00, 0xd6,
-	0x01, 0x58, 0x18, 0x8a, 0x3c, 0x91, 0xcd, 0x1e, 0xaf, 0x91, 0xd3, 0x93, 0x1f, 0x0b, 0x5d, 0xfb,
-	0xb9, 0xd0, 0xb5, 0x5f, 0x0b, 0x5d, 0xfb, 0xf2, 0x7c, 0xc2, 0x33, 0x59, 0x0c, 0x24, 0x0f, 0x67,
-	0x86, 0x3a, 0x1a, 0x91, 0x30, 0x56, 0xdf, 0xeb, 0xe4, 0xb6, 0x7a, 0x76, 0xc7, 0x7f, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xe8, 0x4b, 0x9a, 0xff, 0xcf, 0x02, 0x00, 0x00,
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xd1, 0x6e, 0xda, 0x4a,
+	0x10, 0x8d, 0x49, 0x42, 0x92, 0x09, 0x24, 0xbe, 0x7b, 0xd3, 0xd4, 0x22, 0x11, 0xa1, 0x34, 0x6d,
+	0x51, 0xa5, 0x80, 0x4a, 0xd2, 0x87, 0xaa, 0x52, 0x55, 0x62, 0x3b, 0x8d, 0x05, 0x35, 0xc8, 0x26,
+	0xad, 0xd2, 0x17, 0xcb, 0x98, 0x0d, 0xac, 0x00, 0x1b, 0xd9, 0x4b, 0x54, 0xfe, 0xa2, 0xea, 0x0f,
+	0xf4, 0x77, 0xfa, 0xd8, 0x4f, 0xa8, 0xf2, 0xd2, 0xdf, 0xa8, 0xbc, 0x36, 0xb6, 0x81, 0x34, 0x6f,
+	0x3e, 0x67, 0xce, 0xcc, 0xf8, 0xec, 0xce, 0x0e, 0x1c, 0xf4, 0x9c, 0x13, 0x62, 0x53, 0xec, 0xda,
+	0xe6, 0xb0, 0xd2, 0x37, 0xed, 0xae, 0xd7, 0x37, 0x07, 0xb8, 0x3c, 0x76, 0x1d, 0xea, 0xa0, 0xad,
+	0x88, 0xc8, 0x9d, 0xf4, 0x08, 0xed, 0x4f, 0x3a, 0x65, 0xcb, 0x19, 0x55, 0x7a, 0x4e, 0xcf, 0xa9,
+	0x30, 0x45, 0x67, 0x72, 0xc3, 0x10, 0x03, 0xec, 0x2b, 0xc8, 0x2c, 0x7e, 0x4b, 0xc3, 0xce, 0xe5,
+	0x2c, 0xf9, 0xc2, 0x35, 0x47, 0x18, 0xbd, 0x85, 0x35, 0x8f, 0xe2, 0xb1, 0xc0, 0x15, 0xb8, 0xd2,
+	0x4e, 0xf5, 0x45, 0x39, 0x6e, 0x36, 0x2f, 0x8c, 0xa1, 0x4e, 0xf1, 0x58, 0x63, 0x49, 0xa8, 0x08,
+	0x19, 0x8f, 0xf4, 0x6c, 0x93, 0x4e, 0x5c, 0x5c, 0xc7, 0x53, 0x21, 0x55, 0xe0, 0x4a, 0x19, 0x6d,
+	0x8e, 0x43, 0xc7, 0x90, 0xc5, 0xb6, 0xe5, 0x4e, 0xc7, 0x94, 0x38, 0xb6, 0x2f, 0x5a, 0x65, 0xa2,
+	0x79, 0x12, 0xbd, 0x04, 0x3e, 0x24, 0x70, 0xb7, 0x65, 0x4e, 0x87, 0x8e, 0xd9, 0x15, 0xd6, 0x98,
+	0x70, 0x89, 0x47, 0x39, 0xd8, 0xbc, 0xc5, 0xae, 0x47, 0x1c, 0xdb, 0x13, 0xd6, 0x0b, 0xab, 0xa5,
+	0xac, 0x16, 0x61, 0x74, 0x09, 0x19, 0xcb, 0x1c, 0x9b, 0x1d, 0x32, 0x24, 0x94, 0x60, 0x4f, 0x48,
+	0x17, 0x56, 0x4b, 0x3b, 0xd5, 0xe3, 0x7f, 0xdb, 0x12, 0x67, 0xea, 0xa9, 0x36, 0x97, 0x89, 0x04,
+	0xd8, 0x08, 0xab, 0x0a, 0x1b, 0x05, 0xae, 0x94, 0xd5, 0x66, 0xd0, 0x77, 0x3d, 0xc0, 0xa3, 0xd6,
+	0xa4, 0x33, 0x24, 0x96, 0x6f, 0x68, 0x33, 0x70, 0x9d, 0xe4, 0x7c, 0xd7, 0x03, 0x3c, 0x12, 0xc9,
+	0xb8, 0x8f, 0x5d, 0x8a, 0xbf, 0x52, 0x61, 0x2b, 0x70, 0x3d, 0x47, 0x16, 0x7f, 0xa4, 0x20, 0x3b,
+	0x77, 0xae, 0x48, 0x80, 0x3d, 0xbd, 0x2d, 0xb7, 0x8c, 0x57, 0x46, 0x5d, 0xbe, 0x36, 0x6a, 0x1f,
+	0x34, 0x59, 0xfe, 0x28, 0xab, 0x6d, 0x7e, 0x25, 0x8a, 0x54, 0x17, 0x22, 0x1c, 0xfa, 0x1f, 0x76,
+	0x59, 0xe4, 0xd4, 0x90, 0x14, 0xbd, 0x55, 0x6b, 0x8b, 0x97, 0x3c, 0xa0, 0x02, 0x1c, 0x06, 0x64,
+	0xcd, 0xa8, 0xab, 0xcd, 0xcf, 0xaa, 0xa1, 0x48, 0xb2, 0xda, 0x56, 0xda, 0xd7, 0x46, 0x4b, 0x6b,
+	0x36, 0x2f, 0xf8, 0x3d, 0xf4, 0x0c, 0x9e, 0x30, 0xc5, 0xd9, 0x92, 0x42, 0x52, 0x74, 0xb1, 0xd1,
+	0xd4, 0xaf, 0x34, 0x99, 0x7f, 0x14, 0xc9, 0x5e, 0x3f, 0x24, 0xdb, 0x47, 0x79, 0xc8, 0x05, 0xfd,
+	0xce, 0x43, 0x99, 0x24, 0x7f, 0x52, 0x44, 0x39, 0xec, 0x96, 0x47, 0x4f, 0xe1, 0x28, 0xe8, 0xb6,
+	0x10, 0x4f, 0x14, 0x39, 0x42, 0x3c, 0x6c, 0x33, 0xd1, 0x1b, 0x43, 0x6a, 0xaa, 0x32, 0xff, 0x67,
+	0xa3, 0x68, 0x00, 0xc4, 0x37, 0xe4, 0x9f, 0x81, 0x58, 0x6b, 0xd5, 0xce, 0x95, 0x86, 0xdf, 0xff,
+	0x4a, 0x95, 0xe4, 0x0b, 0x45, 0x95, 0x25, 0x7e, 0x05, 0x1d, 0xc0, 0xe3, 0x44, 0x24, 0xd9, 0x81,
+	0xe7, 0x50, 0x0e, 0xf6, 0x13, 0xc1, 0xb0, 0x71, 0x43, 0x51, 0xeb, 0x7c, 0xaa, 0xf8, 0x3d, 0x05,
+	0x7c, 0x74, 0x05, 0xb3, 0x09, 0x3b, 0x84, 0xad, 0x68, 0x86, 0xd9, 0xcb, 0xc8, 0x68, 0x31, 0x81,
+	0xf2, 0x00, 0xa6, 0x65, 0x39, 0x13, 0x9b, 0xc6, 0x33, 0x9f, 0x60, 0xfc, 0xf9, 0xf4, 0x48, 0x4f,
+	0xec, 0x9b, 0xc4, 0x0e, 0x87, 0x3d, 0xc2, 0x7e, 0xe5, 0x2e, 0xbe, 0x25, 0x16, 0x7b, 0x2e, 0xc1,
+	0x80, 0xc7, 0x04, 0x2a, 0xc1, 0x6e, 0x00, 0xf4, 0xa8, 0xfb, 0x3a, 0xd3, 0x2c, 0xd2, 0x7e, 0x9d,
+	0x21, 0xb1, 0x07, 0x2d, 0xd7, 0x71, 0x6e, 0x84, 0x74, 0x50, 0x27, 0x22, 0xd0, 0x3b, 0xd8, 0xf6,
+	0x81, 0x8e, 0x2d, 0x17, 0x53, 0x8f, 0xcd, 0xef, 0x76, 0xf5, 0x30, 0xf1, 0x08, 0x24, 0x56, 0xae,
+	0x11, 0x6b, 0xb4, 0x64, 0x42, 0x71, 0x0a, 0xff, 0x2d, 0x29, 0x16, 0x6c, 0x73, 0x4b, 0xb6, 0x4b,
+	0xb0, 0x1b, 0x22, 0xf6, 0x13, 0xf1, 0xd9, 0x2c, 0xd2, 0x0f, 0x1d, 0x50, 0xf1, 0x3d, 0x20, 0x1d,
+	0x5b, 0x13, 0x17, 0x8b, 0x7d, 0xd3, 0xb6, 0xf1, 0x30, 0xd8, 0x52, 0xf7, 0xad, 0x07, 0xee, 0xfe,
+	0xf5, 0x70, 0x7e, 0xf6, 0xf3, 0x2e, 0xcf, 0xfd, 0xba, 0xcb, 0x73, 0xbf, 0xef, 0xf2, 0xdc, 0x97,
+	0xe7, 0x1d, 0xec, 0xd2, 0x69, 0x99, 0x62, 0xab, 0x5f, 0x61, 0x9f, 0x95, 0x9e, 0x53, 0x59, 0x5e,
+	0xad, 0x9d, 0x34, 0xdb, 0x90, 0xa7, 0x7f, 0x07, 0x00, 0x4a, 0x73, 0x8d, 0x2c, 0x7a, 0x05, 0x00,
+	0x00,
 }
 
 func (m *HandshakeFrame) Marshal() (dAtA []byte, err error) {
@@ -259,6 +510,61 @@ func (m *HandshakeFrame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.KemCiphertext) > 0 {
+		i -= len(m.KemCiphertext)
+		copy(dAtA[i:], m.KemCiphertext)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.KemCiphertext)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.KemPublicKey) > 0 {
+		i -= len(m.KemPublicKey)
+		copy(dAtA[i:], m.KemPublicKey)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.KemPublicKey)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Version != 0 {
+		i = encodeVarintHandshake(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Capabilities) > 0 {
+		dAtA2 := make([]byte, len(m.Capabilities)*10)
+		var j1 int
+		for _, num := range m.Capabilities {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintHandshake(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Versions) > 0 {
+		dAtA4 := make([]byte, len(m.Versions)*10)
+		var j3 int
+		for _, num := range m.Versions {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintHandshake(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.EncryptedPayload) > 0 {
 		i -= len(m.EncryptedPayload)
 		copy(dAtA[i:], m.EncryptedPayload)
@@ -312,6 +618,46 @@ func (m *HandshakePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.LinkSecrets != nil {
+		{
+			size, err := m.LinkSecrets.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintHandshake(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LinkProof) > 0 {
+		i -= len(m.LinkProof)
+		copy(dAtA[i:], m.LinkProof)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.LinkProof)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DeviceSignature) > 0 {
+		i -= len(m.DeviceSignature)
+		copy(dAtA[i:], m.DeviceSignature)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.DeviceSignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DeviceKey) > 0 {
+		i -= len(m.DeviceKey)
+		copy(dAtA[i:], m.DeviceKey)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.DeviceKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SigChain) > 0 {
+		i -= len(m.SigChain)
+		copy(dAtA[i:], m.SigChain)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.SigChain)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.AccountKey) > 0 {
 		i -= len(m.AccountKey)
 		copy(dAtA[i:], m.AccountKey)
@@ -329,6 +675,88 @@ func (m *HandshakePayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeviceLinkSecrets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeviceLinkSecrets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeviceLinkSecrets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.SigChain) > 0 {
+		i -= len(m.SigChain)
+		copy(dAtA[i:], m.SigChain)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.SigChain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AccountProofKey) > 0 {
+		i -= len(m.AccountProofKey)
+		copy(dAtA[i:], m.AccountProofKey)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.AccountProofKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AccountKey) > 0 {
+		i -= len(m.AccountKey)
+		copy(dAtA[i:], m.AccountKey)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.AccountKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecureChannelFrame) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecureChannelFrame) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecureChannelFrame) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EncryptedPayload) > 0 {
+		i -= len(m.EncryptedPayload)
+		copy(dAtA[i:], m.EncryptedPayload)
+		i = encodeVarintHandshake(dAtA, i, uint64(len(m.EncryptedPayload)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHandshake(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHandshake(v)
 	base := offset
@@ -361,6 +789,31 @@ func (m *HandshakeFrame) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHandshake(uint64(l))
 	}
+	if len(m.Versions) > 0 {
+		l = 0
+		for _, e := range m.Versions {
+			l += sovHandshake(uint64(e))
+		}
+		n += 1 + sovHandshake(uint64(l)) + l
+	}
+	if len(m.Capabilities) > 0 {
+		l = 0
+		for _, e := range m.Capabilities {
+			l += sovHandshake(uint64(e))
+		}
+		n += 1 + sovHandshake(uint64(l)) + l
+	}
+	if m.Version != 0 {
+		n += 1 + sovHandshake(uint64(m.Version))
+	}
+	l = len(m.KemPublicKey)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
+	}
+	l = len(m.KemCiphertext)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -381,16 +834,76 @@ func (m *HandshakePayload) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovHandshake(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	l = len(m.SigChain)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
 	}
-	return n
-}
-
-func sovHandshake(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozHandshake(x uint64) (n int) {
+	l = len(m.DeviceKey)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
+	}
+	l = len(m.DeviceSignature)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
+	}
+	l = len(m.LinkProof)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
+	}
+	if m.LinkSecrets != nil {
+		l = m.LinkSecrets.Size()
+		n += 1 + l + sovHandshake(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeviceLinkSecrets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AccountKey)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
+	}
+	l = len(m.AccountProofKey)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
+	}
+	l = len(m.SigChain)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SecureChannelFrame) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncryptedPayload)
+	if l > 0 {
+		n += 1 + l + sovHandshake(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovHandshake(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHandshake(x uint64) (n int) {
 	return sovHandshake(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *HandshakeFrame) Unmarshal(dAtA []byte) error {
@@ -543,6 +1056,238 @@ func (m *HandshakeFrame) Unmarshal(dAtA []byte) error {
 				m.EncryptedPayload = []byte{}
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandshake
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Versions = append(m.Versions, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandshake
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthHandshake
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthHandshake
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Versions) == 0 {
+					m.Versions = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHandshake
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Versions = append(m.Versions, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+		case 6:
+			if wireType == 0 {
+				var v HandshakeFrame_Capability
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandshake
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= HandshakeFrame_Capability(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Capabilities = append(m.Capabilities, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowHandshake
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthHandshake
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthHandshake
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.Capabilities) == 0 {
+					m.Capabilities = make([]HandshakeFrame_Capability, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v HandshakeFrame_Capability
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowHandshake
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= HandshakeFrame_Capability(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Capabilities = append(m.Capabilities, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capabilities", wireType)
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KemPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KemPublicKey = append(m.KemPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.KemPublicKey == nil {
+				m.KemPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KemCiphertext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KemCiphertext = append(m.KemCiphertext[:0], dAtA[iNdEx:postIndex]...)
+			if m.KemCiphertext == nil {
+				m.KemCiphertext = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandshake(dAtA[iNdEx:])
@@ -665,6 +1410,422 @@ func (m *HandshakePayload) Unmarshal(dAtA []byte) error {
 				m.AccountKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigChain", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigChain = append(m.SigChain[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigChain == nil {
+				m.SigChain = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceKey = append(m.DeviceKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.DeviceKey == nil {
+				m.DeviceKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviceSignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeviceSignature = append(m.DeviceSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.DeviceSignature == nil {
+				m.DeviceSignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkProof = append(m.LinkProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.LinkProof == nil {
+				m.LinkProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkSecrets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LinkSecrets == nil {
+				m.LinkSecrets = &DeviceLinkSecrets{}
+			}
+			if err := m.LinkSecrets.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandshake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceLinkSecrets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandshake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceLinkSecrets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceLinkSecrets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountKey = append(m.AccountKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccountKey == nil {
+				m.AccountKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountProofKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountProofKey = append(m.AccountProofKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccountProofKey == nil {
+				m.AccountProofKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigChain", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigChain = append(m.SigChain[:0], dAtA[iNdEx:postIndex]...)
+			if m.SigChain == nil {
+				m.SigChain = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHandshake(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecureChannelFrame) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHandshake
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecureChannelFrame: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecureChannelFrame: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPayload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHandshake
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHandshake
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedPayload = append(m.EncryptedPayload[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedPayload == nil {
+				m.EncryptedPayload = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHandshake(dAtA[iNdEx:])
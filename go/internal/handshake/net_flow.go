@@ -2,56 +2,142 @@ package handshake
 
 import (
 	"context"
+	"errors"
 	"net"
+	"time"
 
+	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/pkg/errcode"
 	ggio "github.com/gogo/protobuf/io"
 	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
 	inet "github.com/libp2p/go-libp2p-core/network"
 )
 
+const (
+	// DefaultStepTimeout is the maximum duration of a single handshake step
+	DefaultStepTimeout = time.Second * 10
+
+	// DefaultTimeout is the maximum duration of a whole handshake
+	DefaultTimeout = time.Second * 30
+)
+
+// Timeouts are the deadlines of a handshake, a zero duration disables the
+// corresponding deadline, the deadline of the context is always honored
+type Timeouts struct {
+	// Step is the maximum duration of each step, including reading the
+	// message sent by the other peer
+	Step time.Duration
+
+	// Overall is the maximum duration of the whole handshake
+	Overall time.Duration
+}
+
+type timeoutsCtxKey struct{}
+
+// WithTimeouts returns a context making the handshakes performed using it
+// apply the supplied timeouts instead of the default ones
+func WithTimeouts(ctx context.Context, timeouts Timeouts) context.Context {
+	return context.WithValue(ctx, timeoutsCtxKey{}, timeouts)
+}
+
+func timeoutsFromContext(ctx context.Context) Timeouts {
+	if timeouts, ok := ctx.Value(timeoutsCtxKey{}).(Timeouts); ok {
+		return timeouts
+	}
+
+	return Timeouts{
+		Step:    DefaultStepTimeout,
+		Overall: DefaultTimeout,
+	}
+}
+
 type flowStep interface {
 	action(ctx context.Context, f *flow, step HandshakeFrame_HandshakeStep, readMsg *HandshakeFrame) (nextStep *HandshakeFrame_HandshakeStep, err error)
 	isReadAction() bool
 }
 
+// OwnDevice is the device performing a handshake, its sig chain proves to
+// the other peer that it belongs to the account
+type OwnDevice struct {
+	DeviceSK p2pcrypto.PrivKey
+	SigChain *account.SigChain
+}
+
+// OtherDevice is the device disclosed by the other peer during a handshake,
+// it has been verified against the sig chain of the other account
+type OtherDevice struct {
+	DevicePK p2pcrypto.PubKey
+	SigChain *account.SigChain
+}
+
 type flow struct {
-	reader  ggio.ReadCloser
-	writer  ggio.WriteCloser
-	session *handshakeSession
-	steps   map[HandshakeFrame_HandshakeStep]flowStep
-	ownPK   p2pcrypto.PubKey
-	otherPK p2pcrypto.PubKey
+	conn        net.Conn
+	reader      ggio.ReadCloser
+	writer      ggio.WriteCloser
+	session     *handshakeSession
+	steps       map[HandshakeFrame_HandshakeStep]flowStep
+	ownPK       p2pcrypto.PubKey
+	otherPK     p2pcrypto.PubKey
+	ownDevice   *OwnDevice
+	otherDevice *OtherDevice
+
+	// linkSecret is the one-time secret shared out of band when linking a
+	// device, getLinkSecrets returns the secrets to send to the new device
+	// and linkSecrets are the secrets it has received
+	linkSecret     []byte
+	getLinkSecrets func(devicePK p2pcrypto.PubKey) (*DeviceLinkSecrets, error)
+	linkSecrets    *DeviceLinkSecrets
+
+	// versions and capabilities are advertised to the other peer, version
+	// is the one negotiated with it and requiredCapabilities are the ones
+	// the other peer must support for the flow to be performed
+	versions             []uint32
+	capabilities         []HandshakeFrame_Capability
+	version              uint32
+	otherCapabilities    map[HandshakeFrame_Capability]struct{}
+	requiredCapabilities []HandshakeFrame_Capability
+	negotiationErr       error
+
+	// kem is used along X25519 when the hybrid version is negotiated
+	kem KEM
 }
 
-func newHandshakeFlow(ctx context.Context, conn net.Conn, pk p2pcrypto.PubKey, session *handshakeSession, steps map[HandshakeFrame_HandshakeStep]flowStep) (p2pcrypto.PubKey, error) {
+func newFlow(conn net.Conn, pk p2pcrypto.PubKey, session *handshakeSession, steps map[HandshakeFrame_HandshakeStep]flowStep) (*flow, error) {
 	if conn == nil || session == nil || steps == nil {
 		return nil, errcode.ErrHandshakeParams
 	}
 
-	writer := ggio.NewDelimitedWriter(conn)
-	reader := ggio.NewDelimitedReader(conn, inet.MessageSizeMax)
+	return &flow{
+		conn:         conn,
+		reader:       newFrameReader(conn, inet.MessageSizeMax),
+		writer:       ggio.NewDelimitedWriter(conn),
+		session:      session,
+		steps:        steps,
+		ownPK:        pk,
+		versions:     supportedVersions,
+		capabilities: supportedCapabilities,
+	}, nil
+}
 
-	f := flow{
-		reader:  reader,
-		writer:  writer,
-		session: session,
-		steps:   steps,
-		ownPK:   pk,
+// newHandshakeFlow performs a handshake and returns its completed flow
+func newHandshakeFlow(ctx context.Context, conn net.Conn, pk p2pcrypto.PubKey, device *OwnDevice, session *handshakeSession, steps map[HandshakeFrame_HandshakeStep]flowStep) (*flow, error) {
+	f, err := newFlow(conn, pk, session, steps)
+	if err != nil {
+		return nil, err
 	}
 
-	return f.performFlow(ctx)
-}
+	f.ownDevice = device
 
-func (f *flow) close() error {
-	if f.writer != nil {
-		_ = f.writer.Close()
+	if _, err := f.performFlow(ctx); err != nil {
+		return nil, err
 	}
 
-	if f.reader != nil {
-		_ = f.reader.Close()
-	}
+	return f, nil
+}
 
+// close releases the handshake session, the underlying connection is owned by
+// the caller and is kept open so it can be used once the handshake succeeded
+func (f *flow) close() error {
 	if f.session != nil {
 		_ = f.session.Close()
 	}
@@ -59,19 +145,64 @@ func (f *flow) close() error {
 	return nil
 }
 
-func (f *flow) performFlow(ctx context.Context) (p2pcrypto.PubKey, error) {
-	var err error
+// performFlow runs the steps of the handshake. The deadline of the
+// connection is updated before each step and the connection is closed if the
+// handshake fails or if the context is done before it completes. Once done,
+// the deadline of the connection is set to the one of the parent context, if
+// any.
+func (f *flow) performFlow(ctx context.Context) (_ p2pcrypto.PubKey, err error) {
 	defer func() { _ = f.close() }()
 
+	defer func() {
+		if err != nil && f.conn != nil {
+			_ = f.conn.Close()
+		}
+	}()
+
+	timeouts := timeoutsFromContext(ctx)
+	parentDeadline, _ := ctx.Deadline()
+
+	f.setHybridKEM(hybridKEMFromContext(ctx))
+
+	if timeouts.Overall > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeouts.Overall)
+		defer cancel()
+	}
+
+	if f.conn != nil {
+		done := make(chan struct{})
+		defer close(done)
+
+		// a pending read or write is interrupted by closing the connection
+		go func() {
+			select {
+			case <-ctx.Done():
+				_ = f.conn.Close()
+			case <-done:
+			}
+		}()
+	}
+
 	initialStep := HandshakeFrame_STEP_1_KEY_AGREEMENT
 	nextStep := &initialStep
 
 	for nextStep != nil {
+		if err := ctx.Err(); err != nil {
+			return nil, f.timeoutError(ctx, time.Time{}, err)
+		}
+
 		if *nextStep == HandshakeFrame_STEP_9_DONE {
 			if f.otherPK == nil {
 				return nil, errcode.ErrHandshakeNoAuthReturned
 			}
 
+			// the other peer may have closed the connection once done, the
+			// handshake has succeeded nonetheless
+			if f.conn != nil {
+				_ = f.conn.SetDeadline(parentDeadline)
+			}
+
 			return f.otherPK, nil
 		}
 
@@ -82,18 +213,20 @@ func (f *flow) performFlow(ctx context.Context) (p2pcrypto.PubKey, error) {
 			return nil, errcode.ErrHandshakeInvalidFlowStepNotFound
 		}
 
+		stepDeadline, err := f.setStepDeadline(ctx, timeouts.Step)
+		if err != nil {
+			return nil, err
+		}
+
 		var readMsg = &HandshakeFrame{}
 		if step.isReadAction() {
-			// TODO: time out
-
 			if err := f.reader.ReadMsg(readMsg); err != nil {
-				return nil, err
+				return nil, f.timeoutError(ctx, stepDeadline, err)
 			}
-
 		}
 
 		if nextStep, err = step.action(ctx, f, *nextStep, readMsg); err != nil {
-			return nil, err
+			return nil, f.timeoutError(ctx, stepDeadline, err)
 		}
 
 		if *nextStep == currentStep {
@@ -104,13 +237,90 @@ func (f *flow) performFlow(ctx context.Context) (p2pcrypto.PubKey, error) {
 	return nil, errcode.ErrHandshakeInvalidFlow
 }
 
+// setStepDeadline sets the deadline of the connection for the next step, the
+// earliest of the step timeout and the context deadline is used. The step
+// deadline is returned, it is zero if there is no step timeout.
+func (f *flow) setStepDeadline(ctx context.Context, stepTimeout time.Duration) (time.Time, error) {
+	if f.conn == nil {
+		return time.Time{}, nil
+	}
+
+	stepDeadline := time.Time{}
+	if stepTimeout > 0 {
+		stepDeadline = time.Now().Add(stepTimeout)
+	}
+
+	deadline := stepDeadline
+	if ctxDeadline, ok := ctx.Deadline(); ok && (deadline.IsZero() || ctxDeadline.Before(deadline)) {
+		deadline = ctxDeadline
+	}
+
+	if err := f.conn.SetDeadline(deadline); err != nil {
+		return time.Time{}, errcode.TODO.Wrap(err)
+	}
+
+	return stepDeadline, nil
+}
+
+// timeoutError returns a distinct error when a step failed because the
+// handshake has been canceled or has timed out, other errors are returned
+// as is
+func (f *flow) timeoutError(ctx context.Context, stepDeadline time.Time, err error) error {
+	switch ctx.Err() {
+	case context.Canceled:
+		return errcode.ErrHandshakeCanceled.Wrap(err)
+	case context.DeadlineExceeded:
+		return errcode.ErrHandshakeTimeout.Wrap(err)
+	}
+
+	var netErr net.Error
+	if !errors.As(err, &netErr) || !netErr.Timeout() {
+		return err
+	}
+
+	if !stepDeadline.IsZero() && !time.Now().Before(stepDeadline) {
+		return errcode.ErrHandshakeStepTimeout.Wrap(err)
+	}
+
+	return errcode.ErrHandshakeTimeout.Wrap(err)
+}
+
 func Request(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey, pk p2pcrypto.PubKey) (p2pcrypto.PubKey, error) {
+	otherPK, _, err := RequestWithDevice(ctx, conn, sk, pk, nil)
+
+	return otherPK, err
+}
+
+// RequestWithDevice performs the same handshake as Request, the device is
+// disclosed to the other peer if not nil, the device of the other peer is
+// returned if it has disclosed one
+func RequestWithDevice(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey, pk p2pcrypto.PubKey, device *OwnDevice) (p2pcrypto.PubKey, *OtherDevice, error) {
+	f, err := request(ctx, conn, sk, pk, device)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return f.otherPK, f.otherDevice, nil
+}
+
+// RequestSecure performs the same handshake as RequestWithDevice and returns
+// an encrypted channel to communicate with the other peer
+func RequestSecure(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey, pk p2pcrypto.PubKey, device *OwnDevice) (*SecureConn, error) {
+	f, err := request(ctx, conn, sk, pk, device)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSecureConn(f)
+}
+
+func request(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey, pk p2pcrypto.PubKey, device *OwnDevice) (*flow, error) {
 	session, err := newCryptoRequest(sk, pk)
 	if err != nil {
 		return nil, err
 	}
 
-	return newHandshakeFlow(ctx, conn, sk.GetPublic(), session, map[HandshakeFrame_HandshakeStep]flowStep{
+	return newHandshakeFlow(ctx, conn, sk.GetPublic(), device, session, map[HandshakeFrame_HandshakeStep]flowStep{
 		HandshakeFrame_STEP_1_KEY_AGREEMENT:              &step1or2SendKeys{next: HandshakeFrame_STEP_2_KEY_AGREEMENT},
 		HandshakeFrame_STEP_2_KEY_AGREEMENT:              &step1or2ReceiveKey{next: HandshakeFrame_STEP_3A_KNOWN_IDENTITY_PROOF},
 		HandshakeFrame_STEP_3A_KNOWN_IDENTITY_PROOF:      &step3ProveOtherKey{next: HandshakeFrame_STEP_4A_KNOWN_IDENTITY_DISCLOSURE},
@@ -120,12 +330,41 @@ func Request(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey, pk p2pcry
 }
 
 func Response(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey) (p2pcrypto.PubKey, error) {
+	otherPK, _, err := ResponseWithDevice(ctx, conn, sk, nil)
+
+	return otherPK, err
+}
+
+// ResponseWithDevice performs the same handshake as Response, the device is
+// disclosed to the other peer if not nil, the device of the other peer is
+// returned if it has disclosed one
+func ResponseWithDevice(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey, device *OwnDevice) (p2pcrypto.PubKey, *OtherDevice, error) {
+	f, err := response(ctx, conn, sk, device)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return f.otherPK, f.otherDevice, nil
+}
+
+// ResponseSecure performs the same handshake as ResponseWithDevice and
+// returns an encrypted channel to communicate with the other peer
+func ResponseSecure(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey, device *OwnDevice) (*SecureConn, error) {
+	f, err := response(ctx, conn, sk, device)
+	if err != nil {
+		return nil, err
+	}
+
+	return newSecureConn(f)
+}
+
+func response(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey, device *OwnDevice) (*flow, error) {
 	session, err := newCryptoResponse(sk)
 	if err != nil {
 		return nil, err
 	}
 
-	return newHandshakeFlow(ctx, conn, sk.GetPublic(), session, map[HandshakeFrame_HandshakeStep]flowStep{
+	return newHandshakeFlow(ctx, conn, sk.GetPublic(), device, session, map[HandshakeFrame_HandshakeStep]flowStep{
 		HandshakeFrame_STEP_1_KEY_AGREEMENT:              &step1or2ReceiveKey{next: HandshakeFrame_STEP_2_KEY_AGREEMENT},
 		HandshakeFrame_STEP_2_KEY_AGREEMENT:              &step1or2SendKeys{next: HandshakeFrame_STEP_3A_KNOWN_IDENTITY_PROOF},
 		HandshakeFrame_STEP_3A_KNOWN_IDENTITY_PROOF:      &step3CheckOwnKey{next: HandshakeFrame_STEP_4A_KNOWN_IDENTITY_DISCLOSURE},
@@ -133,3 +372,61 @@ func Response(ctx context.Context, conn net.Conn, sk p2pcrypto.PrivKey) (p2pcryp
 		HandshakeFrame_STEP_5A_KNOWN_IDENTITY_DISCLOSURE: &step4or5CheckSigChainProof{next: HandshakeFrame_STEP_9_DONE},
 	})
 }
+
+// LinkDeviceRequest is performed by a new device to join the account of the
+// device it is connected to, the link secret must have been shared by the
+// other device out of band, the secrets of the account are returned once the
+// other device has proven it owns the account key
+func LinkDeviceRequest(ctx context.Context, conn net.Conn, deviceSK p2pcrypto.PrivKey, accountPK p2pcrypto.PubKey, linkSecret []byte) (*DeviceLinkSecrets, error) {
+	session, err := newCryptoRequest(deviceSK, accountPK)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := newFlow(conn, deviceSK.GetPublic(), session, map[HandshakeFrame_HandshakeStep]flowStep{
+		HandshakeFrame_STEP_1_KEY_AGREEMENT:            &step1or2SendKeys{next: HandshakeFrame_STEP_2_KEY_AGREEMENT},
+		HandshakeFrame_STEP_2_KEY_AGREEMENT:            &step1or2ReceiveKey{next: HandshakeFrame_STEP_3A_KNOWN_IDENTITY_PROOF},
+		HandshakeFrame_STEP_3A_KNOWN_IDENTITY_PROOF:    &step3ProveOtherKey{next: HandshakeFrame_STEP_3B_KNOWN_DEVICE_PROOF},
+		HandshakeFrame_STEP_3B_KNOWN_DEVICE_PROOF:      &step3bProveDeviceKey{next: HandshakeFrame_STEP_4B_KNOWN_DEVICE_DISCLOSURE},
+		HandshakeFrame_STEP_4B_KNOWN_DEVICE_DISCLOSURE: &step4bReceiveLinkSecrets{next: HandshakeFrame_STEP_9_DONE},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	f.linkSecret = linkSecret
+	f.requiredCapabilities = []HandshakeFrame_Capability{HandshakeFrame_CAPABILITY_DEVICE_LINK}
+
+	if _, err := f.performFlow(ctx); err != nil {
+		return nil, err
+	}
+
+	return f.linkSecrets, nil
+}
+
+// LinkDeviceResponse is performed by a device of an account to send the
+// secrets of the account to a new device, getLinkSecrets is called once the
+// new device has proven it knows the link secret, its public key is returned
+func LinkDeviceResponse(ctx context.Context, conn net.Conn, accountSK p2pcrypto.PrivKey, linkSecret []byte, getLinkSecrets func(devicePK p2pcrypto.PubKey) (*DeviceLinkSecrets, error)) (p2pcrypto.PubKey, error) {
+	session, err := newCryptoResponse(accountSK)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := newFlow(conn, accountSK.GetPublic(), session, map[HandshakeFrame_HandshakeStep]flowStep{
+		HandshakeFrame_STEP_1_KEY_AGREEMENT:            &step1or2ReceiveKey{next: HandshakeFrame_STEP_2_KEY_AGREEMENT},
+		HandshakeFrame_STEP_2_KEY_AGREEMENT:            &step1or2SendKeys{next: HandshakeFrame_STEP_3A_KNOWN_IDENTITY_PROOF},
+		HandshakeFrame_STEP_3A_KNOWN_IDENTITY_PROOF:    &step3CheckOwnKey{next: HandshakeFrame_STEP_3B_KNOWN_DEVICE_PROOF},
+		HandshakeFrame_STEP_3B_KNOWN_DEVICE_PROOF:      &step3bCheckDeviceKey{next: HandshakeFrame_STEP_4B_KNOWN_DEVICE_DISCLOSURE},
+		HandshakeFrame_STEP_4B_KNOWN_DEVICE_DISCLOSURE: &step4bSendLinkSecrets{next: HandshakeFrame_STEP_9_DONE},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	f.linkSecret = linkSecret
+	f.getLinkSecrets = getLinkSecrets
+	f.requiredCapabilities = []HandshakeFrame_Capability{HandshakeFrame_CAPABILITY_DEVICE_LINK}
+
+	return f.performFlow(ctx)
+}
@@ -4,18 +4,22 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"encoding/binary"
 	"errors"
+	"io"
 	"net"
 	"sync"
 	"testing"
 	"time"
 
+	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/pkg/errcode"
 	ggio "github.com/gogo/protobuf/io"
 	"github.com/gogo/protobuf/proto"
 	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/nacl/box"
 )
 
 var (
@@ -234,3 +238,546 @@ func Test_Request_Response(t *testing.T) {
 
 	wg.Wait()
 }
+
+func Test_Request_Response_TamperedKeyAgreement(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancel()
+
+	reqPrivateKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	resPrivateKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	// a third party strips the capabilities advertised by the requester
+	reqConn, resConn := newTamperingRelay(func(frame *HandshakeFrame) {
+		if frame.Step == HandshakeFrame_STEP_1_KEY_AGREEMENT {
+			frame.Capabilities = nil
+		}
+	})
+
+	reqErr := make(chan error, 1)
+
+	go func() {
+		_, err := Request(ctx, reqConn, reqPrivateKey, resPrivateKey.GetPublic())
+		reqErr <- err
+	}()
+
+	_, err = Response(ctx, resConn, resPrivateKey)
+	require.Equal(t, int32(errcode.ErrHandshakeInvalidSignature), errcode.LastCode(err), "%v", err)
+
+	require.Error(t, <-reqErr)
+}
+
+func Test_Request_Response_UnknownField(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancel()
+
+	reqPrivateKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	resPrivateKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	// a third party adds a field unknown to both peers to the advertisement
+	// of the requester, it is part of the transcript of the responder
+	reqConn, resConn := newTamperingRelay(func(frame *HandshakeFrame) {
+		if frame.Step == HandshakeFrame_STEP_1_KEY_AGREEMENT {
+			frame.XXX_unrecognized = []byte{0x98, 0x06, 0x01}
+		}
+	})
+
+	reqErr := make(chan error, 1)
+
+	go func() {
+		_, err := Request(ctx, reqConn, reqPrivateKey, resPrivateKey.GetPublic())
+		reqErr <- err
+	}()
+
+	_, err = Response(ctx, resConn, resPrivateKey)
+	require.Equal(t, int32(errcode.ErrHandshakeInvalidSignature), errcode.LastCode(err), "%v", err)
+
+	require.Error(t, <-reqErr)
+}
+
+func Test_Request_Response_BaselinePeer(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancel()
+
+	reqPrivateKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	resPrivateKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	t.Run("baseline responder", func(t *testing.T) {
+		reqConn, resConn := net.Pipe()
+		defer reqConn.Close()
+
+		resErr := make(chan error, 1)
+
+		go func() {
+			otherPK, err := newBaselinePeer(resConn, resPrivateKey).response()
+			if err == nil && !otherPK.Equals(reqPrivateKey.GetPublic()) {
+				err = ErrNotExpectedMsg
+			}
+
+			resErr <- err
+		}()
+
+		otherPK, err := Request(ctx, reqConn, reqPrivateKey, resPrivateKey.GetPublic())
+		require.NoError(t, err)
+		require.True(t, otherPK.Equals(resPrivateKey.GetPublic()))
+		require.NoError(t, <-resErr)
+	})
+
+	t.Run("baseline requester", func(t *testing.T) {
+		reqConn, resConn := net.Pipe()
+		defer resConn.Close()
+
+		reqErr := make(chan error, 1)
+
+		go func() {
+			otherPK, err := newBaselinePeer(reqConn, reqPrivateKey).request(resPrivateKey.GetPublic())
+			if err == nil && !otherPK.Equals(resPrivateKey.GetPublic()) {
+				err = ErrNotExpectedMsg
+			}
+
+			reqErr <- err
+		}()
+
+		otherPK, err := Response(ctx, resConn, resPrivateKey)
+		require.NoError(t, err)
+		require.True(t, otherPK.Equals(reqPrivateKey.GetPublic()))
+		require.NoError(t, <-reqErr)
+	})
+}
+
+// baselinePeer performs the handshake as the peers predating the version
+// negotiation, the frames of steps 1 and 2 only hold the keys and the proofs
+// are sig_a(B·b) and sig_B(a)
+type baselinePeer struct {
+	reader      ggio.ReadCloser
+	writer      ggio.WriteCloser
+	sk          p2pcrypto.PrivKey
+	signSK      p2pcrypto.PrivKey
+	boxPK       *[32]byte
+	boxSK       *[32]byte
+	otherSignPK p2pcrypto.PubKey
+	otherBoxPK  *[32]byte
+	nonce       uint16
+}
+
+func newBaselinePeer(conn net.Conn, sk p2pcrypto.PrivKey) *baselinePeer {
+	return &baselinePeer{
+		reader: ggio.NewDelimitedReader(conn, 1<<16),
+		writer: ggio.NewDelimitedWriter(conn),
+		sk:     sk,
+	}
+}
+
+func (p *baselinePeer) request(pk p2pcrypto.PubKey) (p2pcrypto.PubKey, error) {
+	if err := p.sendKeys(HandshakeFrame_STEP_1_KEY_AGREEMENT); err != nil {
+		return nil, err
+	}
+
+	if err := p.receiveKeys(); err != nil {
+		return nil, err
+	}
+
+	proof, err := p.proveKey(p.signSK, pk, p2pcrypto.PubKey.Raw)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.sendPayload(HandshakeFrame_STEP_3A_KNOWN_IDENTITY_PROOF, &HandshakePayload{Signature: proof}); err != nil {
+		return nil, err
+	}
+
+	otherPK, err := p.receiveAccountProof()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.sendAccountProof(HandshakeFrame_STEP_5A_KNOWN_IDENTITY_DISCLOSURE); err != nil {
+		return nil, err
+	}
+
+	return otherPK, nil
+}
+
+func (p *baselinePeer) response() (p2pcrypto.PubKey, error) {
+	if err := p.receiveKeys(); err != nil {
+		return nil, err
+	}
+
+	if err := p.sendKeys(HandshakeFrame_STEP_2_KEY_AGREEMENT); err != nil {
+		return nil, err
+	}
+
+	payload, err := p.receivePayload()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkProof(p.otherSignPK, p.sk.GetPublic(), p2pcrypto.PubKey.Raw, payload.Signature); err != nil {
+		return nil, err
+	}
+
+	if err := p.sendAccountProof(HandshakeFrame_STEP_4A_KNOWN_IDENTITY_DISCLOSURE); err != nil {
+		return nil, err
+	}
+
+	return p.receiveAccountProof()
+}
+
+func (p *baselinePeer) sendKeys(step HandshakeFrame_HandshakeStep) error {
+	var err error
+
+	if p.boxPK, p.boxSK, err = box.GenerateKey(rand.Reader); err != nil {
+		return err
+	}
+
+	if p.signSK, _, err = p2pcrypto.GenerateEd25519Key(rand.Reader); err != nil {
+		return err
+	}
+
+	signKey, err := p2pcrypto.MarshalPublicKey(p.signSK.GetPublic())
+	if err != nil {
+		return err
+	}
+
+	return p.writer.WriteMsg(&HandshakeFrame{
+		Step:          step,
+		SignatureKey:  signKey,
+		EncryptionKey: p.boxPK[:],
+	})
+}
+
+func (p *baselinePeer) receiveKeys() error {
+	frame := &HandshakeFrame{}
+	if err := p.reader.ReadMsg(frame); err != nil {
+		return err
+	}
+
+	var err error
+
+	if p.otherSignPK, err = p2pcrypto.UnmarshalPublicKey(frame.SignatureKey); err != nil {
+		return err
+	}
+
+	p.otherBoxPK, err = bytesSliceToArray(frame.EncryptionKey)
+
+	return err
+}
+
+// sendAccountProof discloses the account key along with sig_B(a)
+func (p *baselinePeer) sendAccountProof(step HandshakeFrame_HandshakeStep) error {
+	proof, err := p.proveKey(p.sk, p.sk.GetPublic(), p2pcrypto.MarshalPublicKey)
+	if err != nil {
+		return err
+	}
+
+	accountKey, err := p2pcrypto.MarshalPublicKey(p.sk.GetPublic())
+	if err != nil {
+		return err
+	}
+
+	return p.sendPayload(step, &HandshakePayload{Signature: proof, AccountKey: accountKey})
+}
+
+func (p *baselinePeer) receiveAccountProof() (p2pcrypto.PubKey, error) {
+	payload, err := p.receivePayload()
+	if err != nil {
+		return nil, err
+	}
+
+	accountKey, err := p2pcrypto.UnmarshalPublicKey(payload.AccountKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.checkProof(accountKey, accountKey, p2pcrypto.MarshalPublicKey, payload.Signature); err != nil {
+		return nil, err
+	}
+
+	return accountKey, nil
+}
+
+// proveKey signs the key to prove followed by the box key of the other peer
+func (p *baselinePeer) proveKey(sk p2pcrypto.PrivKey, key p2pcrypto.PubKey, encode func(p2pcrypto.PubKey) ([]byte, error)) ([]byte, error) {
+	value, err := encode(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return sk.Sign(append(value, p.otherBoxPK[:]...))
+}
+
+// checkProof ensures the key has been signed followed by the box key of the
+// current peer
+func (p *baselinePeer) checkProof(signer p2pcrypto.PubKey, key p2pcrypto.PubKey, encode func(p2pcrypto.PubKey) ([]byte, error), sig []byte) error {
+	value, err := encode(key)
+	if err != nil {
+		return err
+	}
+
+	ok, err := signer.Verify(append(value, p.boxPK[:]...), sig)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return errcode.ErrHandshakeInvalidSignature
+	}
+
+	return nil
+}
+
+func (p *baselinePeer) nextNonce() *[24]byte {
+	var nonce [24]byte
+	binary.BigEndian.PutUint16(nonce[:], p.nonce)
+	p.nonce++
+
+	return &nonce
+}
+
+func (p *baselinePeer) sendPayload(step HandshakeFrame_HandshakeStep, payload *HandshakePayload) error {
+	data, err := payload.Marshal()
+	if err != nil {
+		return err
+	}
+
+	return p.writer.WriteMsg(&HandshakeFrame{
+		Step:             step,
+		EncryptedPayload: box.Seal(nil, data, p.nextNonce(), p.otherBoxPK, p.boxSK),
+	})
+}
+
+func (p *baselinePeer) receivePayload() (*HandshakePayload, error) {
+	frame := &HandshakeFrame{}
+	if err := p.reader.ReadMsg(frame); err != nil {
+		return nil, err
+	}
+
+	data, ok := box.Open(nil, frame.EncryptedPayload, p.nextNonce(), p.otherBoxPK, p.boxSK)
+	if !ok {
+		return nil, errcode.ErrHandshakeDecrypt
+	}
+
+	payload := &HandshakePayload{}
+	if err := payload.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// newTamperingRelay returns the connections of a requester and of a
+// responder, the frames sent by the requester are altered by tamper before
+// being relayed
+func newTamperingRelay(tamper func(frame *HandshakeFrame)) (net.Conn, net.Conn) {
+	reqConn, reqRelayConn := net.Pipe()
+	resRelayConn, resConn := net.Pipe()
+
+	go func() {
+		reader := ggio.NewDelimitedReader(reqRelayConn, 1<<16)
+		writer := ggio.NewDelimitedWriter(resRelayConn)
+
+		for {
+			frame := &HandshakeFrame{}
+			if err := reader.ReadMsg(frame); err != nil {
+				_ = resRelayConn.Close()
+				return
+			}
+
+			tamper(frame)
+
+			if err := writer.WriteMsg(frame); err != nil {
+				_ = reqRelayConn.Close()
+				return
+			}
+		}
+	}()
+
+	go func() {
+		_, _ = io.Copy(reqRelayConn, resRelayConn)
+		_ = reqRelayConn.Close()
+	}()
+
+	return reqConn, resConn
+}
+
+func Test_Response_Timeouts(t *testing.T) {
+	resPrivateKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		timeouts Timeouts
+		cancel   bool
+		expected error
+	}{
+		{
+			name:     "step timeout",
+			timeouts: Timeouts{Step: time.Millisecond * 100, Overall: time.Second * 2},
+			expected: errcode.ErrHandshakeStepTimeout,
+		},
+		{
+			name:     "overall timeout",
+			timeouts: Timeouts{Overall: time.Millisecond * 100},
+			expected: errcode.ErrHandshakeTimeout,
+		},
+		{
+			name:     "canceled",
+			cancel:   true,
+			expected: errcode.ErrHandshakeCanceled,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(WithTimeouts(context.Background(), c.timeouts))
+			defer cancel()
+
+			// the other peer opens the connection and stays silent
+			silentConn, resConn := net.Pipe()
+			defer silentConn.Close()
+
+			if c.cancel {
+				time.AfterFunc(time.Millisecond*100, cancel)
+			}
+
+			start := time.Now()
+
+			_, err := Response(ctx, resConn, resPrivateKey)
+			testSameErrcodes(t, c.expected, err)
+			assert.True(t, time.Since(start) < time.Second)
+
+			// the connection is closed once the handshake has failed
+			_, err = silentConn.Write([]byte("data"))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func Test_RequestWithDevice_ResponseWithDevice(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancel()
+
+	reqPrivateKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	reqDeviceKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	resPrivateKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	sigChain := &account.SigChain{}
+	_, err = sigChain.Init(reqPrivateKey, reqDeviceKey.GetPublic())
+	require.NoError(t, err)
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	reqConn, resConn := net.Pipe()
+
+	go func() {
+		defer wg.Done()
+
+		_, otherDevice, err := RequestWithDevice(ctx, reqConn, reqPrivateKey, resPrivateKey.GetPublic(), &OwnDevice{
+			DeviceSK: reqDeviceKey,
+			SigChain: sigChain,
+		})
+		require.NoError(t, err)
+
+		assert.Nil(t, otherDevice)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		resProvedKey, otherDevice, err := ResponseWithDevice(ctx, resConn, resPrivateKey, nil)
+		require.NoError(t, err)
+
+		assert.True(t, resProvedKey.Equals(reqPrivateKey.GetPublic()))
+		require.NotNil(t, otherDevice)
+		assert.True(t, otherDevice.DevicePK.Equals(reqDeviceKey.GetPublic()))
+	}()
+
+	go func() {
+		select {
+		case <-time.After(time.Second * 2):
+			wg.Done()
+			wg.Done()
+			t.Fail()
+		case <-ctx.Done():
+			return
+		}
+	}()
+
+	wg.Wait()
+}
+
+func Test_LinkDeviceRequest_LinkDeviceResponse(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*2)
+	defer cancel()
+
+	accountKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	deviceKey, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	secret := []byte("link secret")
+	secrets := &DeviceLinkSecrets{AccountKey: []byte("account key")}
+
+	cases := []struct {
+		name          string
+		requestSecret []byte
+		expectSuccess bool
+	}{
+		{name: "valid secret", requestSecret: secret, expectSuccess: true},
+		{name: "invalid secret", requestSecret: []byte("other secret"), expectSuccess: false},
+	}
+
+	for _, c := range cases {
+		wg := sync.WaitGroup{}
+		wg.Add(2)
+
+		reqConn, resConn := net.Pipe()
+
+		go func() {
+			defer wg.Done()
+			defer reqConn.Close()
+
+			received, err := LinkDeviceRequest(ctx, reqConn, deviceKey, accountKey.GetPublic(), c.requestSecret)
+			if !c.expectSuccess {
+				assert.Error(t, err, c.name)
+				return
+			}
+
+			require.NoError(t, err, c.name)
+			assert.Equal(t, secrets.AccountKey, received.AccountKey, c.name)
+		}()
+
+		go func() {
+			defer wg.Done()
+			defer resConn.Close()
+
+			devicePK, err := LinkDeviceResponse(ctx, resConn, accountKey, secret, func(pk p2pcrypto.PubKey) (*DeviceLinkSecrets, error) {
+				return secrets, nil
+			})
+			if !c.expectSuccess {
+				assert.Error(t, err, c.name)
+				return
+			}
+
+			require.NoError(t, err, c.name)
+			assert.True(t, devicePK.Equals(deviceKey.GetPublic()), c.name)
+		}()
+
+		wg.Wait()
+	}
+}
@@ -0,0 +1,113 @@
+package handshake
+
+import (
+	"context"
+
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/protocol"
+
+	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+// ProtocolID is the libp2p protocol used to perform a handshake on a stream
+const ProtocolID protocol.ID = "/berty/handshake/1.0.0"
+
+// Session is a libp2p stream on which a handshake has been performed, the
+// account key of the other peer has been authenticated. It can be used as a
+// net.Conn to exchange messages encrypted for the other peer.
+type Session struct {
+	*SecureConn
+
+	stream network.Stream
+}
+
+// RemotePeer returns the libp2p peer the session is opened with
+func (s *Session) RemotePeer() peer.ID {
+	return s.stream.Conn().RemotePeer()
+}
+
+// Reset closes both sides of the underlying stream
+func (s *Session) Reset() error {
+	return s.stream.Reset()
+}
+
+// Dial opens a stream to a peer and performs the request side of the
+// handshake, the peer must prove it owns the account key pk
+func Dial(ctx context.Context, h host.Host, pi peer.AddrInfo, sk p2pcrypto.PrivKey, pk p2pcrypto.PubKey, device *OwnDevice) (*Session, error) {
+	return DialProtocol(ctx, h, pi, ProtocolID, sk, pk, device)
+}
+
+// DialProtocol is the same as Dial using another protocol, it allows
+// protocols to start with a handshake before exchanging their own messages
+func DialProtocol(ctx context.Context, h host.Host, pi peer.AddrInfo, pid protocol.ID, sk p2pcrypto.PrivKey, pk p2pcrypto.PubKey, device *OwnDevice) (*Session, error) {
+	if h == nil || sk == nil || pk == nil {
+		return nil, errcode.ErrHandshakeParams
+	}
+
+	if len(pi.Addrs) > 0 {
+		if err := h.Connect(ctx, pi); err != nil {
+			return nil, errcode.TODO.Wrap(err)
+		}
+	}
+
+	s, err := h.NewStream(ctx, pi.ID, pid)
+	if err != nil {
+		return nil, errcode.TODO.Wrap(err)
+	}
+
+	conn, err := RequestSecure(ctx, ipfsutil.NewStreamConn(s), sk, pk, device)
+	if err != nil {
+		_ = s.Reset()
+		return nil, err
+	}
+
+	return &Session{
+		SecureConn: conn,
+		stream:     s,
+	}, nil
+}
+
+// Accept performs the response side of the handshake on an incoming stream,
+// the stream is reset if the handshake fails
+func Accept(ctx context.Context, s network.Stream, sk p2pcrypto.PrivKey, device *OwnDevice) (*Session, error) {
+	if s == nil || sk == nil {
+		return nil, errcode.ErrHandshakeParams
+	}
+
+	conn, err := ResponseSecure(ctx, ipfsutil.NewStreamConn(s), sk, device)
+	if err != nil {
+		_ = s.Reset()
+		return nil, err
+	}
+
+	return &Session{
+		SecureConn: conn,
+		stream:     s,
+	}, nil
+}
+
+// NewStreamHandler returns a stream handler accepting handshakes on incoming
+// streams, handler is called with each authenticated session which is closed
+// once it returns. The handshakes are canceled when ctx is done.
+func NewStreamHandler(ctx context.Context, sk p2pcrypto.PrivKey, device *OwnDevice, handler func(s *Session)) network.StreamHandler {
+	return func(s network.Stream) {
+		session, err := Accept(ctx, s, sk, device)
+		if err != nil {
+			return
+		}
+
+		defer session.Close()
+
+		handler(session)
+	}
+}
+
+// SetStreamHandler registers a stream handler accepting handshakes on the
+// host, see NewStreamHandler
+func SetStreamHandler(ctx context.Context, h host.Host, sk p2pcrypto.PrivKey, device *OwnDevice, handler func(s *Session)) {
+	h.SetStreamHandler(ProtocolID, NewStreamHandler(ctx, sk, device, handler))
+}
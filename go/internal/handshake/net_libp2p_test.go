@@ -0,0 +1,81 @@
+package handshake
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	ggio "github.com/gogo/protobuf/io"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	mocknet "github.com/libp2p/go-libp2p/p2p/net/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Dial_SetStreamHandler(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	mn := mocknet.New(ctx)
+
+	reqHost, err := mn.GenPeer()
+	require.NoError(t, err)
+
+	resHost, err := mn.GenPeer()
+	require.NoError(t, err)
+
+	require.NoError(t, mn.LinkAll())
+
+	reqSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	resSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	_, otherPK, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	sessions := make(chan *Session, 1)
+
+	SetStreamHandler(ctx, resHost, resSK, nil, func(s *Session) {
+		// echo the first frame
+		frame := &HandshakeFrame{}
+		if err := ggio.NewDelimitedReader(s, network.MessageSizeMax).ReadMsg(frame); err == nil {
+			_ = ggio.NewDelimitedWriter(s).WriteMsg(frame)
+		}
+
+		sessions <- s
+	})
+
+	pi := peer.AddrInfo{ID: resHost.ID(), Addrs: resHost.Addrs()}
+
+	// the other peer can't prove it owns another account key
+	_, err = Dial(ctx, reqHost, pi, reqSK, otherPK, nil)
+	require.Error(t, err)
+
+	session, err := Dial(ctx, reqHost, pi, reqSK, resSK.GetPublic(), nil)
+	require.NoError(t, err)
+
+	defer session.Close()
+
+	assert.True(t, session.OtherAccountPubKey().Equals(resSK.GetPublic()))
+	assert.Equal(t, resHost.ID(), session.RemotePeer())
+
+	sent := &HandshakeFrame{Step: HandshakeFrame_STEP_9_DONE, EncryptedPayload: []byte("payload")}
+	require.NoError(t, ggio.NewDelimitedWriter(session).WriteMsg(sent))
+
+	received := &HandshakeFrame{}
+	require.NoError(t, ggio.NewDelimitedReader(session, network.MessageSizeMax).ReadMsg(received))
+	assert.Equal(t, sent.EncryptedPayload, received.EncryptedPayload)
+
+	select {
+	case s := <-sessions:
+		assert.True(t, s.OtherAccountPubKey().Equals(reqSK.GetPublic()))
+		assert.Equal(t, reqHost.ID(), s.RemotePeer())
+	case <-ctx.Done():
+		t.Fatal("the stream handler has not been called")
+	}
+}
@@ -0,0 +1,156 @@
+package handshake
+
+import (
+	"encoding/binary"
+	"net"
+	"sync"
+	"time"
+
+	ggio "github.com/gogo/protobuf/io"
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"golang.org/x/crypto/nacl/secretbox"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+// secureConnMaxPayload is the maximum size of the data sealed in a single
+// frame, larger writes are split in several frames
+const secureConnMaxPayload = 1 << 16
+
+// SecureConn is an encrypted channel opened once a handshake is done, the
+// data is sealed using keys derived from the ones agreed during the
+// handshake. Frames are numbered so they can't be replayed or reordered.
+type SecureConn struct {
+	conn   net.Conn
+	reader ggio.ReadCloser
+	writer ggio.WriteCloser
+
+	otherPK     p2pcrypto.PubKey
+	otherDevice *OtherDevice
+
+	readLock     sync.Mutex
+	receiveKey   *[32]byte
+	receiveNonce uint64
+	readBuf      []byte
+
+	writeLock sync.Mutex
+	sendKey   *[32]byte
+	sendNonce uint64
+}
+
+// newSecureConn creates the channel of a completed handshake flow, it keeps
+// using the reader of the flow as it may hold data already sent on the
+// channel
+func newSecureConn(f *flow) (*SecureConn, error) {
+	if f.conn == nil || f.otherPK == nil {
+		return nil, errcode.ErrHandshakeSessionInvalid
+	}
+
+	sendKey, receiveKey, err := f.session.channelKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecureConn{
+		conn:        f.conn,
+		reader:      f.reader,
+		writer:      f.writer,
+		otherPK:     f.otherPK,
+		otherDevice: f.otherDevice,
+		receiveKey:  receiveKey,
+		sendKey:     sendKey,
+	}, nil
+}
+
+func secureConnNonce(counter uint64) *[24]byte {
+	var nonce [24]byte
+	binary.BigEndian.PutUint64(nonce[:], counter)
+
+	return &nonce
+}
+
+// OtherAccountPubKey returns the account key proven by the other peer
+func (c *SecureConn) OtherAccountPubKey() p2pcrypto.PubKey {
+	return c.otherPK
+}
+
+// OtherDevice returns the device disclosed by the other peer, if any
+func (c *SecureConn) OtherDevice() *OtherDevice {
+	return c.otherDevice
+}
+
+func (c *SecureConn) Read(b []byte) (int, error) {
+	c.readLock.Lock()
+	defer c.readLock.Unlock()
+
+	for len(c.readBuf) == 0 {
+		frame := &SecureChannelFrame{}
+		if err := c.reader.ReadMsg(frame); err != nil {
+			return 0, err
+		}
+
+		data, ok := secretbox.Open(nil, frame.EncryptedPayload, secureConnNonce(c.receiveNonce), c.receiveKey)
+		if !ok {
+			return 0, errcode.ErrHandshakeDecrypt
+		}
+
+		c.receiveNonce++
+		c.readBuf = data
+	}
+
+	n := copy(b, c.readBuf)
+	c.readBuf = c.readBuf[n:]
+
+	return n, nil
+}
+
+func (c *SecureConn) Write(b []byte) (int, error) {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	written := 0
+
+	for written < len(b) {
+		end := written + secureConnMaxPayload
+		if end > len(b) {
+			end = len(b)
+		}
+
+		sealed := secretbox.Seal(nil, b[written:end], secureConnNonce(c.sendNonce), c.sendKey)
+
+		if err := c.writer.WriteMsg(&SecureChannelFrame{EncryptedPayload: sealed}); err != nil {
+			return written, err
+		}
+
+		c.sendNonce++
+		written = end
+	}
+
+	return written, nil
+}
+
+func (c *SecureConn) Close() error {
+	return c.conn.Close()
+}
+
+func (c *SecureConn) LocalAddr() net.Addr {
+	return c.conn.LocalAddr()
+}
+
+func (c *SecureConn) RemoteAddr() net.Addr {
+	return c.conn.RemoteAddr()
+}
+
+func (c *SecureConn) SetDeadline(t time.Time) error {
+	return c.conn.SetDeadline(t)
+}
+
+func (c *SecureConn) SetReadDeadline(t time.Time) error {
+	return c.conn.SetReadDeadline(t)
+}
+
+func (c *SecureConn) SetWriteDeadline(t time.Time) error {
+	return c.conn.SetWriteDeadline(t)
+}
+
+var _ net.Conn = (*SecureConn)(nil)
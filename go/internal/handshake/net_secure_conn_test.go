@@ -0,0 +1,113 @@
+package handshake
+
+import (
+	"context"
+	"crypto/rand"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+func Test_RequestSecure_ResponseSecure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	reqSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	resSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	reqConn, resConn := net.Pipe()
+
+	resCh := make(chan *SecureConn, 1)
+
+	go func() {
+		conn, err := ResponseSecure(ctx, resConn, resSK, nil)
+		assert.NoError(t, err)
+		resCh <- conn
+	}()
+
+	req, err := RequestSecure(ctx, reqConn, reqSK, resSK.GetPublic(), nil)
+	require.NoError(t, err)
+	defer req.Close()
+
+	res := <-resCh
+	require.NotNil(t, res)
+	defer res.Close()
+
+	assert.True(t, req.OtherAccountPubKey().Equals(resSK.GetPublic()))
+	assert.True(t, res.OtherAccountPubKey().Equals(reqSK.GetPublic()))
+
+	// the payload is split in several frames
+	sent := make([]byte, secureConnMaxPayload*2+42)
+	_, err = rand.Read(sent)
+	require.NoError(t, err)
+
+	go func() {
+		_, err := req.Write(sent)
+		assert.NoError(t, err)
+	}()
+
+	received := make([]byte, len(sent))
+	_, err = io.ReadFull(res, received)
+	require.NoError(t, err)
+	assert.Equal(t, sent, received)
+
+	// both directions use their own keys and nonces
+	go func() {
+		_, err := res.Write([]byte("response"))
+		assert.NoError(t, err)
+	}()
+
+	received = make([]byte, len("response"))
+	_, err = io.ReadFull(req, received)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("response"), received)
+}
+
+func Test_SecureConn_Tampered(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	reqSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	resSK, _, err := p2pcrypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	reqConn, resConn := net.Pipe()
+
+	resCh := make(chan *SecureConn, 1)
+
+	go func() {
+		conn, err := ResponseSecure(ctx, resConn, resSK, nil)
+		assert.NoError(t, err)
+		resCh <- conn
+	}()
+
+	req, err := RequestSecure(ctx, reqConn, reqSK, resSK.GetPublic(), nil)
+	require.NoError(t, err)
+	defer req.Close()
+
+	res := <-resCh
+	require.NotNil(t, res)
+	defer res.Close()
+
+	// frames can't be replayed or reordered
+	req.sendNonce = 1
+
+	go func() {
+		_, _ = req.Write([]byte("data"))
+	}()
+
+	_, err = res.Read(make([]byte, 4))
+	testSameErrcodes(t, errcode.ErrHandshakeDecrypt, err)
+}
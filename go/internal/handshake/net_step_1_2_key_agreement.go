@@ -19,14 +19,60 @@ func (s *step1or2SendKeys) action(ctx context.Context, f *flow, step HandshakeFr
 		return nil, errcode.TODO.Wrap(err)
 	}
 
-	if err = f.writer.WriteMsg(&HandshakeFrame{
+	frame := &HandshakeFrame{
 		Step:          step,
 		SignatureKey:  signKeyProto,
 		EncryptionKey: encryptKey,
-	}); err != nil {
+		Versions:      f.versions,
+		Capabilities:  f.capabilities,
+	}
+
+	if step == HandshakeFrame_STEP_1_KEY_AGREEMENT && f.kem != nil {
+		// the requester sends an ephemeral KEM key in case the hybrid
+		// version is selected
+		frame.KemPublicKey, f.session.kemSK, err = f.kem.GenerateKeyPair()
+		if err != nil {
+			return nil, errcode.TODO.Wrap(err)
+		}
+	}
+
+	// the responder sends the version it selected, or zero so the requester
+	// knows the negotiation failed
+	if step == HandshakeFrame_STEP_2_KEY_AGREEMENT {
+		frame.Version = f.version
+
+		if f.version == handshakeVersion2Hybrid {
+			ciphertext, secret, err := f.kem.Encapsulate(f.session.otherKEMPK)
+			if err != nil {
+				return nil, errcode.TODO.Wrap(err)
+			}
+
+			if err := f.session.setHybridSecret(secret); err != nil {
+				return nil, err
+			}
+
+			frame.KemCiphertext = ciphertext
+		}
+	}
+
+	// the frame is added to the transcript as it is sent
+	data, err := frame.Marshal()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	if err := f.session.addTranscriptFrame(data); err != nil {
+		return nil, err
+	}
+
+	if err = f.writer.WriteMsg(frame); err != nil {
 		return nil, errcode.TODO.Wrap(err)
 	}
 
+	if f.negotiationErr != nil {
+		return nil, f.negotiationErr
+	}
+
 	return &s.next, nil
 }
 
@@ -45,5 +91,46 @@ func (s *step1or2ReceiveKey) action(ctx context.Context, f *flow, step Handshake
 		return nil, errcode.TODO.Wrap(err)
 	}
 
+	// the frame is added to the transcript as it has been received, including
+	// the fields unknown to the current peer
+	data, err := f.lastFrame()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := f.session.addTranscriptFrame(data); err != nil {
+		return nil, err
+	}
+
+	if step == HandshakeFrame_STEP_1_KEY_AGREEMENT {
+		// the failure is reported once the other peer has been notified
+		f.negotiationErr = f.negotiateResponderVersion(readMsg)
+		f.session.otherKEMPK = readMsg.KemPublicKey
+		f.session.bindTranscript = f.version >= handshakeVersion2Hybrid
+
+		return &s.next, nil
+	}
+
+	if err := f.negotiateRequesterVersion(readMsg); err != nil {
+		return nil, err
+	}
+
+	f.session.bindTranscript = f.version >= handshakeVersion2Hybrid
+
+	if f.version == handshakeVersion2Hybrid {
+		if f.kem == nil || f.session.kemSK == nil {
+			return nil, errcode.ErrHandshakeSessionInvalid
+		}
+
+		secret, err := f.kem.Decapsulate(f.session.kemSK, readMsg.KemCiphertext)
+		if err != nil {
+			return nil, errcode.TODO.Wrap(err)
+		}
+
+		if err := f.session.setHybridSecret(secret); err != nil {
+			return nil, err
+		}
+	}
+
 	return &s.next, nil
 }
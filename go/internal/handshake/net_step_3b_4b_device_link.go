@@ -0,0 +1,142 @@
+package handshake
+
+import (
+	"context"
+
+	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+type step3bProveDeviceKey struct {
+	next HandshakeFrame_HandshakeStep
+}
+
+func (s *step3bProveDeviceKey) isReadAction() bool { return false }
+func (s *step3bProveDeviceKey) action(ctx context.Context, f *flow, step HandshakeFrame_HandshakeStep, readMsg *HandshakeFrame) (*HandshakeFrame_HandshakeStep, error) {
+	// the session key of the new device is its device key
+	sig, err := f.session.ProveOwnAccountKey()
+	if err != nil {
+		return nil, errcode.ErrSignatureFailed.Wrap(err)
+	}
+
+	linkProof, err := f.session.ProveLinkSecret(f.linkSecret, f.ownPK)
+	if err != nil {
+		return nil, errcode.ErrSignatureFailed.Wrap(err)
+	}
+
+	deviceKey, err := p2pcrypto.MarshalPublicKey(f.ownPK)
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	if err := writeEncryptedPayload(f.session, f.writer, step, &HandshakePayload{
+		Signature: sig,
+		DeviceKey: deviceKey,
+		LinkProof: linkProof,
+	}); err != nil {
+		return nil, errcode.TODO.Wrap(err)
+	}
+
+	return &s.next, nil
+}
+
+type step3bCheckDeviceKey struct {
+	next HandshakeFrame_HandshakeStep
+}
+
+func (s *step3bCheckDeviceKey) isReadAction() bool { return true }
+func (s *step3bCheckDeviceKey) action(ctx context.Context, f *flow, step HandshakeFrame_HandshakeStep, readMsg *HandshakeFrame) (*HandshakeFrame_HandshakeStep, error) {
+	payload, err := decryptPayload(f.session, readMsg.EncryptedPayload)
+	if err != nil {
+		return nil, errcode.TODO.Wrap(err)
+	}
+
+	deviceKey, err := p2pcrypto.UnmarshalPublicKey(payload.DeviceKey)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if err := f.session.CheckOtherKeyProof(payload.Signature, deviceKey); err != nil {
+		return nil, errcode.ErrHandshakeInvalidSignature.Wrap(err)
+	}
+
+	// only a device knowing the secret shared out of band can join the account
+	if err := f.session.CheckLinkSecretProof(payload.LinkProof, f.linkSecret, deviceKey); err != nil {
+		return nil, err
+	}
+
+	f.otherPK = deviceKey
+
+	return &s.next, nil
+}
+
+type step4bSendLinkSecrets struct {
+	next HandshakeFrame_HandshakeStep
+}
+
+func (s *step4bSendLinkSecrets) isReadAction() bool { return false }
+func (s *step4bSendLinkSecrets) action(ctx context.Context, f *flow, step HandshakeFrame_HandshakeStep, readMsg *HandshakeFrame) (*HandshakeFrame_HandshakeStep, error) {
+	if f.otherPK == nil || f.getLinkSecrets == nil {
+		return nil, errcode.ErrHandshakeSessionInvalid
+	}
+
+	proof, err := f.session.ProveOwnAccountKey()
+	if err != nil {
+		return nil, errcode.ErrSignatureFailed.Wrap(err)
+	}
+
+	accountKey, err := p2pcrypto.MarshalPublicKey(f.ownPK)
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	secrets, err := f.getLinkSecrets(f.otherPK)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := writeEncryptedPayload(f.session, f.writer, step, &HandshakePayload{
+		Signature:   proof,
+		AccountKey:  accountKey,
+		LinkSecrets: secrets,
+	}); err != nil {
+		return nil, errcode.TODO.Wrap(err)
+	}
+
+	return &s.next, nil
+}
+
+type step4bReceiveLinkSecrets struct {
+	next HandshakeFrame_HandshakeStep
+}
+
+func (s *step4bReceiveLinkSecrets) isReadAction() bool { return true }
+func (s *step4bReceiveLinkSecrets) action(ctx context.Context, f *flow, step HandshakeFrame_HandshakeStep, readMsg *HandshakeFrame) (*HandshakeFrame_HandshakeStep, error) {
+	payload, err := decryptPayload(f.session, readMsg.EncryptedPayload)
+	if err != nil {
+		return nil, errcode.TODO.Wrap(err)
+	}
+
+	accountKey, err := p2pcrypto.UnmarshalPublicKey(payload.AccountKey)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if !accountKey.Equals(f.session.otherAccountPK) {
+		return nil, errcode.ErrHandshakeInvalidSignature
+	}
+
+	if err := f.session.CheckOtherKeyProof(payload.Signature, accountKey); err != nil {
+		return nil, errcode.ErrHandshakeInvalidSignature.Wrap(err)
+	}
+
+	if payload.LinkSecrets == nil {
+		return nil, errcode.ErrHandshakeNoPayload
+	}
+
+	f.otherPK = accountKey
+	f.linkSecrets = payload.LinkSecrets
+
+	return &s.next, nil
+}
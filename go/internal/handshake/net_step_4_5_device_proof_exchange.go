@@ -3,6 +3,7 @@ package handshake
 import (
 	"context"
 
+	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/pkg/errcode"
 	p2pcrypto "github.com/libp2p/go-libp2p-core/crypto"
 )
@@ -27,11 +28,44 @@ func (s *step4or5CheckSigChainProof) action(ctx context.Context, f *flow, step H
 		return nil, errcode.TODO.Wrap(err)
 	}
 
+	if len(payload.SigChain) > 0 {
+		if f.otherDevice, err = checkDeviceProof(f, signKey, payload); err != nil {
+			return nil, err
+		}
+	}
+
 	f.otherPK = signKey
 
 	return &s.next, nil
 }
 
+// checkDeviceProof ensures the disclosed device is owned by the other peer
+// and is a current device of its account
+func checkDeviceProof(f *flow, accountKey p2pcrypto.PubKey, payload *HandshakePayload) (*OtherDevice, error) {
+	deviceKey, err := p2pcrypto.UnmarshalPublicKey(payload.DeviceKey)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if err := f.session.CheckOtherKeyProof(payload.DeviceSignature, deviceKey); err != nil {
+		return nil, errcode.ErrHandshakeInvalidSignature.Wrap(err)
+	}
+
+	sigChain := &account.SigChain{}
+	if err := sigChain.Unmarshal(payload.SigChain); err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if err := sigChain.VerifyDevice(accountKey, deviceKey); err != nil {
+		return nil, errcode.ErrHandshakeKeyNotInSigChain.Wrap(err)
+	}
+
+	return &OtherDevice{
+		DevicePK: deviceKey,
+		SigChain: sigChain,
+	}, nil
+}
+
 type step4or5SendSigChainProof struct {
 	next HandshakeFrame_HandshakeStep
 }
@@ -48,12 +82,45 @@ func (s *step4or5SendSigChainProof) action(ctx context.Context, f *flow, step Ha
 		return nil, errcode.TODO.Wrap(err)
 	}
 
-	if err := writeEncryptedPayload(f.session, f.writer, step, &HandshakePayload{
+	payload := &HandshakePayload{
 		Signature:  proof,
 		AccountKey: accountPubKey,
-	}); err != nil {
+	}
+
+	if f.ownDevice != nil && f.otherSupports(HandshakeFrame_CAPABILITY_KNOWN_DEVICE) {
+		if err := addDeviceProof(f, payload); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writeEncryptedPayload(f.session, f.writer, step, payload); err != nil {
 		return nil, errcode.TODO.Wrap(err)
 	}
 
 	return &s.next, nil
 }
+
+// addDeviceProof discloses the device performing the handshake along with the
+// sig chain of the account
+func addDeviceProof(f *flow, payload *HandshakePayload) error {
+	sigChain, err := f.ownDevice.SigChain.Marshal()
+	if err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	deviceKey, err := p2pcrypto.MarshalPublicKey(f.ownDevice.DeviceSK.GetPublic())
+	if err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	deviceSig, err := f.session.ProveOwnDeviceKey(f.ownDevice.DeviceSK)
+	if err != nil {
+		return errcode.ErrSignatureFailed.Wrap(err)
+	}
+
+	payload.SigChain = sigChain
+	payload.DeviceKey = deviceKey
+	payload.DeviceSignature = deviceSig
+
+	return nil
+}
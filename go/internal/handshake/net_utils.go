@@ -1,10 +1,75 @@
 package handshake
 
 import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
 	"berty.tech/berty/go/pkg/errcode"
 	ggio "github.com/gogo/protobuf/io"
+	"github.com/gogo/protobuf/proto"
 )
 
+// frameReader reads delimited messages like the reader of ggio and keeps the
+// bytes of the last one, so a frame can be added to the transcript as it has
+// been received
+type frameReader struct {
+	r       *bufio.Reader
+	closer  io.Closer
+	maxSize int
+	last    []byte
+}
+
+var _ ggio.ReadCloser = (*frameReader)(nil)
+
+func newFrameReader(r io.Reader, maxSize int) *frameReader {
+	closer, _ := r.(io.Closer)
+
+	return &frameReader{
+		r:       bufio.NewReader(r),
+		closer:  closer,
+		maxSize: maxSize,
+	}
+}
+
+func (r *frameReader) ReadMsg(msg proto.Message) error {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return err
+	}
+
+	if size > uint64(r.maxSize) {
+		return io.ErrShortBuffer
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		return err
+	}
+
+	r.last = data
+
+	return proto.Unmarshal(data, msg)
+}
+
+func (r *frameReader) Close() error {
+	if r.closer != nil {
+		return r.closer.Close()
+	}
+
+	return nil
+}
+
+// lastFrame returns the bytes of the last frame read by the flow
+func (f *flow) lastFrame() ([]byte, error) {
+	r, ok := f.reader.(*frameReader)
+	if !ok || r.last == nil {
+		return nil, errcode.ErrHandshakeSessionInvalid
+	}
+
+	return r.last, nil
+}
+
 func encryptPayload(session *handshakeSession, payload *HandshakePayload) ([]byte, error) {
 	data, err := payload.Marshal()
 	if err != nil {
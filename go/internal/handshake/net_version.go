@@ -0,0 +1,161 @@
+package handshake
+
+import (
+	"fmt"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const (
+	// handshakeVersion1 is the first version of the handshake, it is assumed
+	// for peers which don't advertise their versions
+	handshakeVersion1 uint32 = 1
+
+	// handshakeVersion2Hybrid is the same as the first version, the keys of
+	// the session are derived from both X25519 and a KEM shared secrets, it
+	// is offered unless the KEM has been disabled using WithHybridKEM
+	handshakeVersion2Hybrid uint32 = 2
+
+	// HandshakeVersion is the preferred version of the handshake
+	HandshakeVersion = handshakeVersion2Hybrid
+)
+
+// supportedVersions are the versions of the handshake the current peer can
+// perform, from the preferred one to the oldest one
+var supportedVersions = []uint32{handshakeVersion1}
+
+// supportedCapabilities are the optional features the current peer supports
+var supportedCapabilities = []HandshakeFrame_Capability{
+	HandshakeFrame_CAPABILITY_KNOWN_DEVICE,
+	HandshakeFrame_CAPABILITY_DEVICE_LINK,
+}
+
+// legacyCapabilities are the capabilities assumed for peers which don't
+// advertise their versions
+var legacyCapabilities = []HandshakeFrame_Capability{
+	HandshakeFrame_CAPABILITY_KNOWN_DEVICE,
+	HandshakeFrame_CAPABILITY_DEVICE_LINK,
+}
+
+// selectVersion returns the preferred version of the requester which is also
+// supported by the responder, zero if there is none
+func selectVersion(requester []uint32, responder []uint32) uint32 {
+	for _, v := range requester {
+		for _, supported := range responder {
+			if v == supported {
+				return v
+			}
+		}
+	}
+
+	return 0
+}
+
+// setHybridKEM makes the flow offer the hybrid version over the other ones,
+// both peers must use the same KEM
+func (f *flow) setHybridKEM(kem KEM) {
+	if kem == nil || f.kem != nil {
+		return
+	}
+
+	f.kem = kem
+	f.versions = append([]uint32{handshakeVersion2Hybrid}, f.versions...)
+}
+
+// setOtherCapabilities records the capabilities advertised by the other peer,
+// peers advertising no version are assumed to support the legacy ones
+func (f *flow) setOtherCapabilities(frame *HandshakeFrame) {
+	capabilities := frame.Capabilities
+	if len(frame.Versions) == 0 {
+		capabilities = legacyCapabilities
+	}
+
+	f.otherCapabilities = map[HandshakeFrame_Capability]struct{}{}
+	for _, c := range capabilities {
+		f.otherCapabilities[c] = struct{}{}
+	}
+}
+
+// otherSupports returns whether the other peer advertised a capability
+func (f *flow) otherSupports(c HandshakeFrame_Capability) bool {
+	_, ok := f.otherCapabilities[c]
+
+	return ok
+}
+
+// checkRequiredCapabilities ensures the other peer supports the capabilities
+// needed by the flow
+func (f *flow) checkRequiredCapabilities() error {
+	for _, c := range f.requiredCapabilities {
+		if !f.otherSupports(c) {
+			return errcode.ErrHandshakeUnsupportedVersion.Wrap(fmt.Errorf("capability %s not supported by the other peer", c))
+		}
+	}
+
+	return nil
+}
+
+// negotiateResponderVersion selects the version of the handshake on the
+// responder side once the advertisement of the requester has been read
+func (f *flow) negotiateResponderVersion(frame *HandshakeFrame) error {
+	f.setOtherCapabilities(frame)
+
+	versions := frame.Versions
+	if len(versions) == 0 {
+		versions = []uint32{handshakeVersion1}
+	}
+
+	// a requester offering the hybrid version always sends its KEM key, the
+	// advertisement has been altered if it is missing
+	if offersHybrid(versions) && len(frame.KemPublicKey) == 0 {
+		return errcode.ErrHandshakeUnsupportedVersion.Wrap(fmt.Errorf("hybrid version offered without a KEM key"))
+	}
+
+	f.version = selectVersion(versions, f.versions)
+
+	// peers which both offer the hybrid version always use it
+	if offersHybrid(versions) && offersHybrid(f.versions) {
+		f.version = handshakeVersion2Hybrid
+	}
+
+	if f.version == 0 {
+		return errcode.ErrHandshakeUnsupportedVersion.Wrap(fmt.Errorf("no common version in %v", frame.Versions))
+	}
+
+	if err := f.checkRequiredCapabilities(); err != nil {
+		f.version = 0
+		return err
+	}
+
+	return nil
+}
+
+// negotiateRequesterVersion checks the version selected by the responder
+func (f *flow) negotiateRequesterVersion(frame *HandshakeFrame) error {
+	f.setOtherCapabilities(frame)
+
+	f.version = frame.Version
+	if len(frame.Versions) == 0 {
+		f.version = handshakeVersion1
+	}
+
+	if selectVersion([]uint32{f.version}, f.versions) == 0 {
+		f.version = 0
+		return errcode.ErrHandshakeUnsupportedVersion.Wrap(fmt.Errorf("no common version in %v", frame.Versions))
+	}
+
+	// the hybrid version is preferred by both peers, the responder can't
+	// select another one if it also offers it
+	if f.version != handshakeVersion2Hybrid && offersHybrid(f.versions) && offersHybrid(frame.Versions) {
+		f.version = 0
+		return errcode.ErrHandshakeUnsupportedVersion.Wrap(fmt.Errorf("hybrid version downgraded to %d", frame.Version))
+	}
+
+	return f.checkRequiredCapabilities()
+}
+
+// offersHybrid returns whether the hybrid version is among the advertised
+// versions
+func offersHybrid(versions []uint32) bool {
+	return selectVersion([]uint32{handshakeVersion2Hybrid}, versions) != 0
+}
@@ -0,0 +1,108 @@
+package handshake
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+func Test_selectVersion(t *testing.T) {
+	assert.Equal(t, uint32(2), selectVersion([]uint32{3, 2, 1}, []uint32{1, 2}))
+	assert.Equal(t, uint32(1), selectVersion([]uint32{1, 2}, []uint32{2, 1}))
+	assert.Equal(t, uint32(0), selectVersion([]uint32{3}, []uint32{1, 2}))
+	assert.Equal(t, uint32(0), selectVersion(nil, []uint32{1}))
+}
+
+func Test_flow_negotiateResponderVersion(t *testing.T) {
+	newResponder := func() *flow {
+		return &flow{versions: []uint32{1, 3}, capabilities: supportedCapabilities}
+	}
+
+	// the preferred version of the requester is selected
+	f := newResponder()
+	require.NoError(t, f.negotiateResponderVersion(&HandshakeFrame{
+		Versions:     []uint32{3, 1},
+		Capabilities: []HandshakeFrame_Capability{HandshakeFrame_CAPABILITY_DEVICE_LINK},
+	}))
+	assert.Equal(t, uint32(3), f.version)
+	assert.True(t, f.otherSupports(HandshakeFrame_CAPABILITY_DEVICE_LINK))
+	assert.False(t, f.otherSupports(HandshakeFrame_CAPABILITY_KNOWN_DEVICE))
+
+	// peers which don't advertise their versions are legacy ones
+	f = newResponder()
+	require.NoError(t, f.negotiateResponderVersion(&HandshakeFrame{}))
+	assert.Equal(t, handshakeVersion1, f.version)
+	assert.True(t, f.otherSupports(HandshakeFrame_CAPABILITY_KNOWN_DEVICE))
+	assert.True(t, f.otherSupports(HandshakeFrame_CAPABILITY_DEVICE_LINK))
+
+	// no common version
+	f = newResponder()
+	testSameErrcodes(t, errcode.ErrHandshakeUnsupportedVersion, f.negotiateResponderVersion(&HandshakeFrame{Versions: []uint32{99}}))
+	assert.Equal(t, uint32(0), f.version)
+
+	// the hybrid version needs the KEM key of the requester
+	f = newResponder()
+	f.versions = []uint32{handshakeVersion2Hybrid, handshakeVersion1}
+	testSameErrcodes(t, errcode.ErrHandshakeUnsupportedVersion, f.negotiateResponderVersion(&HandshakeFrame{Versions: []uint32{handshakeVersion2Hybrid, handshakeVersion1}}))
+	assert.Equal(t, uint32(0), f.version)
+
+	f = newResponder()
+	f.versions = []uint32{handshakeVersion2Hybrid, handshakeVersion1}
+	require.NoError(t, f.negotiateResponderVersion(&HandshakeFrame{Versions: []uint32{handshakeVersion2Hybrid, handshakeVersion1}, KemPublicKey: []byte("key")}))
+	assert.Equal(t, handshakeVersion2Hybrid, f.version)
+
+	// the hybrid version is used whenever both peers offer it
+	f = newResponder()
+	f.versions = []uint32{handshakeVersion2Hybrid, handshakeVersion1}
+	require.NoError(t, f.negotiateResponderVersion(&HandshakeFrame{Versions: []uint32{handshakeVersion1, handshakeVersion2Hybrid}, KemPublicKey: []byte("key")}))
+	assert.Equal(t, handshakeVersion2Hybrid, f.version)
+
+	// a responder without KEM ignores the hybrid version
+	f = newResponder()
+	require.NoError(t, f.negotiateResponderVersion(&HandshakeFrame{Versions: []uint32{handshakeVersion2Hybrid, handshakeVersion1}, KemPublicKey: []byte("key")}))
+	assert.Equal(t, handshakeVersion1, f.version)
+
+	// a required capability is missing
+	f = newResponder()
+	f.requiredCapabilities = []HandshakeFrame_Capability{HandshakeFrame_CAPABILITY_DEVICE_LINK}
+	testSameErrcodes(t, errcode.ErrHandshakeUnsupportedVersion, f.negotiateResponderVersion(&HandshakeFrame{Versions: []uint32{1}}))
+	assert.Equal(t, uint32(0), f.version)
+}
+
+func Test_flow_negotiateRequesterVersion(t *testing.T) {
+	newRequester := func() *flow {
+		return &flow{versions: []uint32{3, 1}, capabilities: supportedCapabilities}
+	}
+
+	f := newRequester()
+	require.NoError(t, f.negotiateRequesterVersion(&HandshakeFrame{Versions: []uint32{1}, Version: 1}))
+	assert.Equal(t, uint32(1), f.version)
+
+	// legacy responders don't send the selected version
+	f = newRequester()
+	require.NoError(t, f.negotiateRequesterVersion(&HandshakeFrame{}))
+	assert.Equal(t, handshakeVersion1, f.version)
+
+	// the responder has found no common version
+	f = newRequester()
+	testSameErrcodes(t, errcode.ErrHandshakeUnsupportedVersion, f.negotiateRequesterVersion(&HandshakeFrame{Versions: []uint32{99}}))
+
+	// the responder has selected a version which wasn't advertised
+	f = newRequester()
+	testSameErrcodes(t, errcode.ErrHandshakeUnsupportedVersion, f.negotiateRequesterVersion(&HandshakeFrame{Versions: []uint32{4}, Version: 4}))
+
+	// a responder offering the hybrid version can't select another one
+	f = newRequester()
+	f.versions = []uint32{handshakeVersion2Hybrid, handshakeVersion1}
+	testSameErrcodes(t, errcode.ErrHandshakeUnsupportedVersion, f.negotiateRequesterVersion(&HandshakeFrame{Versions: []uint32{handshakeVersion2Hybrid, handshakeVersion1}, Version: handshakeVersion1}))
+	assert.Equal(t, uint32(0), f.version)
+
+	// responders without KEM use another version
+	f = newRequester()
+	f.versions = []uint32{handshakeVersion2Hybrid, handshakeVersion1}
+	require.NoError(t, f.negotiateRequesterVersion(&HandshakeFrame{Versions: []uint32{handshakeVersion1}, Version: handshakeVersion1}))
+	assert.Equal(t, handshakeVersion1, f.version)
+}
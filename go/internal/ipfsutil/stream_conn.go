@@ -0,0 +1,46 @@
+package ipfsutil
+
+import (
+	"net"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+)
+
+type streamConn struct {
+	network.Stream
+}
+
+// NewStreamConn wraps a libp2p stream into a net.Conn
+func NewStreamConn(s network.Stream) net.Conn {
+	return &streamConn{Stream: s}
+}
+
+func (c *streamConn) LocalAddr() net.Addr {
+	return streamAddr(c.Stream.Conn().LocalMultiaddr())
+}
+
+func (c *streamConn) RemoteAddr() net.Addr {
+	return streamAddr(c.Stream.Conn().RemoteMultiaddr())
+}
+
+type multiaddrAddr struct {
+	ma.Multiaddr
+}
+
+func (a *multiaddrAddr) Network() string {
+	return "libp2p"
+}
+
+// streamAddr converts a multiaddr to a net.Addr, falling back to the raw
+// multiaddr when it has no net equivalent (ie. relayed or mocked connections)
+func streamAddr(m ma.Multiaddr) net.Addr {
+	if addr, err := manet.ToNetAddr(m); err == nil {
+		return addr
+	}
+
+	return &multiaddrAddr{Multiaddr: m}
+}
+
+var _ net.Conn = (*streamConn)(nil)
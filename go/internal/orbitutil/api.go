@@ -3,24 +3,27 @@ package orbitutil
 import (
 	"context"
 	"io"
+	"time"
 
 	"berty.tech/go-ipfs-log/identityprovider"
 	orbitdb "berty.tech/go-orbit-db"
 	"berty.tech/go-orbit-db/address"
 	"berty.tech/go-orbit-db/iface"
 	"berty.tech/go-orbit-db/stores/operation"
+	"github.com/ipfs/go-cid"
 	coreapi "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/libp2p/go-libp2p-core/crypto"
 
+	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/internal/bertycrypto"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
 type ContextGroup interface {
 	io.Closer
 	MessageStore() MessageStore
 	MetadataStore() MetadataStore
-	Group() *bertyprotocol.Group
+	Group() *bertytypes.Group
 	MemberPubKey() crypto.PubKey
 	DevicePubKey() crypto.PubKey
 
@@ -39,10 +42,10 @@ type MetadataStore interface {
 	GroupStore
 
 	// GetIncomingContactRequestsStatus Get the status of incoming contact requests (whether they can should be received or not) and the contact request reference
-	GetIncomingContactRequestsStatus() (bool, *bertyprotocol.ShareableContact)
+	GetIncomingContactRequestsStatus() (bool, *bertytypes.ShareableContact)
 
 	// ListEvents returns a channel of previously received events
-	ListEvents(ctx context.Context) <-chan *bertyprotocol.GroupMetadataEvent
+	ListEvents(ctx context.Context) <-chan *bertytypes.GroupMetadataEvent
 
 	// ListMembers returns a list of members pubkeys
 	ListMembers() []crypto.PubKey
@@ -54,10 +57,14 @@ type MetadataStore interface {
 	ListAdmins() []crypto.PubKey
 
 	// ListMultiMemberGroups
-	ListMultiMemberGroups() []*bertyprotocol.Group
+	ListMultiMemberGroups() []*bertytypes.Group
 
 	// ListContactsByStatus
-	ListContactsByStatus(state bertyprotocol.ContactState) []*bertyprotocol.ShareableContact
+	ListContactsByStatus(state bertytypes.ContactState) []*bertytypes.ShareableContact
+
+	// GetContact returns a contact of the account and its state, nil and
+	// ContactStateUndefined are returned for an unknown contact
+	GetContact(pk crypto.PubKey) (*bertytypes.ShareableContact, bertytypes.ContactState)
 
 	// GetMemberByDevice
 	GetMemberByDevice(crypto.PubKey) (crypto.PubKey, error)
@@ -75,7 +82,7 @@ type MetadataStore interface {
 	ClaimGroupOwnership(ctx context.Context, groupSK crypto.PrivKey) (operation.Operation, error)
 
 	// GroupJoin
-	GroupJoin(ctx context.Context, g *bertyprotocol.Group) (operation.Operation, error)
+	GroupJoin(ctx context.Context, g *bertytypes.Group) (operation.Operation, error)
 
 	// GroupLeave
 	GroupLeave(ctx context.Context, pk crypto.PubKey) (operation.Operation, error)
@@ -90,13 +97,16 @@ type MetadataStore interface {
 	ContactRequestReferenceReset(ctx context.Context) (operation.Operation, error)
 
 	// ContactRequestOutgoingEnqueue
-	ContactRequestOutgoingEnqueue(ctx context.Context, contact *bertyprotocol.ShareableContact) (operation.Operation, error)
+	ContactRequestOutgoingEnqueue(ctx context.Context, contact *bertytypes.ShareableContact, ownMetadata []byte) (operation.Operation, error)
+
+	// GetOutgoingContactRequestMetadata returns the metadata to send along an outgoing contact request
+	GetOutgoingContactRequestMetadata(pk crypto.PubKey) []byte
 
 	// ContactRequestOutgoingSent
 	ContactRequestOutgoingSent(ctx context.Context, pk crypto.PubKey) (operation.Operation, error)
 
 	// ContactRequestIncomingReceived
-	ContactRequestIncomingReceived(ctx context.Context, contact *bertyprotocol.ShareableContact) (operation.Operation, error)
+	ContactRequestIncomingReceived(ctx context.Context, contact *bertytypes.ShareableContact) (operation.Operation, error)
 
 	// ContactRequestIncomingDiscard
 	ContactRequestIncomingDiscard(ctx context.Context, pk crypto.PubKey) (operation.Operation, error)
@@ -115,13 +125,72 @@ type MetadataStore interface {
 
 	// SendAliasProof
 	SendAliasProof(ctx context.Context) (operation.Operation, error)
+
+	// SendAppMetadata adds an app defined event to the metadata store
+	SendAppMetadata(ctx context.Context, message []byte) (operation.Operation, error)
+
+	// InvitationCreate creates an invitation to the group signed by the member key, only admins can create invitations
+	InvitationCreate(expiresAt time.Time, maxUses uint32) (*bertytypes.GroupInvitation, error)
+
+	// InvitationCheck ensures an invitation to the group can still be used by a member
+	InvitationCheck(invitation *bertytypes.GroupInvitation, memberPK crypto.PubKey) error
+
+	// InvitationUse admits a member in the group using an invitation, only admins can admit members
+	InvitationUse(ctx context.Context, invitation *bertytypes.GroupInvitation, memberPK crypto.PubKey) (operation.Operation, error)
+
+	// InvitationRevoke prevents an invitation to be used, only admins can revoke invitations
+	InvitationRevoke(ctx context.Context, id []byte) (operation.Operation, error)
+
+	// AdminRoleGrant grants the admin role to a member, only admins can grant the role
+	AdminRoleGrant(ctx context.Context, memberPK crypto.PubKey) (operation.Operation, error)
+
+	// AdminRoleRevoke revokes the admin role of a member, only admins can revoke the role and the last admin can't be revoked
+	AdminRoleRevoke(ctx context.Context, memberPK crypto.PubKey) (operation.Operation, error)
+
+	// MemberRemove removes a member from the group, only admins can remove members
+	MemberRemove(ctx context.Context, memberPK crypto.PubKey) (operation.Operation, error)
+
+	// DeviceRevoke revokes another device of the current member, the entries it appends afterwards are ignored
+	DeviceRevoke(ctx context.Context, devicePK crypto.PubKey) (operation.Operation, error)
+
+	// SigChainSend sends the sig chain of the account to its other devices, it only applies to the account group
+	SigChainSend(ctx context.Context, chain *account.SigChain) (operation.Operation, error)
+
+	// GetSigChain returns the merge of the sig chains sent by the devices of the account, nil if none has been sent
+	GetSigChain() *account.SigChain
+
+	// GroupDeviceAnnounce sends the key used by the current device in a multi-member group to the other devices of the account, it only applies to the account group
+	GroupDeviceAnnounce(ctx context.Context, g *bertytypes.Group) (operation.Operation, error)
+
+	// GetGroupDevice returns the key used in a multi-member group by a device of the account, it only applies to the account group
+	GetGroupDevice(groupPK []byte, devicePK crypto.PubKey) (crypto.PubKey, error)
+
+	// KeyGeneration returns the generation of the device secrets used in the group, it is incremented each time a member is removed or a device is revoked
+	KeyGeneration() uint64
+
+	// IsMemberRemoved returns whether a member has been removed from the group
+	IsMemberRemoved(pk crypto.PubKey) bool
+
+	// IsMemberAdmitted returns whether a member has been admitted in a multi-member group
+	IsMemberAdmitted(pk crypto.PubKey) bool
+
+	// IsDeviceRevoked returns whether a device has been revoked by its member
+	IsDeviceRevoked(pk crypto.PubKey) bool
 }
 
 type MessageStore interface {
 	GroupStore
 
 	// ListMessages lists messages in the store
-	ListMessages(ctx context.Context) (<-chan *bertyprotocol.GroupMessageEvent, error)
+	ListMessages(ctx context.Context) (<-chan *bertytypes.GroupMessageEvent, error)
+
+	// ListMessagesRange lists messages in the store from the oldest to the
+	// newest, or the other way if newestFirst is set. since is excluded and
+	// until is included, undefined bounds are ignored and unknown ones are
+	// rejected. A negative limit lists every matching message, otherwise the
+	// oldest ones are kept when paging forward from since and the newest ones
+	// in the other cases. Only the part of the log newer than since is read.
+	ListMessagesRange(ctx context.Context, since, until cid.Cid, limit int, newestFirst bool) (<-chan *bertytypes.GroupMessageEvent, error)
 
 	// AddMessage appends a message to the store
 	AddMessage(ctx context.Context, data []byte) (operation.Operation, error)
@@ -130,10 +199,12 @@ type MessageStore interface {
 type BertyOrbitDB interface {
 	iface.BaseOrbitDB
 
-	OpenMultiMemberGroup(ctx context.Context, g *bertyprotocol.Group, options *orbitdb.CreateDBOptions) (ContextGroup, error)
+	OpenMultiMemberGroup(ctx context.Context, g *bertytypes.Group, options *orbitdb.CreateDBOptions) (ContextGroup, error)
 	OpenAccountGroup(ctx context.Context, options *orbitdb.CreateDBOptions) (ContextGroup, error)
 	OpenContactGroup(ctx context.Context, pk crypto.PubKey, options *orbitdb.CreateDBOptions) (ContextGroup, error)
+	GetContextGroupForID(id []byte) (ContextGroup, error)
+	CloseGroup(id []byte) error
 
-	GroupMetadataStore(ctx context.Context, g *bertyprotocol.Group, options *orbitdb.CreateDBOptions) (MetadataStore, error)
-	GroupMessageStore(ctx context.Context, g *bertyprotocol.Group, options *orbitdb.CreateDBOptions) (MessageStore, error)
+	GroupMetadataStore(ctx context.Context, g *bertytypes.Group, options *orbitdb.CreateDBOptions) (MetadataStore, error)
+	GroupMessageStore(ctx context.Context, g *bertytypes.Group, options *orbitdb.CreateDBOptions) (MessageStore, error)
 }
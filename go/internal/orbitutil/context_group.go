@@ -5,11 +5,11 @@ import (
 
 	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/internal/bertycrypto"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
 type contextGroup struct {
-	group         *bertyprotocol.Group
+	group         *bertytypes.Group
 	metadataStore *MetadataStoreImpl
 	messageStore  *MessageStoreImpl
 	messageKeys   bertycrypto.MessageKeys
@@ -36,7 +36,7 @@ func (c *contextGroup) MetadataStore() MetadataStore {
 	return c.metadataStore
 }
 
-func (c *contextGroup) Group() *bertyprotocol.Group {
+func (c *contextGroup) Group() *bertytypes.Group {
 	return c.group
 }
 
@@ -55,7 +55,7 @@ func (c *contextGroup) Close() error {
 	return nil
 }
 
-func NewContextGroup(group *bertyprotocol.Group, metadataStore *MetadataStoreImpl, messageStore *MessageStoreImpl, messageKeys bertycrypto.MessageKeys, memberDevice *account.OwnMemberDevice) ContextGroup {
+func NewContextGroup(group *bertytypes.Group, metadataStore *MetadataStoreImpl, messageStore *MessageStoreImpl, messageKeys bertycrypto.MessageKeys, memberDevice *account.OwnMemberDevice) ContextGroup {
 	return &contextGroup{
 		group:         group,
 		metadataStore: metadataStore,
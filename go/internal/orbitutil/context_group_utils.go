@@ -9,12 +9,12 @@ import (
 
 	"berty.tech/berty/go/internal/bertycrypto"
 	"berty.tech/berty/go/internal/group"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
-func MetadataStoreListSecrets(ctx context.Context, gc ContextGroup) (map[crypto.PubKey]*bertyprotocol.DeviceSecret, error) {
-	publishedSecrets := map[crypto.PubKey]*bertyprotocol.DeviceSecret{}
+func MetadataStoreListSecrets(ctx context.Context, gc ContextGroup) (map[crypto.PubKey]*bertytypes.DeviceSecret, error) {
+	publishedSecrets := map[crypto.PubKey]*bertytypes.DeviceSecret{}
 
 	m := gc.MetadataStore()
 	ownSK := gc.getMemberPrivKey()
@@ -39,7 +39,7 @@ func FillMessageKeysHolderUsingNewData(ctx context.Context, gc ContextGroup) err
 	m := gc.MetadataStore()
 
 	for evt := range m.Subscribe(ctx) {
-		e, ok := evt.(*bertyprotocol.GroupMetadataEvent)
+		e, ok := evt.(*bertytypes.GroupMetadataEvent)
 		if !ok {
 			continue
 		}
@@ -84,6 +84,10 @@ func ActivateGroupContext(ctx context.Context, gc ContextGroup) error {
 		return errcode.ErrInternal.Wrap(err)
 	}
 
+	if err := RotateSecretIfNeeded(ctx, gc); err != nil {
+		return errcode.ErrInternal.Wrap(err)
+	}
+
 	if err := SendSecretsToExistingMembers(ctx, gc); err != nil {
 		return errcode.ErrInternal.Wrap(err)
 	}
@@ -98,16 +102,16 @@ func ActivateGroupContext(ctx context.Context, gc ContextGroup) error {
 }
 
 func handleNewMember(ctx context.Context, gctx ContextGroup, evt events.Event) error {
-	e, ok := evt.(*bertyprotocol.GroupMetadataEvent)
+	e, ok := evt.(*bertytypes.GroupMetadataEvent)
 	if !ok {
 		return nil
 	}
 
-	if e.Metadata.EventType != bertyprotocol.EventTypeGroupMemberDeviceAdded {
+	if e.Metadata.EventType != bertytypes.EventTypeGroupMemberDeviceAdded {
 		return nil
 	}
 
-	event := &bertyprotocol.GroupAddMemberDevice{}
+	event := &bertytypes.GroupAddMemberDevice{}
 	if err := event.Unmarshal(e.Metadata.Payload); err != nil {
 		return errcode.ErrDeserialization.Wrap(err)
 	}
@@ -117,7 +121,24 @@ func handleNewMember(ctx context.Context, gctx ContextGroup, evt events.Event) e
 		return errcode.ErrDeserialization.Wrap(err)
 	}
 
-	if memberPK.Equals(gctx.MemberPubKey()) {
+	if gctx.MetadataStore().IsMemberRemoved(memberPK) {
+		return nil
+	}
+
+	devicePK, err := crypto.UnmarshalEd25519PublicKey(event.DevicePK)
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	// the log also holds the devices of the members which have not been
+	// admitted in the group, they are not indexed
+	if indexedMemberPK, err := gctx.MetadataStore().GetMemberByDevice(devicePK); err != nil || !indexedMemberPK.Equals(memberPK) {
+		return nil
+	}
+
+	// the secret is also sent to the own member when another device of the
+	// account joins the group, as all of them can open it
+	if memberPK.Equals(gctx.MemberPubKey()) && devicePK.Equals(gctx.DevicePubKey()) {
 		return nil
 	}
 
@@ -132,6 +153,72 @@ func handleNewMember(ctx context.Context, gctx ContextGroup, evt events.Event) e
 	return nil
 }
 
+// RotateSecretIfNeeded replaces the secret of the current device when a member
+// has been removed from the group or a device has been revoked since it has
+// been generated, the new secret is then sent to every remaining member
+func RotateSecretIfNeeded(ctx context.Context, gctx ContextGroup) error {
+	generation := gctx.MetadataStore().KeyGeneration()
+	if generation == 0 || gctx.MetadataStore().IsMemberRemoved(gctx.MemberPubKey()) || gctx.MetadataStore().IsDeviceRevoked(gctx.DevicePubKey()) {
+		return nil
+	}
+
+	ds, err := gctx.getMessageKeys().GetDeviceChainKey(ctx, gctx.DevicePubKey())
+	if err != nil && err != errcode.ErrMissingInput {
+		return errcode.ErrPersistenceGet.Wrap(err)
+	}
+
+	if err == nil && ds.Generation >= generation {
+		return nil
+	}
+
+	if _, err := bertycrypto.RotateDeviceSecret(ctx, gctx.Group(), gctx.getMessageKeys(), gctx.DevicePubKey(), generation); err != nil {
+		return errcode.ErrSecretKeyGenerationFailed.Wrap(err)
+	}
+
+	for _, memberPK := range gctx.MetadataStore().ListMembers() {
+		if memberPK.Equals(gctx.MemberPubKey()) && !hasOtherOwnDevices(gctx) {
+			continue
+		}
+
+		if _, err := gctx.MetadataStore().SendSecret(ctx, memberPK); err != nil && err != errcode.ErrGroupSecretAlreadySentToMember {
+			return errcode.ErrInternal.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// hasOtherOwnDevices checks whether other devices of the account are part of
+// the group
+func hasOtherOwnDevices(gctx ContextGroup) bool {
+	devices, err := gctx.MetadataStore().GetDevicesForMember(gctx.MemberPubKey())
+	if err != nil {
+		return false
+	}
+
+	for _, d := range devices {
+		if !d.Equals(gctx.DevicePubKey()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func handleMemberRemoved(ctx context.Context, gctx ContextGroup, evt events.Event) error {
+	e, ok := evt.(*bertytypes.GroupMetadataEvent)
+	if !ok {
+		return nil
+	}
+
+	switch e.Metadata.EventType {
+	case bertytypes.EventTypeMultiMemberGroupMemberRemoved, bertytypes.EventTypeGroupMemberDeviceRevoked:
+		return RotateSecretIfNeeded(ctx, gctx)
+	}
+
+	return nil
+}
+
 func SendSecretsToExistingMembers(ctx context.Context, gctx ContextGroup) error {
 	ch := gctx.MetadataStore().ListEvents(ctx)
 
@@ -151,6 +238,10 @@ func WatchNewMembersAndSendSecrets(ctx context.Context, logger *zap.Logger, gctx
 				// TODO: log
 				logger.Error("unable to send secrets", zap.Error(err))
 			}
+
+			if err := handleMemberRemoved(ctx, gctx, evt); err != nil {
+				logger.Error("unable to rotate secrets", zap.Error(err))
+			}
 		}
 	}()
 }
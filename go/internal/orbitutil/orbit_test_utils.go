@@ -5,13 +5,14 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/ipfs/go-ipfs/keystore"
 
 	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/internal/bertycrypto"
 	"berty.tech/berty/go/internal/ipfsutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 
 	orbitdb "berty.tech/go-orbit-db"
 	"github.com/libp2p/go-libp2p-core/crypto"
@@ -72,6 +73,18 @@ func DropPeers(t *testing.T, mockedPeers []*MockedPeer) {
 func CreatePeersWithGroup(ctx context.Context, t testing.TB, pathBase string, memberCount int, deviceCount int) ([]*MockedPeer, crypto.PrivKey) {
 	t.Helper()
 
+	g, groupSK, err := bertytypes.NewGroupMultiMember()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return CreatePeersForGroup(ctx, t, g, memberCount, deviceCount), groupSK
+}
+
+// CreatePeersForGroup opens an existing group on new connected peers
+func CreatePeersForGroup(ctx context.Context, t testing.TB, g *bertytypes.Group, memberCount int, deviceCount int) []*MockedPeer {
+	t.Helper()
+
 	var (
 		mn  mocknet.Mocknet
 		acc *account.Account
@@ -79,11 +92,6 @@ func CreatePeersWithGroup(ctx context.Context, t testing.TB, pathBase string, me
 
 	mockedPeers := make([]*MockedPeer, memberCount*deviceCount)
 
-	g, groupSK, err := bertyprotocol.NewGroupMultiMember()
-	if err != nil {
-		t.Fatal(err)
-	}
-
 	deviceIndex := 0
 	for i := 0; i < memberCount; i++ {
 		for j := 0; j < deviceCount; j++ {
@@ -141,7 +149,7 @@ func CreatePeersWithGroup(ctx context.Context, t testing.TB, pathBase string, me
 
 	ConnectPeers(ctx, t, mockedPeers)
 
-	return mockedPeers, groupSK
+	return mockedPeers
 }
 
 func InviteAllPeersToGroup(ctx context.Context, t *testing.T, peers []*MockedPeer, groupSK crypto.PrivKey) {
@@ -160,13 +168,13 @@ func InviteAllPeersToGroup(ctx context.Context, t *testing.T, peers []*MockedPee
 
 			for e := range p.GC.MetadataStore().Subscribe(ctx) {
 				switch e.(type) {
-				case *bertyprotocol.GroupMetadataEvent:
-					casted, _ := e.(*bertyprotocol.GroupMetadataEvent)
-					if casted.Metadata.EventType != bertyprotocol.EventTypeGroupMemberDeviceAdded {
+				case *bertytypes.GroupMetadataEvent:
+					casted, _ := e.(*bertytypes.GroupMetadataEvent)
+					if casted.Metadata.EventType != bertytypes.EventTypeGroupMemberDeviceAdded {
 						continue
 					}
 
-					memdev := &bertyprotocol.GroupAddMemberDevice{}
+					memdev := &bertytypes.GroupAddMemberDevice{}
 					if err := memdev.Unmarshal(casted.Event); err != nil {
 						errChan <- err
 						wg.Done()
@@ -196,6 +204,19 @@ func InviteAllPeersToGroup(ctx context.Context, t *testing.T, peers []*MockedPee
 		}
 	}
 
+	// The devices of the other members are only indexed once an admin has
+	// recorded their use of an invitation
+	invitation, err := peers[0].GC.MetadataStore().InvitationCreate(time.Time{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range peers[1:] {
+		if _, err := peers[0].GC.MetadataStore().InvitationUse(ctx, invitation, p.GC.MemberPubKey()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
 	// Wait for all events to be received in all peers's member log (or timeout)
 	wg.Wait()
 	close(errChan)
@@ -205,7 +226,7 @@ func InviteAllPeersToGroup(ctx context.Context, t *testing.T, peers []*MockedPee
 	}
 }
 
-func WaitForBertyEventType(ctx context.Context, t *testing.T, ms MetadataStore, eventType bertyprotocol.EventType, eventCount int, done chan struct{}) {
+func WaitForBertyEventType(ctx context.Context, t *testing.T, ms MetadataStore, eventType bertytypes.EventType, eventCount int, done chan struct{}) {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -213,12 +234,12 @@ func WaitForBertyEventType(ctx context.Context, t *testing.T, ms MetadataStore,
 
 	for evt := range ms.Subscribe(ctx) {
 		switch evt.(type) {
-		case *bertyprotocol.GroupMetadataEvent:
-			if evt.(*bertyprotocol.GroupMetadataEvent).Metadata.EventType != eventType {
+		case *bertytypes.GroupMetadataEvent:
+			if evt.(*bertytypes.GroupMetadataEvent).Metadata.EventType != eventType {
 				continue
 			}
 
-			eID := string(evt.(*bertyprotocol.GroupMetadataEvent).EventContext.ID)
+			eID := string(evt.(*bertytypes.GroupMetadataEvent).EventContext.ID)
 
 			if _, ok := handledEvents[eID]; ok {
 				continue
@@ -226,8 +247,8 @@ func WaitForBertyEventType(ctx context.Context, t *testing.T, ms MetadataStore,
 
 			handledEvents[eID] = struct{}{}
 
-			e := &bertyprotocol.GroupAddDeviceSecret{}
-			if err := e.Unmarshal(evt.(*bertyprotocol.GroupMetadataEvent).Event); err != nil {
+			e := &bertytypes.GroupAddDeviceSecret{}
+			if err := e.Unmarshal(evt.(*bertytypes.GroupMetadataEvent).Event); err != nil {
 				t.Fatalf(" err: %+v\n", err.Error())
 			}
 
@@ -2,6 +2,7 @@ package orbitutil
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"sync"
 
@@ -12,24 +13,27 @@ import (
 	coreapi "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/internal/bertycrypto"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
 type bertyOrbitDB struct {
 	baseorbitdb.BaseOrbitDB
-	groups          sync.Map // map[string]*bertyprotocol.Group
+	groups          sync.Map // map[string]*bertytypes.Group
 	groupContexts   sync.Map // map[string]*contextGroup
 	groupsSigPubKey sync.Map // map[string]crypto.PubKey
+	metadataStores  sync.Map // map[string]*MetadataStoreImpl
 	keyStore        *BertySignedKeyStore
 	mk              bertycrypto.MessageKeys
 	account         *account.Account
+	logger          *zap.Logger
 }
 
-func (s *bertyOrbitDB) OpenMultiMemberGroup(ctx context.Context, g *bertyprotocol.Group, options *orbitdb.CreateDBOptions) (ContextGroup, error) {
+func (s *bertyOrbitDB) OpenMultiMemberGroup(ctx context.Context, g *bertytypes.Group, options *orbitdb.CreateDBOptions) (ContextGroup, error) {
 	return s.openGroup(ctx, g, options)
 }
 
@@ -39,7 +43,7 @@ func (s *bertyOrbitDB) OpenContactGroup(ctx context.Context, pk crypto.PubKey, o
 		return nil, errcode.ErrSecretKeyGenerationFailed.Wrap(err)
 	}
 
-	g, err := bertyprotocol.GetGroupForContact(sk)
+	g, err := bertytypes.GetGroupForContact(sk)
 	if err != nil {
 		return nil, errcode.ErrOrbitDBOpen.Wrap(err)
 	}
@@ -47,7 +51,7 @@ func (s *bertyOrbitDB) OpenContactGroup(ctx context.Context, pk crypto.PubKey, o
 	return s.openGroup(ctx, g, options)
 }
 
-func (s *bertyOrbitDB) registerGroupPrivateKey(g *bertyprotocol.Group) error {
+func (s *bertyOrbitDB) registerGroupPrivateKey(g *bertytypes.Group) error {
 	groupID := g.GroupIDAsString()
 
 	gSigSK, err := g.GetSigningPrivKey()
@@ -82,16 +86,26 @@ func NewBertyOrbitDB(ctx context.Context, ipfs coreapi.CoreAPI, acc *account.Acc
 		return nil, errcode.TODO.Wrap(err)
 	}
 
+	logger := options.Logger
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+
 	bertyDB := &bertyOrbitDB{
 		BaseOrbitDB: orbitDB,
 		keyStore:    ks,
 		account:     acc,
 		mk:          mk,
+		logger:      logger,
 	}
 
 	if err := bertyDB.RegisterAccessControllerType(NewSimpleAccessController); err != nil {
 		return nil, errcode.TODO.Wrap(err)
 	}
+
+	if err := bertyDB.RegisterAccessControllerType(NewGroupAccessControllerConstructor(bertyDB)); err != nil {
+		return nil, errcode.TODO.Wrap(err)
+	}
 	bertyDB.RegisterStoreType(GroupMetadataStoreType, ConstructorFactoryGroupMetadata(bertyDB))
 	bertyDB.RegisterStoreType(GroupMessageStoreType, ConstructorFactoryGroupMessage(bertyDB))
 
@@ -109,7 +123,7 @@ func (s *bertyOrbitDB) OpenAccountGroup(ctx context.Context, options *orbitdb.Cr
 		return nil, errcode.ErrOrbitDBOpen.Wrap(err)
 	}
 
-	g, err := bertyprotocol.GetGroupForAccount(sk, skProof)
+	g, err := bertytypes.GetGroupForAccount(sk, skProof)
 	if err != nil {
 		return nil, errcode.ErrOrbitDBOpen.Wrap(err)
 	}
@@ -117,7 +131,7 @@ func (s *bertyOrbitDB) OpenAccountGroup(ctx context.Context, options *orbitdb.Cr
 	return s.openGroup(ctx, g, options)
 }
 
-func (s *bertyOrbitDB) openGroup(ctx context.Context, g *bertyprotocol.Group, options *orbitdb.CreateDBOptions) (ContextGroup, error) {
+func (s *bertyOrbitDB) openGroup(ctx context.Context, g *bertytypes.Group, options *orbitdb.CreateDBOptions) (ContextGroup, error) {
 	id := g.GroupIDAsString()
 
 	existingGC, err := s.getGroupContext(id)
@@ -179,6 +193,54 @@ func (s *bertyOrbitDB) getGroupContext(id string) (*contextGroup, error) {
 	return g.(*contextGroup), nil
 }
 
+// GetContextGroupForID returns the context of a previously opened group
+func (s *bertyOrbitDB) GetContextGroupForID(id []byte) (ContextGroup, error) {
+	gc, err := s.getGroupContext(hex.EncodeToString(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return gc, nil
+}
+
+// CloseGroup closes the stores of a previously opened group and removes it
+// from the opened groups
+func (s *bertyOrbitDB) CloseGroup(id []byte) error {
+	groupID := hex.EncodeToString(id)
+
+	gc, err := s.getGroupContext(groupID)
+	if err != nil {
+		return err
+	}
+
+	s.groupContexts.Delete(groupID)
+	s.groups.Delete(groupID)
+	s.metadataStores.Delete(groupID)
+
+	return gc.Close()
+}
+
+// getGroupMetadataIndex returns the metadata index of an opened group, it
+// is not available if the content of the group can't be read
+func (s *bertyOrbitDB) getGroupMetadataIndex(groupID string) (*bertytypes.Group, *metadataStoreIndex, bool) {
+	g, ok := s.groups.Load(groupID)
+	if !ok {
+		return nil, nil, false
+	}
+
+	store, ok := s.metadataStores.Load(groupID)
+	if !ok {
+		return nil, nil, false
+	}
+
+	idx, ok := store.(*MetadataStoreImpl).Index().(*metadataStoreIndex)
+	if !ok {
+		return nil, nil, false
+	}
+
+	return g.(*bertytypes.Group), idx, true
+}
+
 // SetGroupSigPubKey registers a new group signature pubkey, mainly used to
 // replicate a store data without needing to access to its content
 func (s *bertyOrbitDB) SetGroupSigPubKey(groupID string, pubKey crypto.PubKey) error {
@@ -191,7 +253,7 @@ func (s *bertyOrbitDB) SetGroupSigPubKey(groupID string, pubKey crypto.PubKey) e
 	return nil
 }
 
-func (s *bertyOrbitDB) storeForGroup(ctx context.Context, o iface.BaseOrbitDB, g *bertyprotocol.Group, options *orbitdb.CreateDBOptions, storeType string) (iface.Store, error) {
+func (s *bertyOrbitDB) storeForGroup(ctx context.Context, o iface.BaseOrbitDB, g *bertytypes.Group, options *orbitdb.CreateDBOptions, storeType string) (iface.Store, error) {
 	options, err := DefaultOptions(g, options, s.keyStore, storeType)
 	if err != nil {
 		return nil, err
@@ -209,7 +271,7 @@ func (s *bertyOrbitDB) storeForGroup(ctx context.Context, o iface.BaseOrbitDB, g
 	return store, nil
 }
 
-func (s *bertyOrbitDB) GroupMetadataStore(ctx context.Context, g *bertyprotocol.Group, options *orbitdb.CreateDBOptions) (MetadataStore, error) {
+func (s *bertyOrbitDB) GroupMetadataStore(ctx context.Context, g *bertytypes.Group, options *orbitdb.CreateDBOptions) (MetadataStore, error) {
 	store, err := s.storeForGroup(ctx, s, g, options, GroupMetadataStoreType)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to open database")
@@ -223,7 +285,7 @@ func (s *bertyOrbitDB) GroupMetadataStore(ctx context.Context, g *bertyprotocol.
 	return sStore, nil
 }
 
-func (s *bertyOrbitDB) GroupMessageStore(ctx context.Context, g *bertyprotocol.Group, options *orbitdb.CreateDBOptions) (MessageStore, error) {
+func (s *bertyOrbitDB) GroupMessageStore(ctx context.Context, g *bertytypes.Group, options *orbitdb.CreateDBOptions) (MessageStore, error) {
 	store, err := s.storeForGroup(ctx, s, g, options, GroupMessageStoreType)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to open database")
@@ -237,7 +299,7 @@ func (s *bertyOrbitDB) GroupMessageStore(ctx context.Context, g *bertyprotocol.G
 	return mStore, nil
 }
 
-func (s *bertyOrbitDB) getGroupFromOptions(options *iface.NewStoreOptions) (*bertyprotocol.Group, error) {
+func (s *bertyOrbitDB) getGroupFromOptions(options *iface.NewStoreOptions) (*bertytypes.Group, error) {
 	groupIDs, err := options.AccessController.GetAuthorizedByRole(IdentityGroupIDKey)
 	if err != nil {
 		return nil, errcode.TODO.Wrap(err)
@@ -252,7 +314,7 @@ func (s *bertyOrbitDB) getGroupFromOptions(options *iface.NewStoreOptions) (*ber
 		return nil, errcode.ErrInvalidInput
 	}
 
-	typed, ok := g.(*bertyprotocol.Group)
+	typed, ok := g.(*bertytypes.Group)
 	if !ok {
 		return nil, errcode.ErrInvalidInput
 	}
@@ -22,10 +22,10 @@ import (
 	"berty.tech/berty/go/internal/ipfsutil"
 	"berty.tech/berty/go/internal/orbitutil"
 	"berty.tech/berty/go/internal/testutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
-func testAddBerty(ctx context.Context, t *testing.T, api ipfsutil.CoreAPIMock, g *bertyprotocol.Group, pathBase string, amountToAdd, amountCurrentlyPresent int) {
+func testAddBerty(ctx context.Context, t *testing.T, api ipfsutil.CoreAPIMock, g *bertytypes.Group, pathBase string, amountToAdd, amountCurrentlyPresent int) {
 	t.Helper()
 	testutil.SkipSlow(t)
 
@@ -102,7 +102,7 @@ func testAddBerty(ctx context.Context, t *testing.T, api ipfsutil.CoreAPIMock, g
 	// Watch for incoming new messages
 	go func() {
 		for e := range gc.MessageStore().Subscribe(ctx) {
-			_, ok := e.(*bertyprotocol.GroupMessageEvent)
+			_, ok := e.(*bertytypes.GroupMessageEvent)
 			if !ok {
 				continue
 			}
@@ -154,7 +154,7 @@ func TestAddBerty(t *testing.T) {
 
 	defer os.RemoveAll(pathBase)
 
-	g, _, err := bertyprotocol.NewGroupMultiMember()
+	g, _, err := bertytypes.NewGroupMultiMember()
 	require.NoError(t, err)
 
 	testAddBerty(ctx, t, api, g, pathBase, 20, 0)
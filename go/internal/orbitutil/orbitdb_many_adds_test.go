@@ -13,7 +13,7 @@ import (
 	"berty.tech/berty/go/internal/cryptoutil"
 	"berty.tech/berty/go/internal/ipfsutil"
 	"berty.tech/berty/go/internal/orbitutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
 func TestAdd(t *testing.T) {
@@ -59,7 +59,7 @@ func TestAdd(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	g := &bertyprotocol.Group{PublicKey: pubkB, Secret: sigkB}
+	g := &bertytypes.Group{PublicKey: pubkB, Secret: sigkB}
 	opts, err := orbitutil.DefaultOptions(g, &orbitdb.CreateDBOptions{}, ks, "log")
 	if err != nil {
 		t.Fatal(err)
@@ -0,0 +1,318 @@
+package orbitutil
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	ipfslog "berty.tech/go-ipfs-log"
+	logac "berty.tech/go-ipfs-log/accesscontroller"
+	"berty.tech/go-ipfs-log/identityprovider"
+	"berty.tech/go-orbit-db/accesscontroller"
+	"berty.tech/go-orbit-db/address"
+	"berty.tech/go-orbit-db/events"
+	"berty.tech/go-orbit-db/iface"
+	"berty.tech/go-orbit-db/stores/operation"
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/pkg/errors"
+
+	"berty.tech/berty/go/internal/bertycrypto"
+	"berty.tech/berty/go/pkg/bertytypes"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const GroupAccessControllerType = "bertygroup"
+
+// WritePolicyKey is the access key holding the write policy of a store, it is
+// set from the group so every peer of the group uses the same policy
+const WritePolicyKey = "write_policy"
+
+const (
+	// WritePolicyMembers allows the devices of every member of the group to
+	// write to the store
+	WritePolicyMembers = "members"
+
+	// WritePolicyAdmins only allows the devices of the admins of the group
+	// to write to the store, eg. for announcement groups, it only applies to
+	// message stores
+	WritePolicyAdmins = "admins"
+)
+
+// maxCheckedEntries is the number of entries of joined logs remembered as
+// already parsed by the access controller, the announced devices are
+// forgotten with them once it is reached
+const maxCheckedEntries = 10000
+
+type groupAccessController struct {
+	events.EventEmitter
+	allowedKeys map[string][]string
+	groupID     string
+	storeType   string
+	writePolicy string
+	db          *bertyOrbitDB
+
+	// announcedDevices holds the members of the devices announced by the
+	// entries of joined logs which have not been indexed yet, indexed by
+	// device public key, the devices are removed once indexed
+	announcedDevices map[string]crypto.PubKey
+
+	// checkedEntries holds the hashes of the entries of joined logs already
+	// parsed
+	checkedEntries map[string]struct{}
+	lock           sync.Mutex
+}
+
+func (o *groupAccessController) Address() address.Address {
+	return nil
+}
+
+func (o *groupAccessController) Grant(ctx context.Context, capability string, keyID string) error {
+	return nil
+}
+
+func (o *groupAccessController) Revoke(ctx context.Context, capability string, keyID string) error {
+	return nil
+}
+
+func (o *groupAccessController) Load(ctx context.Context, address string) error {
+	return nil
+}
+
+func (o *groupAccessController) Save(ctx context.Context) (accesscontroller.ManifestParams, error) {
+	d, err := json.Marshal(o.allowedKeys)
+	if err != nil {
+		return nil, errcode.ErrInvalidInput.Wrap(err)
+	}
+
+	c, err := cid.Prefix{
+		Version:  1,
+		Codec:    cid.Raw,
+		MhType:   mh.SHA2_256,
+		MhLength: -1,
+	}.Sum(d)
+
+	if err != nil {
+		return nil, errcode.ErrInvalidInput.Wrap(err)
+	}
+
+	return accesscontroller.NewManifestParams(c, true, GroupAccessControllerType), nil
+}
+
+func (o *groupAccessController) Close() error {
+	return nil
+}
+
+func (o *groupAccessController) Type() string {
+	return GroupAccessControllerType
+}
+
+func (o *groupAccessController) GetAuthorizedByRole(role string) ([]string, error) {
+	return o.allowedKeys[role], nil
+}
+
+// CanAppend accepts an entry signed by the group if its content has been
+// written by a device which has been part of the group. The entries are
+// checked when they are joined, before their position in the log is known,
+// so only the facts which can't be undone are checked here: the removals,
+// the revocations and the admissions are applied by the metadata index in the
+// order of the log, and the secrets of the removed members are rotated.
+// Nodes unable to read the content of the group, which only replicate its
+// logs, only check the entry signature.
+func (o *groupAccessController) CanAppend(e logac.LogEntry, p identityprovider.Interface, additionalContext accesscontroller.CanAppendAdditionalContext) error {
+	if !o.isSignedByGroup(e) {
+		return errors.New("not allowed to write entry")
+	}
+
+	g, idx, ok := o.db.getGroupMetadataIndex(o.groupID)
+	if !ok {
+		return nil
+	}
+
+	entry, ok := e.(ipfslog.Entry)
+	if !ok {
+		return errcode.ErrInvalidInput
+	}
+
+	op, err := operation.ParseOperation(entry)
+	if err != nil {
+		return errcode.ErrOrbitDBDeserialization.Wrap(err)
+	}
+
+	switch o.storeType {
+	case GroupMetadataStoreType:
+		return o.canAppendMetadata(g, idx, op, additionalContext)
+	case GroupMessageStoreType:
+		return o.canAppendMessage(g, idx, op)
+	}
+
+	return errcode.ErrInvalidInput
+}
+
+func (o *groupAccessController) isSignedByGroup(e logac.LogEntry) bool {
+	for _, id := range o.allowedKeys["write"] {
+		if e.GetIdentity().ID == id || id == "*" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (o *groupAccessController) canAppendMetadata(g *bertytypes.Group, idx *metadataStoreIndex, op operation.Operation, additionalContext accesscontroller.CanAppendAdditionalContext) error {
+	meta, event, err := bertytypes.OpenGroupEnvelope(g, op.GetValue())
+	if err != nil {
+		return errcode.ErrGroupMemberLogEventOpen.Wrap(err)
+	}
+
+	switch meta.EventType {
+	case bertytypes.EventTypeMultiMemberGroupInitialMemberAnnounced,
+		bertytypes.EventTypeGroupMemberDeviceAdded:
+		// signed using the group secret key, or by both the member and the
+		// device, the index only adds the devices of admitted members
+		return nil
+	}
+
+	evt, ok := event.(interface{ GetDevicePK() []byte })
+	if !ok {
+		return errcode.ErrInvalidInput
+	}
+
+	_, err = o.getMemberForDevice(g, idx, evt.GetDevicePK(), additionalContext)
+
+	return err
+}
+
+func (o *groupAccessController) canAppendMessage(g *bertytypes.Group, idx *metadataStoreIndex, op operation.Operation) error {
+	_, headers, err := bertycrypto.OpenEnvelopeHeaders(op.GetValue(), g)
+	if err != nil {
+		return errcode.ErrCryptoDecrypt.Wrap(err)
+	}
+
+	memberPK, err := o.getMemberForDevice(g, idx, headers.DevicePK, nil)
+	if err != nil {
+		return err
+	}
+
+	// the messages are not ordered with the metadata, a message written by
+	// a former admin may have been written before its role was revoked
+	if o.writePolicy == WritePolicyAdmins && !idx.wasAdmin(memberPK) {
+		return errcode.ErrNotAuthorized
+	}
+
+	return nil
+}
+
+// getMemberForDevice returns the member of a device which has been part of
+// the group according to the metadata index, or announced in the log being
+// joined when it hasn't been indexed yet
+func (o *groupAccessController) getMemberForDevice(g *bertytypes.Group, idx *metadataStoreIndex, devicePK []byte, additionalContext accesscontroller.CanAppendAdditionalContext) (crypto.PubKey, error) {
+	if memberPK, ok := idx.getKnownMemberByDevice(devicePK); ok {
+		o.lock.Lock()
+		delete(o.announcedDevices, string(devicePK))
+		o.lock.Unlock()
+
+		return memberPK, nil
+	}
+
+	if additionalContext == nil {
+		return nil, errcode.ErrNotAuthorized
+	}
+
+	for _, e := range additionalContext.GetLogEntries() {
+		o.checkAnnouncedDevice(g, e)
+	}
+
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	if memberPK, ok := o.announcedDevices[string(devicePK)]; ok {
+		return memberPK, nil
+	}
+
+	return nil, errcode.ErrNotAuthorized
+}
+
+// checkAnnouncedDevice records the device announced by a metadata entry, if
+// any, each entry is only parsed once
+func (o *groupAccessController) checkAnnouncedDevice(g *bertytypes.Group, e logac.LogEntry) {
+	entry, ok := e.(ipfslog.Entry)
+	if !ok {
+		return
+	}
+
+	key := entry.GetHash().String()
+
+	o.lock.Lock()
+	if _, ok := o.checkedEntries[key]; ok {
+		o.lock.Unlock()
+		return
+	}
+
+	if len(o.checkedEntries) >= maxCheckedEntries {
+		o.checkedEntries = map[string]struct{}{}
+		o.announcedDevices = map[string]crypto.PubKey{}
+	}
+
+	o.checkedEntries[key] = struct{}{}
+	o.lock.Unlock()
+
+	op, err := operation.ParseOperation(entry)
+	if err != nil {
+		return
+	}
+
+	meta, event, err := bertytypes.OpenGroupEnvelope(g, op.GetValue())
+	if err != nil || meta.EventType != bertytypes.EventTypeGroupMemberDeviceAdded {
+		return
+	}
+
+	evt := event.(*bertytypes.GroupAddMemberDevice)
+
+	memberPK, err := crypto.UnmarshalEd25519PublicKey(evt.MemberPK)
+	if err != nil {
+		return
+	}
+
+	o.lock.Lock()
+	o.announcedDevices[string(evt.DevicePK)] = memberPK
+	o.lock.Unlock()
+}
+
+// NewGroupAccessControllerConstructor returns an access controller
+// constructor checking the entries against the metadata of the groups opened
+// by the given orbitdb instance
+func NewGroupAccessControllerConstructor(db *bertyOrbitDB) func(context.Context, iface.BaseOrbitDB, accesscontroller.ManifestParams) (accesscontroller.Interface, error) {
+	return func(_ context.Context, _ iface.BaseOrbitDB, options accesscontroller.ManifestParams) (accesscontroller.Interface, error) {
+		if options == nil {
+			return &groupAccessController{}, errors.New("an options object is required")
+		}
+
+		access := options.GetAllAccess()
+
+		ac := &groupAccessController{
+			allowedKeys:      access,
+			writePolicy:      WritePolicyMembers,
+			db:               db,
+			announcedDevices: map[string]crypto.PubKey{},
+			checkedEntries:   map[string]struct{}{},
+		}
+
+		if ids := access[IdentityGroupIDKey]; len(ids) > 0 {
+			ac.groupID = ids[0]
+		}
+
+		if types := access[StoreTypeKey]; len(types) > 0 {
+			ac.storeType = types[0]
+		}
+
+		if policies := access[WritePolicyKey]; len(policies) > 0 {
+			ac.writePolicy = policies[0]
+		}
+
+		return ac, nil
+	}
+}
+
+var _ accesscontroller.Interface = &groupAccessController{}
@@ -0,0 +1,105 @@
+package orbitutil
+
+import (
+	"context"
+	crand "crypto/rand"
+	"testing"
+	"time"
+
+	"berty.tech/go-orbit-db/events"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/internal/account"
+	"berty.tech/berty/go/pkg/bertytypes"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+func TestGroupAccessControllerKnownDevices(t *testing.T) {
+	ctx := context.Background()
+
+	g, _, err := bertytypes.NewGroupMultiMember()
+	require.NoError(t, err)
+
+	newKey := func() (crypto.PubKey, []byte) {
+		_, pk, err := crypto.GenerateEd25519Key(crand.Reader)
+		require.NoError(t, err)
+
+		raw, err := pk.Raw()
+		require.NoError(t, err)
+
+		return pk, raw
+	}
+
+	adminPK, adminPKBytes := newKey()
+	adminDevicePK, adminDevicePKBytes := newKey()
+	memberPK, memberPKBytes := newKey()
+	_, memberDevicePKBytes := newKey()
+	_, unknownDevicePKBytes := newKey()
+
+	md := &account.MemberDevice{Member: adminPK, Device: adminDevicePK}
+	idx := NewMetadataIndex(ctx, &events.EventEmitter{}, g, md, ds_sync.MutexWrap(datastore.NewMapDatastore()))(nil).(*metadataStoreIndex)
+
+	require.NoError(t, idx.handleGroupAddMemberDevice(&bertytypes.GroupAddMemberDevice{MemberPK: adminPKBytes, DevicePK: adminDevicePKBytes}))
+	require.NoError(t, idx.handleMultiMemberInitialMember(&bertytypes.MultiMemberInitialMember{MemberPK: adminPKBytes}))
+	require.NoError(t, idx.handleMultiMemberGrantAdminRole(&bertytypes.MultiMemberGrantAdminRole{DevicePK: adminDevicePKBytes, GranteeMemberPK: memberPKBytes}))
+	require.NoError(t, idx.handleGroupAddMemberDevice(&bertytypes.GroupAddMemberDevice{MemberPK: memberPKBytes, DevicePK: memberDevicePKBytes}))
+	require.NoError(t, idx.handleMultiMemberRemoveMember(&bertytypes.MultiMemberRemoveMember{DevicePK: adminDevicePKBytes, MemberPK: memberPKBytes}))
+
+	require.Len(t, idx.ListDevices(), 1)
+	require.False(t, idx.isAdmin(memberPK))
+
+	ac := &groupAccessController{
+		writePolicy:      WritePolicyAdmins,
+		announcedDevices: map[string]crypto.PubKey{},
+		checkedEntries:   map[string]struct{}{},
+	}
+
+	// the entries written by a removed member before its removal are still
+	// accepted, they can be joined after the removal has been indexed
+	found, err := ac.getMemberForDevice(g, idx, memberDevicePKBytes, nil)
+	require.NoError(t, err)
+	require.True(t, found.Equals(memberPK))
+	require.True(t, idx.wasAdmin(memberPK))
+
+	_, err = ac.getMemberForDevice(g, idx, unknownDevicePKBytes, nil)
+	require.Equal(t, errcode.ErrNotAuthorized, err)
+}
+
+func TestGroupAccessControllerAdminMessagesOnly(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	g, groupSK, err := bertytypes.NewGroupMultiMember()
+	require.NoError(t, err)
+
+	g.AdminMessagesOnly = true
+
+	peers := CreatePeersForGroup(ctx, t, g, 2, 1)
+	defer DropPeers(t, peers)
+
+	InviteAllPeersToGroup(ctx, t, peers, groupSK)
+
+	// the invitees only know the admin once its claim has been replicated
+	for i := 0; i < 50 && len(peers[1].GC.MetadataStore().ListAdmins()) == 0; i++ {
+		<-time.After(time.Millisecond * 100)
+	}
+
+	_, err = peers[0].GC.MessageStore().AddMessage(ctx, []byte("from the admin"))
+	require.NoError(t, err)
+
+	_, err = peers[1].GC.MessageStore().AddMessage(ctx, []byte("from a member"))
+	require.Error(t, err)
+
+	_, err = peers[0].GC.MetadataStore().AdminRoleGrant(ctx, peers[1].GC.MemberPubKey())
+	require.NoError(t, err)
+
+	for i := 0; i < 50 && len(peers[1].GC.MetadataStore().ListAdmins()) < 2; i++ {
+		<-time.After(time.Millisecond * 100)
+	}
+
+	_, err = peers[1].GC.MessageStore().AddMessage(ctx, []byte("from a new admin"))
+	require.NoError(t, err)
+}
@@ -1,6 +1,8 @@
 package orbitutil
 
 import (
+	"bytes"
+	"container/heap"
 	"context"
 
 	"berty.tech/go-ipfs-log/identityprovider"
@@ -9,12 +11,14 @@ import (
 	"berty.tech/go-orbit-db/stores"
 	"berty.tech/go-orbit-db/stores/basestore"
 	"berty.tech/go-orbit-db/stores/operation"
+	"github.com/ipfs/go-cid"
 	coreapi "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/libp2p/go-libp2p-core/crypto"
+	"go.uber.org/zap"
 
 	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/internal/bertycrypto"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 
 	ipfslog "berty.tech/go-ipfs-log"
@@ -25,31 +29,29 @@ const GroupMessageStoreType = "berty_group_messages"
 type MessageStoreImpl struct {
 	basestore.BaseStore
 
-	acc *account.Account
-	mk  bertycrypto.MessageKeys
-	g   *bertyprotocol.Group
+	acc    *account.Account
+	mk     bertycrypto.MessageKeys
+	g      *bertytypes.Group
+	logger *zap.Logger
 }
 
-func (m *MessageStoreImpl) openMessage(ctx context.Context, e ipfslog.Entry) (*bertyprotocol.GroupMessageEvent, error) {
+func (m *MessageStoreImpl) openMessage(ctx context.Context, e ipfslog.Entry) (*bertytypes.GroupMessageEvent, error) {
 	if e == nil {
 		return nil, errcode.ErrInvalidInput
 	}
 
 	op, err := operation.ParseOperation(e)
 	if err != nil {
-		// TODO: log
 		return nil, err
 	}
 
 	headers, payload, decryptInfo, err := bertycrypto.OpenEnvelope(ctx, m.mk, m.g, op.GetValue(), e.GetHash())
 	if err != nil {
-		// TODO: log
 		return nil, err
 	}
 
-	eventContext, err := bertyprotocol.NewEventContext(e.GetHash(), e.GetNext(), m.g)
+	eventContext, err := bertytypes.NewEventContext(e.GetHash(), e.GetNext(), m.g)
 	if err != nil {
-		// TODO: log
 		return nil, err
 	}
 
@@ -63,39 +65,154 @@ func (m *MessageStoreImpl) openMessage(ctx context.Context, e ipfslog.Entry) (*b
 		err = errcode.ErrSecretKeyGenerationFailed.Wrap(err)
 	}
 
-	return &bertyprotocol.GroupMessageEvent{
+	return &bertytypes.GroupMessageEvent{
 		EventContext: eventContext,
 		Headers:      headers,
 		Message:      payload,
 	}, err
 }
 
-func (m *MessageStoreImpl) ListMessages(ctx context.Context) (<-chan *bertyprotocol.GroupMessageEvent, error) {
-	out := make(chan *bertyprotocol.GroupMessageEvent)
-	ch := make(chan ipfslog.Entry)
+func (m *MessageStoreImpl) ListMessages(ctx context.Context) (<-chan *bertytypes.GroupMessageEvent, error) {
+	return m.ListMessagesRange(ctx, cid.Undef, cid.Undef, -1, true)
+}
+
+func (m *MessageStoreImpl) ListMessagesRange(ctx context.Context, since, until cid.Cid, limit int, newestFirst bool) (<-chan *bertytypes.GroupMessageEvent, error) {
+	log := m.OpLog()
+	logEntries := log.GetEntries()
+
+	if since.Defined() {
+		if _, ok := logEntries.Get(since.String()); !ok {
+			return nil, errcode.ErrInvalidInput
+		}
+	}
+
+	from := log.Heads().Slice()
+	if until.Defined() {
+		e, ok := logEntries.Get(until.String())
+		if !ok {
+			return nil, errcode.ErrInvalidInput
+		}
+
+		from = []ipfslog.Entry{e}
+	}
+
+	// when paging forward from since the limit keeps the oldest messages of
+	// the range, the newest ones otherwise
+	keepOldest := since.Defined() && !newestFirst
+
+	maxEntries := -1
+	if !keepOldest {
+		maxEntries = limit
+	}
+
+	// entries are sorted from the newest to the oldest
+	entries := walkLogBackward(logEntries.Get, from, since, maxEntries)
+
+	if keepOldest && limit >= 0 && len(entries) > limit {
+		entries = entries[len(entries)-limit:]
+	}
+
+	out := make(chan *bertytypes.GroupMessageEvent)
 
 	go func() {
-		for e := range ch {
+		defer close(out)
+
+		for i := range entries {
+			e := entries[len(entries)-1-i]
+			if newestFirst {
+				e = entries[i]
+			}
+
 			evt, err := m.openMessage(ctx, e)
 			if err != nil {
-				// TODO: log
+				m.logger.Error("unable to open message", zap.String("cid", e.GetHash().String()), zap.Error(err))
 				continue
 			}
 
-			out <- evt
+			select {
+			case out <- evt:
+			case <-ctx.Done():
+				return
+			}
 		}
-
-		close(out)
-	}()
-
-	go func() {
-		_ = m.OpLog().Iterator(&ipfslog.IteratorOptions{}, ch)
-		// TODO: log
 	}()
 
 	return out, nil
 }
 
+// walkLogBackward lists the entries reachable from the given ones, from the
+// newest to the oldest, until the since entry is reached or max entries are
+// listed, a negative max lists every entry. Only the entries newer than since
+// are visited instead of the whole log.
+func walkLogBackward(getEntry func(string) (ipfslog.Entry, bool), from []ipfslog.Entry, since cid.Cid, max int) []ipfslog.Entry {
+	var (
+		entries []ipfslog.Entry
+		queue   = &entryHeap{}
+		queued  = map[string]struct{}{}
+	)
+
+	for _, e := range from {
+		if _, ok := queued[e.GetHash().String()]; ok {
+			continue
+		}
+
+		queued[e.GetHash().String()] = struct{}{}
+		heap.Push(queue, e)
+	}
+
+	for queue.Len() > 0 && (max < 0 || len(entries) < max) {
+		e := heap.Pop(queue).(ipfslog.Entry)
+		if since.Defined() && e.GetHash().Equals(since) {
+			break
+		}
+
+		entries = append(entries, e)
+
+		for _, c := range e.GetNext() {
+			if _, ok := queued[c.String()]; ok {
+				continue
+			}
+
+			next, ok := getEntry(c.String())
+			if !ok {
+				continue
+			}
+
+			queued[c.String()] = struct{}{}
+			heap.Push(queue, next)
+		}
+	}
+
+	return entries
+}
+
+// entryHeap is a max heap of log entries, sorted like the log by their
+// lamport clock then their writer ID
+type entryHeap []ipfslog.Entry
+
+func (h entryHeap) Len() int { return len(h) }
+
+func (h entryHeap) Less(i, j int) bool {
+	a, b := h[i].GetClock(), h[j].GetClock()
+	if a.GetTime() != b.GetTime() {
+		return a.GetTime() > b.GetTime()
+	}
+
+	return bytes.Compare(a.GetID(), b.GetID()) > 0
+}
+
+func (h entryHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *entryHeap) Push(x interface{}) { *h = append(*h, x.(ipfslog.Entry)) }
+
+func (h *entryHeap) Pop() interface{} {
+	old := *h
+	e := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return e
+}
+
 func (m *MessageStoreImpl) AddMessage(ctx context.Context, payload []byte) (operation.Operation, error) {
 	md, err := m.acc.MemberDeviceForGroup(m.g)
 	if err != nil {
@@ -130,9 +247,10 @@ func ConstructorFactoryGroupMessage(s *bertyOrbitDB) iface.StoreConstructor {
 		}
 
 		store := &MessageStoreImpl{
-			acc: s.account,
-			mk:  s.mk,
-			g:   g,
+			acc:    s.account,
+			mk:     s.mk,
+			g:      g,
+			logger: s.logger.Named("messages"),
 		}
 
 		options.Index = basestore.NewBaseIndex
@@ -159,7 +277,7 @@ func ConstructorFactoryGroupMessage(s *bertyOrbitDB) iface.StoreConstructor {
 
 				messageEvent, err := store.openMessage(ctx, entry)
 				if err != nil {
-					// TODO: log
+					store.logger.Error("unable to open message", zap.String("cid", entry.GetHash().String()), zap.Error(err))
 					continue
 				}
 
@@ -10,10 +10,10 @@ import (
 
 	"berty.tech/berty/go/internal/bertycrypto"
 	"berty.tech/berty/go/internal/orbitutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
-func countEntries(out <-chan *bertyprotocol.GroupMessageEvent) int {
+func countEntries(out <-chan *bertytypes.GroupMessageEvent) int {
 	found := 0
 
 	for range out {
@@ -33,7 +33,7 @@ func Test_AddMessage_ListMessages_manually_supplying_secrets(t *testing.T) {
 
 	testMsg1 := []byte("first message")
 
-	peers, _ := orbitutil.CreatePeersWithGroup(ctx, t, "/tmp/message_test", memberCount, deviceCount)
+	peers, groupSK := orbitutil.CreatePeersWithGroup(ctx, t, "/tmp/message_test", memberCount, deviceCount)
 	defer orbitutil.DropPeers(t, peers)
 
 	dPK0 := peers[0].GC.DevicePubKey()
@@ -44,6 +44,20 @@ func Test_AddMessage_ListMessages_manually_supplying_secrets(t *testing.T) {
 	err = bertycrypto.RegisterChainKey(ctx, peers[1].MK, peers[0].GC.Group(), dPK0, ds0, false)
 	assert.NoError(t, err)
 
+	// only the devices of the group members can write messages
+	_, err = peers[0].GC.MessageStore().AddMessage(ctx, testMsg1)
+	assert.Error(t, err)
+
+	_, err = peers[0].GC.MetadataStore().AddDeviceToGroup(ctx)
+	assert.NoError(t, err)
+
+	_, err = peers[0].GC.MetadataStore().ClaimGroupOwnership(ctx, groupSK)
+	assert.NoError(t, err)
+
+	for i := 0; i < 50 && len(peers[1].GC.MetadataStore().ListDevices()) == 0; i++ {
+		<-time.After(time.Millisecond * 100)
+	}
+
 	_, err = peers[0].GC.MessageStore().AddMessage(ctx, testMsg1)
 	assert.NoError(t, err)
 
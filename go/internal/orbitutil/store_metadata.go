@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"io"
 	"io/ioutil"
+	"time"
 
 	"berty.tech/go-ipfs-log/identityprovider"
 	"berty.tech/go-orbit-db/address"
@@ -18,7 +19,7 @@ import (
 	"berty.tech/berty/go/internal/account"
 	"berty.tech/berty/go/internal/bertycrypto"
 	"berty.tech/berty/go/internal/group"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
@@ -26,21 +27,21 @@ const GroupMetadataStoreType = "berty_group_metadata"
 
 type MetadataStoreImpl struct {
 	basestore.BaseStore
-	g   *bertyprotocol.Group
+	g   *bertytypes.Group
 	acc *account.Account
 	mk  bertycrypto.MessageKeys
 }
 
 func isMultiMemberGroup(m *MetadataStoreImpl) bool {
-	return m.g.GroupType == bertyprotocol.GroupTypeMultiMember
+	return m.g.GroupType == bertytypes.GroupTypeMultiMember
 }
 
 func isAccountGroup(m *MetadataStoreImpl) bool {
-	return m.g.GroupType == bertyprotocol.GroupTypeAccount
+	return m.g.GroupType == bertytypes.GroupTypeAccount
 }
 
 func isContactGroup(m *MetadataStoreImpl) bool {
-	return m.g.GroupType == bertyprotocol.GroupTypeContact
+	return m.g.GroupType == bertytypes.GroupTypeContact
 }
 
 func (m *MetadataStoreImpl) typeChecker(types ...func(m *MetadataStoreImpl) bool) bool {
@@ -53,26 +54,33 @@ func (m *MetadataStoreImpl) typeChecker(types ...func(m *MetadataStoreImpl) bool
 	return false
 }
 
-func (m *MetadataStoreImpl) ListEvents(ctx context.Context) <-chan *bertyprotocol.GroupMetadataEvent {
-	ch := make(chan *bertyprotocol.GroupMetadataEvent)
+func (m *MetadataStoreImpl) ListEvents(ctx context.Context) <-chan *bertytypes.GroupMetadataEvent {
+	ch := make(chan *bertytypes.GroupMetadataEvent)
 
 	go func() {
 		log := m.OpLog()
 
-		for _, e := range log.GetEntries().Slice() {
+		for _, e := range log.Values().Slice() {
 			op, err := operation.ParseOperation(e)
 			if err != nil {
 				// TODO: log
 				continue
 			}
 
-			meta, event, err := bertyprotocol.OpenGroupEnvelope(m.g, op.GetValue())
+			meta, event, err := bertytypes.OpenGroupEnvelope(m.g, op.GetValue())
 			if err != nil {
 				// TODO: log
 				continue
 			}
 
-			metaEvent, err := bertyprotocol.NewGroupMetadataEventFromEntry(log, e, meta, event, m.g)
+			if meta.EventType == bertytypes.EventTypeGroupMetadataPayloadSent {
+				if err := m.Index().(*metadataStoreIndex).checkAppMetadataSender(event); err != nil {
+					// TODO: log
+					continue
+				}
+			}
+
+			metaEvent, err := bertytypes.NewGroupMetadataEventFromEntry(log, e, meta, event, m.g)
 			if err != nil {
 				// TODO: log
 				continue
@@ -96,7 +104,7 @@ func (m *MetadataStoreImpl) AddDeviceToGroup(ctx context.Context) (operation.Ope
 	return MetadataStoreAddDeviceToGroup(ctx, m, m.g, md)
 }
 
-func MetadataStoreAddDeviceToGroup(ctx context.Context, m MetadataStore, g *bertyprotocol.Group, md *account.OwnMemberDevice) (operation.Operation, error) {
+func MetadataStoreAddDeviceToGroup(ctx context.Context, m MetadataStore, g *bertytypes.Group, md *account.OwnMemberDevice) (operation.Operation, error) {
 	device, err := md.Device.GetPublic().Raw()
 	if err != nil {
 		return nil, errcode.ErrSerialization.Wrap(err)
@@ -117,7 +125,7 @@ func MetadataStoreAddDeviceToGroup(ctx context.Context, m MetadataStore, g *bert
 		return nil, errcode.ErrSignatureFailed.Wrap(err)
 	}
 
-	event := &bertyprotocol.GroupAddMemberDevice{
+	event := &bertytypes.GroupAddMemberDevice{
 		MemberPK:  member,
 		DevicePK:  device,
 		MemberSig: memberSig,
@@ -128,7 +136,7 @@ func MetadataStoreAddDeviceToGroup(ctx context.Context, m MetadataStore, g *bert
 		return nil, errcode.ErrSignatureFailed.Wrap(err)
 	}
 
-	return MetadataStoreAddEvent(ctx, m, g, bertyprotocol.EventTypeGroupMemberDeviceAdded, event, sig)
+	return MetadataStoreAddEvent(ctx, m, g, bertytypes.EventTypeGroupMemberDeviceAdded, event, sig)
 }
 
 func (m *MetadataStoreImpl) SendSecret(ctx context.Context, memberPK crypto.PubKey) (operation.Operation, error) {
@@ -137,7 +145,12 @@ func (m *MetadataStoreImpl) SendSecret(ctx context.Context, memberPK crypto.PubK
 		return nil, errcode.ErrInternal.Wrap(err)
 	}
 
-	ok, err := m.Index().(*metadataStoreIndex).areSecretsAlreadySent(memberPK)
+	ds, err := bertycrypto.DeviceSecret(ctx, m.g, m.mk, m.acc)
+	if err != nil {
+		return nil, errcode.ErrInvalidInput.Wrap(err)
+	}
+
+	ok, err := m.Index().(*metadataStoreIndex).areSecretsAlreadySent(memberPK, ds.Generation)
 	if err != nil {
 		return nil, errcode.ErrInvalidInput.Wrap(err)
 	}
@@ -150,15 +163,10 @@ func (m *MetadataStoreImpl) SendSecret(ctx context.Context, memberPK crypto.PubK
 		return nil, errcode.ErrInvalidInput
 	}
 
-	ds, err := bertycrypto.DeviceSecret(ctx, m.g, m.mk, m.acc)
-	if err != nil {
-		return nil, errcode.ErrInvalidInput.Wrap(err)
-	}
-
 	return MetadataStoreSendSecret(ctx, m, m.g, md, memberPK, ds)
 }
 
-func MetadataStoreSendSecret(ctx context.Context, m MetadataStore, g *bertyprotocol.Group, md *account.OwnMemberDevice, memberPK crypto.PubKey, ds *bertyprotocol.DeviceSecret) (operation.Operation, error) {
+func MetadataStoreSendSecret(ctx context.Context, m MetadataStore, g *bertytypes.Group, md *account.OwnMemberDevice, memberPK crypto.PubKey, ds *bertytypes.DeviceSecret) (operation.Operation, error) {
 	payload, err := group.NewSecretEntryPayload(md.Device, memberPK, ds, g)
 	if err != nil {
 		return nil, errcode.ErrInternal.Wrap(err)
@@ -174,10 +182,11 @@ func MetadataStoreSendSecret(ctx context.Context, m MetadataStore, g *bertyproto
 		return nil, errcode.ErrSerialization.Wrap(err)
 	}
 
-	event := &bertyprotocol.GroupAddDeviceSecret{
+	event := &bertytypes.GroupAddDeviceSecret{
 		DevicePK:     devicePKRaw,
 		DestMemberPK: memberPKRaw,
 		Payload:      payload,
+		Generation:   ds.Generation,
 	}
 
 	sig, err := SignProto(event, md.Device)
@@ -185,7 +194,7 @@ func MetadataStoreSendSecret(ctx context.Context, m MetadataStore, g *bertyproto
 		return nil, errcode.ErrSignatureFailed.Wrap(err)
 	}
 
-	return MetadataStoreAddEvent(ctx, m, g, bertyprotocol.EventTypeGroupDeviceSecretAdded, event, sig)
+	return MetadataStoreAddEvent(ctx, m, g, bertytypes.EventTypeGroupDeviceSecretAdded, event, sig)
 }
 
 func (m *MetadataStoreImpl) ClaimGroupOwnership(ctx context.Context, groupSK crypto.PrivKey) (operation.Operation, error) {
@@ -203,7 +212,7 @@ func (m *MetadataStoreImpl) ClaimGroupOwnership(ctx context.Context, groupSK cry
 		return nil, errcode.ErrSerialization.Wrap(err)
 	}
 
-	event := &bertyprotocol.MultiMemberInitialMember{
+	event := &bertytypes.MultiMemberInitialMember{
 		MemberPK: memberPK,
 	}
 
@@ -212,7 +221,7 @@ func (m *MetadataStoreImpl) ClaimGroupOwnership(ctx context.Context, groupSK cry
 		return nil, errcode.ErrSignatureFailed.Wrap(err)
 	}
 
-	return MetadataStoreAddEvent(ctx, m, m.g, bertyprotocol.EventTypeMultiMemberGroupInitialMemberAnnounced, event, sig)
+	return MetadataStoreAddEvent(ctx, m, m.g, bertytypes.EventTypeMultiMemberGroupInitialMemberAnnounced, event, sig)
 }
 
 func SignProto(message proto.Message, sk crypto.PrivKey) ([]byte, error) {
@@ -229,8 +238,8 @@ func SignProto(message proto.Message, sk crypto.PrivKey) ([]byte, error) {
 	return sig, nil
 }
 
-func MetadataStoreAddEvent(ctx context.Context, m MetadataStore, g *bertyprotocol.Group, eventType bertyprotocol.EventType, event proto.Marshaler, sig []byte) (operation.Operation, error) {
-	env, err := bertyprotocol.SealGroupEnvelope(g, eventType, event, sig)
+func MetadataStoreAddEvent(ctx context.Context, m MetadataStore, g *bertytypes.Group, eventType bertytypes.EventType, event proto.Marshaler, sig []byte) (operation.Operation, error) {
+	env, err := bertytypes.SealGroupEnvelope(g, eventType, event, sig)
 	if err != nil {
 		return nil, errcode.ErrSignatureFailed.Wrap(err)
 	}
@@ -266,7 +275,7 @@ func (m *MetadataStoreImpl) ListAdmins() []crypto.PubKey {
 	return m.Index().(*metadataStoreIndex).ListAdmins()
 }
 
-func (m *MetadataStoreImpl) GetIncomingContactRequestsStatus() (bool, *bertyprotocol.ShareableContact) {
+func (m *MetadataStoreImpl) GetIncomingContactRequestsStatus() (bool, *bertytypes.ShareableContact) {
 	if !m.typeChecker(isAccountGroup) {
 		return false, nil
 	}
@@ -290,7 +299,7 @@ func (m *MetadataStoreImpl) GetIncomingContactRequestsStatus() (bool, *bertyprot
 		return enabled, nil
 	}
 
-	contactRef := &bertyprotocol.ShareableContact{
+	contactRef := &bertytypes.ShareableContact{
 		PK:                   pkBytes,
 		PublicRendezvousSeed: seed,
 	}
@@ -306,7 +315,7 @@ func (m *MetadataStoreImpl) ListDevices() []crypto.PubKey {
 	return m.Index().(*metadataStoreIndex).ListDevices()
 }
 
-func (m *MetadataStoreImpl) ListMultiMemberGroups() []*bertyprotocol.Group {
+func (m *MetadataStoreImpl) ListMultiMemberGroups() []*bertytypes.Group {
 	if !m.typeChecker(isAccountGroup) {
 		return nil
 	}
@@ -318,7 +327,7 @@ func (m *MetadataStoreImpl) ListMultiMemberGroups() []*bertyprotocol.Group {
 	idx.lock.Lock()
 	defer idx.lock.Unlock()
 
-	groups := []*bertyprotocol.Group(nil)
+	groups := []*bertytypes.Group(nil)
 
 	for _, c := range idx.groups {
 		if c.state != accountGroupJoinedStateJoined {
@@ -332,7 +341,7 @@ func (m *MetadataStoreImpl) ListMultiMemberGroups() []*bertyprotocol.Group {
 
 }
 
-func (m *MetadataStoreImpl) ListContactsByStatus(state bertyprotocol.ContactState) []*bertyprotocol.ShareableContact {
+func (m *MetadataStoreImpl) ListContactsByStatus(state bertytypes.ContactState) []*bertytypes.ShareableContact {
 	if !m.typeChecker(isAccountGroup) {
 		return nil
 	}
@@ -344,7 +353,7 @@ func (m *MetadataStoreImpl) ListContactsByStatus(state bertyprotocol.ContactStat
 	idx.lock.Lock()
 	defer idx.lock.Unlock()
 
-	contacts := []*bertyprotocol.ShareableContact(nil)
+	contacts := []*bertytypes.ShareableContact(nil)
 
 	for _, c := range idx.contacts {
 		if c.state != state {
@@ -357,6 +366,58 @@ func (m *MetadataStoreImpl) ListContactsByStatus(state bertyprotocol.ContactStat
 	return contacts
 }
 
+func (m *MetadataStoreImpl) GetContact(pk crypto.PubKey) (*bertytypes.ShareableContact, bertytypes.ContactState) {
+	if !m.typeChecker(isAccountGroup) {
+		return nil, bertytypes.ContactStateUndefined
+	}
+
+	idx, ok := m.Index().(*metadataStoreIndex)
+	if !ok {
+		return nil, bertytypes.ContactStateUndefined
+	}
+
+	pkBytes, err := pk.Raw()
+	if err != nil {
+		return nil, bertytypes.ContactStateUndefined
+	}
+
+	idx.lock.Lock()
+	defer idx.lock.Unlock()
+
+	c, ok := idx.contacts[string(pkBytes)]
+	if !ok {
+		return nil, bertytypes.ContactStateUndefined
+	}
+
+	return c.contact, c.state
+}
+
+func (m *MetadataStoreImpl) GetOutgoingContactRequestMetadata(pk crypto.PubKey) []byte {
+	if !m.typeChecker(isAccountGroup) {
+		return nil
+	}
+
+	idx, ok := m.Index().(*metadataStoreIndex)
+	if !ok {
+		return nil
+	}
+
+	pkBytes, err := pk.Raw()
+	if err != nil {
+		return nil
+	}
+
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+
+	c, ok := idx.contacts[string(pkBytes)]
+	if !ok {
+		return nil
+	}
+
+	return c.ownMetadata
+}
+
 func (m *MetadataStoreImpl) checkIfInGroup(pk []byte) bool {
 	idx, ok := m.Index().(*metadataStoreIndex)
 	if !ok {
@@ -374,7 +435,7 @@ func (m *MetadataStoreImpl) checkIfInGroup(pk []byte) bool {
 }
 
 // EventTypeAccountGroupJoined indicates the payload includes that the account has joined a group
-func (m *MetadataStoreImpl) GroupJoin(ctx context.Context, g *bertyprotocol.Group) (operation.Operation, error) {
+func (m *MetadataStoreImpl) GroupJoin(ctx context.Context, g *bertytypes.Group) (operation.Operation, error) {
 	if !m.typeChecker(isAccountGroup) {
 		return nil, errcode.ErrGroupInvalidType
 	}
@@ -387,9 +448,9 @@ func (m *MetadataStoreImpl) GroupJoin(ctx context.Context, g *bertyprotocol.Grou
 		return nil, errcode.ErrInvalidInput
 	}
 
-	return m.attributeSignAndAddEvent(ctx, &bertyprotocol.AccountGroupJoined{
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.AccountGroupJoined{
 		Group: g,
-	}, bertyprotocol.EventTypeAccountGroupJoined)
+	}, bertytypes.EventTypeAccountGroupJoined)
 }
 
 // EventTypeAccountGroupLeft indicates the payload includes that the account has left a group
@@ -411,7 +472,7 @@ func (m *MetadataStoreImpl) GroupLeave(ctx context.Context, pk crypto.PubKey) (o
 		return nil, errcode.ErrInvalidInput
 	}
 
-	return m.groupAction(ctx, pk, &bertyprotocol.AccountGroupLeft{}, bertyprotocol.EventTypeAccountGroupLeft)
+	return m.groupAction(ctx, pk, &bertytypes.AccountGroupLeft{}, bertytypes.EventTypeAccountGroupLeft)
 }
 
 // EventTypeAccountContactRequestDisabled indicates the payload includes that the account has disabled incoming contact requests
@@ -420,7 +481,7 @@ func (m *MetadataStoreImpl) ContactRequestDisable(ctx context.Context) (operatio
 		return nil, errcode.ErrGroupInvalidType
 	}
 
-	return m.attributeSignAndAddEvent(ctx, &bertyprotocol.AccountContactRequestDisabled{}, bertyprotocol.EventTypeAccountContactRequestDisabled)
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.AccountContactRequestDisabled{}, bertytypes.EventTypeAccountContactRequestDisabled)
 }
 
 // EventTypeAccountContactRequestEnabled indicates the payload includes that the account has enabled incoming contact requests
@@ -429,7 +490,7 @@ func (m *MetadataStoreImpl) ContactRequestEnable(ctx context.Context) (operation
 		return nil, errcode.ErrGroupInvalidType
 	}
 
-	return m.attributeSignAndAddEvent(ctx, &bertyprotocol.AccountContactRequestEnabled{}, bertyprotocol.EventTypeAccountContactRequestEnabled)
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.AccountContactRequestEnabled{}, bertytypes.EventTypeAccountContactRequestEnabled)
 }
 
 // EventTypeAccountContactRequestReferenceReset indicates the payload includes that the account has a new contact request reference
@@ -438,18 +499,18 @@ func (m *MetadataStoreImpl) ContactRequestReferenceReset(ctx context.Context) (o
 		return nil, errcode.ErrGroupInvalidType
 	}
 
-	seed, err := ioutil.ReadAll(io.LimitReader(rand.Reader, bertyprotocol.RendezvousSeedLength))
+	seed, err := ioutil.ReadAll(io.LimitReader(rand.Reader, bertytypes.RendezvousSeedLength))
 	if err != nil {
 		return nil, errcode.ErrSecretKeyGenerationFailed.Wrap(err)
 	}
 
-	return m.attributeSignAndAddEvent(ctx, &bertyprotocol.AccountContactRequestReferenceReset{
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.AccountContactRequestReferenceReset{
 		RendezvousSeed: seed,
-	}, bertyprotocol.EventTypeAccountContactRequestReferenceReset)
+	}, bertytypes.EventTypeAccountContactRequestReferenceReset)
 }
 
 // EventTypeAccountContactRequestEnqueued indicates the payload includes that the account will attempt to send a new contact request
-func (m *MetadataStoreImpl) ContactRequestOutgoingEnqueue(ctx context.Context, contact *bertyprotocol.ShareableContact) (operation.Operation, error) {
+func (m *MetadataStoreImpl) ContactRequestOutgoingEnqueue(ctx context.Context, contact *bertytypes.ShareableContact, ownMetadata []byte) (operation.Operation, error) {
 	if !m.typeChecker(isAccountGroup) {
 		return nil, errcode.ErrGroupInvalidType
 	}
@@ -472,15 +533,16 @@ func (m *MetadataStoreImpl) ContactRequestOutgoingEnqueue(ctx context.Context, c
 		return nil, errcode.ErrDeserialization.Wrap(err)
 	}
 
-	if m.checkContactStatus(pk, bertyprotocol.ContactStateRemoved, bertyprotocol.ContactStateDiscarded, bertyprotocol.ContactStateReceived) {
+	if m.checkContactStatus(pk, bertytypes.ContactStateRemoved, bertytypes.ContactStateDiscarded, bertytypes.ContactStateReceived) {
 		return m.ContactRequestOutgoingSent(ctx, pk)
 	}
 
-	return m.attributeSignAndAddEvent(ctx, &bertyprotocol.AccountContactRequestEnqueued{
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.AccountContactRequestEnqueued{
 		ContactPK:             contact.PK,
 		ContactRendezvousSeed: contact.PublicRendezvousSeed,
 		ContactMetadata:       contact.Metadata,
-	}, bertyprotocol.EventTypeAccountContactRequestOutgoingEnqueued)
+		OwnMetadata:           ownMetadata,
+	}, bertytypes.EventTypeAccountContactRequestOutgoingEnqueued)
 }
 
 // EventTypeAccountContactRequestSent indicates the payload includes that the account has sent a contact request
@@ -489,15 +551,15 @@ func (m *MetadataStoreImpl) ContactRequestOutgoingSent(ctx context.Context, pk c
 		return nil, errcode.ErrGroupInvalidType
 	}
 
-	if !m.checkContactStatus(pk, bertyprotocol.ContactStateToRequest, bertyprotocol.ContactStateRemoved, bertyprotocol.ContactStateReceived, bertyprotocol.ContactStateDiscarded) {
+	if !m.checkContactStatus(pk, bertytypes.ContactStateToRequest, bertytypes.ContactStateRemoved, bertytypes.ContactStateReceived, bertytypes.ContactStateDiscarded) {
 		return nil, errcode.ErrInvalidInput
 	}
 
-	return m.contactAction(ctx, pk, &bertyprotocol.AccountContactRequestSent{}, bertyprotocol.EventTypeAccountContactRequestOutgoingSent)
+	return m.contactAction(ctx, pk, &bertytypes.AccountContactRequestSent{}, bertytypes.EventTypeAccountContactRequestOutgoingSent)
 }
 
 // EventTypeAccountContactRequestReceived indicates the payload includes that the account has received a contact request
-func (m *MetadataStoreImpl) ContactRequestIncomingReceived(ctx context.Context, contact *bertyprotocol.ShareableContact) (operation.Operation, error) {
+func (m *MetadataStoreImpl) ContactRequestIncomingReceived(ctx context.Context, contact *bertytypes.ShareableContact) (operation.Operation, error) {
 	if !m.typeChecker(isAccountGroup) {
 		return nil, errcode.ErrGroupInvalidType
 	}
@@ -521,19 +583,19 @@ func (m *MetadataStoreImpl) ContactRequestIncomingReceived(ctx context.Context,
 	}
 
 	// Contact was waiting to be accepted, mark as sent instead
-	if m.checkContactStatus(pk, bertyprotocol.ContactStateToRequest) {
+	if m.checkContactStatus(pk, bertytypes.ContactStateToRequest) {
 		return m.ContactRequestOutgoingSent(ctx, pk)
 	}
 
-	if m.checkContactStatus(pk, bertyprotocol.ContactStateReceived, bertyprotocol.ContactStateAdded, bertyprotocol.ContactStateBlocked) {
+	if m.checkContactStatus(pk, bertytypes.ContactStateReceived, bertytypes.ContactStateAdded, bertytypes.ContactStateBlocked) {
 		return nil, errcode.ErrInvalidInput
 	}
 
-	return m.attributeSignAndAddEvent(ctx, &bertyprotocol.AccountContactRequestReceived{
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.AccountContactRequestReceived{
 		ContactPK:             contact.PK,
 		ContactRendezvousSeed: contact.PublicRendezvousSeed,
 		ContactMetadata:       contact.Metadata,
-	}, bertyprotocol.EventTypeAccountContactRequestIncomingReceived)
+	}, bertytypes.EventTypeAccountContactRequestIncomingReceived)
 }
 
 // EventTypeAccountContactRequestIncomingDiscarded indicates the payload includes that the account has ignored a contact request
@@ -542,11 +604,11 @@ func (m *MetadataStoreImpl) ContactRequestIncomingDiscard(ctx context.Context, p
 		return nil, errcode.ErrGroupInvalidType
 	}
 
-	if !m.checkContactStatus(pk, bertyprotocol.ContactStateReceived) {
+	if !m.checkContactStatus(pk, bertytypes.ContactStateReceived) {
 		return nil, errcode.ErrInvalidInput
 	}
 
-	return m.contactAction(ctx, pk, &bertyprotocol.AccountContactRequestDiscarded{}, bertyprotocol.EventTypeAccountContactRequestIncomingDiscarded)
+	return m.contactAction(ctx, pk, &bertytypes.AccountContactRequestDiscarded{}, bertytypes.EventTypeAccountContactRequestIncomingDiscarded)
 }
 
 // EventTypeAccountContactRequestAccepted indicates the payload includes that the account has accepted a contact request
@@ -555,11 +617,11 @@ func (m *MetadataStoreImpl) ContactRequestIncomingAccept(ctx context.Context, pk
 		return nil, errcode.ErrGroupInvalidType
 	}
 
-	if !m.checkContactStatus(pk, bertyprotocol.ContactStateReceived) {
+	if !m.checkContactStatus(pk, bertytypes.ContactStateReceived) {
 		return nil, errcode.ErrInvalidInput
 	}
 
-	return m.contactAction(ctx, pk, &bertyprotocol.AccountContactRequestAccepted{}, bertyprotocol.EventTypeAccountContactRequestIncomingAccepted)
+	return m.contactAction(ctx, pk, &bertytypes.AccountContactRequestAccepted{}, bertytypes.EventTypeAccountContactRequestIncomingAccepted)
 }
 
 // EventTypeAccountContactBlocked indicates the payload includes that the account has blocked a contact
@@ -577,11 +639,11 @@ func (m *MetadataStoreImpl) ContactBlock(ctx context.Context, pk crypto.PubKey)
 		return nil, errcode.ErrInvalidInput
 	}
 
-	if m.checkContactStatus(pk, bertyprotocol.ContactStateBlocked) {
+	if m.checkContactStatus(pk, bertytypes.ContactStateBlocked) {
 		return nil, errcode.ErrInvalidInput
 	}
 
-	return m.contactAction(ctx, pk, &bertyprotocol.AccountContactBlocked{}, bertyprotocol.EventTypeAccountContactBlocked)
+	return m.contactAction(ctx, pk, &bertytypes.AccountContactBlocked{}, bertytypes.EventTypeAccountContactBlocked)
 }
 
 // EventTypeAccountContactUnblocked indicates the payload includes that the account has unblocked a contact
@@ -590,11 +652,11 @@ func (m *MetadataStoreImpl) ContactUnblock(ctx context.Context, pk crypto.PubKey
 		return nil, errcode.ErrGroupInvalidType
 	}
 
-	if !m.checkContactStatus(pk, bertyprotocol.ContactStateBlocked) {
+	if !m.checkContactStatus(pk, bertytypes.ContactStateBlocked) {
 		return nil, errcode.ErrInvalidInput
 	}
 
-	return m.contactAction(ctx, pk, &bertyprotocol.AccountContactUnblocked{}, bertyprotocol.EventTypeAccountContactUnblocked)
+	return m.contactAction(ctx, pk, &bertytypes.AccountContactUnblocked{}, bertytypes.EventTypeAccountContactUnblocked)
 }
 
 func (m *MetadataStoreImpl) ContactSendAliasKey(ctx context.Context) (operation.Operation, error) {
@@ -612,9 +674,9 @@ func (m *MetadataStoreImpl) ContactSendAliasKey(ctx context.Context) (operation.
 		return nil, errcode.ErrInternal.Wrap(err)
 	}
 
-	return m.attributeSignAndAddEvent(ctx, &bertyprotocol.ContactAddAliasKey{
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.ContactAddAliasKey{
 		AliasPK: alias,
-	}, bertyprotocol.EventTypeContactAliasKeyAdded)
+	}, bertytypes.EventTypeContactAliasKeyAdded)
 
 }
 
@@ -626,10 +688,277 @@ func (m *MetadataStoreImpl) SendAliasProof(ctx context.Context) (operation.Opera
 	resolver := []byte(nil) // TODO: should be a hmac value of something for quicker searches
 	proof := []byte(nil)    // TODO: should be a signed value of something
 
-	return m.attributeSignAndAddEvent(ctx, &bertyprotocol.MultiMemberGroupAddAliasResolver{
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.MultiMemberGroupAddAliasResolver{
 		AliasResolver: resolver,
 		AliasProof:    proof,
-	}, bertyprotocol.EventTypeMultiMemberGroupAliasResolverAdded)
+	}, bertytypes.EventTypeMultiMemberGroupAliasResolverAdded)
+}
+
+// SendAppMetadata appends an app defined event signed by the current device,
+// it is only returned to the other members once its sender is known
+func (m *MetadataStoreImpl) SendAppMetadata(ctx context.Context, message []byte) (operation.Operation, error) {
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.AppMetadata{
+		Message: message,
+	}, bertytypes.EventTypeGroupMetadataPayloadSent)
+}
+
+func (m *MetadataStoreImpl) InvitationCreate(expiresAt time.Time, maxUses uint32) (*bertytypes.GroupInvitation, error) {
+	if !m.typeChecker(isMultiMemberGroup) {
+		return nil, errcode.ErrGroupInvalidType
+	}
+
+	md, err := m.acc.MemberDeviceForGroup(m.g)
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	if !m.Index().(*metadataStoreIndex).isAdmin(md.Member.GetPublic()) {
+		return nil, errcode.ErrNotAuthorized
+	}
+
+	return bertytypes.NewGroupInvitation(m.g, md.Member, expiresAt, maxUses)
+}
+
+func (m *MetadataStoreImpl) InvitationCheck(invitation *bertytypes.GroupInvitation, memberPK crypto.PubKey) error {
+	if !m.typeChecker(isMultiMemberGroup) {
+		return errcode.ErrGroupInvalidType
+	}
+
+	if invitation == nil || memberPK == nil {
+		return errcode.ErrMissingInput
+	}
+
+	return m.Index().(*metadataStoreIndex).CheckInvitation(invitation, memberPK, time.Now())
+}
+
+func (m *MetadataStoreImpl) InvitationUse(ctx context.Context, invitation *bertytypes.GroupInvitation, memberPK crypto.PubKey) (operation.Operation, error) {
+	if err := m.InvitationCheck(invitation, memberPK); err != nil {
+		return nil, err
+	}
+
+	md, err := m.acc.MemberDeviceForGroup(m.g)
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	if !m.Index().(*metadataStoreIndex).isAdmin(md.Member.GetPublic()) {
+		return nil, errcode.ErrNotAuthorized
+	}
+
+	member, err := memberPK.Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	// the private key of the invitation is never added to the group
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.MultiMemberInvitationUsed{
+		Invitation: invitation.Public(),
+		MemberPK:   member,
+	}, bertytypes.EventTypeMultiMemberGroupInvitationUsed)
+}
+
+func (m *MetadataStoreImpl) InvitationRevoke(ctx context.Context, id []byte) (operation.Operation, error) {
+	if !m.typeChecker(isMultiMemberGroup) {
+		return nil, errcode.ErrGroupInvalidType
+	}
+
+	if len(id) == 0 {
+		return nil, errcode.ErrMissingInput
+	}
+
+	md, err := m.acc.MemberDeviceForGroup(m.g)
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	if !m.Index().(*metadataStoreIndex).isAdmin(md.Member.GetPublic()) {
+		return nil, errcode.ErrNotAuthorized
+	}
+
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.MultiMemberInvitationRevoked{
+		InvitationID: id,
+	}, bertytypes.EventTypeMultiMemberGroupInvitationRevoked)
+}
+
+func (m *MetadataStoreImpl) AdminRoleGrant(ctx context.Context, memberPK crypto.PubKey) (operation.Operation, error) {
+	pk, err := m.checkAdminActionOnMember(memberPK)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.MultiMemberGrantAdminRole{
+		GranteeMemberPK: pk,
+	}, bertytypes.EventTypeMultiMemberGroupAdminRoleGranted)
+}
+
+func (m *MetadataStoreImpl) AdminRoleRevoke(ctx context.Context, memberPK crypto.PubKey) (operation.Operation, error) {
+	pk, err := m.checkAdminActionOnMember(memberPK)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := m.Index().(*metadataStoreIndex)
+	if !idx.isAdmin(memberPK) {
+		return nil, errcode.ErrInvalidInput
+	}
+
+	if len(idx.ListAdmins()) == 1 {
+		return nil, errcode.ErrGroupLastAdmin
+	}
+
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.MultiMemberRevokeAdminRole{
+		RevokedMemberPK: pk,
+	}, bertytypes.EventTypeMultiMemberGroupAdminRoleRevoked)
+}
+
+func (m *MetadataStoreImpl) MemberRemove(ctx context.Context, memberPK crypto.PubKey) (operation.Operation, error) {
+	pk, err := m.checkAdminActionOnMember(memberPK)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := m.Index().(*metadataStoreIndex)
+	if idx.isAdmin(memberPK) && len(idx.ListAdmins()) == 1 {
+		return nil, errcode.ErrGroupLastAdmin
+	}
+
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.MultiMemberRemoveMember{
+		MemberPK: pk,
+	}, bertytypes.EventTypeMultiMemberGroupMemberRemoved)
+}
+
+func (m *MetadataStoreImpl) DeviceRevoke(ctx context.Context, devicePK crypto.PubKey) (operation.Operation, error) {
+	if devicePK == nil {
+		return nil, errcode.ErrMissingInput
+	}
+
+	md, err := m.acc.MemberDeviceForGroup(m.g)
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	if md.Device.GetPublic().Equals(devicePK) {
+		return nil, errcode.ErrInvalidInput
+	}
+
+	idx := m.Index().(*metadataStoreIndex)
+	if idx.IsDeviceRevoked(devicePK) {
+		return nil, errcode.ErrGroupMemberDeviceRevoked
+	}
+
+	memberPK, err := idx.GetMemberByDevice(devicePK)
+	if err != nil {
+		return nil, errcode.ErrInvalidInput.Wrap(err)
+	}
+
+	if !memberPK.Equals(md.Member.GetPublic()) {
+		return nil, errcode.ErrNotAuthorized
+	}
+
+	pk, err := devicePK.Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.GroupRevokeMemberDevice{
+		RevokedDevicePK: pk,
+	}, bertytypes.EventTypeGroupMemberDeviceRevoked)
+}
+
+// SigChainSend sends the sig chain of the account to its other devices
+func (m *MetadataStoreImpl) SigChainSend(ctx context.Context, chain *account.SigChain) (operation.Operation, error) {
+	if !m.typeChecker(isAccountGroup) {
+		return nil, errcode.ErrGroupInvalidType
+	}
+
+	data, err := chain.Marshal()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.AccountSigChainUpdated{
+		SigChain: data,
+	}, bertytypes.EventTypeAccountSigChainUpdated)
+}
+
+func (m *MetadataStoreImpl) GetSigChain() *account.SigChain {
+	return m.Index().(*metadataStoreIndex).getSigChain()
+}
+
+// GroupDeviceAnnounce sends the key used by the current device in a
+// multi-member group, the other devices of the account can't derive it
+func (m *MetadataStoreImpl) GroupDeviceAnnounce(ctx context.Context, g *bertytypes.Group) (operation.Operation, error) {
+	if !m.typeChecker(isAccountGroup) || g.GroupType != bertytypes.GroupTypeMultiMember {
+		return nil, errcode.ErrGroupInvalidType
+	}
+
+	md, err := m.acc.MemberDeviceForGroup(g)
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	groupDevicePK, err := md.Device.GetPublic().Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	return m.attributeSignAndAddEvent(ctx, &bertytypes.AccountGroupDeviceAnnounced{
+		GroupPK:       g.PublicKey,
+		GroupDevicePK: groupDevicePK,
+	}, bertytypes.EventTypeAccountGroupDeviceAnnounced)
+}
+
+func (m *MetadataStoreImpl) GetGroupDevice(groupPK []byte, devicePK crypto.PubKey) (crypto.PubKey, error) {
+	return m.Index().(*metadataStoreIndex).GetGroupDevice(groupPK, devicePK)
+}
+
+func (m *MetadataStoreImpl) KeyGeneration() uint64 {
+	return m.Index().(*metadataStoreIndex).KeyGeneration()
+}
+
+func (m *MetadataStoreImpl) IsMemberRemoved(pk crypto.PubKey) bool {
+	return m.Index().(*metadataStoreIndex).IsMemberRemoved(pk)
+}
+
+func (m *MetadataStoreImpl) IsMemberAdmitted(pk crypto.PubKey) bool {
+	return m.Index().(*metadataStoreIndex).IsMemberAdmitted(pk)
+}
+
+func (m *MetadataStoreImpl) IsDeviceRevoked(pk crypto.PubKey) bool {
+	return m.Index().(*metadataStoreIndex).IsDeviceRevoked(pk)
+}
+
+// checkAdminActionOnMember ensures the current member is an admin of the
+// group and the targeted member has not been removed from it
+func (m *MetadataStoreImpl) checkAdminActionOnMember(memberPK crypto.PubKey) ([]byte, error) {
+	if !m.typeChecker(isMultiMemberGroup) {
+		return nil, errcode.ErrGroupInvalidType
+	}
+
+	if memberPK == nil {
+		return nil, errcode.ErrMissingInput
+	}
+
+	md, err := m.acc.MemberDeviceForGroup(m.g)
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	idx := m.Index().(*metadataStoreIndex)
+	if !idx.isAdmin(md.Member.GetPublic()) {
+		return nil, errcode.ErrNotAuthorized
+	}
+
+	if idx.IsMemberRemoved(memberPK) {
+		return nil, errcode.ErrGroupMemberRemoved
+	}
+
+	pk, err := memberPK.Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	return pk, nil
 }
 
 type accountSignableEvent interface {
@@ -648,7 +977,7 @@ type accountGroupEvent interface {
 	SetGroupPK([]byte)
 }
 
-func (m *MetadataStoreImpl) attributeSignAndAddEvent(ctx context.Context, evt accountSignableEvent, eventType bertyprotocol.EventType) (operation.Operation, error) {
+func (m *MetadataStoreImpl) attributeSignAndAddEvent(ctx context.Context, evt accountSignableEvent, eventType bertytypes.EventType) (operation.Operation, error) {
 	md, err := m.acc.MemberDeviceForGroup(m.g)
 	if err != nil {
 		return nil, errcode.ErrInternal.Wrap(err)
@@ -669,7 +998,7 @@ func (m *MetadataStoreImpl) attributeSignAndAddEvent(ctx context.Context, evt ac
 	return MetadataStoreAddEvent(ctx, m, m.g, eventType, evt, sig)
 }
 
-func (m *MetadataStoreImpl) contactAction(ctx context.Context, pk crypto.PubKey, event accountContactEvent, evtType bertyprotocol.EventType) (operation.Operation, error) {
+func (m *MetadataStoreImpl) contactAction(ctx context.Context, pk crypto.PubKey, event accountContactEvent, evtType bertytypes.EventType) (operation.Operation, error) {
 	if pk == nil || event == nil {
 		return nil, errcode.ErrInvalidInput
 	}
@@ -684,7 +1013,7 @@ func (m *MetadataStoreImpl) contactAction(ctx context.Context, pk crypto.PubKey,
 	return m.attributeSignAndAddEvent(ctx, event, evtType)
 }
 
-func (m *MetadataStoreImpl) groupAction(ctx context.Context, pk crypto.PubKey, event accountGroupEvent, evtType bertyprotocol.EventType) (operation.Operation, error) {
+func (m *MetadataStoreImpl) groupAction(ctx context.Context, pk crypto.PubKey, event accountGroupEvent, evtType bertytypes.EventType) (operation.Operation, error) {
 	pkBytes, err := pk.Raw()
 	if err != nil {
 		return nil, errcode.ErrSerialization.Wrap(err)
@@ -695,7 +1024,7 @@ func (m *MetadataStoreImpl) groupAction(ctx context.Context, pk crypto.PubKey, e
 	return m.attributeSignAndAddEvent(ctx, event, evtType)
 }
 
-func (m *MetadataStoreImpl) checkContactStatus(pk crypto.PubKey, states ...bertyprotocol.ContactState) bool {
+func (m *MetadataStoreImpl) checkContactStatus(pk crypto.PubKey, states ...bertytypes.ContactState) bool {
 	if pk == nil {
 		return false
 	}
@@ -733,12 +1062,14 @@ func ConstructorFactoryGroupMetadata(s *bertyOrbitDB) iface.StoreConstructor {
 			acc: s.account,
 		}
 
-		options.Index = NewMetadataIndex(ctx, store, g, md.Public())
+		options.Index = NewMetadataIndex(ctx, store, g, md.Public(), options.Cache)
 
 		if err := store.InitBaseStore(ctx, ipfs, identity, addr, options); err != nil {
 			return nil, errcode.ErrOrbitDBInit.Wrap(err)
 		}
 
+		s.metadataStores.Store(g.GroupIDAsString(), store)
+
 		return store, nil
 	}
 }
@@ -4,36 +4,69 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
 	ipfslog "berty.tech/go-ipfs-log"
 	"berty.tech/go-orbit-db/events"
 	"berty.tech/go-orbit-db/iface"
 	"berty.tech/go-orbit-db/stores/operation"
 	"github.com/golang/protobuf/proto"
+	"github.com/ipfs/go-cid"
+	datastore "github.com/ipfs/go-datastore"
 	"github.com/libp2p/go-libp2p-core/crypto"
 
 	"berty.tech/berty/go/internal/account"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
+const (
+	// maxPendingAppMetadata is the maximum number of app metadata events
+	// waiting for their sender device to be added to the group
+	maxPendingAppMetadata = 100
+
+	// maxPendingMemberDevices is the maximum number of devices waiting for
+	// their member to be admitted in a multi-member group
+	maxPendingMemberDevices = 100
+
+	// pendingEventMaxAge is the number of entries indexed after a pending
+	// event after which it is dropped
+	pendingEventMaxAge = 1000
+)
+
 type metadataStoreIndex struct {
 	members                  map[string][]*account.MemberDevice
 	devices                  map[string]*account.MemberDevice
 	handledEvents            map[string]struct{}
-	sentSecrets              map[string]struct{}
+	sentSecrets              map[string]uint64
 	admins                   map[crypto.PubKey]struct{}
+	removedMembers           map[string]struct{}
+	revokedDevices           map[string]struct{}
 	contacts                 map[string]*accountContact
 	groups                   map[string]*accountGroup
+	invitations              map[string]*groupInvitation
+	admittedMembers          map[string]struct{}
+	knownDevices             map[string]crypto.PubKey
+	knownAdmins              map[string]struct{}
+	sigChains                []*account.SigChain
+	sigChain                 *account.SigChain
+	groupDevices             map[string]map[string][]byte
 	contactRequestSeed       []byte
 	contactRequestEnabled    *bool
-	eventHandlers            map[bertyprotocol.EventType][]func(event proto.Message) error
+	eventHandlers            map[bertytypes.EventType][]func(event proto.Message) error
 	postIndexActions         []func() error
-	eventsContactAddAliasKey []*bertyprotocol.ContactAddAliasKey
+	eventsContactAddAliasKey []*bertytypes.ContactAddAliasKey
+	pendingAppMetadata       []*pendingMetadataEvent
+	pendingMemberDevices     []*pendingMetadataEvent
 	ownAliasKeySent          bool
 	otherAliasKey            []byte
-	g                        *bertyprotocol.Group
+	g                        *bertytypes.Group
 	ownMemberDevice          *account.MemberDevice
+	indexedCount             int
+	lastIndexedCID           cid.Cid
+	snapshotCount            int
+	snapshotChecked          bool
+	cache                    datastore.Datastore
 	ctx                      context.Context
 	eventEmitter             events.EmitterInterface
 	lock                     sync.RWMutex
@@ -43,20 +76,20 @@ func (m *metadataStoreIndex) Get(key string) interface{} {
 	return nil
 }
 
-func openMetadataEntry(g *bertyprotocol.Group, log ipfslog.Log, e ipfslog.Entry) (*bertyprotocol.GroupMetadataEvent, *bertyprotocol.GroupMetadata, proto.Message, error) {
+func openMetadataEntry(g *bertytypes.Group, log ipfslog.Log, e ipfslog.Entry) (*bertytypes.GroupMetadataEvent, *bertytypes.GroupMetadata, proto.Message, error) {
 	op, err := operation.ParseOperation(e)
 	if err != nil {
 		// TODO: log
 		return nil, nil, nil, err
 	}
 
-	meta, event, err := bertyprotocol.OpenGroupEnvelope(g, op.GetValue())
+	meta, event, err := bertytypes.OpenGroupEnvelope(g, op.GetValue())
 	if err != nil {
 		// TODO: log
 		return nil, nil, nil, err
 	}
 
-	metaEvent, err := bertyprotocol.NewGroupMetadataEventFromEntry(log, e, meta, event, g)
+	metaEvent, err := bertytypes.NewGroupMetadataEventFromEntry(log, e, meta, event, g)
 	if err != nil {
 		// TODO: log
 		return nil, nil, nil, err
@@ -65,70 +98,290 @@ func openMetadataEntry(g *bertyprotocol.Group, log ipfslog.Log, e ipfslog.Entry)
 	return metaEvent, meta, event, nil
 }
 
+// UpdateIndex applies the entries appended to the log since the previous
+// update, entries are applied from the oldest to the newest one. If a merge
+// inserted entries before the ones already indexed the whole log is replayed.
 func (m *metadataStoreIndex) UpdateIndex(log ipfslog.Log, _ []ipfslog.Entry) error {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
 	entries := log.Values().Slice()
 
-	// Resetting state
-	m.members = map[string][]*account.MemberDevice{}
-	m.devices = map[string]*account.MemberDevice{}
-	m.admins = map[crypto.PubKey]struct{}{}
-	m.sentSecrets = map[string]struct{}{}
-	m.handledEvents = map[string]struct{}{}
-	m.contacts = map[string]*accountContact{}
-	m.groups = map[string]*accountGroup{}
+	if !m.snapshotChecked {
+		m.snapshotChecked = true
 
-	for i := len(entries) - 1; i >= 0; i-- {
-		e := entries[i]
+		if err := m.unsafeLoadSnapshot(log, entries); err != nil {
+			// TODO: log
+			m.unsafeResetState()
+		}
+	}
+
+	if !m.unsafeIsIndexedPrefix(entries) {
+		m.unsafeResetState()
+	}
 
+	for i, e := range entries[m.indexedCount:] {
 		metaEvent, meta, event, err := openMetadataEntry(m.g, log, e)
 		if err != nil {
 			// TODO: log
 			continue
 		}
 
-		handlers, ok := m.eventHandlers[meta.EventType]
-		if !ok {
+		// entries appended by a revoked device after its revocation are
+		// ignored
+		if m.unsafeIsSentByRevokedDevice(event) {
 			m.handledEvents[e.GetHash().String()] = struct{}{}
-			// TODO: log
 			continue
 		}
 
-		var lastErr error
+		p := &pendingMetadataEvent{entry: e, metaEvent: metaEvent, event: event, position: m.indexedCount + i}
 
-		for _, h := range handlers {
-			err = h(event)
-			if err != nil {
-				// TODO: log
-				lastErr = err
-			}
+		// the sender of app metadata can only be checked once every device
+		// of the log is known
+		if meta.EventType == bertytypes.EventTypeGroupMetadataPayloadSent {
+			m.pendingAppMetadata = append(m.pendingAppMetadata, p)
+			continue
 		}
 
-		if lastErr != nil {
-			m.handledEvents[e.GetHash().String()] = struct{}{}
+		// the devices of a member are only added once an admin admitted it,
+		// the admission can be replicated after the devices
+		if meta.EventType == bertytypes.EventTypeGroupMemberDeviceAdded && !m.unsafeIsMemberDeviceAdmitted(event) {
+			m.pendingMemberDevices = append(m.pendingMemberDevices, p)
 			continue
 		}
 
-		if _, ok := m.handledEvents[e.GetHash().String()]; !ok {
-			m.eventEmitter.Emit(m.ctx, metaEvent)
+		// the roles and the admissions are applied in the order of the log
+		// so every peer agrees on the members of the group
+		admitted := len(m.admittedMembers)
+
+		m.unsafeApplyEvent(p)
+
+		if len(m.admittedMembers) != admitted {
+			m.unsafeApplyPendingMemberDevices()
 		}
+	}
 
-		m.handledEvents[e.GetHash().String()] = struct{}{}
+	if len(entries) > 0 {
+		m.indexedCount = len(entries)
+		m.lastIndexedCID = entries[len(entries)-1].GetHash()
 	}
 
+	// events from unknown devices are not marked as handled, they are kept
+	// until the device is added or until they are considered stale
+	pendingAppMetadata := []*pendingMetadataEvent(nil)
+
+	for _, p := range m.pendingAppMetadata {
+		if m.unsafeIsSentByRevokedDevice(p.event) {
+			m.handledEvents[p.entry.GetHash().String()] = struct{}{}
+			continue
+		}
+
+		if err := m.unsafeCheckAppMetadataSender(p.event); err != nil {
+			// TODO: log
+			pendingAppMetadata = append(pendingAppMetadata, p)
+			continue
+		}
+
+		if _, ok := m.handledEvents[p.entry.GetHash().String()]; !ok {
+			m.eventEmitter.Emit(m.ctx, p.metaEvent)
+		}
+
+		m.handledEvents[p.entry.GetHash().String()] = struct{}{}
+	}
+
+	m.pendingAppMetadata = m.unsafeDropStalePendingEvents(pendingAppMetadata, maxPendingAppMetadata)
+	m.pendingMemberDevices = m.unsafeDropStalePendingEvents(m.pendingMemberDevices, maxPendingMemberDevices)
+
 	for _, h := range m.postIndexActions {
 		if err := h(); err != nil {
 			return errcode.ErrInternal.Wrap(err)
 		}
 	}
 
+	if m.indexedCount-m.snapshotCount >= metadataIndexSnapshotInterval {
+		if err := m.unsafeSaveSnapshot(); err != nil {
+			return errcode.ErrInternal.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
+// unsafeApplyEvent runs the handlers of an event, it is emitted if they
+// succeeded and if it hasn't been emitted before
+func (m *metadataStoreIndex) unsafeApplyEvent(p *pendingMetadataEvent) {
+	var lastErr error
+
+	for _, h := range m.eventHandlers[p.metaEvent.Metadata.EventType] {
+		if err := h(p.event); err != nil {
+			// TODO: log
+			lastErr = err
+		}
+	}
+
+	if _, ok := m.handledEvents[p.entry.GetHash().String()]; !ok && lastErr == nil {
+		m.eventEmitter.Emit(m.ctx, p.metaEvent)
+	}
+
+	m.handledEvents[p.entry.GetHash().String()] = struct{}{}
+}
+
+// unsafeApplyPendingMemberDevices adds the pending devices whose member has
+// been admitted
+func (m *metadataStoreIndex) unsafeApplyPendingMemberDevices() {
+	pendingMemberDevices := []*pendingMetadataEvent(nil)
+
+	for _, p := range m.pendingMemberDevices {
+		if m.unsafeIsSentByRevokedDevice(p.event) {
+			m.handledEvents[p.entry.GetHash().String()] = struct{}{}
+			continue
+		}
+
+		if !m.unsafeIsMemberDeviceAdmitted(p.event) {
+			pendingMemberDevices = append(pendingMemberDevices, p)
+			continue
+		}
+
+		m.unsafeApplyEvent(p)
+	}
+
+	m.pendingMemberDevices = pendingMemberDevices
+}
+
+// unsafeIsIndexedPrefix returns whether the entries already indexed are still
+// the first entries of the log
+func (m *metadataStoreIndex) unsafeIsIndexedPrefix(entries []ipfslog.Entry) bool {
+	if m.indexedCount == 0 {
+		return true
+	}
+
+	if len(entries) < m.indexedCount {
+		return false
+	}
+
+	return entries[m.indexedCount-1].GetHash().Equals(m.lastIndexedCID)
+}
+
+// unsafeResetState clears the state built from the log so it can be replayed,
+// the events already emitted are kept to avoid emitting them again
+func (m *metadataStoreIndex) unsafeResetState() {
+	m.members = map[string][]*account.MemberDevice{}
+	m.devices = map[string]*account.MemberDevice{}
+	m.admins = map[crypto.PubKey]struct{}{}
+	m.removedMembers = map[string]struct{}{}
+	m.revokedDevices = map[string]struct{}{}
+	m.sentSecrets = map[string]uint64{}
+	m.contacts = map[string]*accountContact{}
+	m.groups = map[string]*accountGroup{}
+	m.invitations = map[string]*groupInvitation{}
+	m.admittedMembers = map[string]struct{}{}
+	m.knownDevices = map[string]crypto.PubKey{}
+	m.knownAdmins = map[string]struct{}{}
+	m.sigChains = nil
+	m.sigChain = nil
+	m.groupDevices = map[string]map[string][]byte{}
+	m.contactRequestSeed = nil
+	m.contactRequestEnabled = nil
+	m.eventsContactAddAliasKey = nil
+	m.ownAliasKeySent = false
+	m.otherAliasKey = nil
+	m.pendingAppMetadata = nil
+	m.pendingMemberDevices = nil
+	m.indexedCount = 0
+	m.lastIndexedCID = cid.Undef
+	m.snapshotCount = 0
+}
+
+type pendingMetadataEvent struct {
+	entry     ipfslog.Entry
+	metaEvent *bertytypes.GroupMetadataEvent
+	event     proto.Message
+	position  int
+}
+
+// unsafeDropStalePendingEvents drops the pending events still waiting after
+// pendingEventMaxAge entries, and the oldest ones when more than maxCount
+// events are waiting. Dropped events are marked as handled and will never be
+// emitted.
+func (m *metadataStoreIndex) unsafeDropStalePendingEvents(pending []*pendingMetadataEvent, maxCount int) []*pendingMetadataEvent {
+	kept := []*pendingMetadataEvent(nil)
+
+	for _, p := range pending {
+		if m.indexedCount-p.position > pendingEventMaxAge {
+			m.handledEvents[p.entry.GetHash().String()] = struct{}{}
+			continue
+		}
+
+		kept = append(kept, p)
+	}
+
+	if len(kept) > maxCount {
+		for _, p := range kept[:len(kept)-maxCount] {
+			m.handledEvents[p.entry.GetHash().String()] = struct{}{}
+		}
+
+		kept = kept[len(kept)-maxCount:]
+	}
+
+	return kept
+}
+
+// unsafeCheckAppMetadataSender ensures an app metadata event has been sent
+// by a device of a current member of the group
+func (m *metadataStoreIndex) unsafeCheckAppMetadataSender(event proto.Message) error {
+	e, ok := event.(*bertytypes.AppMetadata)
+	if !ok {
+		return errcode.ErrInvalidInput
+	}
+
+	if _, ok := m.devices[string(e.DevicePK)]; !ok {
+		return errcode.ErrNotAuthorized
+	}
+
 	return nil
 }
 
+func (m *metadataStoreIndex) checkAppMetadataSender(event proto.Message) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.unsafeCheckAppMetadataSender(event)
+}
+
+// unsafeIsMemberDeviceAdmitted returns whether the member of an added device
+// can join the group, only the members admitted by an admin can join a
+// multi-member group
+func (m *metadataStoreIndex) unsafeIsMemberDeviceAdmitted(event proto.Message) bool {
+	if m.g.GroupType != bertytypes.GroupTypeMultiMember {
+		return true
+	}
+
+	e, ok := event.(*bertytypes.GroupAddMemberDevice)
+	if !ok {
+		return false
+	}
+
+	_, ok = m.admittedMembers[string(e.MemberPK)]
+
+	return ok
+}
+
+// IsMemberAdmitted returns whether a member has been admitted in the group
+func (m *metadataStoreIndex) IsMemberAdmitted(pk crypto.PubKey) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	key, err := pk.Raw()
+	if err != nil {
+		return false
+	}
+
+	_, ok := m.admittedMembers[string(key)]
+	return ok
+}
+
 func (m *metadataStoreIndex) handleGroupAddMemberDevice(event proto.Message) error {
-	e, ok := event.(*bertyprotocol.GroupAddMemberDevice)
+	e, ok := event.(*bertytypes.GroupAddMemberDevice)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
@@ -147,6 +400,14 @@ func (m *metadataStoreIndex) handleGroupAddMemberDevice(event proto.Message) err
 		return nil
 	}
 
+	if _, ok := m.removedMembers[string(e.MemberPK)]; ok {
+		return nil
+	}
+
+	if _, ok := m.revokedDevices[string(e.DevicePK)]; ok {
+		return nil
+	}
+
 	m.devices[string(e.DevicePK)] = &account.MemberDevice{
 		Member: member,
 		Device: device,
@@ -157,11 +418,13 @@ func (m *metadataStoreIndex) handleGroupAddMemberDevice(event proto.Message) err
 		Device: device,
 	})
 
+	m.knownDevices[string(e.DevicePK)] = member
+
 	return nil
 }
 
 func (m *metadataStoreIndex) handleGroupAddDeviceSecret(event proto.Message) error {
-	e, ok := event.(*bertyprotocol.GroupAddDeviceSecret)
+	e, ok := event.(*bertytypes.GroupAddDeviceSecret)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
@@ -177,7 +440,9 @@ func (m *metadataStoreIndex) handleGroupAddDeviceSecret(event proto.Message) err
 	}
 
 	if m.ownMemberDevice.Device.Equals(senderPK) {
-		m.sentSecrets[string(e.DestMemberPK)] = struct{}{}
+		if generation, ok := m.sentSecrets[string(e.DestMemberPK)]; !ok || generation < e.Generation {
+			m.sentSecrets[string(e.DestMemberPK)] = e.Generation
+		}
 	}
 
 	if !destPK.Equals(m.ownMemberDevice.Member) {
@@ -274,7 +539,7 @@ func (m *metadataStoreIndex) ListDevices() []crypto.PubKey {
 	return devices
 }
 
-func (m *metadataStoreIndex) areSecretsAlreadySent(pk crypto.PubKey) (bool, error) {
+func (m *metadataStoreIndex) areSecretsAlreadySent(pk crypto.PubKey, generation uint64) (bool, error) {
 	m.lock.RLock()
 	defer m.lock.RUnlock()
 
@@ -283,8 +548,18 @@ func (m *metadataStoreIndex) areSecretsAlreadySent(pk crypto.PubKey) (bool, erro
 		return false, errcode.ErrInvalidInput.Wrap(err)
 	}
 
-	_, ok := m.sentSecrets[string(key)]
-	return ok, nil
+	sent, ok := m.sentSecrets[string(key)]
+	return ok && sent >= generation, nil
+}
+
+// KeyGeneration returns the generation of the device secrets to be used in
+// the group, it is incremented each time a member is removed or a device is
+// revoked so they can't decrypt the messages sent afterwards
+func (m *metadataStoreIndex) KeyGeneration() uint64 {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return uint64(len(m.removedMembers) + len(m.revokedDevices))
 }
 
 type accountGroupJoinedState uint32
@@ -296,25 +571,21 @@ const (
 
 type accountGroup struct {
 	state accountGroupJoinedState
-	group *bertyprotocol.Group
+	group *bertytypes.Group
 }
 
 type accountContact struct {
-	state   bertyprotocol.ContactState
-	contact *bertyprotocol.ShareableContact
+	state       bertytypes.ContactState
+	contact     *bertytypes.ShareableContact
+	ownMetadata []byte
 }
 
 func (m *metadataStoreIndex) handleGroupJoined(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountGroupJoined)
+	evt, ok := event.(*bertytypes.AccountGroupJoined)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	_, ok = m.groups[string(evt.Group.PublicKey)]
-	if ok {
-		return nil
-	}
-
 	m.groups[string(evt.Group.PublicKey)] = &accountGroup{
 		group: evt.Group,
 		state: accountGroupJoinedStateJoined,
@@ -324,16 +595,11 @@ func (m *metadataStoreIndex) handleGroupJoined(event proto.Message) error {
 }
 
 func (m *metadataStoreIndex) handleGroupLeft(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountGroupLeft)
+	evt, ok := event.(*bertytypes.AccountGroupLeft)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	_, ok = m.groups[string(evt.GroupPK)]
-	if ok {
-		return nil
-	}
-
 	m.groups[string(evt.GroupPK)] = &accountGroup{
 		state: accountGroupJoinedStateLeft,
 	}
@@ -342,11 +608,7 @@ func (m *metadataStoreIndex) handleGroupLeft(event proto.Message) error {
 }
 
 func (m *metadataStoreIndex) handleContactRequestDisabled(event proto.Message) error {
-	if m.contactRequestEnabled != nil {
-		return nil
-	}
-
-	_, ok := event.(*bertyprotocol.AccountContactRequestDisabled)
+	_, ok := event.(*bertytypes.AccountContactRequestDisabled)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
@@ -358,11 +620,7 @@ func (m *metadataStoreIndex) handleContactRequestDisabled(event proto.Message) e
 }
 
 func (m *metadataStoreIndex) handleContactRequestEnabled(event proto.Message) error {
-	if m.contactRequestEnabled != nil {
-		return nil
-	}
-
-	_, ok := event.(*bertyprotocol.AccountContactRequestEnabled)
+	_, ok := event.(*bertytypes.AccountContactRequestEnabled)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
@@ -374,213 +632,671 @@ func (m *metadataStoreIndex) handleContactRequestEnabled(event proto.Message) er
 }
 
 func (m *metadataStoreIndex) handleContactRequestReferenceReset(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountContactRequestReferenceReset)
+	evt, ok := event.(*bertytypes.AccountContactRequestReferenceReset)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	if m.contactRequestSeed != nil {
-		return nil
-	}
-
 	m.contactRequestSeed = evt.RendezvousSeed
 
 	return nil
 }
 
+// unsafeGetOrCreateContact returns the contact for the given public key, it
+// is created if unknown
+func (m *metadataStoreIndex) unsafeGetOrCreateContact(pk []byte) *accountContact {
+	c, ok := m.contacts[string(pk)]
+	if !ok {
+		c = &accountContact{
+			contact: &bertytypes.ShareableContact{
+				PK: pk,
+			},
+		}
+		m.contacts[string(pk)] = c
+	}
+
+	return c
+}
+
 func (m *metadataStoreIndex) handleContactRequestOutgoingEnqueued(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountContactRequestEnqueued)
+	evt, ok := event.(*bertytypes.AccountContactRequestEnqueued)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	if _, ok := m.contacts[string(evt.ContactPK)]; ok {
-		if m.contacts[string(evt.ContactPK)].contact.Metadata == nil {
-			m.contacts[string(evt.ContactPK)].contact.Metadata = evt.ContactMetadata
-		}
+	c := m.unsafeGetOrCreateContact(evt.ContactPK)
+	c.state = bertytypes.ContactStateToRequest
 
-		if m.contacts[string(evt.ContactPK)].contact.PublicRendezvousSeed == nil {
-			m.contacts[string(evt.ContactPK)].contact.PublicRendezvousSeed = evt.ContactRendezvousSeed
-		}
+	if evt.ContactMetadata != nil {
+		c.contact.Metadata = evt.ContactMetadata
+	}
 
-		return nil
+	if evt.ContactRendezvousSeed != nil {
+		c.contact.PublicRendezvousSeed = evt.ContactRendezvousSeed
 	}
 
-	m.contacts[string(evt.ContactPK)] = &accountContact{
-		state: bertyprotocol.ContactStateToRequest,
-		contact: &bertyprotocol.ShareableContact{
-			PK:                   evt.ContactPK,
-			Metadata:             evt.ContactMetadata,
-			PublicRendezvousSeed: evt.ContactRendezvousSeed,
-		},
+	if evt.OwnMetadata != nil {
+		c.ownMetadata = evt.OwnMetadata
 	}
 
 	return nil
 }
 
 func (m *metadataStoreIndex) handleContactRequestOutgoingSent(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountContactRequestSent)
+	evt, ok := event.(*bertytypes.AccountContactRequestSent)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	if _, ok := m.contacts[string(evt.ContactPK)]; ok {
-		return nil
+	m.unsafeGetOrCreateContact(evt.ContactPK).state = bertytypes.ContactStateAdded
+
+	return nil
+}
+
+func (m *metadataStoreIndex) handleContactRequestIncomingReceived(event proto.Message) error {
+	evt, ok := event.(*bertytypes.AccountContactRequestReceived)
+	if !ok {
+		return errcode.ErrInvalidInput
+	}
+
+	c := m.unsafeGetOrCreateContact(evt.ContactPK)
+	c.state = bertytypes.ContactStateReceived
+
+	if evt.ContactMetadata != nil {
+		c.contact.Metadata = evt.ContactMetadata
 	}
 
-	m.contacts[string(evt.ContactPK)] = &accountContact{
-		state: bertyprotocol.ContactStateAdded,
-		contact: &bertyprotocol.ShareableContact{
-			PK: evt.ContactPK,
-		},
+	if evt.ContactRendezvousSeed != nil {
+		c.contact.PublicRendezvousSeed = evt.ContactRendezvousSeed
 	}
 
 	return nil
 }
 
-func (m *metadataStoreIndex) handleContactRequestIncomingReceived(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountContactRequestReceived)
+func (m *metadataStoreIndex) handleContactRequestIncomingDiscarded(event proto.Message) error {
+	evt, ok := event.(*bertytypes.AccountContactRequestDiscarded)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	if _, ok := m.contacts[string(evt.ContactPK)]; ok {
-		if m.contacts[string(evt.ContactPK)].contact.Metadata == nil {
-			m.contacts[string(evt.ContactPK)].contact.Metadata = evt.ContactMetadata
+	m.unsafeGetOrCreateContact(evt.ContactPK).state = bertytypes.ContactStateDiscarded
+
+	return nil
+}
+
+func (m *metadataStoreIndex) handleContactRequestIncomingAccepted(event proto.Message) error {
+	evt, ok := event.(*bertytypes.AccountContactRequestAccepted)
+	if !ok {
+		return errcode.ErrInvalidInput
+	}
+
+	m.unsafeGetOrCreateContact(evt.ContactPK).state = bertytypes.ContactStateAdded
+
+	return nil
+}
+
+func (m *metadataStoreIndex) handleContactBlocked(event proto.Message) error {
+	evt, ok := event.(*bertytypes.AccountContactBlocked)
+	if !ok {
+		return errcode.ErrInvalidInput
+	}
+
+	m.unsafeGetOrCreateContact(evt.ContactPK).state = bertytypes.ContactStateBlocked
+
+	return nil
+}
+
+func (m *metadataStoreIndex) handleContactUnblocked(event proto.Message) error {
+	evt, ok := event.(*bertytypes.AccountContactUnblocked)
+	if !ok {
+		return errcode.ErrInvalidInput
+	}
+
+	m.unsafeGetOrCreateContact(evt.ContactPK).state = bertytypes.ContactStateRemoved
+
+	return nil
+}
+
+func (m *metadataStoreIndex) handleSigChainUpdated(event proto.Message) error {
+	evt, ok := event.(*bertytypes.AccountSigChainUpdated)
+	if !ok {
+		return errcode.ErrInvalidInput
+	}
+
+	chain := &account.SigChain{}
+	if err := chain.Unmarshal(evt.SigChain); err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	return m.unsafeAddSigChain(chain)
+}
+
+// unsafeAddSigChain merges a chain sent by a device of the account with the
+// previous ones, only the chains which aren't part of another one are kept
+func (m *metadataStoreIndex) unsafeAddSigChain(chain *account.SigChain) error {
+	for _, c := range m.sigChains {
+		if chain.IsPrefixOf(c) {
+			return nil
 		}
+	}
 
-		if m.contacts[string(evt.ContactPK)].contact.PublicRendezvousSeed == nil {
-			m.contacts[string(evt.ContactPK)].contact.PublicRendezvousSeed = evt.ContactRendezvousSeed
+	chains := []*account.SigChain{chain}
+	for _, c := range m.sigChains {
+		if !c.IsPrefixOf(chain) {
+			chains = append(chains, c)
 		}
+	}
 
-		return nil
+	merged, err := account.MergeSigChains(chains...)
+	if err != nil {
+		return err
+	}
+
+	m.sigChains = chains
+	m.sigChain = merged
+
+	return nil
+}
+
+func (m *metadataStoreIndex) handleGroupDeviceAnnounced(event proto.Message) error {
+	evt, ok := event.(*bertytypes.AccountGroupDeviceAnnounced)
+	if !ok {
+		return errcode.ErrInvalidInput
 	}
 
-	m.contacts[string(evt.ContactPK)] = &accountContact{
-		state: bertyprotocol.ContactStateReceived,
-		contact: &bertyprotocol.ShareableContact{
-			PK:                   evt.ContactPK,
-			Metadata:             evt.ContactMetadata,
-			PublicRendezvousSeed: evt.ContactRendezvousSeed,
-		},
+	if _, err := crypto.UnmarshalEd25519PublicKey(evt.GroupDevicePK); err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
 	}
 
+	m.unsafeAddGroupDevice(evt.GroupPK, evt.DevicePK, evt.GroupDevicePK)
+
 	return nil
 }
 
-func (m *metadataStoreIndex) handleContactRequestIncomingDiscarded(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountContactRequestDiscarded)
+// unsafeAddGroupDevice records the key used in a multi-member group by a
+// device of the account
+func (m *metadataStoreIndex) unsafeAddGroupDevice(groupPK, devicePK, groupDevicePK []byte) {
+	devices, ok := m.groupDevices[string(groupPK)]
+	if !ok {
+		devices = map[string][]byte{}
+		m.groupDevices[string(groupPK)] = devices
+	}
+
+	devices[string(devicePK)] = groupDevicePK
+}
+
+func (m *metadataStoreIndex) handleContactAliasKeyAdded(event proto.Message) error {
+	evt, ok := event.(*bertytypes.ContactAddAliasKey)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	if _, ok := m.contacts[string(evt.ContactPK)]; ok {
-		return nil
+	m.eventsContactAddAliasKey = append(m.eventsContactAddAliasKey, evt)
+
+	return nil
+}
+
+func (m *metadataStoreIndex) handleMultiMemberInitialMember(event proto.Message) error {
+	e, ok := event.(*bertytypes.MultiMemberInitialMember)
+	if !ok {
+		return errcode.ErrInvalidInput
 	}
 
-	m.contacts[string(evt.ContactPK)] = &accountContact{
-		state: bertyprotocol.ContactStateDiscarded,
-		contact: &bertyprotocol.ShareableContact{
-			PK: evt.ContactPK,
-		},
+	pk, err := crypto.UnmarshalEd25519PublicKey(e.MemberPK)
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
 	}
 
+	// the group secret is known by every member, only the first claim is
+	// accepted
+	if len(m.admins) > 0 {
+		return errcode.ErrNotAuthorized
+	}
+
+	m.admins[pk] = struct{}{}
+	m.knownAdmins[string(e.MemberPK)] = struct{}{}
+	m.admittedMembers[string(e.MemberPK)] = struct{}{}
+
 	return nil
 }
 
-func (m *metadataStoreIndex) handleContactRequestIncomingAccepted(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountContactRequestAccepted)
+func (m *metadataStoreIndex) handleMultiMemberGrantAdminRole(event proto.Message) error {
+	e, ok := event.(*bertytypes.MultiMemberGrantAdminRole)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	if _, ok := m.contacts[string(evt.ContactPK)]; ok {
-		return nil
+	if !m.unsafeIsAdminDevice(string(e.DevicePK)) {
+		return errcode.ErrNotAuthorized
+	}
+
+	pk, err := crypto.UnmarshalEd25519PublicKey(e.GranteeMemberPK)
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
 	}
 
-	m.contacts[string(evt.ContactPK)] = &accountContact{
-		state: bertyprotocol.ContactStateAdded,
-		contact: &bertyprotocol.ShareableContact{
-			PK: evt.ContactPK,
-		},
+	if _, ok := m.removedMembers[string(e.GranteeMemberPK)]; ok {
+		return errcode.ErrInvalidInput
 	}
 
+	m.admittedMembers[string(e.GranteeMemberPK)] = struct{}{}
+	m.knownAdmins[string(e.GranteeMemberPK)] = struct{}{}
+
+	if m.unsafeIsAdmin(pk) {
+		return nil
+	}
+
+	m.admins[pk] = struct{}{}
+
 	return nil
 }
 
-func (m *metadataStoreIndex) handleContactBlocked(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountContactBlocked)
+func (m *metadataStoreIndex) handleMultiMemberRevokeAdminRole(event proto.Message) error {
+	e, ok := event.(*bertytypes.MultiMemberRevokeAdminRole)
+	if !ok {
+		return errcode.ErrInvalidInput
+	}
+
+	if !m.unsafeIsAdminDevice(string(e.DevicePK)) {
+		return errcode.ErrNotAuthorized
+	}
+
+	pk, err := crypto.UnmarshalEd25519PublicKey(e.RevokedMemberPK)
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	return m.unsafeRemoveAdmin(pk)
+}
+
+func (m *metadataStoreIndex) handleMultiMemberRemoveMember(event proto.Message) error {
+	e, ok := event.(*bertytypes.MultiMemberRemoveMember)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	if _, ok := m.contacts[string(evt.ContactPK)]; ok {
+	if !m.unsafeIsAdminDevice(string(e.DevicePK)) {
+		return errcode.ErrNotAuthorized
+	}
+
+	pk, err := crypto.UnmarshalEd25519PublicKey(e.MemberPK)
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, ok := m.removedMembers[string(e.MemberPK)]; ok {
 		return nil
 	}
 
-	m.contacts[string(evt.ContactPK)] = &accountContact{
-		state: bertyprotocol.ContactStateBlocked,
-		contact: &bertyprotocol.ShareableContact{
-			PK: evt.ContactPK,
-		},
+	if m.unsafeIsAdmin(pk) {
+		if err := m.unsafeRemoveAdmin(pk); err != nil {
+			return err
+		}
 	}
 
+	m.removedMembers[string(e.MemberPK)] = struct{}{}
+	delete(m.admittedMembers, string(e.MemberPK))
+	m.unsafeDropMember(e.MemberPK)
+
 	return nil
 }
 
-func (m *metadataStoreIndex) handleContactUnblocked(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.AccountContactUnblocked)
+// unsafeRemoveAdmin removes the admin role of a member, the last admin of
+// the group can't be removed
+func (m *metadataStoreIndex) unsafeRemoveAdmin(pk crypto.PubKey) error {
+	for admin := range m.admins {
+		if !admin.Equals(pk) {
+			continue
+		}
+
+		if len(m.admins) == 1 {
+			return errcode.ErrGroupLastAdmin
+		}
+
+		delete(m.admins, admin)
+
+		return nil
+	}
+
+	return errcode.ErrInvalidInput
+}
+
+// unsafeDropMember removes the devices of a removed member from the index, a
+// removed member can't be added back to the group
+func (m *metadataStoreIndex) unsafeDropMember(memberPK []byte) {
+	for _, md := range m.members[string(memberPK)] {
+		devicePK, err := md.Device.Raw()
+		if err != nil {
+			continue
+		}
+
+		delete(m.devices, string(devicePK))
+	}
+
+	delete(m.members, string(memberPK))
+}
+
+func (m *metadataStoreIndex) handleGroupRevokeMemberDevice(event proto.Message) error {
+	e, ok := event.(*bertytypes.GroupRevokeMemberDevice)
 	if !ok {
 		return errcode.ErrInvalidInput
 	}
 
-	if _, ok := m.contacts[string(evt.ContactPK)]; ok {
+	if _, err := crypto.UnmarshalEd25519PublicKey(e.RevokedDevicePK); err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, ok := m.revokedDevices[string(e.RevokedDevicePK)]; ok {
 		return nil
 	}
 
-	m.contacts[string(evt.ContactPK)] = &accountContact{
-		state: bertyprotocol.ContactStateRemoved,
-		contact: &bertyprotocol.ShareableContact{
-			PK: evt.ContactPK,
-		},
+	sender, ok := m.devices[string(e.DevicePK)]
+	if !ok {
+		return errcode.ErrNotAuthorized
+	}
+
+	// a member can only revoke its own devices, which must have been added
+	// to the group, otherwise anyone could bump the key generation
+	revoked, ok := m.devices[string(e.RevokedDevicePK)]
+	if !ok || !revoked.Member.Equals(sender.Member) {
+		return errcode.ErrNotAuthorized
 	}
 
+	m.revokedDevices[string(e.RevokedDevicePK)] = struct{}{}
+	m.unsafeDropDevice(e.RevokedDevicePK)
+
 	return nil
 }
 
-func (m *metadataStoreIndex) handleContactAliasKeyAdded(event proto.Message) error {
-	evt, ok := event.(*bertyprotocol.ContactAddAliasKey)
+// unsafeDropDevice removes a device from the index
+func (m *metadataStoreIndex) unsafeDropDevice(devicePK []byte) {
+	md, ok := m.devices[string(devicePK)]
 	if !ok {
+		return
+	}
+
+	delete(m.devices, string(devicePK))
+
+	memberPK, err := md.Member.Raw()
+	if err != nil {
+		return
+	}
+
+	devices := m.members[string(memberPK)][:0]
+
+	for _, d := range m.members[string(memberPK)] {
+		if !d.Device.Equals(md.Device) {
+			devices = append(devices, d)
+		}
+	}
+
+	if len(devices) == 0 {
+		delete(m.members, string(memberPK))
+		return
+	}
+
+	m.members[string(memberPK)] = devices
+}
+
+// unsafeIsSentByRevokedDevice returns whether an event has been signed by a
+// revoked device
+func (m *metadataStoreIndex) unsafeIsSentByRevokedDevice(event proto.Message) bool {
+	e, ok := event.(bertytypes.EventDeviceSigned)
+	if !ok {
+		return false
+	}
+
+	_, ok = m.revokedDevices[string(e.GetDevicePK())]
+
+	return ok
+}
+
+// IsDeviceRevoked returns whether a device has been revoked by its member
+func (m *metadataStoreIndex) IsDeviceRevoked(pk crypto.PubKey) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	key, err := pk.Raw()
+	if err != nil {
+		return false
+	}
+
+	_, ok := m.revokedDevices[string(key)]
+	return ok
+}
+
+// IsMemberRemoved returns whether a member has been removed from the group
+func (m *metadataStoreIndex) IsMemberRemoved(pk crypto.PubKey) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	key, err := pk.Raw()
+	if err != nil {
+		return false
+	}
+
+	_, ok := m.removedMembers[string(key)]
+	return ok
+}
+
+// groupInvitation holds the members admitted using an invitation and the
+// admin devices which revoked it
+type groupInvitation struct {
+	invitation *bertytypes.GroupInvitation
+	usedBy     map[string]struct{}
+	revokedBy  map[string]struct{}
+}
+
+func (m *metadataStoreIndex) getOrCreateInvitation(id []byte) *groupInvitation {
+	inv, ok := m.invitations[string(id)]
+	if !ok {
+		inv = &groupInvitation{
+			usedBy:    map[string]struct{}{},
+			revokedBy: map[string]struct{}{},
+		}
+		m.invitations[string(id)] = inv
+	}
+
+	return inv
+}
+
+// handleMultiMemberInvitationUsed admits a member in the group, the use must
+// have been recorded by an admin device and the invitation must still be
+// valid at this position of the log
+func (m *metadataStoreIndex) handleMultiMemberInvitationUsed(event proto.Message) error {
+	e, ok := event.(*bertytypes.MultiMemberInvitationUsed)
+	if !ok || e.Invitation == nil {
 		return errcode.ErrInvalidInput
 	}
 
-	m.eventsContactAddAliasKey = append(m.eventsContactAddAliasKey, evt)
+	if !m.unsafeIsAdminDevice(string(e.DevicePK)) {
+		return errcode.ErrNotAuthorized
+	}
+
+	if _, err := crypto.UnmarshalEd25519PublicKey(e.MemberPK); err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, ok := m.removedMembers[string(e.MemberPK)]; ok {
+		return errcode.ErrNotAuthorized
+	}
+
+	if err := m.unsafeCheckInvitation(e.Invitation, e.MemberPK); err != nil {
+		return err
+	}
+
+	inv := m.getOrCreateInvitation(e.Invitation.ID)
+	inv.invitation = e.Invitation
+	inv.usedBy[string(e.MemberPK)] = struct{}{}
+	m.admittedMembers[string(e.MemberPK)] = struct{}{}
 
 	return nil
 }
 
-func (m *metadataStoreIndex) handleMultiMemberInitialMember(event proto.Message) error {
-	e, ok := event.(*bertyprotocol.MultiMemberInitialMember)
+func (m *metadataStoreIndex) handleMultiMemberInvitationRevoked(event proto.Message) error {
+	e, ok := event.(*bertytypes.MultiMemberInvitationRevoked)
+	if !ok || len(e.InvitationID) == 0 {
+		return errcode.ErrInvalidInput
+	}
+
+	if !m.unsafeIsAdminDevice(string(e.DevicePK)) {
+		return errcode.ErrNotAuthorized
+	}
+
+	m.getOrCreateInvitation(e.InvitationID).revokedBy[string(e.DevicePK)] = struct{}{}
+
+	return nil
+}
+
+// unsafeIsAdmin returns whether a member is an admin of the group
+func (m *metadataStoreIndex) unsafeIsAdmin(pk crypto.PubKey) bool {
+	for admin := range m.admins {
+		if admin.Equals(pk) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *metadataStoreIndex) isAdmin(pk crypto.PubKey) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.unsafeIsAdmin(pk)
+}
+
+// getKnownMemberByDevice returns the member of a device which has been part
+// of the group at some point of the log, even if it has been revoked or its
+// member removed since
+func (m *metadataStoreIndex) getKnownMemberByDevice(devicePK []byte) (crypto.PubKey, bool) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	memberPK, ok := m.knownDevices[string(devicePK)]
+
+	return memberPK, ok
+}
+
+// wasAdmin returns whether a member has been an admin of the group at some
+// point of the log
+func (m *metadataStoreIndex) wasAdmin(memberPK crypto.PubKey) bool {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	key, err := memberPK.Raw()
+	if err != nil {
+		return false
+	}
+
+	_, ok := m.knownAdmins[string(key)]
+
+	return ok
+}
+
+// getSigChain returns the merge of the sig chains sent by the devices of the
+// account, nil if none has been sent
+func (m *metadataStoreIndex) getSigChain() *account.SigChain {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.sigChain
+}
+
+// GetGroupDevice returns the key used in a multi-member group by a device of
+// the account, as announced by the device in the account group
+func (m *metadataStoreIndex) GetGroupDevice(groupPK []byte, devicePK crypto.PubKey) (crypto.PubKey, error) {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	id, err := devicePK.Raw()
+	if err != nil {
+		return nil, errcode.ErrInvalidInput.Wrap(err)
+	}
+
+	groupDevicePK, ok := m.groupDevices[string(groupPK)][string(id)]
+	if !ok {
+		return nil, errcode.ErrMissingInput
+	}
+
+	pk, err := crypto.UnmarshalEd25519PublicKey(groupDevicePK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	return pk, nil
+}
+
+// unsafeIsAdminDevice returns whether a device belongs to an admin of the
+// group
+func (m *metadataStoreIndex) unsafeIsAdminDevice(devicePK string) bool {
+	md, ok := m.devices[devicePK]
 	if !ok {
+		return false
+	}
+
+	return m.unsafeIsAdmin(md.Member)
+}
+
+// CheckInvitation ensures an invitation has been issued by an admin of the
+// group and can still be used by a member
+func (m *metadataStoreIndex) CheckInvitation(invitation *bertytypes.GroupInvitation, memberPK crypto.PubKey, now time.Time) error {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	member, err := memberPK.Raw()
+	if err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	if _, ok := m.removedMembers[string(member)]; ok {
+		return errcode.ErrNotAuthorized
+	}
+
+	if invitation.IsExpired(now) {
+		return errcode.ErrGroupInvitationExpired
+	}
+
+	return m.unsafeCheckInvitation(invitation, member)
+}
+
+// unsafeCheckInvitation ensures an invitation has been issued by a current
+// admin, has not been revoked and has not been used by too many members, a
+// member who already used it can use it again
+func (m *metadataStoreIndex) unsafeCheckInvitation(invitation *bertytypes.GroupInvitation, memberPK []byte) error {
+	if !invitation.IsForGroup(m.g) {
 		return errcode.ErrInvalidInput
 	}
 
-	pk, err := crypto.UnmarshalEd25519PublicKey(e.MemberPK)
+	if err := invitation.CheckSignature(); err != nil {
+		return err
+	}
+
+	issuer, err := invitation.GetIssuerPubKey()
 	if err != nil {
 		return errcode.ErrDeserialization.Wrap(err)
 	}
 
-	if _, ok := m.admins[pk]; ok {
-		return errcode.ErrInternal
+	if !m.unsafeIsAdmin(issuer) {
+		return errcode.ErrNotAuthorized
 	}
 
-	m.admins[pk] = struct{}{}
+	inv, ok := m.invitations[string(invitation.ID)]
+	if !ok {
+		return nil
+	}
 
-	return nil
-}
+	if len(inv.revokedBy) > 0 {
+		return errcode.ErrGroupInvitationRevoked
+	}
 
-func (m *metadataStoreIndex) handleMultiMemberGrantAdminRole(event proto.Message) error {
-	// TODO:
+	if _, ok := inv.usedBy[string(memberPK)]; ok {
+		return nil
+	}
+
+	if invitation.MaxUses > 0 && uint32(len(inv.usedBy)) >= invitation.MaxUses {
+		return errcode.ErrGroupInvitationExhausted
+	}
 
 	return nil
 }
@@ -661,40 +1377,54 @@ func (m *metadataStoreIndex) postHandlerSentAliases() error {
 }
 
 // NewMetadataStoreIndex returns a new index to manage the list of the group members
-func NewMetadataIndex(ctx context.Context, eventEmitter events.EmitterInterface, g *bertyprotocol.Group, memberDevice *account.MemberDevice) iface.IndexConstructor {
+func NewMetadataIndex(ctx context.Context, eventEmitter events.EmitterInterface, g *bertytypes.Group, memberDevice *account.MemberDevice, cache datastore.Datastore) iface.IndexConstructor {
 	return func(publicKey []byte) iface.StoreIndex {
 		m := &metadataStoreIndex{
 			members:         map[string][]*account.MemberDevice{},
 			devices:         map[string]*account.MemberDevice{},
 			admins:          map[crypto.PubKey]struct{}{},
-			sentSecrets:     map[string]struct{}{},
+			removedMembers:  map[string]struct{}{},
+			revokedDevices:  map[string]struct{}{},
+			sentSecrets:     map[string]uint64{},
 			handledEvents:   map[string]struct{}{},
 			contacts:        map[string]*accountContact{},
 			groups:          map[string]*accountGroup{},
+			invitations:     map[string]*groupInvitation{},
+			admittedMembers: map[string]struct{}{},
+			knownDevices:    map[string]crypto.PubKey{},
+			knownAdmins:     map[string]struct{}{},
 			g:               g,
 			eventEmitter:    eventEmitter,
 			ownMemberDevice: memberDevice,
 			ctx:             ctx,
+			cache:           cache,
 		}
 
-		m.eventHandlers = map[bertyprotocol.EventType][]func(event proto.Message) error{
-			bertyprotocol.EventTypeAccountContactBlocked:                  {m.handleContactBlocked},
-			bertyprotocol.EventTypeAccountContactRequestDisabled:          {m.handleContactRequestDisabled},
-			bertyprotocol.EventTypeAccountContactRequestEnabled:           {m.handleContactRequestEnabled},
-			bertyprotocol.EventTypeAccountContactRequestIncomingAccepted:  {m.handleContactRequestIncomingAccepted},
-			bertyprotocol.EventTypeAccountContactRequestIncomingDiscarded: {m.handleContactRequestIncomingDiscarded},
-			bertyprotocol.EventTypeAccountContactRequestIncomingReceived:  {m.handleContactRequestIncomingReceived},
-			bertyprotocol.EventTypeAccountContactRequestOutgoingEnqueued:  {m.handleContactRequestOutgoingEnqueued},
-			bertyprotocol.EventTypeAccountContactRequestOutgoingSent:      {m.handleContactRequestOutgoingSent},
-			bertyprotocol.EventTypeAccountContactRequestReferenceReset:    {m.handleContactRequestReferenceReset},
-			bertyprotocol.EventTypeAccountContactUnblocked:                {m.handleContactUnblocked},
-			bertyprotocol.EventTypeAccountGroupJoined:                     {m.handleGroupJoined},
-			bertyprotocol.EventTypeAccountGroupLeft:                       {m.handleGroupLeft},
-			bertyprotocol.EventTypeContactAliasKeyAdded:                   {m.handleContactAliasKeyAdded},
-			bertyprotocol.EventTypeGroupDeviceSecretAdded:                 {m.handleGroupAddDeviceSecret},
-			bertyprotocol.EventTypeGroupMemberDeviceAdded:                 {m.handleGroupAddMemberDevice},
-			bertyprotocol.EventTypeMultiMemberGroupAdminRoleGranted:       {m.handleMultiMemberGrantAdminRole},
-			bertyprotocol.EventTypeMultiMemberGroupInitialMemberAnnounced: {m.handleMultiMemberInitialMember},
+		m.eventHandlers = map[bertytypes.EventType][]func(event proto.Message) error{
+			bertytypes.EventTypeAccountContactBlocked:                  {m.handleContactBlocked},
+			bertytypes.EventTypeAccountContactRequestDisabled:          {m.handleContactRequestDisabled},
+			bertytypes.EventTypeAccountContactRequestEnabled:           {m.handleContactRequestEnabled},
+			bertytypes.EventTypeAccountContactRequestIncomingAccepted:  {m.handleContactRequestIncomingAccepted},
+			bertytypes.EventTypeAccountContactRequestIncomingDiscarded: {m.handleContactRequestIncomingDiscarded},
+			bertytypes.EventTypeAccountContactRequestIncomingReceived:  {m.handleContactRequestIncomingReceived},
+			bertytypes.EventTypeAccountContactRequestOutgoingEnqueued:  {m.handleContactRequestOutgoingEnqueued},
+			bertytypes.EventTypeAccountContactRequestOutgoingSent:      {m.handleContactRequestOutgoingSent},
+			bertytypes.EventTypeAccountContactRequestReferenceReset:    {m.handleContactRequestReferenceReset},
+			bertytypes.EventTypeAccountContactUnblocked:                {m.handleContactUnblocked},
+			bertytypes.EventTypeAccountGroupJoined:                     {m.handleGroupJoined},
+			bertytypes.EventTypeAccountGroupLeft:                       {m.handleGroupLeft},
+			bertytypes.EventTypeAccountSigChainUpdated:                 {m.handleSigChainUpdated},
+			bertytypes.EventTypeAccountGroupDeviceAnnounced:            {m.handleGroupDeviceAnnounced},
+			bertytypes.EventTypeContactAliasKeyAdded:                   {m.handleContactAliasKeyAdded},
+			bertytypes.EventTypeGroupDeviceSecretAdded:                 {m.handleGroupAddDeviceSecret},
+			bertytypes.EventTypeGroupMemberDeviceAdded:                 {m.handleGroupAddMemberDevice},
+			bertytypes.EventTypeGroupMemberDeviceRevoked:               {m.handleGroupRevokeMemberDevice},
+			bertytypes.EventTypeMultiMemberGroupAdminRoleGranted:       {m.handleMultiMemberGrantAdminRole},
+			bertytypes.EventTypeMultiMemberGroupAdminRoleRevoked:       {m.handleMultiMemberRevokeAdminRole},
+			bertytypes.EventTypeMultiMemberGroupInitialMemberAnnounced: {m.handleMultiMemberInitialMember},
+			bertytypes.EventTypeMultiMemberGroupInvitationRevoked:      {m.handleMultiMemberInvitationRevoked},
+			bertytypes.EventTypeMultiMemberGroupInvitationUsed:         {m.handleMultiMemberInvitationUsed},
+			bertytypes.EventTypeMultiMemberGroupMemberRemoved:          {m.handleMultiMemberRemoveMember},
 		}
 
 		m.postIndexActions = []func() error{
@@ -0,0 +1,402 @@
+package orbitutil
+
+import (
+	"bytes"
+
+	ipfslog "berty.tech/go-ipfs-log"
+	"github.com/ipfs/go-cid"
+	datastore "github.com/ipfs/go-datastore"
+	"github.com/libp2p/go-libp2p-core/crypto"
+
+	"berty.tech/berty/go/internal/account"
+	"berty.tech/berty/go/pkg/bertytypes"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const (
+	// metadataIndexSnapshotVersion is the version of the snapshot format,
+	// snapshots using another version are ignored
+	metadataIndexSnapshotVersion = 5
+
+	// metadataIndexSnapshotInterval is the number of entries to index before
+	// saving a new snapshot
+	metadataIndexSnapshotInterval = 100
+)
+
+var metadataIndexSnapshotKey = datastore.NewKey("_metadataIndexSnapshot")
+
+// unsafeSaveSnapshot persists the state of the index in the cache of the
+// store, the events waiting for other ones are referenced by their entry
+func (m *metadataStoreIndex) unsafeSaveSnapshot() error {
+	if m.cache == nil || m.indexedCount == 0 {
+		return nil
+	}
+
+	snapshot, err := m.unsafeSnapshot()
+	if err != nil {
+		return err
+	}
+
+	data, err := snapshot.Marshal()
+	if err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	if err := m.cache.Put(metadataIndexSnapshotKey, data); err != nil {
+		return errcode.ErrPersistencePut.Wrap(err)
+	}
+
+	m.snapshotCount = m.indexedCount
+
+	return nil
+}
+
+// unsafeLoadSnapshot restores the state of the index from the snapshot saved
+// in the cache of the store, it is ignored if its last entry doesn't match
+// the log
+func (m *metadataStoreIndex) unsafeLoadSnapshot(log ipfslog.Log, entries []ipfslog.Entry) error {
+	if m.cache == nil {
+		return nil
+	}
+
+	data, err := m.cache.Get(metadataIndexSnapshotKey)
+	if err == datastore.ErrNotFound {
+		return nil
+	} else if err != nil {
+		return errcode.ErrPersistenceGet.Wrap(err)
+	}
+
+	snapshot := &MetadataIndexSnapshot{}
+	if err := snapshot.Unmarshal(data); err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if snapshot.Version != metadataIndexSnapshotVersion || snapshot.IndexedCount == 0 {
+		return nil
+	}
+
+	if uint64(len(entries)) < snapshot.IndexedCount {
+		return nil
+	}
+
+	last := entries[snapshot.IndexedCount-1].GetHash()
+	if !bytes.Equal(last.Bytes(), snapshot.LastEntryCID) {
+		return nil
+	}
+
+	if err := m.unsafeRestoreSnapshot(snapshot, last); err != nil {
+		return err
+	}
+
+	return m.unsafeRestorePendingEvents(log, entries[:snapshot.IndexedCount], snapshot.PendingEntryCIDs)
+}
+
+// unsafeRestorePendingEvents opens again the entries of the events which
+// were waiting for other ones when the snapshot was saved
+func (m *metadataStoreIndex) unsafeRestorePendingEvents(log ipfslog.Log, entries []ipfslog.Entry, pendingCIDs [][]byte) error {
+	if len(pendingCIDs) == 0 {
+		return nil
+	}
+
+	positions := map[string]int{}
+	for i, e := range entries {
+		positions[string(e.GetHash().Bytes())] = i
+	}
+
+	for _, c := range pendingCIDs {
+		position, ok := positions[string(c)]
+		if !ok {
+			return errcode.ErrInvalidInput
+		}
+
+		e := entries[position]
+
+		metaEvent, meta, event, err := openMetadataEntry(m.g, log, e)
+		if err != nil {
+			return err
+		}
+
+		p := &pendingMetadataEvent{entry: e, metaEvent: metaEvent, event: event, position: position}
+
+		switch meta.EventType {
+		case bertytypes.EventTypeGroupMetadataPayloadSent:
+			m.pendingAppMetadata = append(m.pendingAppMetadata, p)
+		case bertytypes.EventTypeGroupMemberDeviceAdded:
+			m.pendingMemberDevices = append(m.pendingMemberDevices, p)
+		default:
+			return errcode.ErrInvalidInput
+		}
+	}
+
+	return nil
+}
+
+func (m *metadataStoreIndex) unsafeSnapshot() (*MetadataIndexSnapshot, error) {
+	snapshot := &MetadataIndexSnapshot{
+		Version:            metadataIndexSnapshotVersion,
+		IndexedCount:       uint64(m.indexedCount),
+		LastEntryCID:       m.lastIndexedCID.Bytes(),
+		ContactRequestSeed: m.contactRequestSeed,
+		OwnAliasKeySent:    m.ownAliasKeySent,
+		OtherAliasKey:      m.otherAliasKey,
+	}
+
+	if m.contactRequestEnabled != nil {
+		snapshot.ContactRequestsState = MetadataIndexSnapshot_Disabled
+		if *m.contactRequestEnabled {
+			snapshot.ContactRequestsState = MetadataIndexSnapshot_Enabled
+		}
+	}
+
+	for _, mds := range m.members {
+		for _, md := range mds {
+			memberPK, err := md.Member.Raw()
+			if err != nil {
+				return nil, errcode.ErrSerialization.Wrap(err)
+			}
+
+			devicePK, err := md.Device.Raw()
+			if err != nil {
+				return nil, errcode.ErrSerialization.Wrap(err)
+			}
+
+			snapshot.Devices = append(snapshot.Devices, &MetadataIndexSnapshot_MemberDevice{
+				MemberPK: memberPK,
+				DevicePK: devicePK,
+			})
+		}
+	}
+
+	for admin := range m.admins {
+		pk, err := admin.Raw()
+		if err != nil {
+			return nil, errcode.ErrSerialization.Wrap(err)
+		}
+
+		snapshot.Admins = append(snapshot.Admins, pk)
+	}
+
+	for pk := range m.removedMembers {
+		snapshot.RemovedMembers = append(snapshot.RemovedMembers, []byte(pk))
+	}
+
+	for pk := range m.revokedDevices {
+		snapshot.RevokedDevices = append(snapshot.RevokedDevices, []byte(pk))
+	}
+
+	for pk := range m.admittedMembers {
+		snapshot.AdmittedMembers = append(snapshot.AdmittedMembers, []byte(pk))
+	}
+
+	for devicePK, member := range m.knownDevices {
+		memberPK, err := member.Raw()
+		if err != nil {
+			return nil, errcode.ErrSerialization.Wrap(err)
+		}
+
+		snapshot.KnownDevices = append(snapshot.KnownDevices, &MetadataIndexSnapshot_MemberDevice{
+			MemberPK: memberPK,
+			DevicePK: []byte(devicePK),
+		})
+	}
+
+	for pk := range m.knownAdmins {
+		snapshot.KnownAdmins = append(snapshot.KnownAdmins, []byte(pk))
+	}
+
+	for _, c := range m.sigChains {
+		data, err := c.Marshal()
+		if err != nil {
+			return nil, errcode.ErrSerialization.Wrap(err)
+		}
+
+		snapshot.SigChains = append(snapshot.SigChains, data)
+	}
+
+	for groupPK, devices := range m.groupDevices {
+		for devicePK, groupDevicePK := range devices {
+			snapshot.GroupDevices = append(snapshot.GroupDevices, &MetadataIndexSnapshot_GroupDevice{
+				GroupPK:       []byte(groupPK),
+				DevicePK:      []byte(devicePK),
+				GroupDevicePK: groupDevicePK,
+			})
+		}
+	}
+
+	for pk, generation := range m.sentSecrets {
+		snapshot.SentSecrets = append(snapshot.SentSecrets, &MetadataIndexSnapshot_SentSecret{
+			MemberPK:   []byte(pk),
+			Generation: generation,
+		})
+	}
+
+	for _, c := range m.contacts {
+		snapshot.Contacts = append(snapshot.Contacts, &MetadataIndexSnapshot_Contact{
+			State:       c.state,
+			Contact:     c.contact,
+			OwnMetadata: c.ownMetadata,
+		})
+	}
+
+	for pk, g := range m.groups {
+		snapshot.Groups = append(snapshot.Groups, &MetadataIndexSnapshot_Group{
+			GroupPK: []byte(pk),
+			State:   uint32(g.state),
+			Group:   g.group,
+		})
+	}
+
+	for _, p := range m.pendingAppMetadata {
+		snapshot.PendingEntryCIDs = append(snapshot.PendingEntryCIDs, p.entry.GetHash().Bytes())
+	}
+
+	for _, p := range m.pendingMemberDevices {
+		snapshot.PendingEntryCIDs = append(snapshot.PendingEntryCIDs, p.entry.GetHash().Bytes())
+	}
+
+	for id, inv := range m.invitations {
+		i := &MetadataIndexSnapshot_Invitation{
+			ID:         []byte(id),
+			Invitation: inv.invitation,
+		}
+
+		for memberPK := range inv.usedBy {
+			i.UsedBy = append(i.UsedBy, []byte(memberPK))
+		}
+
+		for devicePK := range inv.revokedBy {
+			i.RevokedBy = append(i.RevokedBy, []byte(devicePK))
+		}
+
+		snapshot.Invitations = append(snapshot.Invitations, i)
+	}
+
+	return snapshot, nil
+}
+
+func (m *metadataStoreIndex) unsafeRestoreSnapshot(snapshot *MetadataIndexSnapshot, last cid.Cid) error {
+	m.unsafeResetState()
+
+	for _, d := range snapshot.Devices {
+		member, err := crypto.UnmarshalEd25519PublicKey(d.MemberPK)
+		if err != nil {
+			return errcode.ErrDeserialization.Wrap(err)
+		}
+
+		device, err := crypto.UnmarshalEd25519PublicKey(d.DevicePK)
+		if err != nil {
+			return errcode.ErrDeserialization.Wrap(err)
+		}
+
+		md := &account.MemberDevice{
+			Member: member,
+			Device: device,
+		}
+
+		m.devices[string(d.DevicePK)] = md
+		m.members[string(d.MemberPK)] = append(m.members[string(d.MemberPK)], md)
+	}
+
+	for _, a := range snapshot.Admins {
+		pk, err := crypto.UnmarshalEd25519PublicKey(a)
+		if err != nil {
+			return errcode.ErrDeserialization.Wrap(err)
+		}
+
+		m.admins[pk] = struct{}{}
+	}
+
+	for _, pk := range snapshot.RemovedMembers {
+		m.removedMembers[string(pk)] = struct{}{}
+	}
+
+	for _, pk := range snapshot.RevokedDevices {
+		m.revokedDevices[string(pk)] = struct{}{}
+	}
+
+	for _, pk := range snapshot.AdmittedMembers {
+		m.admittedMembers[string(pk)] = struct{}{}
+	}
+
+	for _, d := range snapshot.KnownDevices {
+		member, err := crypto.UnmarshalEd25519PublicKey(d.MemberPK)
+		if err != nil {
+			return errcode.ErrDeserialization.Wrap(err)
+		}
+
+		m.knownDevices[string(d.DevicePK)] = member
+	}
+
+	for _, pk := range snapshot.KnownAdmins {
+		m.knownAdmins[string(pk)] = struct{}{}
+	}
+
+	for _, data := range snapshot.SigChains {
+		c := &account.SigChain{}
+		if err := c.Unmarshal(data); err != nil {
+			return errcode.ErrDeserialization.Wrap(err)
+		}
+
+		if err := m.unsafeAddSigChain(c); err != nil {
+			return err
+		}
+	}
+
+	for _, d := range snapshot.GroupDevices {
+		m.unsafeAddGroupDevice(d.GroupPK, d.DevicePK, d.GroupDevicePK)
+	}
+
+	for _, s := range snapshot.SentSecrets {
+		m.sentSecrets[string(s.MemberPK)] = s.Generation
+	}
+
+	for _, c := range snapshot.Contacts {
+		if c.Contact == nil {
+			return errcode.ErrInvalidInput
+		}
+
+		m.contacts[string(c.Contact.PK)] = &accountContact{
+			state:       c.State,
+			contact:     c.Contact,
+			ownMetadata: c.OwnMetadata,
+		}
+	}
+
+	for _, g := range snapshot.Groups {
+		m.groups[string(g.GroupPK)] = &accountGroup{
+			state: accountGroupJoinedState(g.State),
+			group: g.Group,
+		}
+	}
+
+	for _, i := range snapshot.Invitations {
+		inv := m.getOrCreateInvitation(i.ID)
+		inv.invitation = i.Invitation
+
+		for _, memberPK := range i.UsedBy {
+			inv.usedBy[string(memberPK)] = struct{}{}
+		}
+
+		for _, devicePK := range i.RevokedBy {
+			inv.revokedBy[string(devicePK)] = struct{}{}
+		}
+	}
+
+	switch snapshot.ContactRequestsState {
+	case MetadataIndexSnapshot_Enabled:
+		t := true
+		m.contactRequestEnabled = &t
+	case MetadataIndexSnapshot_Disabled:
+		f := false
+		m.contactRequestEnabled = &f
+	}
+
+	m.contactRequestSeed = snapshot.ContactRequestSeed
+	m.ownAliasKeySent = snapshot.OwnAliasKeySent
+	m.otherAliasKey = snapshot.OtherAliasKey
+	m.indexedCount = int(snapshot.IndexedCount)
+	m.lastIndexedCID = last
+	m.snapshotCount = m.indexedCount
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package orbitutil
+
+import (
+	"context"
+	crand "crypto/rand"
+	"fmt"
+	"testing"
+	"time"
+
+	orbitdb "berty.tech/go-orbit-db"
+	"berty.tech/go-orbit-db/events"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/ipfs/go-ipfs/keystore"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/internal/account"
+	"berty.tech/berty/go/internal/bertycrypto"
+	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/pkg/bertytypes"
+)
+
+func TestMetadataIndexSnapshot(t *testing.T) {
+	ctx := context.Background()
+
+	g, _, err := bertytypes.NewGroupMultiMember()
+	require.NoError(t, err)
+
+	_, memberPK, err := crypto.GenerateEd25519Key(crand.Reader)
+	require.NoError(t, err)
+
+	_, devicePK, err := crypto.GenerateEd25519Key(crand.Reader)
+	require.NoError(t, err)
+
+	_, contactPK, err := crypto.GenerateEd25519Key(crand.Reader)
+	require.NoError(t, err)
+
+	memberPKBytes, err := memberPK.Raw()
+	require.NoError(t, err)
+
+	devicePKBytes, err := devicePK.Raw()
+	require.NoError(t, err)
+
+	contactPKBytes, err := contactPK.Raw()
+	require.NoError(t, err)
+
+	mh, err := multihash.Sum([]byte("last entry"), multihash.SHA2_256, -1)
+	require.NoError(t, err)
+
+	lastCID := cid.NewCidV1(cid.DagCBOR, mh)
+
+	cache := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	md := &account.MemberDevice{Member: memberPK, Device: devicePK}
+
+	newIndex := func() *metadataStoreIndex {
+		return NewMetadataIndex(ctx, &events.EventEmitter{}, g, md, cache)(nil).(*metadataStoreIndex)
+	}
+
+	idx := newIndex()
+
+	require.NoError(t, idx.handleGroupAddMemberDevice(&bertytypes.GroupAddMemberDevice{MemberPK: memberPKBytes, DevicePK: devicePKBytes}))
+	require.NoError(t, idx.handleMultiMemberInitialMember(&bertytypes.MultiMemberInitialMember{MemberPK: memberPKBytes}))
+	require.NoError(t, idx.handleContactRequestEnabled(&bertytypes.AccountContactRequestEnabled{}))
+	require.NoError(t, idx.handleContactRequestOutgoingEnqueued(&bertytypes.AccountContactRequestEnqueued{
+		ContactPK:       contactPKBytes,
+		ContactMetadata: []byte("metadata"),
+	}))
+
+	acc := account.New(keystore.NewMemKeystore())
+	sigChain, err := acc.SigChain()
+	require.NoError(t, err)
+
+	require.NoError(t, idx.unsafeAddSigChain(sigChain))
+
+	idx.unsafeAddGroupDevice(g.PublicKey, devicePKBytes, memberPKBytes)
+
+	idx.indexedCount = 4
+	idx.lastIndexedCID = lastCID
+
+	require.NoError(t, idx.unsafeSaveSnapshot())
+	require.Equal(t, 4, idx.snapshotCount)
+
+	data, err := cache.Get(metadataIndexSnapshotKey)
+	require.NoError(t, err)
+
+	snapshot := &MetadataIndexSnapshot{}
+	require.NoError(t, snapshot.Unmarshal(data))
+	require.Equal(t, lastCID.Bytes(), snapshot.LastEntryCID)
+
+	restored := newIndex()
+	require.NoError(t, restored.unsafeRestoreSnapshot(snapshot, lastCID))
+
+	require.Equal(t, 4, restored.indexedCount)
+	require.Len(t, restored.ListDevices(), 1)
+	require.Len(t, restored.ListMembers(), 1)
+	require.True(t, restored.isAdmin(memberPK))
+	require.True(t, restored.IsMemberAdmitted(memberPK))
+	require.True(t, restored.wasAdmin(memberPK))
+
+	knownMemberPK, ok := restored.getKnownMemberByDevice(devicePKBytes)
+	require.True(t, ok)
+	require.True(t, knownMemberPK.Equals(memberPK))
+	require.True(t, restored.ContactRequestsEnabled())
+	require.Equal(t, sigChain.Entries, restored.getSigChain().Entries)
+
+	groupDevicePK, err := restored.GetGroupDevice(g.PublicKey, devicePK)
+	require.NoError(t, err)
+	require.True(t, groupDevicePK.Equals(memberPK))
+
+	contact, err := restored.GetContact(contactPK)
+	require.NoError(t, err)
+	require.Equal(t, bertytypes.ContactStateToRequest, contact.state)
+	require.Equal(t, []byte("metadata"), contact.contact.Metadata)
+
+	// the newest event wins when entries are applied after the snapshot
+	require.NoError(t, restored.handleContactBlocked(&bertytypes.AccountContactBlocked{ContactPK: contactPKBytes}))
+
+	contact, err = restored.GetContact(contactPK)
+	require.NoError(t, err)
+	require.Equal(t, bertytypes.ContactStateBlocked, contact.state)
+	require.Equal(t, []byte("metadata"), contact.contact.Metadata)
+}
+
+func TestMetadataIndexSnapshotReopen(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	api := ipfsutil.TestingCoreAPI(ctx, t)
+	defer api.MockNode().Close()
+
+	ds := ds_sync.MutexWrap(datastore.NewMapDatastore())
+	acc := account.New(keystore.NewMemKeystore())
+	mk := bertycrypto.NewInMemoryMessageKeys()
+
+	g, groupSK, err := bertytypes.NewGroupMultiMember()
+	require.NoError(t, err)
+
+	openGroup := func() (BertyOrbitDB, MetadataStore) {
+		db, err := NewBertyOrbitDB(ctx, api, acc, mk, &orbitdb.NewOrbitDBOptions{Cache: NewOrbitDatastoreCache(ds)})
+		require.NoError(t, err)
+
+		gc, err := db.OpenMultiMemberGroup(ctx, g, nil)
+		require.NoError(t, err)
+
+		return db, gc.MetadataStore()
+	}
+
+	db, ms := openGroup()
+
+	_, err = ms.AddDeviceToGroup(ctx)
+	require.NoError(t, err)
+
+	_, err = ms.ClaimGroupOwnership(ctx, groupSK)
+	require.NoError(t, err)
+
+	// the device of a member which has not been admitted yet is pending
+	otherAcc := account.New(keystore.NewMemKeystore())
+	otherMD, err := otherAcc.MemberDeviceForGroup(g)
+	require.NoError(t, err)
+
+	_, err = MetadataStoreAddDeviceToGroup(ctx, ms, g, otherMD)
+	require.NoError(t, err)
+
+	for i := 0; i < metadataIndexSnapshotInterval+5; i++ {
+		_, err = ms.SendAppMetadata(ctx, []byte(fmt.Sprintf("message%d", i)))
+		require.NoError(t, err)
+	}
+
+	idx := ms.Index().(*metadataStoreIndex)
+	idx.lock.RLock()
+	snapshotCount, indexedCount := idx.snapshotCount, idx.indexedCount
+	require.Len(t, idx.pendingMemberDevices, 1)
+	idx.lock.RUnlock()
+
+	require.NotZero(t, snapshotCount)
+	require.Greater(t, indexedCount, snapshotCount)
+
+	require.NoError(t, db.Close())
+
+	db, ms = openGroup()
+	defer db.Close()
+
+	// the state is restored from the snapshot, only the entries appended
+	// after it are applied
+	idx = ms.Index().(*metadataStoreIndex)
+	idx.lock.RLock()
+	require.Equal(t, snapshotCount, idx.snapshotCount)
+	require.Equal(t, indexedCount, idx.indexedCount)
+	require.Len(t, idx.pendingMemberDevices, 1)
+	idx.lock.RUnlock()
+
+	require.Len(t, ms.ListDevices(), 1)
+	require.Len(t, ms.ListAdmins(), 1)
+
+	// the pending device is added once its member is admitted
+	invitation, err := ms.InvitationCreate(time.Time{}, 0)
+	require.NoError(t, err)
+
+	_, err = ms.InvitationUse(ctx, invitation, otherMD.Member.GetPublic())
+	require.NoError(t, err)
+
+	require.Len(t, ms.ListDevices(), 2)
+
+	member, err := ms.GetMemberByDevice(otherMD.Device.GetPublic())
+	require.NoError(t, err)
+	require.True(t, member.Equals(otherMD.Member.GetPublic()))
+}
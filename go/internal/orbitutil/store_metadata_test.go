@@ -9,13 +9,14 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ipfs/go-cid"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 
 	"berty.tech/berty/go/internal/testutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 )
 
 func TestMetadataStoreSecret_Basic(t *testing.T) {
@@ -38,8 +39,8 @@ func TestMetadataStoreSecret_Basic(t *testing.T) {
 
 	go WatchNewMembersAndSendSecrets(ctx, zap.L(), peers[0].GC)
 	go WatchNewMembersAndSendSecrets(ctx, zap.L(), peers[1].GC)
-	go WaitForBertyEventType(ctx, t, msA, bertyprotocol.EventTypeGroupDeviceSecretAdded, 2, secretsAdded)
-	go WaitForBertyEventType(ctx, t, msB, bertyprotocol.EventTypeGroupDeviceSecretAdded, 2, secretsAdded)
+	go WaitForBertyEventType(ctx, t, msA, bertytypes.EventTypeGroupDeviceSecretAdded, 2, secretsAdded)
+	go WaitForBertyEventType(ctx, t, msB, bertytypes.EventTypeGroupDeviceSecretAdded, 2, secretsAdded)
 	InviteAllPeersToGroup(ctx, t, peers, groupSK)
 
 	devPkA := peers[0].GC.DevicePubKey()
@@ -111,7 +112,7 @@ func testMemberStore(t *testing.T, memberCount, deviceCount int) {
 	done := make(chan struct{})
 
 	for _, peer := range peers {
-		go WaitForBertyEventType(ctx, t, peer.GC.MetadataStore(), bertyprotocol.EventTypeGroupMemberDeviceAdded, len(peers), done)
+		go WaitForBertyEventType(ctx, t, peer.GC.MetadataStore(), bertytypes.EventTypeGroupMemberDeviceAdded, len(peers), done)
 	}
 
 	for i, peer := range peers {
@@ -238,7 +239,7 @@ func TestMetadataContactLifecycle(t *testing.T) {
 		err      error
 		meta     = make([]MetadataStore, peersCount)
 		ownCG    = make([]ContextGroup, peersCount)
-		contacts = make([]*bertyprotocol.ShareableContact, peersCount)
+		contacts = make([]*bertytypes.ShareableContact, peersCount)
 	)
 
 	for i, p := range peers {
@@ -267,41 +268,41 @@ func TestMetadataContactLifecycle(t *testing.T) {
 
 	// Enqueuing outgoing
 
-	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[0])
+	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[0], nil)
 	require.Error(t, err)
 
-	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1])
+	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1], nil)
 	require.NoError(t, err)
 
-	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1])
+	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1], nil)
 	require.NoError(t, err)
 
 	require.Equal(t, len(meta[0].Index().(*metadataStoreIndex).contacts), 1)
 	require.NotNil(t, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)])
-	require.Equal(t, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)].state, bertyprotocol.ContactStateToRequest)
+	require.Equal(t, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)].state, bertytypes.ContactStateToRequest)
 	require.Equal(t, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)].contact.PK, contact2PK)
 	require.Equal(t, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)].contact.PublicRendezvousSeed, contact2RDVS)
 
 	contacts[1].PublicRendezvousSeed = nil
-	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1])
+	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1], nil)
 	require.Error(t, err)
 
 	contacts[1].PublicRendezvousSeed = []byte("too_short")
-	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1])
+	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1], nil)
 	require.Error(t, err)
 
 	contacts[1].PK = nil
 	contacts[1].PublicRendezvousSeed = contact2RDVS
-	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1])
+	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1], nil)
 	require.Error(t, err)
 
 	contacts[1].PK = []byte("invalid")
-	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1])
+	_, err = meta[0].ContactRequestOutgoingEnqueue(ctx, contacts[1], nil)
 	require.Error(t, err)
 
 	require.Equal(t, 1, len(meta[0].Index().(*metadataStoreIndex).contacts))
 	require.NotNil(t, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)])
-	require.Equal(t, bertyprotocol.ContactStateToRequest, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)].state)
+	require.Equal(t, bertytypes.ContactStateToRequest, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)].state)
 	require.Equal(t, contact2PK, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)].contact.PK)
 	require.Equal(t, contact2RDVS, meta[0].Index().(*metadataStoreIndex).contacts[string(contact2PK)].contact.PublicRendezvousSeed)
 
@@ -319,32 +320,32 @@ func TestMetadataContactLifecycle(t *testing.T) {
 	_, err = meta[0].ContactRequestOutgoingSent(ctx, ownCG[0].MemberPubKey())
 	require.Error(t, err)
 
-	meta[0].Index().(*metadataStoreIndex).contacts[string(contacts[1].PK)].state = bertyprotocol.ContactStateAdded
+	meta[0].Index().(*metadataStoreIndex).contacts[string(contacts[1].PK)].state = bertytypes.ContactStateAdded
 	_, err = meta[0].ContactRequestOutgoingSent(ctx, ownCG[1].MemberPubKey())
 	require.Error(t, err)
 
-	meta[0].Index().(*metadataStoreIndex).contacts[string(contacts[1].PK)].state = bertyprotocol.ContactStateToRequest
+	meta[0].Index().(*metadataStoreIndex).contacts[string(contacts[1].PK)].state = bertytypes.ContactStateToRequest
 	_, err = meta[0].ContactRequestOutgoingSent(ctx, ownCG[1].MemberPubKey())
 	require.NoError(t, err)
 
 	require.Equal(t, len(meta[0].Index().(*metadataStoreIndex).contacts), 1)
 	require.NotNil(t, meta[0].Index().(*metadataStoreIndex).contacts[string(contacts[1].PK)])
-	require.Equal(t, bertyprotocol.ContactStateAdded, meta[0].Index().(*metadataStoreIndex).contacts[string(contacts[1].PK)].state)
+	require.Equal(t, bertytypes.ContactStateAdded, meta[0].Index().(*metadataStoreIndex).contacts[string(contacts[1].PK)].state)
 	require.Equal(t, contacts[1].PK, meta[0].Index().(*metadataStoreIndex).contacts[string(contacts[1].PK)].contact.PK)
 	require.Equal(t, contacts[1].PublicRendezvousSeed, meta[0].Index().(*metadataStoreIndex).contacts[string(contacts[1].PK)].contact.PublicRendezvousSeed)
 
 	// Marking as received
 
-	_, err = meta[1].ContactRequestIncomingReceived(ctx, &bertyprotocol.ShareableContact{})
+	_, err = meta[1].ContactRequestIncomingReceived(ctx, &bertytypes.ShareableContact{})
 	require.Error(t, err)
 
-	_, err = meta[1].ContactRequestIncomingReceived(ctx, &bertyprotocol.ShareableContact{PK: []byte("invalid"), PublicRendezvousSeed: []byte("invalid")})
+	_, err = meta[1].ContactRequestIncomingReceived(ctx, &bertytypes.ShareableContact{PK: []byte("invalid"), PublicRendezvousSeed: []byte("invalid")})
 	require.Error(t, err)
 
-	_, err = meta[1].ContactRequestIncomingReceived(ctx, &bertyprotocol.ShareableContact{PK: []byte("invalid"), PublicRendezvousSeed: contacts[0].PublicRendezvousSeed})
+	_, err = meta[1].ContactRequestIncomingReceived(ctx, &bertytypes.ShareableContact{PK: []byte("invalid"), PublicRendezvousSeed: contacts[0].PublicRendezvousSeed})
 	require.Error(t, err)
 
-	_, err = meta[1].ContactRequestIncomingReceived(ctx, &bertyprotocol.ShareableContact{PK: contacts[0].PK, PublicRendezvousSeed: []byte("invalid")})
+	_, err = meta[1].ContactRequestIncomingReceived(ctx, &bertytypes.ShareableContact{PK: contacts[0].PK, PublicRendezvousSeed: []byte("invalid")})
 	require.Error(t, err)
 
 	_, err = meta[1].ContactRequestIncomingReceived(ctx, contacts[1])
@@ -355,7 +356,7 @@ func TestMetadataContactLifecycle(t *testing.T) {
 
 	require.Equal(t, len(meta[1].Index().(*metadataStoreIndex).contacts), 1)
 	require.NotNil(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)])
-	require.Equal(t, bertyprotocol.ContactStateReceived, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state)
+	require.Equal(t, bertytypes.ContactStateReceived, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state)
 	require.Equal(t, contacts[0].PK, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].contact.PK)
 	require.Equal(t, contacts[0].PublicRendezvousSeed, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].contact.PublicRendezvousSeed)
 
@@ -375,7 +376,7 @@ func TestMetadataContactLifecycle(t *testing.T) {
 
 	require.Equal(t, len(meta[1].Index().(*metadataStoreIndex).contacts), 1)
 	require.NotNil(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)])
-	require.Equal(t, bertyprotocol.ContactStateAdded, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state)
+	require.Equal(t, bertytypes.ContactStateAdded, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state)
 	require.Equal(t, contacts[0].PK, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].contact.PK)
 	require.Equal(t, contacts[0].PublicRendezvousSeed, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].contact.PublicRendezvousSeed)
 
@@ -384,7 +385,7 @@ func TestMetadataContactLifecycle(t *testing.T) {
 
 	// Auto accept when receiving an invitation from a contact you were waiting to send an invitation to
 
-	_, err = meta[1].ContactRequestOutgoingEnqueue(ctx, contacts[3])
+	_, err = meta[1].ContactRequestOutgoingEnqueue(ctx, contacts[3], nil)
 	require.NoError(t, err)
 
 	_, err = meta[1].ContactRequestIncomingReceived(ctx, contacts[3])
@@ -392,7 +393,7 @@ func TestMetadataContactLifecycle(t *testing.T) {
 
 	require.Equal(t, 2, len(meta[1].Index().(*metadataStoreIndex).contacts))
 
-	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[3].PK)].state, bertyprotocol.ContactStateAdded)
+	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[3].PK)].state, bertytypes.ContactStateAdded)
 
 	// Refuse contact
 
@@ -400,7 +401,7 @@ func TestMetadataContactLifecycle(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, len(meta[1].Index().(*metadataStoreIndex).contacts), 3)
-	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertyprotocol.ContactStateReceived)
+	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertytypes.ContactStateReceived)
 
 	_, err = meta[1].ContactRequestIncomingDiscard(ctx, nil)
 	require.Error(t, err)
@@ -418,7 +419,7 @@ func TestMetadataContactLifecycle(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, len(meta[1].Index().(*metadataStoreIndex).contacts), 3)
-	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertyprotocol.ContactStateDiscarded)
+	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertytypes.ContactStateDiscarded)
 
 	// Allow receiving requests again after discarded
 
@@ -426,23 +427,23 @@ func TestMetadataContactLifecycle(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t, len(meta[1].Index().(*metadataStoreIndex).contacts), 3)
-	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertyprotocol.ContactStateReceived)
+	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertytypes.ContactStateReceived)
 
-	_, err = meta[1].ContactRequestOutgoingEnqueue(ctx, contacts[2])
+	_, err = meta[1].ContactRequestOutgoingEnqueue(ctx, contacts[2], nil)
 	require.NoError(t, err)
 
 	require.Equal(t, len(meta[1].Index().(*metadataStoreIndex).contacts), 3)
-	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertyprotocol.ContactStateAdded)
+	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertytypes.ContactStateAdded)
 
 	// Auto accept discarded requests
 
-	meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state = bertyprotocol.ContactStateDiscarded
+	meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state = bertytypes.ContactStateDiscarded
 
-	_, err = meta[1].ContactRequestOutgoingEnqueue(ctx, contacts[2])
+	_, err = meta[1].ContactRequestOutgoingEnqueue(ctx, contacts[2], nil)
 	require.NoError(t, err)
 
 	require.Equal(t, len(meta[1].Index().(*metadataStoreIndex).contacts), 3)
-	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertyprotocol.ContactStateAdded)
+	require.Equal(t, meta[1].Index().(*metadataStoreIndex).contacts[string(contacts[2].PK)].state, bertytypes.ContactStateAdded)
 
 	// Block contact
 
@@ -455,29 +456,29 @@ func TestMetadataContactLifecycle(t *testing.T) {
 	_, err = meta[2].ContactBlock(ctx, ownCG[0].MemberPubKey())
 	require.NoError(t, err)
 	require.Equal(t, len(meta[2].Index().(*metadataStoreIndex).contacts), 1)
-	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertyprotocol.ContactStateBlocked)
+	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertytypes.ContactStateBlocked)
 
 	_, err = meta[2].ContactBlock(ctx, ownCG[0].MemberPubKey())
 	require.Error(t, err)
 	require.Equal(t, len(meta[2].Index().(*metadataStoreIndex).contacts), 1)
-	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertyprotocol.ContactStateBlocked)
+	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertytypes.ContactStateBlocked)
 
 	// Unblock contact
 
 	_, err = meta[2].ContactUnblock(ctx, ownCG[1].MemberPubKey())
 	require.Error(t, err)
 	require.Equal(t, len(meta[2].Index().(*metadataStoreIndex).contacts), 1)
-	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertyprotocol.ContactStateBlocked)
+	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertytypes.ContactStateBlocked)
 
 	_, err = meta[2].ContactUnblock(ctx, ownCG[0].MemberPubKey())
 	require.NoError(t, err)
 	require.Equal(t, len(meta[2].Index().(*metadataStoreIndex).contacts), 1)
-	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertyprotocol.ContactStateRemoved)
+	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertytypes.ContactStateRemoved)
 
 	_, err = meta[2].ContactUnblock(ctx, ownCG[0].MemberPubKey())
 	require.Error(t, err)
 	require.Equal(t, len(meta[2].Index().(*metadataStoreIndex).contacts), 1)
-	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertyprotocol.ContactStateRemoved)
+	require.Equal(t, meta[2].Index().(*metadataStoreIndex).contacts[string(contacts[0].PK)].state, bertytypes.ContactStateRemoved)
 }
 
 func TestMetadataAliasLifecycle(t *testing.T) {
@@ -531,13 +532,13 @@ func TestMetadataGroupsLifecycle(t *testing.T) {
 	ownCG, err := peers[0].DB.OpenAccountGroup(ctx, nil)
 	assert.NoError(t, err)
 
-	g1, _, err := bertyprotocol.NewGroupMultiMember()
+	g1, _, err := bertytypes.NewGroupMultiMember()
 	require.NoError(t, err)
 
-	g2, _, err := bertyprotocol.NewGroupMultiMember()
+	g2, _, err := bertytypes.NewGroupMultiMember()
 	require.NoError(t, err)
 
-	g3, _, err := bertyprotocol.NewGroupMultiMember()
+	g3, _, err := bertytypes.NewGroupMultiMember()
 	require.NoError(t, err)
 
 	g1PK, err := g1.GetPubKey()
@@ -575,30 +576,30 @@ func TestMetadataGroupsLifecycle(t *testing.T) {
 	require.Equal(t, groups[second].SecretSig, g2.SecretSig)
 	require.Equal(t, groups[second].GroupType, g2.GroupType)
 
-	_, err = ownCG.MetadataStore().GroupJoin(ctx, &bertyprotocol.Group{
+	_, err = ownCG.MetadataStore().GroupJoin(ctx, &bertytypes.Group{
 		PublicKey: []byte("invalid_pk"),
 		Secret:    g3.Secret,
 		SecretSig: g3.SecretSig,
-		GroupType: bertyprotocol.GroupTypeMultiMember,
+		GroupType: bertytypes.GroupTypeMultiMember,
 	})
 	require.Error(t, err)
 
 	groups = ownCG.MetadataStore().ListMultiMemberGroups()
 	require.Len(t, groups, 2)
 
-	_, err = ownCG.MetadataStore().GroupJoin(ctx, &bertyprotocol.Group{
+	_, err = ownCG.MetadataStore().GroupJoin(ctx, &bertytypes.Group{
 		PublicKey: g3.PublicKey,
 		Secret:    nil,
 		SecretSig: g3.SecretSig,
-		GroupType: bertyprotocol.GroupTypeMultiMember,
+		GroupType: bertytypes.GroupTypeMultiMember,
 	})
 	require.Error(t, err)
 
-	_, err = ownCG.MetadataStore().GroupJoin(ctx, &bertyprotocol.Group{
+	_, err = ownCG.MetadataStore().GroupJoin(ctx, &bertytypes.Group{
 		PublicKey: g3.PublicKey,
 		Secret:    g3.Secret,
 		SecretSig: []byte("invalid_sig"),
-		GroupType: bertyprotocol.GroupTypeMultiMember,
+		GroupType: bertytypes.GroupTypeMultiMember,
 	})
 	require.Error(t, err)
 
@@ -636,3 +637,373 @@ func TestMetadataGroupsLifecycle(t *testing.T) {
 	require.Equal(t, groups[0].SecretSig, g2.SecretSig)
 	require.Equal(t, groups[0].GroupType, g2.GroupType)
 }
+
+func TestMetadataAppMetadataSender(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peers, groupSK := CreatePeersWithGroup(ctx, t, "/tmp/app_metadata_test", 1, 1)
+	defer DropPeers(t, peers)
+
+	ms := peers[0].GC.MetadataStore()
+
+	_, err := ms.AddDeviceToGroup(ctx)
+	require.NoError(t, err)
+
+	_, err = ms.ClaimGroupOwnership(ctx, groupSK)
+	require.NoError(t, err)
+
+	// an event signed by a device which is not a member of the group
+	otherSK, otherPK, err := crypto.GenerateEd25519Key(crand.Reader)
+	require.NoError(t, err)
+
+	otherPKBytes, err := otherPK.Raw()
+	require.NoError(t, err)
+
+	forged := &bertytypes.AppMetadata{DevicePK: otherPKBytes, Message: []byte("forged")}
+	sig, err := SignProto(forged, otherSK)
+	require.NoError(t, err)
+
+	_, err = MetadataStoreAddEvent(ctx, ms, peers[0].GC.Group(), bertytypes.EventTypeGroupMetadataPayloadSent, forged, sig)
+	require.NoError(t, err)
+
+	_, err = ms.SendAppMetadata(ctx, []byte("test"))
+	require.NoError(t, err)
+
+	var messages [][]byte
+	for evt := range ms.ListEvents(ctx) {
+		if evt.Metadata.EventType != bertytypes.EventTypeGroupMetadataPayloadSent {
+			continue
+		}
+
+		appMetadata := &bertytypes.AppMetadata{}
+		require.NoError(t, appMetadata.Unmarshal(evt.Event))
+
+		messages = append(messages, appMetadata.Message)
+	}
+
+	require.Equal(t, [][]byte{[]byte("test")}, messages)
+}
+
+func TestMetadataDeviceRevokeUnknownDevice(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peers, _ := CreatePeersWithGroup(ctx, t, "/tmp/device_revoke_test", 1, 1)
+	defer DropPeers(t, peers)
+
+	ms := peers[0].GC.MetadataStore()
+
+	_, err := ms.AddDeviceToGroup(ctx)
+	require.NoError(t, err)
+
+	md, err := peers[0].Acc.MemberDeviceForGroup(peers[0].GC.Group())
+	require.NoError(t, err)
+
+	devicePKBytes, err := md.Device.GetPublic().Raw()
+	require.NoError(t, err)
+
+	// a revocation of a device which hasn't been added by the member of the
+	// sender is ignored
+	_, unknownPK, err := crypto.GenerateEd25519Key(crand.Reader)
+	require.NoError(t, err)
+
+	unknownPKBytes, err := unknownPK.Raw()
+	require.NoError(t, err)
+
+	forged := &bertytypes.GroupRevokeMemberDevice{DevicePK: devicePKBytes, RevokedDevicePK: unknownPKBytes}
+	sig, err := SignProto(forged, md.Device)
+	require.NoError(t, err)
+
+	_, err = MetadataStoreAddEvent(ctx, ms, peers[0].GC.Group(), bertytypes.EventTypeGroupMemberDeviceRevoked, forged, sig)
+	require.NoError(t, err)
+
+	require.False(t, ms.IsDeviceRevoked(unknownPK))
+	require.Equal(t, uint64(0), ms.KeyGeneration())
+}
+
+func TestMetadataAppMetadataPendingLimit(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peers, _ := CreatePeersWithGroup(ctx, t, "/tmp/app_metadata_pending_test", 1, 1)
+	defer DropPeers(t, peers)
+
+	ms := peers[0].GC.MetadataStore()
+
+	otherSK, otherPK, err := crypto.GenerateEd25519Key(crand.Reader)
+	require.NoError(t, err)
+
+	otherPKBytes, err := otherPK.Raw()
+	require.NoError(t, err)
+
+	// events from an unknown device are kept until the limit is reached
+	for i := 0; i < maxPendingAppMetadata+10; i++ {
+		forged := &bertytypes.AppMetadata{DevicePK: otherPKBytes, Message: []byte(fmt.Sprintf("forged%d", i))}
+		sig, err := SignProto(forged, otherSK)
+		require.NoError(t, err)
+
+		_, err = MetadataStoreAddEvent(ctx, ms, peers[0].GC.Group(), bertytypes.EventTypeGroupMetadataPayloadSent, forged, sig)
+		require.NoError(t, err)
+	}
+
+	idx := ms.Index().(*metadataStoreIndex)
+	idx.lock.RLock()
+	defer idx.lock.RUnlock()
+
+	require.Len(t, idx.pendingAppMetadata, maxPendingAppMetadata)
+
+	oldest := &bertytypes.AppMetadata{}
+	require.NoError(t, oldest.Unmarshal(idx.pendingAppMetadata[0].metaEvent.Event))
+	require.Equal(t, []byte("forged10"), oldest.Message)
+}
+
+func TestMetadataIndexResetEmitsOnce(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peers, groupSK := CreatePeersWithGroup(ctx, t, "/tmp/metadata_reset_test", 1, 1)
+	defer DropPeers(t, peers)
+
+	ms := peers[0].GC.MetadataStore()
+
+	_, err := ms.AddDeviceToGroup(ctx)
+	require.NoError(t, err)
+
+	_, err = ms.ClaimGroupOwnership(ctx, groupSK)
+	require.NoError(t, err)
+
+	_, err = ms.SendAppMetadata(ctx, []byte("first"))
+	require.NoError(t, err)
+
+	subCtx, subCancel := context.WithCancel(ctx)
+	defer subCancel()
+
+	sub := ms.Subscribe(subCtx)
+
+	// simulate a merge inserting entries before the indexed ones, the whole
+	// log is replayed on the next update
+	idx := ms.Index().(*metadataStoreIndex)
+	idx.lock.Lock()
+	idx.lastIndexedCID = cid.Undef
+	idx.lock.Unlock()
+
+	op, err := ms.SendAppMetadata(ctx, []byte("second"))
+	require.NoError(t, err)
+
+	timeout := time.After(10 * time.Second)
+
+	for {
+		select {
+		case evt := <-sub:
+			e, ok := evt.(*bertytypes.GroupMetadataEvent)
+			if !ok {
+				continue
+			}
+
+			// the replayed events must not be emitted again
+			require.Equal(t, op.GetEntry().GetHash().Bytes(), e.EventContext.ID)
+
+			return
+
+		case <-timeout:
+			require.FailNow(t, "timed out waiting for the new event")
+		}
+	}
+}
+
+func TestMetadataMemberAdmission(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peers, groupSK := CreatePeersWithGroup(ctx, t, "/tmp/member_admission_test", 2, 1)
+	defer DropPeers(t, peers)
+
+	ms0 := peers[0].GC.MetadataStore()
+	ms1 := peers[1].GC.MetadataStore()
+
+	_, err := ms0.AddDeviceToGroup(ctx)
+	require.NoError(t, err)
+
+	_, err = ms0.ClaimGroupOwnership(ctx, groupSK)
+	require.NoError(t, err)
+
+	// a member can't add its devices before being admitted
+	_, err = ms1.AddDeviceToGroup(ctx)
+	require.NoError(t, err)
+
+	waitForDevices := func(ms MetadataStore, count int) {
+		for i := 0; i < 50 && len(ms.ListDevices()) < count; i++ {
+			<-time.After(time.Millisecond * 100)
+		}
+	}
+
+	waitForDevices(ms1, 1)
+	waitForDevices(ms0, 2)
+
+	require.Len(t, ms0.ListDevices(), 1)
+	require.Len(t, ms1.ListDevices(), 1)
+	require.False(t, ms0.IsMemberAdmitted(peers[1].GC.MemberPubKey()))
+
+	_, err = ms0.GetMemberByDevice(peers[1].GC.DevicePubKey())
+	require.Error(t, err)
+
+	// a member can't admit itself
+	invitation, err := ms0.InvitationCreate(time.Time{}, 1)
+	require.NoError(t, err)
+
+	_, err = ms1.InvitationUse(ctx, invitation, peers[1].GC.MemberPubKey())
+	require.Error(t, err)
+
+	// the pending devices are added once an admin admitted the member
+	_, err = ms0.InvitationUse(ctx, invitation, peers[1].GC.MemberPubKey())
+	require.NoError(t, err)
+
+	waitForDevices(ms0, 2)
+	waitForDevices(ms1, 2)
+
+	for _, ms := range []MetadataStore{ms0, ms1} {
+		require.Len(t, ms.ListDevices(), 2)
+		require.True(t, ms.IsMemberAdmitted(peers[1].GC.MemberPubKey()))
+
+		member, err := ms.GetMemberByDevice(peers[1].GC.DevicePubKey())
+		require.NoError(t, err)
+		require.True(t, member.Equals(peers[1].GC.MemberPubKey()))
+	}
+
+	// the invitation can only be used once
+	_, err = ms0.InvitationUse(ctx, invitation, peers[0].GC.MemberPubKey())
+	require.Error(t, err)
+}
+
+func TestMetadataIndexIncrementalUpdate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peers, groupSK := CreatePeersWithGroup(ctx, t, "/tmp/metadata_incremental_test", 1, 1)
+	defer DropPeers(t, peers)
+
+	ms := peers[0].GC.MetadataStore()
+
+	_, err := ms.AddDeviceToGroup(ctx)
+	require.NoError(t, err)
+
+	_, err = ms.ClaimGroupOwnership(ctx, groupSK)
+	require.NoError(t, err)
+
+	idx := ms.Index().(*metadataStoreIndex)
+
+	// each update only applies the entries appended since the previous one
+	for i := 0; i < 5; i++ {
+		idx.lock.RLock()
+		indexedCount := idx.indexedCount
+		idx.lock.RUnlock()
+
+		op, err := ms.SendAppMetadata(ctx, []byte(fmt.Sprintf("message%d", i)))
+		require.NoError(t, err)
+
+		idx.lock.RLock()
+		require.Equal(t, indexedCount+1, idx.indexedCount)
+		require.True(t, idx.lastIndexedCID.Equals(op.GetEntry().GetHash()))
+		idx.lock.RUnlock()
+	}
+
+	require.Equal(t, len(ms.OpLog().Values().Slice()), idx.indexedCount)
+	require.Len(t, ms.ListDevices(), 1)
+	require.Len(t, ms.ListAdmins(), 1)
+}
+
+func TestMetadataIndexMergeReset(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peers, groupSK := CreatePeersWithGroup(ctx, t, "/tmp/metadata_merge_test", 1, 2)
+	defer DropPeers(t, peers)
+
+	ms0 := peers[0].GC.MetadataStore()
+	ms1 := peers[1].GC.MetadataStore()
+
+	_, err := ms0.AddDeviceToGroup(ctx)
+	require.NoError(t, err)
+
+	_, err = ms0.ClaimGroupOwnership(ctx, groupSK)
+	require.NoError(t, err)
+
+	_, err = ms1.AddDeviceToGroup(ctx)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return len(ms0.ListDevices()) == 2 && len(ms1.ListDevices()) == 2
+	}, time.Second*10, time.Millisecond*100)
+
+	// both devices append entries concurrently while disconnected
+	mn := peers[0].CoreAPI.MockNetwork()
+	id0, id1 := peers[0].CoreAPI.MockNode().Identity, peers[1].CoreAPI.MockNode().Identity
+	require.NoError(t, mn.DisconnectPeers(id0, id1))
+	require.NoError(t, mn.UnlinkPeers(id0, id1))
+
+	subCtx, subCancel := context.WithCancel(ctx)
+	defer subCancel()
+
+	sub := ms0.Subscribe(subCtx)
+
+	for i := 0; i < 3; i++ {
+		_, err = ms0.SendAppMetadata(ctx, []byte(fmt.Sprintf("first%d", i)))
+		require.NoError(t, err)
+
+		_, err = ms1.SendAppMetadata(ctx, []byte(fmt.Sprintf("second%d", i)))
+		require.NoError(t, err)
+	}
+
+	log := ms0.OpLog()
+	_, err = log.Join(ms1.OpLog(), -1)
+	require.NoError(t, err)
+
+	entries := log.Values().Slice()
+	idx := ms0.Index().(*metadataStoreIndex)
+
+	// the merged entries are interleaved with the indexed ones
+	idx.lock.RLock()
+	require.False(t, idx.unsafeIsIndexedPrefix(entries))
+	idx.lock.RUnlock()
+
+	require.NoError(t, idx.UpdateIndex(log, nil))
+
+	idx.lock.RLock()
+	require.Equal(t, len(entries), idx.indexedCount)
+	require.True(t, idx.lastIndexedCID.Equals(entries[len(entries)-1].GetHash()))
+	idx.lock.RUnlock()
+
+	require.Len(t, ms0.ListDevices(), 2)
+	require.Len(t, ms0.ListAdmins(), 1)
+
+	// the replayed events are not emitted again
+	received := map[string]struct{}{}
+	timeout := time.After(time.Second * 10)
+
+	for len(received) < 6 {
+		select {
+		case evt := <-sub:
+			e, ok := evt.(*bertytypes.GroupMetadataEvent)
+			if !ok || e.Metadata.EventType != bertytypes.EventTypeGroupMetadataPayloadSent {
+				continue
+			}
+
+			_, ok = received[string(e.EventContext.ID)]
+			require.False(t, ok, "event emitted twice")
+
+			received[string(e.EventContext.ID)] = struct{}{}
+
+		case <-timeout:
+			require.FailNow(t, "timed out waiting for the events")
+		}
+	}
+
+	select {
+	case evt := <-sub:
+		if e, ok := evt.(*bertytypes.GroupMetadataEvent); ok {
+			require.NotEqual(t, bertytypes.EventTypeGroupMetadataPayloadSent, e.Metadata.EventType)
+		}
+	case <-time.After(time.Millisecond * 500):
+	}
+}
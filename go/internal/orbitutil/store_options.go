@@ -7,11 +7,11 @@ import (
 	orbitdb "berty.tech/go-orbit-db"
 	"berty.tech/go-orbit-db/accesscontroller"
 
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
-func DefaultOptions(g *bertyprotocol.Group, options *orbitdb.CreateDBOptions, keystore *BertySignedKeyStore, storeType string) (*orbitdb.CreateDBOptions, error) {
+func DefaultOptions(g *bertytypes.Group, options *orbitdb.CreateDBOptions, keystore *BertySignedKeyStore, storeType string) (*orbitdb.CreateDBOptions, error) {
 	var err error
 
 	if options == nil {
@@ -48,7 +48,20 @@ func DefaultOptions(g *bertyprotocol.Group, options *orbitdb.CreateDBOptions, ke
 	return options, nil
 }
 
-func defaultACForGroup(g *bertyprotocol.Group, storeType string) (accesscontroller.ManifestParams, error) {
+func defaultACForGroup(g *bertytypes.Group, storeType string) (accesscontroller.ManifestParams, error) {
+	writePolicy := WritePolicyMembers
+	if storeType == GroupMessageStoreType && g.AdminMessagesOnly {
+		writePolicy = WritePolicyAdmins
+	}
+
+	return NewGroupAccessControllerParams(g, storeType, writePolicy)
+}
+
+// NewGroupAccessControllerParams returns the parameters of an access
+// controller checking the entries of a group store against the group members
+// and the given write policy, the access map including the policy is part of
+// the store address so the peers using another policy open another store
+func NewGroupAccessControllerParams(g *bertytypes.Group, storeType string, writePolicy string) (accesscontroller.ManifestParams, error) {
 	groupID := g.GroupIDAsString()
 
 	sigPK, err := g.GetSigningPubKey()
@@ -66,15 +79,16 @@ func defaultACForGroup(g *bertyprotocol.Group, storeType string) (accesscontroll
 			"write":            {hex.EncodeToString(signingKeyBytes)},
 			IdentityGroupIDKey: {groupID},
 			StoreTypeKey:       {storeType},
+			WritePolicyKey:     {writePolicy},
 		},
 		SkipManifest: true,
-		Type:         "bertysimple",
+		Type:         GroupAccessControllerType,
 	}
 
 	return param, nil
 }
 
-func defaultIdentityForGroup(g *bertyprotocol.Group, ks *BertySignedKeyStore) (*identityprovider.Identity, error) {
+func defaultIdentityForGroup(g *bertytypes.Group, ks *BertySignedKeyStore) (*identityprovider.Identity, error) {
 	sigPK, err := g.GetSigningPubKey()
 	if err != nil {
 		return nil, errcode.TODO.Wrap(err)
@@ -0,0 +1,87 @@
+package tinder
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
+)
+
+// advertiseRetryInterval is the time to wait before advertising again when
+// a driver has failed or has returned no TTL
+const advertiseRetryInterval = time.Minute
+
+// advertisement is a namespace advertised in the background on several
+// drivers until it is stopped
+type advertisement struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startAdvertisement advertises ns on each driver until ctx is done or the
+// advertisement is stopped, see advertiseLoop
+func startAdvertisement(ctx context.Context, drivers []Driver, ns string, opts ...p2p_discovery.Option) *advertisement {
+	ctx, cancel := context.WithCancel(ctx)
+	a := &advertisement{cancel: cancel, done: make(chan struct{})}
+
+	var wg sync.WaitGroup
+	wg.Add(len(drivers))
+
+	for _, driver := range drivers {
+		go func(driver Driver) {
+			defer wg.Done()
+			advertiseLoop(ctx, driver, ns, opts...)
+		}(driver)
+	}
+
+	go func() {
+		wg.Wait()
+		close(a.done)
+	}()
+
+	return a
+}
+
+// stop stops the advertisement and waits for the drivers to be done so the
+// namespace can be unregistered safely
+func (a *advertisement) stop() {
+	a.cancel()
+	<-a.done
+}
+
+// advertiseLoop advertises ns on the driver at the time set by Schedule, or
+// right away, and again before the TTL returned by the driver expires until
+// ctx is done
+func advertiseLoop(ctx context.Context, driver p2p_discovery.Advertiser, ns string, opts ...p2p_discovery.Option) {
+	var options p2p_discovery.Options
+	if err := options.Apply(opts...); err != nil {
+		return
+	}
+
+	if at := scheduleFromOptions(options); !at.IsZero() {
+		select {
+		case <-time.After(time.Until(at)):
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	for {
+		ttl, err := driver.Advertise(ctx, ns, opts...)
+		if ctx.Err() != nil {
+			return
+		}
+
+		wait := 7 * ttl / 8
+		if err != nil || wait <= 0 {
+			wait = advertiseRetryInterval
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
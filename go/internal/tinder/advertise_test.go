@@ -0,0 +1,97 @@
+package tinder
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
+	p2p_mock "github.com/libp2p/go-libp2p/p2p/net/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingDriver counts the advertisements made on a driver
+type countingDriver struct {
+	Driver
+
+	mu    sync.Mutex
+	count int
+}
+
+func (d *countingDriver) Advertise(ctx context.Context, ns string, opts ...p2p_discovery.Option) (time.Duration, error) {
+	d.mu.Lock()
+	d.count++
+	d.mu.Unlock()
+
+	return d.Driver.Advertise(ctx, ns, opts...)
+}
+
+func (d *countingDriver) advertisements() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.count
+}
+
+func TestMultiDriver_Schedule(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ms := NewMockedDriverServer()
+	mn := p2p_mock.New(ctx)
+
+	peers := testingPeers(t, mn, 1)
+	driver := &countingDriver{Driver: NewMockedDriverClient(peers[0], ms)}
+	md := NewMultiDriver(driver)
+
+	const testKey = "testkey"
+
+	// the advertisement is made at the scheduled time
+	_, err := md.Advertise(ctx, testKey, p2p_discovery.TTL(time.Minute), Schedule(time.Now().Add(time.Millisecond*300)))
+	require.NoError(t, err)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.False(t, ms.HasPeerRecord(testKey, peers[0].ID()))
+
+	require.Eventually(t, func() bool {
+		return ms.HasPeerRecord(testKey, peers[0].ID())
+	}, time.Second, time.Millisecond*10)
+
+	assert.Equal(t, 1, driver.advertisements())
+
+	require.NoError(t, md.Unregister(ctx, testKey))
+	assert.False(t, ms.HasPeerRecord(testKey, peers[0].ID()))
+}
+
+func TestMultiDriver_Readvertise(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ms := NewMockedDriverServer()
+	mn := p2p_mock.New(ctx)
+
+	peers := testingPeers(t, mn, 1)
+	driver := &countingDriver{Driver: NewMockedDriverClient(peers[0], ms)}
+	md := NewMultiDriver(driver)
+
+	const testKey = "testkey"
+
+	// the namespace is advertised again before the TTL expires
+	_, err := md.Advertise(ctx, testKey, p2p_discovery.TTL(time.Millisecond*100))
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		return driver.advertisements() >= 3
+	}, time.Second, time.Millisecond*10)
+
+	// no advertisement is made once unregistered
+	require.NoError(t, md.Unregister(ctx, testKey))
+	count := driver.advertisements()
+
+	time.Sleep(time.Millisecond * 300)
+	assert.Equal(t, count, driver.advertisements())
+	assert.False(t, ms.HasPeerRecord(testKey, peers[0].ID()))
+}
@@ -0,0 +1,465 @@
+package tinder
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
+	p2p_host "github.com/libp2p/go-libp2p-core/host"
+	p2p_peer "github.com/libp2p/go-libp2p-core/peer"
+	p2p_mdns "github.com/libp2p/go-libp2p/p2p/discovery"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr-net"
+	"github.com/whyrusleeping/mdns"
+)
+
+const (
+	// DefaultMDNSInterval is the interval between two mDNS queries
+	DefaultMDNSInterval = time.Second * 10
+
+	// DefaultMDNSTTL is returned by Advertise, the namespace stays advertised
+	// on the LAN until it is unregistered
+	DefaultMDNSTTL = time.Hour
+
+	// mdnsLookupDuration is the time FindPeers waits for LAN peers to answer
+	mdnsLookupDuration = time.Second * 5
+
+	// mdnsQueryTimeout is the time a single mDNS query waits for answers
+	mdnsQueryTimeout = time.Second * 5
+)
+
+type mdnsServiceFactory func(ctx context.Context, h p2p_host.Host, interval time.Duration, tag string) (p2p_mdns.Service, error)
+
+var _ Driver = (*mdnsDriver)(nil)
+
+// mdnsDriver advertises and finds peers of a namespace on the LAN using a
+// tag derived from it, a mDNS service is run for each advertised namespace
+// and a resolver, which only sends queries, for each namespace looked up
+type mdnsDriver struct {
+	rootCtx     context.Context
+	host        p2p_host.Host
+	interval    time.Duration
+	newService  mdnsServiceFactory
+	newResolver mdnsServiceFactory
+
+	mu         sync.Mutex
+	namespaces map[string]*mdnsNamespace
+}
+
+// mdnsNamespace is the mDNS service of a namespace, it is kept running while
+// the namespace is advertised, its resolver is run instead while it is only
+// looked up
+type mdnsNamespace struct {
+	service    p2p_mdns.Service
+	resolver   p2p_mdns.Service
+	advertised bool
+	lookups    int
+
+	mu    sync.Mutex
+	peers map[p2p_peer.ID]*mdnsRecord
+	subs  map[chan p2p_peer.AddrInfo]struct{}
+}
+
+type mdnsRecord struct {
+	info     p2p_peer.AddrInfo
+	lastSeen time.Time
+}
+
+// NewMDNSDriver returns a driver using mDNS to advertise and find peers on
+// the LAN, the mDNS services are stopped once ctx is done
+func NewMDNSDriver(ctx context.Context, h p2p_host.Host, interval time.Duration) Driver {
+	return newMDNSDriver(ctx, h, interval, p2p_mdns.NewMdnsService, newMDNSResolver)
+}
+
+func newMDNSDriver(ctx context.Context, h p2p_host.Host, interval time.Duration, newService, newResolver mdnsServiceFactory) *mdnsDriver {
+	if interval == 0 {
+		interval = DefaultMDNSInterval
+	}
+
+	return &mdnsDriver{
+		rootCtx:     ctx,
+		host:        h,
+		interval:    interval,
+		newService:  newService,
+		newResolver: newResolver,
+		namespaces:  make(map[string]*mdnsNamespace),
+	}
+}
+
+// mdnsServiceTag returns the mDNS service tag of a namespace, the namespace
+// is hashed so it fits in a DNS label
+func mdnsServiceTag(ns string) string {
+	sum := sha256.Sum256([]byte(ns))
+	return fmt.Sprintf("_berty-%x._udp", sum[:12])
+}
+
+func (d *mdnsDriver) Advertise(ctx context.Context, ns string, opts ...p2p_discovery.Option) (time.Duration, error) {
+	// Get options
+	var options p2p_discovery.Options
+	err := options.Apply(opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n := d.getNamespace(ns)
+	n.advertised = true
+
+	if err := d.updateNamespace(ns, n); err != nil {
+		n.advertised = false
+		_ = d.updateNamespace(ns, n)
+
+		return 0, err
+	}
+
+	if options.Ttl != 0 {
+		return options.Ttl, nil
+	}
+
+	return DefaultMDNSTTL, nil
+}
+
+func (d *mdnsDriver) FindPeers(ctx context.Context, ns string, opts ...p2p_discovery.Option) (<-chan p2p_peer.AddrInfo, error) {
+	// Get options
+	var options p2p_discovery.Options
+	err := options.Apply(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	n := d.getNamespace(ns)
+	n.lookups++
+
+	if err := d.updateNamespace(ns, n); err != nil {
+		n.lookups--
+		_ = d.updateNamespace(ns, n)
+		d.mu.Unlock()
+
+		return nil, err
+	}
+	d.mu.Unlock()
+
+	// peers found during the lookup are sent along the ones already known
+	sub := make(chan p2p_peer.AddrInfo, 16)
+	known := n.subscribe(sub, time.Now().Add(-3*d.interval))
+
+	cpeers := make(chan p2p_peer.AddrInfo, len(known))
+	go func() {
+		defer close(cpeers)
+		defer d.endLookup(ns, n, sub)
+
+		ctx, cancel := context.WithTimeout(ctx, mdnsLookupDuration)
+		defer cancel()
+
+		sent := make(map[p2p_peer.ID]struct{})
+		send := func(info p2p_peer.AddrInfo) bool {
+			if _, ok := sent[info.ID]; ok {
+				return true
+			}
+
+			select {
+			case cpeers <- info:
+			case <-ctx.Done():
+				return false
+			}
+
+			sent[info.ID] = struct{}{}
+			return options.Limit == 0 || len(sent) < options.Limit
+		}
+
+		for _, info := range known {
+			if !send(info) {
+				return
+			}
+		}
+
+		for {
+			select {
+			case info := <-sub:
+				if !send(info) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return cpeers, nil
+}
+
+// Unregister stops advertising the namespace, its resolver is started
+// instead if a lookup is running
+func (d *mdnsDriver) Unregister(ctx context.Context, ns string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n, ok := d.namespaces[ns]
+	if !ok {
+		return nil
+	}
+
+	n.advertised = false
+
+	return d.updateNamespace(ns, n)
+}
+
+// getNamespace returns the namespace, it is created if needed, d.mu must be
+// held
+func (d *mdnsDriver) getNamespace(ns string) *mdnsNamespace {
+	if n, ok := d.namespaces[ns]; ok {
+		return n
+	}
+
+	n := &mdnsNamespace{
+		peers: make(map[p2p_peer.ID]*mdnsRecord),
+		subs:  make(map[chan p2p_peer.AddrInfo]struct{}),
+	}
+
+	d.namespaces[ns] = n
+
+	return n
+}
+
+// updateNamespace runs the mDNS service of a namespace if it is advertised,
+// its resolver if it is only looked up, and removes it if it is unused, d.mu
+// must be held
+func (d *mdnsDriver) updateNamespace(ns string, n *mdnsNamespace) error {
+	tag := mdnsServiceTag(ns)
+
+	if !n.advertised && n.service != nil {
+		n.stop(n.service)
+		n.service = nil
+	}
+
+	if (n.advertised || n.lookups == 0) && n.resolver != nil {
+		n.stop(n.resolver)
+		n.resolver = nil
+	}
+
+	var err error
+
+	switch {
+	case n.advertised && n.service == nil:
+		n.service, err = n.start(d.rootCtx, d.newService, d.host, d.interval, tag)
+	case !n.advertised && n.lookups > 0 && n.resolver == nil:
+		n.resolver, err = n.start(d.rootCtx, d.newResolver, d.host, d.interval, tag)
+	case !n.advertised && n.lookups == 0:
+		delete(d.namespaces, ns)
+	}
+
+	return err
+}
+
+func (d *mdnsDriver) endLookup(ns string, n *mdnsNamespace, sub chan p2p_peer.AddrInfo) {
+	n.unsubscribe(sub)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	n.lookups--
+	_ = d.updateNamespace(ns, n)
+}
+
+// mdnsCancelableService cancels the context of a service once it is closed
+type mdnsCancelableService struct {
+	p2p_mdns.Service
+	cancel context.CancelFunc
+}
+
+func (s *mdnsCancelableService) Close() error {
+	err := s.Service.Close()
+	s.cancel()
+
+	return err
+}
+
+func (n *mdnsNamespace) start(ctx context.Context, newService mdnsServiceFactory, h p2p_host.Host, interval time.Duration, tag string) (p2p_mdns.Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	service, err := newService(ctx, h, interval, tag)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	service.RegisterNotifee(n)
+
+	return &mdnsCancelableService{Service: service, cancel: cancel}, nil
+}
+
+func (n *mdnsNamespace) stop(service p2p_mdns.Service) {
+	service.UnregisterNotifee(n)
+	_ = service.Close()
+}
+
+// HandlePeerFound implements p2p_mdns.Notifee
+func (n *mdnsNamespace) HandlePeerFound(info p2p_peer.AddrInfo) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.peers[info.ID] = &mdnsRecord{info: info, lastSeen: time.Now()}
+
+	for sub := range n.subs {
+		select {
+		case sub <- info:
+		default: // the lookup is lagging, the peer will be found again
+		}
+	}
+}
+
+// subscribe registers a channel receiving the peers found from now on and
+// returns the ones seen since the given time
+func (n *mdnsNamespace) subscribe(sub chan p2p_peer.AddrInfo, since time.Time) []p2p_peer.AddrInfo {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.subs[sub] = struct{}{}
+
+	known := []p2p_peer.AddrInfo{}
+	for id, rec := range n.peers {
+		if rec.lastSeen.Before(since) {
+			delete(n.peers, id)
+			continue
+		}
+
+		known = append(known, rec.info)
+	}
+
+	return known
+}
+
+func (n *mdnsNamespace) unsubscribe(sub chan p2p_peer.AddrInfo) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	delete(n.subs, sub)
+}
+
+// mdnsResolver periodically queries the peers of a service tag on the LAN,
+// unlike a mDNS service it doesn't answer the queries of the other peers so
+// looking up a namespace doesn't advertise it
+type mdnsResolver struct {
+	host     p2p_host.Host
+	tag      string
+	interval time.Duration
+	cancel   context.CancelFunc
+
+	mu       sync.Mutex
+	notifees []p2p_mdns.Notifee
+}
+
+var _ p2p_mdns.Service = (*mdnsResolver)(nil)
+
+func newMDNSResolver(ctx context.Context, h p2p_host.Host, interval time.Duration, tag string) (p2p_mdns.Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	r := &mdnsResolver{
+		host:     h,
+		tag:      tag,
+		interval: interval,
+		cancel:   cancel,
+	}
+
+	go r.poll(ctx)
+
+	return r, nil
+}
+
+func (r *mdnsResolver) poll(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		r.query()
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// query sends a mDNS query and notifies the peers which answered it
+func (r *mdnsResolver) query() {
+	entries := make(chan *mdns.ServiceEntry, 16)
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for entry := range entries {
+			r.handleEntry(entry)
+		}
+	}()
+
+	timeout := mdnsQueryTimeout
+	if r.interval < timeout {
+		timeout = r.interval
+	}
+
+	_ = mdns.Query(&mdns.QueryParam{
+		Service: r.tag,
+		Domain:  "local",
+		Timeout: timeout,
+		Entries: entries,
+	})
+
+	close(entries)
+	<-done
+}
+
+// handleEntry decodes an answer the same way the mDNS service of libp2p does
+func (r *mdnsResolver) handleEntry(entry *mdns.ServiceEntry) {
+	id, err := p2p_peer.IDB58Decode(entry.Info)
+	if err != nil || id == r.host.ID() {
+		return
+	}
+
+	addr, err := manet.FromNetAddr(&net.TCPAddr{IP: entry.AddrV4, Port: entry.Port})
+	if err != nil {
+		return
+	}
+
+	info := p2p_peer.AddrInfo{ID: id, Addrs: []ma.Multiaddr{addr}}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, n := range r.notifees {
+		go n.HandlePeerFound(info)
+	}
+}
+
+func (r *mdnsResolver) RegisterNotifee(n p2p_mdns.Notifee) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.notifees = append(r.notifees, n)
+}
+
+func (r *mdnsResolver) UnregisterNotifee(n p2p_mdns.Notifee) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, notifee := range r.notifees {
+		if notifee == n {
+			r.notifees = append(r.notifees[:i], r.notifees[i+1:]...)
+			break
+		}
+	}
+}
+
+func (r *mdnsResolver) Close() error {
+	r.cancel()
+
+	return nil
+}
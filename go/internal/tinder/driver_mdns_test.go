@@ -0,0 +1,239 @@
+package tinder
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
+	p2p_host "github.com/libp2p/go-libp2p-core/host"
+	p2p_peer "github.com/libp2p/go-libp2p-core/peer"
+	p2p_mdns "github.com/libp2p/go-libp2p/p2p/discovery"
+	p2p_mock "github.com/libp2p/go-libp2p/p2p/net/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedLAN simulates mDNS services answering each other on a LAN
+type mockedLAN struct {
+	mu       sync.Mutex
+	services map[*mockedMDNSService]struct{}
+}
+
+type mockedMDNSService struct {
+	lan      *mockedLAN
+	host     p2p_host.Host
+	tag      string
+	notifees []p2p_mdns.Notifee
+
+	// queryOnly services find the other ones without being found
+	queryOnly bool
+}
+
+func newMockedLAN() *mockedLAN {
+	return &mockedLAN{services: make(map[*mockedMDNSService]struct{})}
+}
+
+func (l *mockedLAN) newService(ctx context.Context, h p2p_host.Host, interval time.Duration, tag string) (p2p_mdns.Service, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s := &mockedMDNSService{lan: l, host: h, tag: tag}
+	l.services[s] = struct{}{}
+
+	// announce the new service to the others
+	for other := range l.services {
+		if other.tag == tag && other.host.ID() != h.ID() {
+			for _, n := range other.notifees {
+				go n.HandlePeerFound(*p2p_host.InfoFromHost(h))
+			}
+		}
+	}
+
+	return s, nil
+}
+
+func (l *mockedLAN) newResolver(ctx context.Context, h p2p_host.Host, interval time.Duration, tag string) (p2p_mdns.Service, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s := &mockedMDNSService{lan: l, host: h, tag: tag, queryOnly: true}
+	l.services[s] = struct{}{}
+
+	return s, nil
+}
+
+// serviceCount returns the number of services and resolvers running
+func (l *mockedLAN) serviceCount() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return len(l.services)
+}
+
+func (s *mockedMDNSService) RegisterNotifee(n p2p_mdns.Notifee) {
+	s.lan.mu.Lock()
+	defer s.lan.mu.Unlock()
+
+	s.notifees = append(s.notifees, n)
+
+	for other := range s.lan.services {
+		if other.tag == s.tag && other.host.ID() != s.host.ID() && !other.queryOnly {
+			go n.HandlePeerFound(*p2p_host.InfoFromHost(other.host))
+		}
+	}
+}
+
+func (s *mockedMDNSService) UnregisterNotifee(p2p_mdns.Notifee) {
+	s.lan.mu.Lock()
+	defer s.lan.mu.Unlock()
+
+	s.notifees = nil
+}
+
+func (s *mockedMDNSService) Close() error {
+	s.lan.mu.Lock()
+	defer s.lan.mu.Unlock()
+
+	delete(s.lan.services, s)
+
+	return nil
+}
+
+func collectPeers(ch <-chan p2p_peer.AddrInfo) []p2p_peer.ID {
+	ids := []p2p_peer.ID{}
+	for info := range ch {
+		ids = append(ids, info.ID)
+	}
+
+	return ids
+}
+
+func TestMDNSDriver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mn := p2p_mock.New(ctx)
+	peers := testingPeers(t, mn, 3)
+
+	lan := newMockedLAN()
+	drivers := make([]*mdnsDriver, len(peers))
+	for i, h := range peers {
+		drivers[i] = newMDNSDriver(ctx, h, time.Second, lan.newService, lan.newResolver)
+	}
+
+	const testKey = "testkey"
+
+	ttl, err := drivers[0].Advertise(ctx, testKey, p2p_discovery.TTL(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, ttl)
+
+	ttl, err = drivers[1].Advertise(ctx, testKey)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultMDNSTTL, ttl)
+
+	_, err = drivers[2].Advertise(ctx, "otherkey")
+	require.NoError(t, err)
+
+	// only the peers advertising the namespace are found
+	findCtx, findCancel := context.WithTimeout(ctx, time.Millisecond*500)
+	defer findCancel()
+
+	ch, err := drivers[2].FindPeers(findCtx, testKey)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []p2p_peer.ID{peers[0].ID(), peers[1].ID()}, collectPeers(ch))
+
+	// the lookup stops once the limit is reached
+	ch, err = drivers[2].FindPeers(ctx, testKey, p2p_discovery.Limit(1))
+	require.NoError(t, err)
+	assert.Len(t, collectPeers(ch), 1)
+
+	// the services are stopped once unregistered and no lookup is running
+	assert.Equal(t, 3, lan.serviceCount())
+
+	require.NoError(t, drivers[0].Unregister(ctx, testKey))
+	require.NoError(t, drivers[2].Unregister(ctx, "otherkey"))
+	assert.Equal(t, 1, lan.serviceCount())
+
+	findCtx, findCancel = context.WithTimeout(ctx, time.Millisecond*500)
+	defer findCancel()
+
+	ch, err = drivers[2].FindPeers(findCtx, testKey)
+	require.NoError(t, err)
+	assert.Equal(t, []p2p_peer.ID{peers[1].ID()}, collectPeers(ch))
+}
+
+func TestMDNSDriver_LookupDoesNotAdvertise(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mn := p2p_mock.New(ctx)
+	peers := testingPeers(t, mn, 2)
+
+	lan := newMockedLAN()
+	driverA := newMDNSDriver(ctx, peers[0], time.Second, lan.newService, lan.newResolver)
+	driverB := newMDNSDriver(ctx, peers[1], time.Second, lan.newService, lan.newResolver)
+
+	const testKey = "testkey"
+
+	// B looks the namespace up while A is looking it up too
+	lookupCtx, lookupCancel := context.WithCancel(ctx)
+	chB, err := driverB.FindPeers(lookupCtx, testKey)
+	require.NoError(t, err)
+
+	findCtx, findCancel := context.WithTimeout(ctx, time.Millisecond*500)
+	defer findCancel()
+
+	chA, err := driverA.FindPeers(findCtx, testKey)
+	require.NoError(t, err)
+	assert.Empty(t, collectPeers(chA))
+
+	lookupCancel()
+	assert.Empty(t, collectPeers(chB))
+
+	// the resolvers are stopped once the lookups are done
+	assert.Equal(t, 0, lan.serviceCount())
+
+	// once advertised, the namespace is found
+	_, err = driverB.Advertise(ctx, testKey)
+	require.NoError(t, err)
+
+	findCtx, findCancel = context.WithTimeout(ctx, time.Millisecond*500)
+	defer findCancel()
+
+	chA, err = driverA.FindPeers(findCtx, testKey)
+	require.NoError(t, err)
+	assert.Equal(t, []p2p_peer.ID{peers[1].ID()}, collectPeers(chA))
+}
+
+func TestMDNSDriver_MultiDriver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mn := p2p_mock.New(ctx)
+	peers := testingPeers(t, mn, 2)
+
+	ms := NewMockedDriverServer()
+	lan := newMockedLAN()
+
+	// the first peer is only reachable on the LAN
+	mdA := NewMultiDriver(newMDNSDriver(ctx, peers[0], time.Second, lan.newService, lan.newResolver))
+	mdB := NewMultiDriver(
+		NewMockedDriverClient(peers[1], ms),
+		newMDNSDriver(ctx, peers[1], time.Second, lan.newService, lan.newResolver),
+	)
+
+	const testKey = "testkey"
+
+	_, err := mdA.Advertise(ctx, testKey, p2p_discovery.TTL(time.Minute))
+	require.NoError(t, err)
+
+	findCtx, findCancel := context.WithTimeout(ctx, time.Millisecond*500)
+	defer findCancel()
+
+	ch, err := mdB.FindPeers(findCtx, testKey)
+	require.NoError(t, err)
+	assert.Contains(t, collectPeers(ch), peers[0].ID())
+}
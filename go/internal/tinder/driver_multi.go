@@ -9,25 +9,27 @@ import (
 
 	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
 	p2p_peer "github.com/libp2p/go-libp2p-core/peer"
-	disc "github.com/libp2p/go-libp2p-discovery"
 )
 
 // MultiDriver is a simple driver manager, that forward request across multiple driver
 type MultiDriver struct {
 	drivers []Driver
 
-	mapc map[string]context.CancelFunc
+	mapc map[string]*advertisement
 	muc  sync.Mutex
 }
 
 func NewMultiDriver(drivers ...Driver) Driver {
 	return &MultiDriver{
 		drivers: drivers,
-		mapc:    make(map[string]context.CancelFunc),
+		mapc:    make(map[string]*advertisement),
 	}
 }
 
-// Advertise simply dispatch Advertise request across all the drivers
+// Advertise dispatch Advertise request across all the drivers in the
+// background, at the time set by Schedule if any. Each driver advertises
+// again before the returned TTL expires until ns is unregistered or ctx is
+// done, ns can then be advertised again.
 func (md *MultiDriver) Advertise(ctx context.Context, ns string, opts ...p2p_discovery.Option) (time.Duration, error) {
 	// Get options
 	var options p2p_discovery.Options
@@ -43,13 +45,21 @@ func (md *MultiDriver) Advertise(ctx context.Context, ns string, opts ...p2p_dis
 		return 0, fmt.Errorf("already advertising")
 	}
 
-	ctx, cf := context.WithCancel(ctx)
-	md.mapc[ns] = cf
+	a := startAdvertisement(ctx, md.drivers, ns, opts...)
+	md.mapc[ns] = a
 	md.muc.Unlock()
 
-	for _, driver := range md.drivers {
-		disc.Advertise(ctx, driver, ns, opts...)
-	}
+	// forget the advertisement once ctx is done, so ns can be advertised
+	// again
+	go func() {
+		<-a.done
+
+		md.muc.Lock()
+		if md.mapc[ns] == a {
+			delete(md.mapc, ns)
+		}
+		md.muc.Unlock()
+	}()
 
 	return options.Ttl, nil
 }
@@ -118,14 +128,17 @@ func (md *MultiDriver) FindPeers(ctx context.Context, ns string, opts ...p2p_dis
 }
 
 func (md *MultiDriver) Unregister(ctx context.Context, ns string) error {
-	// first cancel advertiser
+	// first stop advertiser, so the drivers won't advertise again once
+	// unregistered
 	md.muc.Lock()
-	if cf, ok := md.mapc[ns]; ok {
-		cf()
-		delete(md.mapc, ns)
-	}
+	a, ok := md.mapc[ns]
+	delete(md.mapc, ns)
 	md.muc.Unlock()
 
+	if ok {
+		a.stop()
+	}
+
 	// unregister drivers
 	for _, driver := range md.drivers {
 		_ = driver.Unregister(ctx, ns) // @TODO(gfanton): log this
@@ -72,6 +72,37 @@ func TestMultiDriver_Advertise(t *testing.T) {
 
 }
 
+func TestMultiDriver_AdvertiseAfterCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ms := NewMockedDriverServer()
+	mn := p2p_mock.New(ctx)
+
+	peers := testingPeers(t, mn, 1)
+	drivers := testingMockedDriverClients(t, ms, peers...)
+	md := NewMultiDriver(drivers...)
+
+	const testKey = "testkey"
+
+	advCtx, advCancel := context.WithCancel(ctx)
+	_, err := md.Advertise(advCtx, testKey, p2p_discovery.TTL(time.Minute))
+	require.NoError(t, err)
+
+	_, err = md.Advertise(ctx, testKey, p2p_discovery.TTL(time.Minute))
+	require.Error(t, err)
+
+	advCancel()
+
+	require.Eventually(t, func() bool {
+		_, err := md.Advertise(ctx, testKey, p2p_discovery.TTL(time.Minute))
+		return err == nil
+	}, time.Second, time.Millisecond*10)
+
+	time.Sleep(time.Millisecond * 100)
+	assert.True(t, ms.HasPeerRecord(testKey, peers[0].ID()))
+}
+
 func TestMultiDriver_FindPeers(t *testing.T) {
 	cases := []struct {
 		Name             string
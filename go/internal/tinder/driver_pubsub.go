@@ -0,0 +1,518 @@
+package tinder
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"sync"
+	"time"
+
+	p2p_crypto "github.com/libp2p/go-libp2p-core/crypto"
+	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
+	p2p_host "github.com/libp2p/go-libp2p-core/host"
+	p2p_peer "github.com/libp2p/go-libp2p-core/peer"
+	p2p_pubsub "github.com/libp2p/go-libp2p-pubsub"
+	ma "github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// DefaultPubsubInterval is the interval between two announcements of an
+	// advertised namespace
+	DefaultPubsubInterval = time.Minute
+
+	// DefaultPubsubTTL is the validity of the announcements when no TTL is
+	// given to Advertise
+	DefaultPubsubTTL = time.Minute * 5
+
+	// pubsubMaxTTL caps the validity of the announcements received
+	pubsubMaxTTL = time.Hour * 2
+
+	// pubsubLookupDuration is the time FindPeers waits for announcements
+	pubsubLookupDuration = time.Second * 5
+
+	// pubsubQueryInterval limits the announcements made to answer queries
+	pubsubQueryInterval = time.Second
+
+	pubsubTopicPrefix     = "/berty/tinder/1.0.0/"
+	pubsubSignaturePrefix = "berty tinder pubsub announcement"
+)
+
+var _ Driver = (*pubsubDriver)(nil)
+
+// pubsubDriver advertises and finds peers of a namespace using signed
+// announcements published on a pubsub topic derived from it
+type pubsubDriver struct {
+	rootCtx  context.Context
+	host     p2p_host.Host
+	sk       p2p_crypto.PrivKey
+	ps       *p2p_pubsub.PubSub
+	interval time.Duration
+
+	mu     sync.Mutex
+	topics map[string]*pubsubTopic
+}
+
+// pubsubTopic is the subscription to the topic of a namespace, it is kept
+// while the namespace is advertised or looked up
+type pubsubTopic struct {
+	name    string
+	sub     *p2p_pubsub.Subscription
+	cancel  context.CancelFunc
+	lookups int
+
+	// stopAnnounce stops the periodic announcements, nil when the namespace
+	// isn't advertised
+	stopAnnounce context.CancelFunc
+
+	mu           sync.Mutex
+	ttl          time.Duration
+	lastAnnounce time.Time
+	peers        map[p2p_peer.ID]*pubsubRecord
+	subs         map[chan p2p_peer.AddrInfo]struct{}
+}
+
+// pubsubRecord is the last announcement of a peer, the record of a peer
+// which has stopped advertising the namespace is kept until older
+// announcements have expired
+type pubsubRecord struct {
+	info      p2p_peer.AddrInfo
+	timestamp int64
+	expire    time.Time
+	removed   bool
+}
+
+// NewPubsubDriver returns a driver announcing the advertised namespaces on
+// pubsub every interval, the subscriptions are canceled once ctx is done
+func NewPubsubDriver(ctx context.Context, h p2p_host.Host, ps *p2p_pubsub.PubSub, interval time.Duration) (Driver, error) {
+	sk := h.Peerstore().PrivKey(h.ID())
+	if sk == nil {
+		return nil, fmt.Errorf("unable to get the private key of the host")
+	}
+
+	if interval == 0 {
+		interval = DefaultPubsubInterval
+	}
+
+	return &pubsubDriver{
+		rootCtx:  ctx,
+		host:     h,
+		sk:       sk,
+		ps:       ps,
+		interval: interval,
+		topics:   make(map[string]*pubsubTopic),
+	}, nil
+}
+
+// pubsubTopicName returns the topic of a namespace
+func pubsubTopicName(ns string) string {
+	sum := sha256.Sum256([]byte(ns))
+	return fmt.Sprintf("%s%x", pubsubTopicPrefix, sum)
+}
+
+func (d *pubsubDriver) Advertise(ctx context.Context, ns string, opts ...p2p_discovery.Option) (time.Duration, error) {
+	// Get options
+	var options p2p_discovery.Options
+	err := options.Apply(opts...)
+	if err != nil {
+		return 0, err
+	}
+
+	ttl := options.Ttl
+	if ttl == 0 {
+		ttl = DefaultPubsubTTL
+	}
+
+	d.mu.Lock()
+	t, err := d.getTopic(ns)
+	if err != nil {
+		d.mu.Unlock()
+		return 0, err
+	}
+
+	t.mu.Lock()
+	t.ttl = ttl
+	t.mu.Unlock()
+
+	if t.stopAnnounce == nil {
+		var announceCtx context.Context
+		announceCtx, t.stopAnnounce = context.WithCancel(d.rootCtx)
+
+		go d.announceLoop(announceCtx, t)
+	}
+	d.mu.Unlock()
+
+	if err := d.announce(t, ttl); err != nil {
+		return 0, err
+	}
+
+	return ttl, nil
+}
+
+func (d *pubsubDriver) FindPeers(ctx context.Context, ns string, opts ...p2p_discovery.Option) (<-chan p2p_peer.AddrInfo, error) {
+	// Get options
+	var options p2p_discovery.Options
+	err := options.Apply(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	t, err := d.getTopic(ns)
+	if err != nil {
+		d.mu.Unlock()
+		return nil, err
+	}
+
+	t.lookups++
+	d.mu.Unlock()
+
+	// peers announced during the lookup are sent along the cached ones
+	sub := make(chan p2p_peer.AddrInfo, 16)
+	known := t.subscribe(sub)
+
+	// ask the peers advertising the namespace to announce themselves
+	if query, err := (&PubsubAnnouncement{Query: true}).Marshal(); err == nil {
+		_ = d.ps.Publish(t.name, query)
+	}
+
+	cpeers := make(chan p2p_peer.AddrInfo, len(known))
+	go func() {
+		defer close(cpeers)
+		defer d.endLookup(ns, t, sub)
+
+		ctx, cancel := context.WithTimeout(ctx, pubsubLookupDuration)
+		defer cancel()
+
+		sent := make(map[p2p_peer.ID]struct{})
+		send := func(info p2p_peer.AddrInfo) bool {
+			if _, ok := sent[info.ID]; ok {
+				return true
+			}
+
+			select {
+			case cpeers <- info:
+			case <-ctx.Done():
+				return false
+			}
+
+			sent[info.ID] = struct{}{}
+			return options.Limit == 0 || len(sent) < options.Limit
+		}
+
+		for _, info := range known {
+			if !send(info) {
+				return
+			}
+		}
+
+		for {
+			select {
+			case info := <-sub:
+				if !send(info) {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return cpeers, nil
+}
+
+// Unregister stops announcing the namespace and lets the other peers know
+// it isn't advertised anymore
+func (d *pubsubDriver) Unregister(ctx context.Context, ns string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	t, ok := d.topics[ns]
+	if !ok || t.stopAnnounce == nil {
+		return nil
+	}
+
+	t.stopAnnounce()
+	t.stopAnnounce = nil
+
+	err := d.announce(t, 0)
+
+	d.releaseTopic(ns, t)
+
+	return err
+}
+
+// getTopic returns the topic of a namespace, it is subscribed to if needed,
+// d.mu must be held
+func (d *pubsubDriver) getTopic(ns string) (*pubsubTopic, error) {
+	if t, ok := d.topics[ns]; ok {
+		return t, nil
+	}
+
+	name := pubsubTopicName(ns)
+
+	sub, err := d.ps.Subscribe(name)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(d.rootCtx)
+
+	t := &pubsubTopic{
+		name:   name,
+		sub:    sub,
+		cancel: cancel,
+		peers:  make(map[p2p_peer.ID]*pubsubRecord),
+		subs:   make(map[chan p2p_peer.AddrInfo]struct{}),
+	}
+
+	d.topics[ns] = t
+
+	go d.readLoop(ctx, t)
+
+	return t, nil
+}
+
+// releaseTopic cancels the subscription to the topic of a namespace if it
+// isn't used anymore, d.mu must be held
+func (d *pubsubDriver) releaseTopic(ns string, t *pubsubTopic) {
+	if t.stopAnnounce != nil || t.lookups > 0 {
+		return
+	}
+
+	t.cancel()
+	t.sub.Cancel()
+
+	delete(d.topics, ns)
+}
+
+func (d *pubsubDriver) endLookup(ns string, t *pubsubTopic, sub chan p2p_peer.AddrInfo) {
+	t.unsubscribe(sub)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	t.lookups--
+	d.releaseTopic(ns, t)
+}
+
+func (d *pubsubDriver) announceLoop(ctx context.Context, t *pubsubTopic) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			t.mu.Lock()
+			ttl := t.ttl
+			t.mu.Unlock()
+
+			_ = d.announce(t, ttl)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// announce publishes a signed announcement of the host on the topic, a zero
+// ttl lets the other peers know the namespace isn't advertised anymore
+func (d *pubsubDriver) announce(t *pubsubTopic, ttl time.Duration) error {
+	pk, err := p2p_crypto.MarshalPublicKey(d.sk.GetPublic())
+	if err != nil {
+		return err
+	}
+
+	addrs := d.host.Addrs()
+	ann := &PubsubAnnouncement{
+		PublicKey: pk,
+		Addrs:     make([][]byte, len(addrs)),
+		Timestamp: time.Now().UnixNano(),
+		Ttl:       int64(ttl / time.Second),
+	}
+
+	for i, addr := range addrs {
+		ann.Addrs[i] = addr.Bytes()
+	}
+
+	signed, err := pubsubSignedValue(t.name, ann)
+	if err != nil {
+		return err
+	}
+
+	if ann.Signature, err = d.sk.Sign(signed); err != nil {
+		return err
+	}
+
+	data, err := ann.Marshal()
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	t.lastAnnounce = time.Now()
+	t.mu.Unlock()
+
+	return d.ps.Publish(t.name, data)
+}
+
+// answerQuery announces the host if the namespace is advertised and no
+// announcement has just been made
+func (d *pubsubDriver) answerQuery(t *pubsubTopic) {
+	d.mu.Lock()
+	advertised := t.stopAnnounce != nil
+	d.mu.Unlock()
+
+	t.mu.Lock()
+	ttl := t.ttl
+	recent := time.Since(t.lastAnnounce) < pubsubQueryInterval
+	t.mu.Unlock()
+
+	if advertised && !recent {
+		_ = d.announce(t, ttl)
+	}
+}
+
+func (d *pubsubDriver) readLoop(ctx context.Context, t *pubsubTopic) {
+	for {
+		msg, err := t.sub.Next(ctx)
+		if err != nil {
+			return
+		}
+
+		if p2p_peer.ID(msg.GetFrom()) == d.host.ID() {
+			continue
+		}
+
+		ann := &PubsubAnnouncement{}
+		if err := ann.Unmarshal(msg.Data); err != nil {
+			continue
+		}
+
+		if ann.Query {
+			d.answerQuery(t)
+			continue
+		}
+
+		info, err := checkPubsubAnnouncement(t.name, ann)
+		if err != nil || info.ID == d.host.ID() {
+			continue
+		}
+
+		t.handleAnnouncement(info, ann)
+	}
+}
+
+func pubsubSignedValue(topic string, ann *PubsubAnnouncement) ([]byte, error) {
+	unsigned := *ann
+	unsigned.Signature = nil
+
+	data, err := unsigned.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	value := append([]byte(pubsubSignaturePrefix), []byte(topic)...)
+
+	return append(value, data...), nil
+}
+
+// checkPubsubAnnouncement ensures the announcement has been signed by the
+// announced peer for the topic and returns the peer
+func checkPubsubAnnouncement(topic string, ann *PubsubAnnouncement) (p2p_peer.AddrInfo, error) {
+	pk, err := p2p_crypto.UnmarshalPublicKey(ann.PublicKey)
+	if err != nil {
+		return p2p_peer.AddrInfo{}, err
+	}
+
+	signed, err := pubsubSignedValue(topic, ann)
+	if err != nil {
+		return p2p_peer.AddrInfo{}, err
+	}
+
+	if ok, err := pk.Verify(signed, ann.Signature); err != nil || !ok {
+		return p2p_peer.AddrInfo{}, fmt.Errorf("invalid announcement signature")
+	}
+
+	id, err := p2p_peer.IDFromPublicKey(pk)
+	if err != nil {
+		return p2p_peer.AddrInfo{}, err
+	}
+
+	info := p2p_peer.AddrInfo{ID: id}
+	for _, b := range ann.Addrs {
+		if addr, err := ma.NewMultiaddrBytes(b); err == nil {
+			info.Addrs = append(info.Addrs, addr)
+		}
+	}
+
+	return info, nil
+}
+
+// handleAnnouncement updates the cache, announcements older than the cached
+// one or already expired are ignored so they can't be replayed
+func (t *pubsubTopic) handleAnnouncement(info p2p_peer.AddrInfo, ann *PubsubAnnouncement) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if rec, ok := t.peers[info.ID]; ok && rec.timestamp >= ann.Timestamp {
+		return
+	}
+
+	ttl := time.Duration(ann.Ttl) * time.Second
+	if ttl > pubsubMaxTTL {
+		ttl = pubsubMaxTTL
+	}
+
+	expire := time.Unix(0, ann.Timestamp).Add(ttl)
+	if max := time.Now().Add(pubsubMaxTTL); expire.After(max) {
+		expire = max
+	}
+
+	if ttl <= 0 {
+		t.peers[info.ID] = &pubsubRecord{timestamp: ann.Timestamp, expire: time.Now().Add(pubsubMaxTTL), removed: true}
+		return
+	}
+
+	if expire.Before(time.Now()) {
+		return
+	}
+
+	t.peers[info.ID] = &pubsubRecord{info: info, timestamp: ann.Timestamp, expire: expire}
+
+	for sub := range t.subs {
+		select {
+		case sub <- info:
+		default: // the lookup is lagging, the peer will be announced again
+		}
+	}
+}
+
+// subscribe registers a channel receiving the peers announced from now on
+// and returns the cached ones which haven't expired
+func (t *pubsubTopic) subscribe(sub chan p2p_peer.AddrInfo) []p2p_peer.AddrInfo {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.subs[sub] = struct{}{}
+
+	now := time.Now()
+	known := []p2p_peer.AddrInfo{}
+	for id, rec := range t.peers {
+		if rec.expire.Before(now) {
+			delete(t.peers, id)
+			continue
+		}
+
+		if !rec.removed {
+			known = append(known, rec.info)
+		}
+	}
+
+	return known
+}
+
+func (t *pubsubTopic) unsubscribe(sub chan p2p_peer.AddrInfo) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.subs, sub)
+}
@@ -0,0 +1,156 @@
+package tinder
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	p2p_crypto "github.com/libp2p/go-libp2p-core/crypto"
+	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
+	p2p_peer "github.com/libp2p/go-libp2p-core/peer"
+	p2p_pubsub "github.com/libp2p/go-libp2p-pubsub"
+	p2p_mock "github.com/libp2p/go-libp2p/p2p/net/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testingPubsubDrivers(ctx context.Context, t *testing.T, mn p2p_mock.Mocknet, n int) ([]p2p_peer.ID, []Driver) {
+	t.Helper()
+
+	peers := testingPeers(t, mn, n)
+
+	ids := make([]p2p_peer.ID, n)
+	drivers := make([]Driver, n)
+	for i, h := range peers {
+		ps, err := p2p_pubsub.NewFloodSub(ctx, h)
+		require.NoError(t, err)
+
+		drivers[i], err = NewPubsubDriver(ctx, h, ps, time.Millisecond*100)
+		require.NoError(t, err)
+
+		ids[i] = h.ID()
+	}
+
+	require.NoError(t, mn.ConnectAllButSelf())
+
+	return ids, drivers
+}
+
+func findPeersFor(ctx context.Context, t *testing.T, d Driver, ns string, timeout time.Duration) []p2p_peer.ID {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ch, err := d.FindPeers(ctx, ns)
+	require.NoError(t, err)
+
+	return collectPeers(ch)
+}
+
+func TestPubsubDriver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mn := p2p_mock.New(ctx)
+	ids, drivers := testingPubsubDrivers(ctx, t, mn, 3)
+
+	const testKey = "testkey"
+
+	ttl, err := drivers[0].Advertise(ctx, testKey, p2p_discovery.TTL(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, ttl)
+
+	_, err = drivers[1].Advertise(ctx, testKey)
+	require.NoError(t, err)
+
+	_, err = drivers[2].Advertise(ctx, "otherkey")
+	require.NoError(t, err)
+
+	// only the peers advertising the namespace are found
+	found := findPeersFor(ctx, t, drivers[2], testKey, time.Second)
+	assert.ElementsMatch(t, []p2p_peer.ID{ids[0], ids[1]}, found)
+
+	found = findPeersFor(ctx, t, drivers[0], testKey, time.Second)
+	assert.Equal(t, []p2p_peer.ID{ids[1]}, found)
+
+	// the peers are dropped from the cache once unregistered
+	require.NoError(t, drivers[0].Unregister(ctx, testKey))
+	time.Sleep(time.Millisecond * 300)
+
+	found = findPeersFor(ctx, t, drivers[1], testKey, time.Millisecond*500)
+	assert.Empty(t, found)
+}
+
+func TestCheckPubsubAnnouncement(t *testing.T) {
+	sk, pk, err := p2p_crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	otherSK, _, err := p2p_crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	id, err := p2p_peer.IDFromPublicKey(pk)
+	require.NoError(t, err)
+
+	pkBytes, err := p2p_crypto.MarshalPublicKey(pk)
+	require.NoError(t, err)
+
+	topic := pubsubTopicName("testkey")
+
+	sign := func(ann *PubsubAnnouncement, sk p2p_crypto.PrivKey, topic string) *PubsubAnnouncement {
+		signed, err := pubsubSignedValue(topic, ann)
+		require.NoError(t, err)
+
+		ann.Signature, err = sk.Sign(signed)
+		require.NoError(t, err)
+
+		return ann
+	}
+
+	ann := sign(&PubsubAnnouncement{PublicKey: pkBytes, Timestamp: time.Now().UnixNano(), Ttl: 60}, sk, topic)
+	info, err := checkPubsubAnnouncement(topic, ann)
+	require.NoError(t, err)
+	assert.Equal(t, id, info.ID)
+
+	// the announcement can't be replayed on another topic
+	_, err = checkPubsubAnnouncement(pubsubTopicName("otherkey"), ann)
+	assert.Error(t, err)
+
+	// the announcement can't be altered
+	ann.Ttl = 3600
+	_, err = checkPubsubAnnouncement(topic, ann)
+	assert.Error(t, err)
+
+	// the announcement must be signed by the announced peer
+	ann = sign(&PubsubAnnouncement{PublicKey: pkBytes, Timestamp: time.Now().UnixNano(), Ttl: 60}, otherSK, topic)
+	_, err = checkPubsubAnnouncement(topic, ann)
+	assert.Error(t, err)
+}
+
+func TestPubsubTopic_handleAnnouncement(t *testing.T) {
+	topic := &pubsubTopic{
+		peers: make(map[p2p_peer.ID]*pubsubRecord),
+		subs:  make(map[chan p2p_peer.AddrInfo]struct{}),
+	}
+
+	info := p2p_peer.AddrInfo{ID: p2p_peer.ID("peer")}
+	now := time.Now()
+
+	topic.handleAnnouncement(info, &PubsubAnnouncement{Timestamp: now.UnixNano(), Ttl: 60})
+	assert.Len(t, topic.subscribe(make(chan p2p_peer.AddrInfo)), 1)
+
+	// the peer has stopped advertising the namespace
+	topic.handleAnnouncement(info, &PubsubAnnouncement{Timestamp: now.Add(time.Second).UnixNano()})
+	assert.Len(t, topic.subscribe(make(chan p2p_peer.AddrInfo)), 0)
+
+	// older announcements are ignored
+	topic.handleAnnouncement(info, &PubsubAnnouncement{Timestamp: now.UnixNano(), Ttl: 60})
+	assert.Len(t, topic.subscribe(make(chan p2p_peer.AddrInfo)), 0)
+
+	// expired announcements are ignored
+	other := p2p_peer.AddrInfo{ID: p2p_peer.ID("other")}
+	topic.handleAnnouncement(other, &PubsubAnnouncement{Timestamp: now.Add(-time.Minute * 2).UnixNano(), Ttl: 60})
+	assert.Len(t, topic.subscribe(make(chan p2p_peer.AddrInfo)), 0)
+}
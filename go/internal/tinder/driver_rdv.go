@@ -5,8 +5,10 @@ package tinder
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"math/rand"
+	"sort"
 	"sync"
 	"time"
 
@@ -17,18 +19,38 @@ import (
 	p2p_rp "github.com/libp2p/go-libp2p-rendezvous"
 )
 
+const (
+	// rdvBackoffBase is the time a rendezvous point is skipped after a
+	// failure, it doubles with each consecutive failure
+	rdvBackoffBase = time.Second * 5
+
+	// rdvBackoffMax caps the time a rendezvous point is skipped
+	rdvBackoffMax = time.Minute * 5
+)
+
 type rendezvousDiscovery struct {
-	rp           p2p_rp.RendezvousPoint
+	points       []*rdvPoint
 	peerCache    map[string]*rpCache
 	peerCacheMux sync.RWMutex
 	rng          *rand.Rand
 	rngMux       sync.Mutex
 }
 
+// rdvPoint is a rendezvous point along its health, failing points are
+// skipped until their backoff is over
+type rdvPoint struct {
+	id peer.ID
+	rp p2p_rp.RendezvousPoint
+
+	mux          sync.Mutex
+	failures     int
+	backoffUntil time.Time
+}
+
 type rpCache struct {
-	recs   map[peer.ID]*rpRecord
-	cookie []byte
-	mux    sync.Mutex
+	recs    map[peer.ID]*rpRecord
+	cookies map[peer.ID][]byte
+	mux     sync.Mutex
 }
 
 type rpRecord struct {
@@ -37,14 +59,86 @@ type rpRecord struct {
 }
 
 func NewRendezvousDiscovery(host host.Host, rdvPeer peer.ID, rng *rand.Rand) Driver {
-	rp := p2p_rp.NewRendezvousPoint(host, rdvPeer)
+	return NewMultiRendezvousDiscovery(host, []peer.ID{rdvPeer}, rng)
+}
+
+// NewMultiRendezvousDiscovery returns a driver registering with all the
+// rendezvous points, the discovery is balanced across the healthy ones and
+// fails over to the next one when a point fails
+func NewMultiRendezvousDiscovery(host host.Host, rdvPeers []peer.ID, rng *rand.Rand) Driver {
+	points := make([]*rdvPoint, len(rdvPeers))
+	for i, rdvPeer := range rdvPeers {
+		points[i] = &rdvPoint{id: rdvPeer, rp: p2p_rp.NewRendezvousPoint(host, rdvPeer)}
+	}
+
+	return newRendezvousDiscovery(points, rng)
+}
+
+func newRendezvousDiscovery(points []*rdvPoint, rng *rand.Rand) *rendezvousDiscovery {
 	return &rendezvousDiscovery{
-		rp:        rp,
+		points:    points,
 		rng:       rng,
 		peerCache: make(map[string]*rpCache),
 	}
 }
 
+func (p *rdvPoint) healthy(now time.Time) bool {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	return !now.Before(p.backoffUntil)
+}
+
+// report updates the health of the point with the result of a request
+func (p *rdvPoint) report(err error) {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	if err == nil {
+		p.failures = 0
+		p.backoffUntil = time.Time{}
+		return
+	}
+
+	backoff := rdvBackoffMax
+	if p.failures < 16 && rdvBackoffBase<<uint(p.failures) < backoff {
+		backoff = rdvBackoffBase << uint(p.failures)
+	}
+
+	p.failures++
+	p.backoffUntil = time.Now().Add(backoff)
+}
+
+func (p *rdvPoint) backoff() time.Time {
+	p.mux.Lock()
+	defer p.mux.Unlock()
+
+	return p.backoffUntil
+}
+
+// orderedPoints returns the healthy points in a random order to balance the
+// load, followed by the failing ones, the soonest to recover first
+func (c *rendezvousDiscovery) orderedPoints() []*rdvPoint {
+	now := time.Now()
+
+	var healthy, failing []*rdvPoint
+	for _, p := range c.points {
+		if p.healthy(now) {
+			healthy = append(healthy, p)
+		} else {
+			failing = append(failing, p)
+		}
+	}
+
+	c.rngMux.Lock()
+	c.rng.Shuffle(len(healthy), func(i, j int) { healthy[i], healthy[j] = healthy[j], healthy[i] })
+	c.rngMux.Unlock()
+
+	sort.Slice(failing, func(i, j int) bool { return failing[i].backoff().Before(failing[j].backoff()) })
+
+	return append(healthy, failing...)
+}
+
 func (c *rendezvousDiscovery) Advertise(ctx context.Context, ns string, opts ...discovery.Option) (time.Duration, error) {
 	// Get options
 	var options discovery.Options
@@ -62,12 +156,51 @@ func (c *rendezvousDiscovery) Advertise(ctx context.Context, ns string, opts ...
 		ttlSeconds = int(math.Round(ttl.Seconds()))
 	}
 
-	rttl, err := c.rp.Register(ctx, ns, ttlSeconds)
-	if err != nil {
-		return 0, err
+	if len(c.points) == 0 {
+		return 0, fmt.Errorf("no rendezvous point")
+	}
+
+	type result struct {
+		ttl time.Duration
+		err error
+	}
+
+	// register with every point so the namespace can be found even if some
+	// of them fail
+	results := make(chan result, len(c.points))
+	for _, p := range c.points {
+		go func(p *rdvPoint) {
+			rttl, err := p.rp.Register(ctx, ns, ttlSeconds)
+			p.report(err)
+			results <- result{rttl, err}
+		}(p)
+	}
+
+	var (
+		minTTL  time.Duration
+		lastErr error
+		ok      bool
+	)
+
+	for range c.points {
+		res := <-results
+		if res.err != nil {
+			lastErr = res.err
+			continue
+		}
+
+		if !ok || res.ttl < minTTL {
+			minTTL = res.ttl
+		}
+
+		ok = true
 	}
 
-	return rttl, nil
+	if !ok {
+		return 0, lastErr
+	}
+
+	return minTTL, nil
 }
 
 func (c *rendezvousDiscovery) FindPeers(ctx context.Context, ns string, opts ...discovery.Option) (<-chan peer.AddrInfo, error) {
@@ -94,7 +227,7 @@ func (c *rendezvousDiscovery) FindPeers(ctx context.Context, ns string, opts ...
 		c.peerCacheMux.Lock()
 		cache, ok = c.peerCache[ns]
 		if !ok {
-			cache = &rpCache{recs: make(map[peer.ID]*rpRecord)}
+			cache = &rpCache{recs: make(map[peer.ID]*rpRecord), cookies: make(map[peer.ID][]byte)}
 			c.peerCache[ns] = cache
 		}
 		c.peerCacheMux.Unlock()
@@ -115,19 +248,30 @@ func (c *rendezvousDiscovery) FindPeers(ctx context.Context, ns string, opts ...
 		}
 	}
 
-	cookie := cache.cookie
-
-	// Discover new records if we don't have enough
+	// Discover new records if we don't have enough, the points are tried
+	// until one of them answers, the records are merged in the cache
 	if newCacheSize < limit {
 		// TODO: Should we return error even if we have valid cached results?
-		var regs []p2p_rp.Registration
-		var newCookie []byte
-		if regs, newCookie, err = c.rp.Discover(ctx, ns, limit, cookie); err == nil {
+		err = fmt.Errorf("no rendezvous point")
+
+		for _, p := range c.orderedPoints() {
+			var regs []p2p_rp.Registration
+			var newCookie []byte
+
+			regs, newCookie, err = p.rp.Discover(ctx, ns, limit, cache.cookies[p.id])
+			p.report(err)
+
+			if err != nil {
+				continue
+			}
+
 			for _, reg := range regs {
 				rec := &rpRecord{peer: reg.Peer, expire: int64(reg.Ttl) + currentTime}
 				cache.recs[rec.peer.ID] = rec
 			}
-			cache.cookie = newCookie
+			cache.cookies[p.id] = newCookie
+
+			break
 		}
 	}
 
@@ -165,6 +309,22 @@ func (c *rendezvousDiscovery) FindPeers(ctx context.Context, ns string, opts ...
 	return chPeer, err
 }
 
+// Unregister unregisters the namespace from every point, it only fails if
+// all of them failed
 func (c *rendezvousDiscovery) Unregister(ctx context.Context, ns string) error {
-	return c.rp.Unregister(ctx, ns)
+	var lastErr error
+
+	failures := 0
+	for _, p := range c.points {
+		if err := p.rp.Unregister(ctx, ns); err != nil {
+			lastErr = err
+			failures++
+		}
+	}
+
+	if failures > 0 && failures == len(c.points) {
+		return lastErr
+	}
+
+	return nil
 }
@@ -0,0 +1,167 @@
+package tinder
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
+	p2p_peer "github.com/libp2p/go-libp2p-core/peer"
+	p2p_rp "github.com/libp2p/go-libp2p-rendezvous"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockedRendezvousPoint is a rendezvous point which can be made unreachable
+type mockedRendezvousPoint struct {
+	p2p_rp.RendezvousPoint
+
+	mu        sync.Mutex
+	down      bool
+	regs      map[string][]p2p_rp.Registration
+	discovers int
+}
+
+func newMockedRendezvousPoint(regs map[string][]p2p_rp.Registration) *mockedRendezvousPoint {
+	if regs == nil {
+		regs = make(map[string][]p2p_rp.Registration)
+	}
+
+	return &mockedRendezvousPoint{regs: regs}
+}
+
+func (p *mockedRendezvousPoint) setDown(down bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.down = down
+}
+
+func (p *mockedRendezvousPoint) Register(ctx context.Context, ns string, ttl int) (time.Duration, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.down {
+		return 0, fmt.Errorf("unreachable")
+	}
+
+	return time.Duration(ttl) * time.Second, nil
+}
+
+func (p *mockedRendezvousPoint) Unregister(ctx context.Context, ns string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.down {
+		return fmt.Errorf("unreachable")
+	}
+
+	return nil
+}
+
+func (p *mockedRendezvousPoint) Discover(ctx context.Context, ns string, limit int, cookie []byte) ([]p2p_rp.Registration, []byte, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.discovers++
+
+	if p.down {
+		return nil, nil, fmt.Errorf("unreachable")
+	}
+
+	return p.regs[ns], cookie, nil
+}
+
+func testingRendezvousDiscovery(mocked ...*mockedRendezvousPoint) *rendezvousDiscovery {
+	points := make([]*rdvPoint, len(mocked))
+	for i, m := range mocked {
+		points[i] = &rdvPoint{id: p2p_peer.ID(fmt.Sprintf("rdvp-%d", i)), rp: m}
+	}
+
+	return newRendezvousDiscovery(points, rand.New(rand.NewSource(42)))
+}
+
+func TestRendezvousDiscovery_Failover(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	const testKey = "testkey"
+
+	peerA := p2p_peer.AddrInfo{ID: p2p_peer.ID("peer-a")}
+	peerB := p2p_peer.AddrInfo{ID: p2p_peer.ID("peer-b")}
+
+	rdvp1 := newMockedRendezvousPoint(map[string][]p2p_rp.Registration{
+		testKey: {{Peer: peerA, Ns: testKey, Ttl: 60}},
+	})
+	rdvp2 := newMockedRendezvousPoint(map[string][]p2p_rp.Registration{
+		testKey: {{Peer: peerB, Ns: testKey, Ttl: 60}},
+	})
+
+	d := testingRendezvousDiscovery(rdvp1, rdvp2)
+
+	// the registration succeeds as long as one point is reachable
+	rdvp1.setDown(true)
+
+	ttl, err := d.Advertise(ctx, testKey, p2p_discovery.TTL(time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, ttl)
+	assert.False(t, d.points[0].healthy(time.Now()))
+	assert.True(t, d.points[1].healthy(time.Now()))
+
+	require.NoError(t, d.Unregister(ctx, testKey))
+
+	// the discovery fails over to the reachable point
+	ch, err := d.FindPeers(ctx, testKey)
+	require.NoError(t, err)
+	assert.Equal(t, []p2p_peer.ID{peerB.ID}, collectPeers(ch))
+
+	// the failing point is skipped until its backoff is over
+	discovers := rdvp1.discovers
+	ch, err = d.FindPeers(ctx, testKey)
+	require.NoError(t, err)
+	collectPeers(ch)
+	assert.Equal(t, discovers, rdvp1.discovers)
+
+	// once recovered, the results of both points are merged in the cache
+	rdvp1.setDown(false)
+	d.points[0].report(nil)
+	rdvp2.setDown(true)
+
+	ch, err = d.FindPeers(ctx, testKey)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []p2p_peer.ID{peerA.ID, peerB.ID}, collectPeers(ch))
+
+	// every point is unreachable
+	rdvp1.setDown(true)
+
+	_, err = d.Advertise(ctx, testKey)
+	assert.Error(t, err)
+	assert.Error(t, d.Unregister(ctx, testKey))
+}
+
+func TestRdvPoint_report(t *testing.T) {
+	p := &rdvPoint{}
+	now := time.Now()
+
+	assert.True(t, p.healthy(now))
+
+	p.report(fmt.Errorf("unreachable"))
+	assert.False(t, p.healthy(now))
+	first := p.backoff()
+
+	// the backoff grows with consecutive failures
+	p.report(fmt.Errorf("unreachable"))
+	assert.True(t, p.backoff().After(first.Add(rdvBackoffBase/2)))
+
+	for i := 0; i < 20; i++ {
+		p.report(fmt.Errorf("unreachable"))
+	}
+	assert.True(t, p.backoff().Before(time.Now().Add(rdvBackoffMax+time.Second)))
+
+	p.report(nil)
+	assert.True(t, p.healthy(time.Now()))
+}
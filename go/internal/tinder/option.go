@@ -11,7 +11,17 @@ const ScheduleKey = "Schedule"
 // Schedule advertise at the given time
 func Schedule(t time.Time) p2p_discovery.Option {
 	return func(opts *p2p_discovery.Options) error {
+		if opts.Other == nil {
+			opts.Other = make(map[interface{}]interface{})
+		}
+
 		opts.Other[ScheduleKey] = t
 		return nil
 	}
 }
+
+// scheduleFromOptions returns the time set by Schedule, zero if none
+func scheduleFromOptions(opts p2p_discovery.Options) time.Time {
+	t, _ := opts.Other[ScheduleKey].(time.Time)
+	return t
+}
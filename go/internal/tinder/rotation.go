@@ -0,0 +1,208 @@
+package tinder
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
+	p2p_peer "github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	// DefaultRotationPeriod is the duration of an epoch, the namespaces of
+	// a rendezvous seed change at each epoch boundary
+	DefaultRotationPeriod = time.Hour * 24
+
+	// rotationUnregisterTimeout is the maximum duration of the unregistration
+	// of a namespace once its epoch is over
+	rotationUnregisterTimeout = time.Second * 10
+)
+
+// RotationPoint derives the namespaces of a rendezvous seed, a namespace is
+// only used during an epoch so an observer of the discovery drivers can't
+// track the seed over a longer period
+type RotationPoint struct {
+	seed   []byte
+	period time.Duration
+}
+
+// NewRotationPoint returns the rotation point of a seed, the peers
+// advertising and looking for the seed must use the same period
+func NewRotationPoint(seed []byte, period time.Duration) *RotationPoint {
+	if period <= 0 {
+		period = DefaultRotationPeriod
+	}
+
+	return &RotationPoint{
+		seed:   seed,
+		period: period,
+	}
+}
+
+// Epoch returns the epoch of the given time
+func (p *RotationPoint) Epoch(t time.Time) int64 {
+	return t.UnixNano() / int64(p.period)
+}
+
+// Namespace returns the namespace of an epoch, HMAC(seed, epoch)
+func (p *RotationPoint) Namespace(epoch int64) string {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(epoch))
+
+	mac := hmac.New(sha256.New, p.seed)
+	_, _ = mac.Write(buf[:])
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NamespaceAt returns the namespace to advertise at the given time
+func (p *RotationPoint) NamespaceAt(t time.Time) string {
+	return p.Namespace(p.Epoch(t))
+}
+
+// NextRotation returns the start of the epoch following the given time
+func (p *RotationPoint) NextRotation(t time.Time) time.Time {
+	return time.Unix(0, (p.Epoch(t)+1)*int64(p.period))
+}
+
+// LookupNamespaces returns the namespaces to look up at the given time, the
+// namespace of the closest adjacent epoch is included to tolerate clock
+// skew between the peers
+func (p *RotationPoint) LookupNamespaces(t time.Time) []string {
+	epoch := p.Epoch(t)
+
+	adjacent := epoch + 1
+	if t.Sub(time.Unix(0, epoch*int64(p.period))) < p.period/2 {
+		adjacent = epoch - 1
+	}
+
+	return []string{p.Namespace(epoch), p.Namespace(adjacent)}
+}
+
+// AdvertiseRotation advertises the namespace of the current epoch until ctx
+// is done, it is replaced at each epoch boundary, the previous one being
+// unregistered. Each namespace is advertised once on a MultiDriver, d is
+// wrapped in one if needed, which advertises it again before the TTL returned
+// by the drivers expires. Failures are reported to onError, if not nil, and
+// retried after retry.
+func AdvertiseRotation(ctx context.Context, d Driver, p *RotationPoint, retry time.Duration, onError func(error), opts ...p2p_discovery.Option) {
+	if _, ok := d.(*MultiDriver); !ok {
+		d = NewMultiDriver(d)
+	}
+
+	ns := ""
+
+	unregister := func(ns string) {
+		ctx, cancel := context.WithTimeout(context.Background(), rotationUnregisterTimeout)
+		defer cancel()
+
+		if err := d.Unregister(ctx, ns); err != nil && onError != nil {
+			onError(err)
+		}
+	}
+
+	defer func() {
+		if ns != "" {
+			unregister(ns)
+		}
+	}()
+
+	for {
+		now := time.Now()
+
+		// the namespace of the next epoch is advertised at its start
+		wait := p.NextRotation(now).Sub(now)
+
+		if current := p.NamespaceAt(now); current != ns {
+			if ns != "" {
+				go unregister(ns)
+				ns = ""
+			}
+
+			if _, err := d.Advertise(ctx, current, opts...); err != nil {
+				if onError != nil && ctx.Err() == nil {
+					onError(err)
+				}
+
+				if retry < wait {
+					wait = retry
+				}
+			} else {
+				ns = current
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// FindPeersRotation looks up the namespaces of the rotation point, see
+// LookupNamespaces, the peers found on both are only sent once
+func FindPeersRotation(ctx context.Context, d Driver, p *RotationPoint, opts ...p2p_discovery.Option) (<-chan p2p_peer.AddrInfo, error) {
+	var (
+		chans   []<-chan p2p_peer.AddrInfo
+		lastErr error
+	)
+
+	for _, ns := range p.LookupNamespaces(time.Now()) {
+		ch, err := d.FindPeers(ctx, ns, opts...)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		chans = append(chans, ch)
+	}
+
+	if len(chans) == 0 {
+		return nil, lastErr
+	}
+
+	cpeers := make(chan p2p_peer.AddrInfo)
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = make(map[p2p_peer.ID]struct{})
+	)
+
+	wg.Add(len(chans))
+	for _, ch := range chans {
+		go func(ch <-chan p2p_peer.AddrInfo) {
+			defer wg.Done()
+
+			// keep draining the channel so the driver is not blocked
+			for info := range ch {
+				mu.Lock()
+				_, ok := seen[info.ID]
+				seen[info.ID] = struct{}{}
+				mu.Unlock()
+
+				if ok {
+					continue
+				}
+
+				select {
+				case cpeers <- info:
+				case <-ctx.Done():
+				}
+			}
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(cpeers)
+	}()
+
+	return cpeers, nil
+}
@@ -0,0 +1,164 @@
+package tinder
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	p2p_discovery "github.com/libp2p/go-libp2p-core/discovery"
+	p2p_peer "github.com/libp2p/go-libp2p-core/peer"
+	p2p_mock "github.com/libp2p/go-libp2p/p2p/net/mock"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotationPoint(t *testing.T) {
+	p := NewRotationPoint([]byte("seed"), time.Hour)
+	start := time.Unix(0, p.Epoch(time.Now())*int64(time.Hour))
+
+	// the namespace only changes at the epoch boundaries
+	assert.Equal(t, p.NamespaceAt(start), p.NamespaceAt(start.Add(time.Minute*59)))
+	assert.NotEqual(t, p.NamespaceAt(start), p.NamespaceAt(start.Add(time.Hour)))
+	assert.Equal(t, start.Add(time.Hour), p.NextRotation(start.Add(time.Minute)))
+
+	// the namespaces depend on the seed
+	other := NewRotationPoint([]byte("other seed"), time.Hour)
+	assert.NotEqual(t, p.NamespaceAt(start), other.NamespaceAt(start))
+	assert.Equal(t, p.NamespaceAt(start), NewRotationPoint([]byte("seed"), time.Hour).NamespaceAt(start))
+
+	// the closest adjacent epoch is looked up as well
+	epoch := p.Epoch(start)
+	assert.Equal(t, []string{p.Namespace(epoch), p.Namespace(epoch - 1)}, p.LookupNamespaces(start.Add(time.Minute)))
+	assert.Equal(t, []string{p.Namespace(epoch), p.Namespace(epoch + 1)}, p.LookupNamespaces(start.Add(time.Minute*59)))
+}
+
+func TestAdvertiseRotation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mn := p2p_mock.New(ctx)
+	peers := testingPeers(t, mn, 2)
+
+	ms := NewMockedDriverServer()
+	drivers := testingMockedDriverClients(t, ms, peers...)
+	driver := &countingDriver{Driver: drivers[0]}
+
+	period := time.Millisecond * 500
+	p := NewRotationPoint([]byte("seed"), period)
+
+	// start right after an epoch boundary
+	time.Sleep(time.Until(p.NextRotation(time.Now())))
+	epoch := p.Epoch(time.Now())
+
+	advCtx, advCancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		AdvertiseRotation(advCtx, driver, p, time.Millisecond*50, nil, p2p_discovery.TTL(time.Minute))
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return ms.HasPeerRecord(p.Namespace(epoch), peers[0].ID())
+	}, period/2, time.Millisecond*10)
+
+	ch, err := FindPeersRotation(ctx, drivers[1], p)
+	require.NoError(t, err)
+	assert.Equal(t, []p2p_peer.ID{peers[0].ID()}, collectPeers(ch))
+
+	// the namespace of the next epoch replaces the previous one
+	require.Eventually(t, func() bool {
+		return ms.HasPeerRecord(p.Namespace(epoch+1), peers[0].ID()) &&
+			!ms.HasPeerRecord(p.Namespace(epoch), peers[0].ID())
+	}, period*2, time.Millisecond*10)
+
+	// the namespaces are not refreshed before their TTL expires
+	assert.Equal(t, 2, driver.advertisements())
+
+	// the last namespace is unregistered once the advertisement is stopped
+	advCancel()
+	<-done
+
+	assert.False(t, ms.HasPeerRecord(p.Namespace(epoch+1), peers[0].ID()))
+}
+
+func TestAdvertiseRotation_Refresh(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mn := p2p_mock.New(ctx)
+	peers := testingPeers(t, mn, 1)
+
+	ms := NewMockedDriverServer()
+	driver := &countingDriver{Driver: NewMockedDriverClient(peers[0], ms)}
+
+	p := NewRotationPoint([]byte("seed"), time.Hour)
+
+	advCtx, advCancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		AdvertiseRotation(advCtx, driver, p, time.Millisecond*50, nil, p2p_discovery.TTL(time.Millisecond*100))
+		close(done)
+	}()
+
+	// the namespace is advertised again before its registration expires
+	require.Eventually(t, func() bool {
+		return driver.advertisements() >= 3
+	}, time.Second, time.Millisecond*10)
+
+	assert.True(t, ms.HasPeerRecord(p.NamespaceAt(time.Now()), peers[0].ID()))
+
+	advCancel()
+	<-done
+
+	assert.False(t, ms.HasPeerRecord(p.NamespaceAt(time.Now()), peers[0].ID()))
+}
+
+func TestAdvertiseRotation_MultiDriver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mn := p2p_mock.New(ctx)
+	peers := testingPeers(t, mn, 1)
+
+	ms := NewMockedDriverServer()
+	md := NewMultiDriver(NewMockedDriverClient(peers[0], ms))
+
+	period := time.Millisecond * 500
+	p := NewRotationPoint([]byte("seed"), period)
+
+	time.Sleep(time.Until(p.NextRotation(time.Now())))
+	epoch := p.Epoch(time.Now())
+
+	var (
+		mu   sync.Mutex
+		errs []error
+	)
+
+	advCtx, advCancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		AdvertiseRotation(advCtx, md, p, time.Millisecond*50, func(err error) {
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+		}, p2p_discovery.TTL(time.Minute))
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		return ms.HasPeerRecord(p.Namespace(epoch+1), peers[0].ID()) &&
+			!ms.HasPeerRecord(p.Namespace(epoch), peers[0].ID())
+	}, period*2, time.Millisecond*10)
+
+	advCancel()
+	<-done
+
+	// the namespaces are not advertised twice on the multi driver
+	mu.Lock()
+	assert.Empty(t, errs)
+	mu.Unlock()
+
+	assert.False(t, ms.HasPeerRecord(p.Namespace(epoch+1), peers[0].ID()))
+}
@@ -18,7 +18,7 @@ import (
 	"berty.tech/berty/go/internal/cryptoutil"
 	"berty.tech/berty/go/internal/ipfsutil"
 	"berty.tech/berty/go/internal/orbitutil"
-	"berty.tech/berty/go/pkg/bertyprotocol"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
@@ -114,7 +114,7 @@ func (d *Client) logFromToken(ctx context.Context, token string) (orbitdb.EventL
 		return nil, errcode.TODO.Wrap(err)
 	}
 
-	g := &bertyprotocol.Group{PublicKey: pubkb, Secret: sigkb}
+	g := &bertytypes.Group{PublicKey: pubkb, Secret: sigkb}
 	opts, err := orbitutil.DefaultOptions(g, &orbitdb.CreateDBOptions{}, ks, "log")
 	if err != nil {
 		return nil, errcode.TODO.Wrap(err)
@@ -6,10 +6,32 @@ import (
 	"berty.tech/berty/go/pkg/errcode"
 )
 
-func (c *client) AppMetadataSend(context.Context, *AppMetadataSend_Request) (*AppMetadataSend_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+// AppMetadataSend adds an app event to the metadata store of a group
+func (c *client) AppMetadataSend(ctx context.Context, req *AppMetadataSend_Request) (*AppMetadataSend_Reply, error) {
+	cg, err := c.instance().getContextGroupForID(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := cg.MetadataStore().SendAppMetadata(ctx, req.Payload)
+	if err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	return &AppMetadataSend_Reply{CID: op.GetEntry().GetHash().Bytes()}, nil
 }
 
-func (c *client) AppMessageSend(context.Context, *AppMessageSend_Request) (*AppMessageSend_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+// AppMessageSend adds an app event to the message store of a group
+func (c *client) AppMessageSend(ctx context.Context, req *AppMessageSend_Request) (*AppMessageSend_Reply, error) {
+	cg, err := c.instance().getContextGroupForID(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := cg.MessageStore().AddMessage(ctx, req.Payload)
+	if err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	return &AppMessageSend_Reply{CID: op.GetEntry().GetHash().Bytes()}, nil
 }
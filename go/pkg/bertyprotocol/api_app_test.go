@@ -0,0 +1,62 @@
+package bertyprotocol
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/internal/testutil"
+	"berty.tech/berty/go/pkg/bertytypes"
+)
+
+func TestClient_AppMessageSend(t *testing.T) {
+	ctx := context.Background()
+
+	c, cleanup := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	res, err := c.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	sent, err := c.AppMessageSend(ctx, &AppMessageSend_Request{GroupPK: res.GroupPK, Payload: []byte("test")})
+	require.NoError(t, err)
+	require.NotEmpty(t, sent.CID)
+
+	sub := &testMessageSubscribeServer{ctx: ctx}
+	err = c.GroupMessageSubscribe(&GroupMessageSubscribe_Request{
+		GroupPK: res.GroupPK,
+		Until:   sent.CID,
+	}, sub)
+	require.NoError(t, err)
+	require.Len(t, sub.events, 1)
+	require.Equal(t, sent.CID, sub.events[0].EventContext.ID)
+	require.Equal(t, []byte("test"), sub.events[0].Message)
+
+	_, err = c.AppMessageSend(ctx, &AppMessageSend_Request{Payload: []byte("test")})
+	require.Error(t, err)
+}
+
+func TestClient_AppMetadataSend(t *testing.T) {
+	ctx := context.Background()
+
+	c, cleanup := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	res, err := c.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	sent, err := c.AppMetadataSend(ctx, &AppMetadataSend_Request{GroupPK: res.GroupPK, Payload: []byte("test")})
+	require.NoError(t, err)
+	require.NotEmpty(t, sent.CID)
+
+	sub := &testMetadataSubscribeServer{ctx: ctx}
+	err = c.GroupMetadataSubscribe(&GroupMetadataSubscribe_Request{
+		GroupPK:    res.GroupPK,
+		Until:      sent.CID,
+		EventTypes: []bertytypes.EventType{bertytypes.EventTypeGroupMetadataPayloadSent},
+	}, sub)
+	require.NoError(t, err)
+	require.Len(t, sub.events, 1)
+	require.Equal(t, sent.CID, sub.events[0].EventContext.ID)
+}
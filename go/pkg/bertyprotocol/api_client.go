@@ -1,13 +1,170 @@
 package bertyprotocol
 
 import (
+	"bytes"
 	"context"
+	"io"
+	"io/ioutil"
 
+	"berty.tech/go-orbit-db/iface"
+	"github.com/ipfs/go-cid"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"go.uber.org/zap"
+
+	"berty.tech/berty/go/internal/backup"
+	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/internal/orbitutil"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
-func (c *client) InstanceExportData(context.Context, *InstanceExportData_Request) (*InstanceExportData_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+const (
+	// maxArchiveContentSize is the maximum size of the datastore entries of
+	// an imported archive
+	maxArchiveContentSize = 256 << 20
+
+	// maxArchiveBlockSize is the maximum size of a log block of an imported
+	// archive
+	maxArchiveBlockSize = 4 << 20
+)
+
+// InstanceExportData exports the account keys, the logs of every joined group
+// and their cached state, encrypted using the given passphrase. The logs are
+// written as a CAR file and the archive is streamed in chunks.
+func (c *client) InstanceExportData(req *InstanceExportData_Request, sub ProtocolService_InstanceExportDataServer) error {
+	if len(req.Passphrase) == 0 {
+		return errcode.ErrMissingInput
+	}
+
+	ctx := sub.Context()
+	inst := c.instance()
+	ds := ipfsutil.NewNamespacedDatastore(c.rootDatastore, inst.namespace)
+	content := &backup.ArchiveContent{}
+
+	for _, ns := range instanceNamespaces {
+		entries, err := backup.ExportDatastore(ds, datastore.NewKey(ns))
+		if err != nil {
+			return err
+		}
+
+		content.Entries = append(content.Entries, entries...)
+	}
+
+	groups, release, err := inst.joinedContextGroups(ctx)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	stores := []iface.Store(nil)
+	roots := []cid.Cid(nil)
+	for _, cg := range groups {
+		for _, store := range []iface.Store{cg.MetadataStore(), cg.MessageStore()} {
+			for _, head := range store.OpLog().Heads().Slice() {
+				roots = append(roots, head.GetHash())
+			}
+
+			stores = append(stores, store)
+		}
+	}
+
+	w, err := backup.NewWriter(&exportDataWriter{sub: sub}, req.Passphrase)
+	if err != nil {
+		return err
+	}
+
+	if err := backup.WriteContent(w, content); err != nil {
+		return err
+	}
+
+	car, err := backup.NewCARWriter(w, roots)
+	if err != nil {
+		return err
+	}
+
+	for _, store := range stores {
+		if err := c.exportLogBlocks(ctx, car, store); err != nil {
+			return err
+		}
+	}
+
+	return w.Close()
+}
+
+// InstanceImportData replaces the account and the groups of the instance with
+// exported data. The archive is checked and the imported account is opened
+// before it replaces the current one, which is kept if anything fails.
+func (c *client) InstanceImportData(sub ProtocolService_InstanceImportDataServer) error {
+	ctx := sub.Context()
+
+	first, err := sub.Recv()
+	if err != nil {
+		return errcode.ErrInvalidInput.Wrap(err)
+	}
+
+	r, err := backup.NewReader(&importDataReader{sub: sub, buf: first.ExportedData}, first.Passphrase)
+	if err != nil {
+		return err
+	}
+
+	content, err := backup.ReadContent(r, maxArchiveContentSize)
+	if err != nil {
+		return err
+	}
+
+	prefixes := make([]datastore.Key, len(instanceNamespaces))
+	for i, ns := range instanceNamespaces {
+		prefixes[i] = datastore.NewKey(ns)
+	}
+
+	// the archive must hold the account keys and only data of the instance
+	if err := backup.CheckEntries(content.Entries, datastore.NewKey(accountNamespace), prefixes...); err != nil {
+		return err
+	}
+
+	car, err := backup.NewCARReader(r, maxArchiveBlockSize)
+	if err != nil {
+		return err
+	}
+
+	// the log entries have to be available before the groups are opened
+	imported := map[cid.Cid]struct{}{}
+	for {
+		id, data, err := car.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+
+		if err := c.importLogBlock(ctx, id, data); err != nil {
+			return err
+		}
+
+		imported[id] = struct{}{}
+	}
+
+	for _, root := range car.Roots() {
+		if _, ok := imported[root]; !ok {
+			return errcode.ErrInvalidInput
+		}
+	}
+
+	if err := c.replaceInstance(func(ds datastore.Batching) error {
+		for _, prefix := range prefixes {
+			if err := backup.ImportDatastore(ds, prefix, content.Entries); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	return sub.SendAndClose(&InstanceImportData_Reply{})
 }
 
 func (c *client) InstanceGetConfiguration(ctx context.Context, req *InstanceGetConfiguration_Request) (*InstanceGetConfiguration_Reply, error) {
@@ -30,3 +187,143 @@ func (c *client) InstanceGetConfiguration(ctx context.Context, req *InstanceGetC
 
 	return ret, nil
 }
+
+// joinedContextGroups returns the account group and every group joined by
+// the account, the groups that were not opened are opened until release is
+// called
+func (i *instance) joinedContextGroups(ctx context.Context) ([]orbitutil.ContextGroup, func(), error) {
+	groups := []orbitutil.ContextGroup{i.accContextGroup}
+	opened := [][]byte(nil)
+
+	release := func() {
+		for _, id := range opened {
+			if err := i.odb.CloseGroup(id); err != nil {
+				i.logger.Warn("unable to close joined group", zap.Error(err))
+			}
+		}
+	}
+
+	for _, g := range i.joinedGroups() {
+		if cg, err := i.odb.GetContextGroupForID(g.PublicKey); err == nil {
+			groups = append(groups, cg)
+			continue
+		}
+
+		cg, err := i.odb.OpenMultiMemberGroup(ctx, g, nil)
+		if err != nil {
+			release()
+			return nil, nil, errcode.ErrOrbitDBOpen.Wrap(err)
+		}
+
+		opened = append(opened, g.PublicKey)
+		groups = append(groups, cg)
+	}
+
+	return groups, release, nil
+}
+
+// joinedGroups returns the multi member groups joined by the account and the
+// groups shared with its contacts
+func (i *instance) joinedGroups() []*bertytypes.Group {
+	ms := i.accContextGroup.MetadataStore()
+	groups := ms.ListMultiMemberGroups()
+
+	for _, state := range []bertytypes.ContactState{bertytypes.ContactStateAdded, bertytypes.ContactStateBlocked} {
+		for _, contact := range ms.ListContactsByStatus(state) {
+			pk, err := contact.GetPubKey()
+			if err != nil {
+				continue
+			}
+
+			g, err := i.getContactGroup(pk)
+			if err != nil {
+				continue
+			}
+
+			groups = append(groups, g)
+		}
+	}
+
+	return groups
+}
+
+// exportLogBlocks writes the ipfs blocks of every entry of a store log
+func (c *client) exportLogBlocks(ctx context.Context, car *backup.CARWriter, store iface.Store) error {
+	for _, e := range store.OpLog().Values().Slice() {
+		r, err := c.ipfsCoreAPI.Block().Get(ctx, path.IpfsPath(e.GetHash()))
+		if err != nil {
+			return errcode.TODO.Wrap(err)
+		}
+
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return errcode.TODO.Wrap(err)
+		}
+
+		if err := car.Put(e.GetHash(), data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importLogBlock adds an exported block to ipfs, its content is checked
+// against its CID
+func (c *client) importLogBlock(ctx context.Context, id cid.Cid, data []byte) error {
+	prefix := id.Prefix()
+
+	format := "v0"
+	if prefix.Version != 0 {
+		format = cid.CodecToStr[prefix.Codec]
+	}
+
+	stat, err := c.ipfsCoreAPI.Block().Put(ctx, bytes.NewReader(data), options.Block.Format(format), options.Block.Hash(prefix.MhType, prefix.MhLength))
+	if err != nil {
+		return errcode.TODO.Wrap(err)
+	}
+
+	if !stat.Path().Cid().Equals(id) {
+		return errcode.ErrInvalidInput
+	}
+
+	return nil
+}
+
+// exportDataWriter sends the chunks of an exported archive
+type exportDataWriter struct {
+	sub ProtocolService_InstanceExportDataServer
+}
+
+func (w *exportDataWriter) Write(p []byte) (int, error) {
+	chunk := make([]byte, len(p))
+	copy(chunk, p)
+
+	if err := w.sub.Send(&InstanceExportData_Reply{ExportedData: chunk}); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// importDataReader reads the chunks of an imported archive
+type importDataReader struct {
+	sub ProtocolService_InstanceImportDataServer
+	buf []byte
+}
+
+func (r *importDataReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		req, err := r.sub.Recv()
+		if err != nil {
+			return 0, err
+		}
+
+		r.buf = req.ExportedData
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+
+	return n, nil
+}
@@ -0,0 +1,118 @@
+package bertyprotocol
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"berty.tech/berty/go/internal/testutil"
+)
+
+type testExportDataServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	chunks [][]byte
+}
+
+func (s *testExportDataServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testExportDataServer) Send(reply *InstanceExportData_Reply) error {
+	s.chunks = append(s.chunks, reply.ExportedData)
+	return nil
+}
+
+type testImportDataServer struct {
+	grpc.ServerStream
+
+	ctx      context.Context
+	requests []*InstanceImportData_Request
+	reply    *InstanceImportData_Reply
+}
+
+func (s *testImportDataServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testImportDataServer) Recv() (*InstanceImportData_Request, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+
+	return req, nil
+}
+
+func (s *testImportDataServer) SendAndClose(reply *InstanceImportData_Reply) error {
+	s.reply = reply
+	return nil
+}
+
+func newTestImportDataServer(ctx context.Context, chunks [][]byte, passphrase []byte) *testImportDataServer {
+	s := &testImportDataServer{ctx: ctx}
+	for i, chunk := range chunks {
+		req := &InstanceImportData_Request{ExportedData: chunk}
+		if i == 0 {
+			req.Passphrase = passphrase
+		}
+
+		s.requests = append(s.requests, req)
+	}
+
+	return s
+}
+
+func TestClient_InstanceExportImportData(t *testing.T) {
+	ctx := context.Background()
+
+	a, cleanupA := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanupA()
+
+	res, err := a.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	sent, err := a.AppMessageSend(ctx, &AppMessageSend_Request{GroupPK: res.GroupPK, Payload: []byte("test")})
+	require.NoError(t, err)
+
+	exported := &testExportDataServer{ctx: ctx}
+	require.Error(t, a.InstanceExportData(&InstanceExportData_Request{}, exported))
+
+	// the group is not opened anymore but has been joined, it must be exported
+	require.NoError(t, a.(*client).instance().odb.CloseGroup(res.GroupPK))
+
+	exported = &testExportDataServer{ctx: ctx}
+	require.NoError(t, a.InstanceExportData(&InstanceExportData_Request{Passphrase: []byte("passphrase")}, exported))
+	require.True(t, len(exported.chunks) > 1)
+
+	// the importing instance uses its own ipfs node and datastore
+	b, cleanupB := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanupB()
+
+	require.Error(t, b.InstanceImportData(newTestImportDataServer(ctx, exported.chunks, []byte("wrong"))))
+
+	// a truncated archive is rejected
+	require.Error(t, b.InstanceImportData(newTestImportDataServer(ctx, exported.chunks[:len(exported.chunks)-1], []byte("passphrase"))))
+
+	imported := newTestImportDataServer(ctx, exported.chunks, []byte("passphrase"))
+	require.NoError(t, b.InstanceImportData(imported))
+	require.NotNil(t, imported.reply)
+
+	require.Equal(t, a.(*client).instance().accContextGroup.Group().PublicKey, b.(*client).instance().accContextGroup.Group().PublicKey)
+	require.Len(t, b.(*client).instance().accContextGroup.MetadataStore().ListMultiMemberGroups(), 1)
+
+	sub := &testMessageSubscribeServer{ctx: ctx}
+	err = b.GroupMessageSubscribe(&GroupMessageSubscribe_Request{
+		GroupPK: res.GroupPK,
+		Until:   sent.CID,
+	}, sub)
+	require.NoError(t, err)
+	require.Len(t, sub.events, 1)
+	require.Equal(t, []byte("test"), sub.events[0].Message)
+}
@@ -3,6 +3,8 @@ package bertyprotocol
 import (
 	"context"
 
+	"github.com/libp2p/go-libp2p-core/crypto"
+
 	"berty.tech/berty/go/pkg/errcode"
 )
 
@@ -10,10 +12,49 @@ func (c *client) ContactAliasKeySend(context.Context, *ContactAliasKeySend_Reque
 	return nil, errcode.ErrNotImplemented
 }
 
-func (c *client) ContactBlock(context.Context, *ContactBlock_Request) (*ContactBlock_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+// ContactBlock blocks a contact, its group is not replicated anymore and its
+// contact requests are rejected. The public rendezvous seed known by the
+// contact is reset, the account stops being advertised on it, so a new
+// contact request reference has to be shared with the other peers.
+func (c *client) ContactBlock(ctx context.Context, req *ContactBlock_Request) (*ContactBlock_Reply, error) {
+	inst := c.instance()
+	ms := inst.accContextGroup.MetadataStore()
+
+	pk, err := crypto.UnmarshalEd25519PublicKey(req.ContactPK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, err := ms.ContactBlock(ctx, pk); err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	if err := inst.closeContactGroup(pk); err != nil {
+		return nil, err
+	}
+
+	// the contact requests manager announces the account on the new seed
+	// once the reset is indexed
+	if _, ref := ms.GetIncomingContactRequestsStatus(); ref != nil {
+		if _, err := ms.ContactRequestReferenceReset(ctx); err != nil {
+			return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+		}
+	}
+
+	return &ContactBlock_Reply{}, nil
 }
 
-func (c *client) ContactUnblock(context.Context, *ContactUnblock_Request) (*ContactUnblock_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+// ContactUnblock unblocks a contact, a new contact request is needed to
+// exchange with it again
+func (c *client) ContactUnblock(ctx context.Context, req *ContactUnblock_Request) (*ContactUnblock_Reply, error) {
+	pk, err := crypto.UnmarshalEd25519PublicKey(req.ContactPK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, err := c.instance().accContextGroup.MetadataStore().ContactUnblock(ctx, pk); err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	return &ContactUnblock_Reply{}, nil
 }
@@ -0,0 +1,121 @@
+package bertyprotocol
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/internal/testutil"
+	"berty.tech/berty/go/internal/tinder"
+	"berty.tech/berty/go/pkg/bertytypes"
+)
+
+func TestClient_ContactBlock(t *testing.T) {
+	ctx := context.Background()
+
+	c, cleanup := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	typed := c.(*client)
+
+	_, contactPK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	contactPKBytes, err := contactPK.Raw()
+	require.NoError(t, err)
+
+	cg, err := typed.instance().activateContactGroup(contactPK)
+	require.NoError(t, err)
+
+	_, err = c.ContactBlock(ctx, &ContactBlock_Request{ContactPK: contactPKBytes})
+	require.NoError(t, err)
+
+	blocked := typed.instance().accContextGroup.MetadataStore().ListContactsByStatus(bertytypes.ContactStateBlocked)
+	require.Len(t, blocked, 1)
+	require.Equal(t, contactPKBytes, blocked[0].PK)
+
+	_, err = typed.instance().getContextGroupForID(cg.Group().PublicKey)
+	require.Error(t, err)
+
+	_, err = c.ContactBlock(ctx, &ContactBlock_Request{ContactPK: contactPKBytes})
+	require.Error(t, err)
+
+	_, err = c.ContactUnblock(ctx, &ContactUnblock_Request{ContactPK: contactPKBytes})
+	require.NoError(t, err)
+	require.Empty(t, typed.instance().accContextGroup.MetadataStore().ListContactsByStatus(bertytypes.ContactStateBlocked))
+}
+
+func TestClient_ContactBlockIncomingRequest(t *testing.T) {
+	testutil.SkipSlow(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := tinder.NewMockedDriverServer()
+
+	apiA := ipfsutil.TestingCoreAPI(ctx, t)
+	apiB := ipfsutil.TestingCoreAPIUsingMockNet(ctx, t, apiA.MockNetwork())
+	require.NoError(t, apiA.MockNetwork().LinkAll())
+
+	hostA, hostB := apiA.MockNode().PeerHost, apiB.MockNode().PeerHost
+
+	a, cleanupA := TestingClient(t, Opts{
+		Logger:       testutil.Logger(t),
+		RootContext:  ctx,
+		IpfsCoreAPI:  apiA,
+		TinderDriver: tinder.NewMockedDriverClient(hostA, server),
+	})
+	defer cleanupA()
+
+	b, cleanupB := TestingClient(t, Opts{
+		Logger:       testutil.Logger(t),
+		RootContext:  ctx,
+		IpfsCoreAPI:  apiB,
+		TinderDriver: tinder.NewMockedDriverClient(hostB, server),
+	})
+	defer cleanupB()
+
+	instA, instB := a.(*client).instance(), b.(*client).instance()
+	pkA, pkB := instA.accContextGroup.MemberPubKey(), instB.accContextGroup.MemberPubKey()
+
+	_, err := a.ContactRequestEnable(ctx, &ContactRequestEnable_Request{})
+	require.NoError(t, err)
+
+	_, oldRef := instA.accContextGroup.MetadataStore().GetIncomingContactRequestsStatus()
+	require.NotNil(t, oldRef)
+
+	oldPoint := rendezvousPoint(oldRef.PublicRendezvousSeed)
+
+	require.Eventually(t, func() bool {
+		return server.HasPeerRecord(oldPoint.NamespaceAt(time.Now()), hostA.ID())
+	}, time.Second*5, time.Millisecond*100)
+
+	pkBBytes, err := pkB.Raw()
+	require.NoError(t, err)
+
+	_, err = a.ContactBlock(ctx, &ContactBlock_Request{ContactPK: pkBBytes})
+	require.NoError(t, err)
+
+	// the account stops being advertised on the seed known by the contact
+	_, newRef := instA.accContextGroup.MetadataStore().GetIncomingContactRequestsStatus()
+	require.NotNil(t, newRef)
+	require.NotEqual(t, oldRef.PublicRendezvousSeed, newRef.PublicRendezvousSeed)
+
+	require.Eventually(t, func() bool {
+		return !server.HasPeerRecord(oldPoint.NamespaceAt(time.Now()), hostA.ID()) &&
+			server.HasPeerRecord(rendezvousPoint(newRef.PublicRendezvousSeed).NamespaceAt(time.Now()), hostA.ID())
+	}, time.Second*5, time.Millisecond*100)
+
+	// a contact request sent by the blocked contact is rejected
+	require.NoError(t, instB.ensureContactRequestReference(ctx))
+
+	err = instB.contactRequests.sendRequest(ctx, peer.AddrInfo{ID: hostA.ID(), Addrs: hostA.Addrs()}, pkA)
+	require.Error(t, err)
+	require.Empty(t, instA.accContextGroup.MetadataStore().ListContactsByStatus(bertytypes.ContactStateReceived))
+}
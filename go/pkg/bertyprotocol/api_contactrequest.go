@@ -3,40 +3,152 @@ package bertyprotocol
 import (
 	"context"
 
+	"github.com/libp2p/go-libp2p-core/crypto"
+
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
 // ContactRequestReference retrieves the necessary information to create a contact link
 func (c *client) ContactRequestReference(context.Context, *ContactRequestReference_Request) (*ContactRequestReference_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+	reference, err := c.instance().contactRequestReference()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContactRequestReference_Reply{Reference: reference}, nil
 }
 
 // ContactRequestDisable disables incoming contact requests
-func (c *client) ContactRequestDisable(context.Context, *ContactRequestDisable_Request) (*ContactRequestDisable_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) ContactRequestDisable(ctx context.Context, _ *ContactRequestDisable_Request) (*ContactRequestDisable_Reply, error) {
+	if _, err := c.instance().accContextGroup.MetadataStore().ContactRequestDisable(ctx); err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	return &ContactRequestDisable_Reply{}, nil
 }
 
 // ContactRequestEnable enables incoming contact requests
-func (c *client) ContactRequestEnable(context.Context, *ContactRequestEnable_Request) (*ContactRequestEnable_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) ContactRequestEnable(ctx context.Context, _ *ContactRequestEnable_Request) (*ContactRequestEnable_Reply, error) {
+	inst := c.instance()
+
+	if err := inst.ensureContactRequestReference(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := inst.accContextGroup.MetadataStore().ContactRequestEnable(ctx); err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	reference, err := inst.contactRequestReference()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContactRequestEnable_Reply{Reference: reference}, nil
 }
 
 // ContactRequestResetReference generates a new contact request reference
-func (c *client) ContactRequestResetReference(context.Context, *ContactRequestResetReference_Request) (*ContactRequestResetReference_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) ContactRequestResetReference(ctx context.Context, _ *ContactRequestResetReference_Request) (*ContactRequestResetReference_Reply, error) {
+	inst := c.instance()
+
+	if _, err := inst.accContextGroup.MetadataStore().ContactRequestReferenceReset(ctx); err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	reference, err := inst.contactRequestReference()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContactRequestResetReference_Reply{Reference: reference}, nil
 }
 
 // ContactRequestSend enqueues a new contact request to be sent
-func (c *client) ContactRequestSend(context.Context, *ContactRequestSend_Request) (*ContactRequestSend_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) ContactRequestSend(ctx context.Context, req *ContactRequestSend_Request) (*ContactRequestSend_Reply, error) {
+	inst := c.instance()
+
+	contact := &bertytypes.ShareableContact{}
+	if err := contact.Unmarshal(req.Reference); err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if len(req.ContactMetadata) > 0 {
+		contact.Metadata = req.ContactMetadata
+	}
+
+	// our own reference is sent to the contact along with the request
+	if err := inst.ensureContactRequestReference(ctx); err != nil {
+		return nil, err
+	}
+
+	if _, err := inst.accContextGroup.MetadataStore().ContactRequestOutgoingEnqueue(ctx, contact, req.OwnMetadata); err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	return &ContactRequestSend_Reply{}, nil
 }
 
 // ContactRequestAccept accepts a contact request
-func (c *client) ContactRequestAccept(context.Context, *ContactRequestAccept_Request) (*ContactRequestAccept_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) ContactRequestAccept(ctx context.Context, req *ContactRequestAccept_Request) (*ContactRequestAccept_Reply, error) {
+	inst := c.instance()
+
+	pk, err := crypto.UnmarshalEd25519PublicKey(req.ContactPK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, err := inst.accContextGroup.MetadataStore().ContactRequestIncomingAccept(ctx, pk); err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	if _, err := inst.activateContactGroup(pk); err != nil {
+		return nil, err
+	}
+
+	return &ContactRequestAccept_Reply{}, nil
 }
 
 // ContactRequestDiscard ignores a contact request without informing the request sender
-func (c *client) ContactRequestDiscard(context.Context, *ContactRequestDiscard_Request) (*ContactRequestDiscard_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) ContactRequestDiscard(ctx context.Context, req *ContactRequestDiscard_Request) (*ContactRequestDiscard_Reply, error) {
+	pk, err := crypto.UnmarshalEd25519PublicKey(req.ContactPK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, err := c.instance().accContextGroup.MetadataStore().ContactRequestIncomingDiscard(ctx, pk); err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	return &ContactRequestDiscard_Reply{}, nil
+}
+
+// ensureContactRequestReference creates a contact request reference if none
+// has been created yet
+func (i *instance) ensureContactRequestReference(ctx context.Context) error {
+	if _, ref := i.accContextGroup.MetadataStore().GetIncomingContactRequestsStatus(); ref != nil {
+		return nil
+	}
+
+	if _, err := i.accContextGroup.MetadataStore().ContactRequestReferenceReset(ctx); err != nil {
+		return errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	return nil
+}
+
+// contactRequestReference returns the serialized contact request reference of
+// the account, it is empty if no reference has been created yet
+func (i *instance) contactRequestReference() ([]byte, error) {
+	_, ref := i.accContextGroup.MetadataStore().GetIncomingContactRequestsStatus()
+	if ref == nil {
+		return nil, nil
+	}
+
+	reference, err := ref.Marshal()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	return reference, nil
 }
@@ -0,0 +1,88 @@
+package bertyprotocol
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/internal/testutil"
+	"berty.tech/berty/go/internal/tinder"
+	"berty.tech/berty/go/pkg/bertytypes"
+)
+
+func TestClient_ContactRequest(t *testing.T) {
+	testutil.SkipSlow(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := tinder.NewMockedDriverServer()
+
+	apiA := ipfsutil.TestingCoreAPI(ctx, t)
+	apiB := ipfsutil.TestingCoreAPIUsingMockNet(ctx, t, apiA.MockNetwork())
+	require.NoError(t, apiA.MockNetwork().LinkAll())
+
+	hostA, hostB := apiA.MockNode().PeerHost, apiB.MockNode().PeerHost
+
+	a, cleanupA := TestingClient(t, Opts{
+		Logger:       testutil.Logger(t),
+		RootContext:  ctx,
+		IpfsCoreAPI:  apiA,
+		TinderDriver: tinder.NewMockedDriverClient(hostA, server),
+	})
+	defer cleanupA()
+
+	b, cleanupB := TestingClient(t, Opts{
+		Logger:       testutil.Logger(t),
+		RootContext:  ctx,
+		IpfsCoreAPI:  apiB,
+		TinderDriver: tinder.NewMockedDriverClient(hostB, server),
+	})
+	defer cleanupB()
+
+	enabled, err := a.ContactRequestEnable(ctx, &ContactRequestEnable_Request{})
+	require.NoError(t, err)
+	require.NotEmpty(t, enabled.Reference)
+
+	ref, err := a.ContactRequestReference(ctx, &ContactRequestReference_Request{})
+	require.NoError(t, err)
+	require.Equal(t, enabled.Reference, ref.Reference)
+
+	refA := &bertytypes.ShareableContact{}
+	require.NoError(t, refA.Unmarshal(ref.Reference))
+
+	require.Eventually(t, func() bool {
+		return server.HasPeerRecord(rendezvousPoint(refA.PublicRendezvousSeed).NamespaceAt(time.Now()), hostA.ID())
+	}, time.Second*5, time.Millisecond*100)
+
+	_, err = b.ContactRequestSend(ctx, &ContactRequestSend_Request{
+		Reference:   ref.Reference,
+		OwnMetadata: []byte("b"),
+	})
+	require.NoError(t, err)
+
+	msA := a.(*client).instance().accContextGroup.MetadataStore()
+	msB := b.(*client).instance().accContextGroup.MetadataStore()
+
+	require.Eventually(t, func() bool {
+		return len(msB.ListContactsByStatus(bertytypes.ContactStateAdded)) == 1 &&
+			len(msA.ListContactsByStatus(bertytypes.ContactStateReceived)) == 1
+	}, time.Second*10, time.Millisecond*100)
+
+	received := msA.ListContactsByStatus(bertytypes.ContactStateReceived)[0]
+	require.Equal(t, []byte("b"), received.Metadata)
+
+	_, err = a.ContactRequestAccept(ctx, &ContactRequestAccept_Request{ContactPK: received.PK})
+	require.NoError(t, err)
+
+	cgA, err := a.(*client).instance().activateContactGroup(b.(*client).instance().accContextGroup.MemberPubKey())
+	require.NoError(t, err)
+
+	cgB, err := b.(*client).instance().activateContactGroup(a.(*client).instance().accContextGroup.MemberPubKey())
+	require.NoError(t, err)
+
+	require.Equal(t, cgA.Group().PublicKey, cgB.Group().PublicKey)
+}
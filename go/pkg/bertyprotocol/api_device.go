@@ -0,0 +1,148 @@
+package bertyprotocol
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+
+	"berty.tech/berty/go/internal/account"
+	"berty.tech/berty/go/internal/orbitutil"
+	"berty.tech/berty/go/pkg/bertytypes"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+// DeviceLinkReference creates a one-time reference allowing another device to
+// join the current account
+func (c *client) DeviceLinkReference(ctx context.Context, req *DeviceLinkReference_Request) (*DeviceLinkReference_Reply, error) {
+	ref, err := c.startDeviceLink()
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeviceLinkReference_Reply{Reference: ref}, nil
+}
+
+// DeviceLinkJoin replaces the account of the instance with the account of the
+// device which created the reference
+func (c *client) DeviceLinkJoin(ctx context.Context, req *DeviceLinkJoin_Request) (*DeviceLinkJoin_Reply, error) {
+	if req.Reference == nil {
+		return nil, errcode.ErrInvalidInput
+	}
+
+	if err := c.joinAccount(ctx, req.Reference); err != nil {
+		return nil, err
+	}
+
+	return &DeviceLinkJoin_Reply{}, nil
+}
+
+// DeviceRevoke revokes a device of the account in its sig chain, in the
+// account group and in every group it has joined, the secrets of the
+// remaining devices are then rotated. The keys used by the devices in the sig
+// chain and in the multi-member groups are their own, so the revoked device
+// can't sign for the remaining ones nor add itself back to the sig chain with
+// the account key it holds.
+func (c *client) DeviceRevoke(ctx context.Context, req *DeviceRevoke_Request) (*DeviceRevoke_Reply, error) {
+	inst := c.instance()
+
+	devicePK, err := crypto.UnmarshalEd25519PublicKey(req.DevicePK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	deviceSK, err := inst.account.DevicePrivKey()
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	if deviceSK.GetPublic().Equals(devicePK) {
+		return nil, errcode.ErrInvalidInput
+	}
+
+	if _, err := inst.account.SigChainRevokeDevice(devicePK); err != nil && err != errcode.ErrSigChainOperationAlreadyDone {
+		return nil, err
+	}
+
+	if err := inst.syncSigChain(ctx); err != nil {
+		return nil, err
+	}
+
+	groups, release, err := inst.joinedContextGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	for _, cg := range groups {
+		if err := inst.revokeDeviceInGroup(ctx, cg, devicePK); err != nil {
+			return nil, err
+		}
+	}
+
+	return &DeviceRevoke_Reply{}, nil
+}
+
+// revokeDeviceInGroup revokes the key used in a group by a device of the
+// account, groups the device hasn't joined are skipped
+func (i *instance) revokeDeviceInGroup(ctx context.Context, cg orbitutil.ContextGroup, devicePK crypto.PubKey) error {
+	groupDevicePK := devicePK
+
+	// the devices use keys of their own in the multi-member groups, they
+	// are announced in the account group
+	if cg.Group().GroupType == bertytypes.GroupTypeMultiMember {
+		var err error
+
+		groupDevicePK, err = i.accContextGroup.MetadataStore().GetGroupDevice(cg.Group().PublicKey, devicePK)
+		if err == errcode.ErrMissingInput {
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+
+	ms := cg.MetadataStore()
+
+	if !ms.IsDeviceRevoked(groupDevicePK) {
+		memberPK, err := ms.GetMemberByDevice(groupDevicePK)
+		if err != nil || !memberPK.Equals(cg.MemberPubKey()) {
+			return nil
+		}
+
+		if _, err := ms.DeviceRevoke(ctx, groupDevicePK); err != nil {
+			return err
+		}
+	}
+
+	return orbitutil.RotateSecretIfNeeded(ctx, cg)
+}
+
+// syncSigChain merges the sig chain sent by the other devices of the account
+// in the account group with the local one, the result is sent back unless
+// the other devices already know it
+func (i *instance) syncSigChain(ctx context.Context) error {
+	ms := i.accContextGroup.MetadataStore()
+	remote := ms.GetSigChain()
+
+	var (
+		merged *account.SigChain
+		err    error
+	)
+
+	if remote == nil {
+		merged, err = i.account.SigChain()
+	} else {
+		merged, err = i.account.SigChainMerge(remote)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if remote != nil && merged.IsPrefixOf(remote) {
+		return nil
+	}
+
+	_, err = ms.SigChainSend(ctx, merged)
+
+	return err
+}
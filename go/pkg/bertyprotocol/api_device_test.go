@@ -0,0 +1,163 @@
+package bertyprotocol
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/internal/testutil"
+)
+
+func TestClient_DeviceLink(t *testing.T) {
+	testutil.SkipSlow(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	apiA := ipfsutil.TestingCoreAPI(ctx, t)
+	apiB := ipfsutil.TestingCoreAPIUsingMockNet(ctx, t, apiA.MockNetwork())
+	require.NoError(t, apiA.MockNetwork().LinkAll())
+
+	a, cleanupA := TestingClient(t, Opts{Logger: testutil.Logger(t), RootContext: ctx, IpfsCoreAPI: apiA})
+	defer cleanupA()
+
+	b, cleanupB := TestingClient(t, Opts{Logger: testutil.Logger(t), RootContext: ctx, IpfsCoreAPI: apiB})
+	defer cleanupB()
+
+	res, err := a.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	ref, err := a.DeviceLinkReference(ctx, &DeviceLinkReference_Request{})
+	require.NoError(t, err)
+	require.NotNil(t, ref.Reference)
+
+	_, err = b.DeviceLinkJoin(ctx, &DeviceLinkJoin_Request{})
+	require.Error(t, err)
+
+	_, err = b.DeviceLinkJoin(ctx, &DeviceLinkJoin_Request{Reference: ref.Reference})
+	require.NoError(t, err)
+
+	clientA, clientB := a.(*client), b.(*client)
+	require.Equal(t, clientA.instance().accContextGroup.Group().PublicKey, clientB.instance().accContextGroup.Group().PublicKey)
+
+	sigChain, err := clientB.instance().account.SigChain()
+	require.NoError(t, err)
+
+	devices, err := sigChain.ListDevices()
+	require.NoError(t, err)
+	require.Len(t, devices, 2)
+
+	// the groups joined by the account are replicated on the new device
+	require.Eventually(t, func() bool {
+		_, err := clientB.instance().odb.GetContextGroupForID(res.GroupPK)
+		return err == nil
+	}, time.Second*10, time.Millisecond*100)
+
+	// the reference can only be used once, the current account is kept
+	inst := clientB.instance()
+
+	_, err = b.DeviceLinkJoin(ctx, &DeviceLinkJoin_Request{Reference: ref.Reference})
+	require.Error(t, err)
+	require.Equal(t, inst, clientB.instance())
+
+	ns, err := currentInstanceNamespace(clientB.rootDatastore)
+	require.NoError(t, err)
+	require.Equal(t, inst.namespace, ns)
+}
+
+func TestClient_DeviceRevoke(t *testing.T) {
+	testutil.SkipSlow(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	apiA := ipfsutil.TestingCoreAPI(ctx, t)
+	apiB := ipfsutil.TestingCoreAPIUsingMockNet(ctx, t, apiA.MockNetwork())
+	require.NoError(t, apiA.MockNetwork().LinkAll())
+
+	a, cleanupA := TestingClient(t, Opts{Logger: testutil.Logger(t), RootContext: ctx, IpfsCoreAPI: apiA})
+	defer cleanupA()
+
+	b, cleanupB := TestingClient(t, Opts{Logger: testutil.Logger(t), RootContext: ctx, IpfsCoreAPI: apiB})
+	defer cleanupB()
+
+	res, err := a.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	ref, err := a.DeviceLinkReference(ctx, &DeviceLinkReference_Request{})
+	require.NoError(t, err)
+
+	_, err = b.DeviceLinkJoin(ctx, &DeviceLinkJoin_Request{Reference: ref.Reference})
+	require.NoError(t, err)
+
+	clientA, clientB := a.(*client), b.(*client)
+
+	deviceASK, err := clientA.instance().account.DevicePrivKey()
+	require.NoError(t, err)
+
+	deviceBSK, err := clientB.instance().account.DevicePrivKey()
+	require.NoError(t, err)
+
+	deviceAPK, err := deviceASK.GetPublic().Raw()
+	require.NoError(t, err)
+
+	deviceBPK, err := deviceBSK.GetPublic().Raw()
+	require.NoError(t, err)
+
+	ms := clientA.instance().accContextGroup.MetadataStore()
+
+	require.Eventually(t, func() bool {
+		_, err := ms.GetMemberByDevice(deviceBSK.GetPublic())
+		return err == nil
+	}, time.Second*10, time.Millisecond*100)
+
+	// the key used by the device in the multi-member group is announced in
+	// the account group, it can't be derived from the account keys
+	var groupDeviceBPK crypto.PubKey
+	require.Eventually(t, func() bool {
+		groupDeviceBPK, err = ms.GetGroupDevice(res.GroupPK, deviceBSK.GetPublic())
+		return err == nil
+	}, time.Second*10, time.Millisecond*100)
+
+	cg, err := clientA.instance().odb.GetContextGroupForID(res.GroupPK)
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool {
+		_, err := cg.MetadataStore().GetMemberByDevice(groupDeviceBPK)
+		return err == nil
+	}, time.Second*10, time.Millisecond*100)
+
+	// the current device can't be revoked
+	_, err = a.DeviceRevoke(ctx, &DeviceRevoke_Request{DevicePK: deviceAPK})
+	require.Error(t, err)
+
+	_, err = a.DeviceRevoke(ctx, &DeviceRevoke_Request{DevicePK: deviceBPK})
+	require.NoError(t, err)
+
+	require.True(t, ms.IsDeviceRevoked(deviceBSK.GetPublic()))
+	require.Equal(t, uint64(1), ms.KeyGeneration())
+	require.True(t, cg.MetadataStore().IsDeviceRevoked(groupDeviceBPK))
+
+	_, err = ms.GetMemberByDevice(deviceBSK.GetPublic())
+	require.Error(t, err)
+
+	sigChain, err := clientA.instance().account.SigChain()
+	require.NoError(t, err)
+
+	devices, err := sigChain.ListDevices()
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+
+	// the entries appended by the revoked device are ignored
+	_, err = a.ContactRequestEnable(ctx, &ContactRequestEnable_Request{})
+	require.NoError(t, err)
+
+	_, _ = clientB.instance().accContextGroup.MetadataStore().ContactRequestDisable(ctx)
+
+	time.Sleep(time.Second)
+	require.True(t, ms.ContactRequestsEnabled())
+}
@@ -1,15 +1,214 @@
 package bertyprotocol
 
 import (
+	"bytes"
+
+	"github.com/ipfs/go-cid"
+
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
 // GroupMetadataSubscribe subscribes to the metadata events for a group
-func (c *client) GroupMetadataSubscribe(*GroupMetadataSubscribe_Request, ProtocolService_GroupMetadataSubscribeServer) error {
-	return errcode.ErrNotImplemented
+func (c *client) GroupMetadataSubscribe(req *GroupMetadataSubscribe_Request, sub ProtocolService_GroupMetadataSubscribeServer) error {
+	cg, err := c.instance().getContextGroupForID(req.GroupPK)
+	if err != nil {
+		return err
+	}
+
+	since, err := parseEventID(req.Since)
+	if err != nil {
+		return err
+	}
+
+	until, err := parseEventID(req.Until)
+	if err != nil {
+		return err
+	}
+
+	accept := eventTypesFilter(req.EventTypes)
+	ctx := sub.Context()
+
+	// subscribe before replaying the history so no event is missed in between
+	live := cg.MetadataStore().Subscribe(ctx)
+
+	var (
+		history []*bertytypes.GroupMetadataEvent
+		started = !since.Defined()
+		ended   = false
+	)
+
+	// events are listed from the oldest to the newest, the channel is
+	// drained even when the until bound has been reached
+	for evt := range cg.MetadataStore().ListEvents(ctx) {
+		if ended {
+			continue
+		}
+
+		id := evt.EventContext.ID
+		if !started {
+			started = bytes.Equal(id, since.Bytes())
+			ended = started && until.Defined() && bytes.Equal(id, until.Bytes())
+			continue
+		}
+
+		ended = until.Defined() && bytes.Equal(id, until.Bytes())
+
+		if accept(evt.Metadata.EventType) {
+			history = append(history, evt)
+		}
+	}
+
+	// the cursors must be events of the group
+	if !started || (until.Defined() && !ended) {
+		return errcode.ErrInvalidInput
+	}
+
+	if req.GoBackwards {
+		for i, j := 0, len(history)-1; i < j; i, j = i+1, j-1 {
+			history[i], history[j] = history[j], history[i]
+		}
+	}
+
+	// sent holds the events of the history which may also be received as
+	// live events, it is dropped once both don't overlap anymore
+	sent := make(map[string]struct{}, len(history))
+
+	for _, evt := range history {
+		sent[string(evt.EventContext.ID)] = struct{}{}
+
+		if err := sub.Send(evt); err != nil {
+			return err
+		}
+	}
+
+	if until.Defined() {
+		return nil
+	}
+
+	for evt := range live {
+		e, ok := evt.(*bertytypes.GroupMetadataEvent)
+		if !ok || !accept(e.Metadata.EventType) {
+			continue
+		}
+
+		if sent != nil {
+			if _, ok := sent[string(e.EventContext.ID)]; ok {
+				continue
+			}
+
+			sent = nil
+		}
+
+		if err := sub.Send(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // GroupMessageSubscribe subscribes to the message events for a group
-func (c *client) GroupMessageSubscribe(*GroupMessageSubscribe_Request, ProtocolService_GroupMessageSubscribeServer) error {
-	return errcode.ErrNotImplemented
+func (c *client) GroupMessageSubscribe(req *GroupMessageSubscribe_Request, sub ProtocolService_GroupMessageSubscribeServer) error {
+	cg, err := c.instance().getContextGroupForID(req.GroupPK)
+	if err != nil {
+		return err
+	}
+
+	since, err := parseEventID(req.Since)
+	if err != nil {
+		return err
+	}
+
+	until, err := parseEventID(req.Until)
+	if err != nil {
+		return err
+	}
+
+	ctx := sub.Context()
+
+	// subscribe before replaying the history so no message is missed in between
+	live := cg.MessageStore().Subscribe(ctx)
+
+	// sent holds the messages of the history which may also be received as
+	// live events, it is dropped once both don't overlap anymore
+	var sent map[string]struct{}
+
+	if !req.LiveOnly {
+		limit := -1
+		if req.Limit > 0 {
+			limit = int(req.Limit)
+		}
+
+		ch, err := cg.MessageStore().ListMessagesRange(ctx, since, until, limit, req.GoBackwards)
+		if err != nil {
+			return err
+		}
+
+		sent = map[string]struct{}{}
+		for evt := range ch {
+			sent[string(evt.EventContext.ID)] = struct{}{}
+
+			if err := sub.Send(evt); err != nil {
+				return err
+			}
+		}
+	}
+
+	if until.Defined() {
+		return nil
+	}
+
+	for evt := range live {
+		e, ok := evt.(*bertytypes.GroupMessageEvent)
+		if !ok {
+			continue
+		}
+
+		if sent != nil {
+			if _, ok := sent[string(e.EventContext.ID)]; ok {
+				continue
+			}
+
+			sent = nil
+		}
+
+		if err := sub.Send(e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseEventID parses an optional event ID
+func parseEventID(id []byte) (cid.Cid, error) {
+	if len(id) == 0 {
+		return cid.Undef, nil
+	}
+
+	c, err := cid.Cast(id)
+	if err != nil {
+		return cid.Undef, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	return c, nil
+}
+
+// eventTypesFilter returns a function matching the given event types, every
+// event type is matched if none is given
+func eventTypesFilter(types []bertytypes.EventType) func(bertytypes.EventType) bool {
+	if len(types) == 0 {
+		return func(bertytypes.EventType) bool { return true }
+	}
+
+	accepted := make(map[bertytypes.EventType]struct{}, len(types))
+	for _, t := range types {
+		accepted[t] = struct{}{}
+	}
+
+	return func(t bertytypes.EventType) bool {
+		_, ok := accepted[t]
+		return ok
+	}
 }
@@ -0,0 +1,234 @@
+package bertyprotocol
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"berty.tech/berty/go/internal/testutil"
+	"berty.tech/berty/go/pkg/bertytypes"
+)
+
+type testMessageSubscribeServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	events []*bertytypes.GroupMessageEvent
+
+	// live receives the events instead of events when set
+	live chan *bertytypes.GroupMessageEvent
+}
+
+func (s *testMessageSubscribeServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testMessageSubscribeServer) Send(evt *bertytypes.GroupMessageEvent) error {
+	if s.live == nil {
+		s.events = append(s.events, evt)
+		return nil
+	}
+
+	select {
+	case s.live <- evt:
+		return nil
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+type testMetadataSubscribeServer struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	events []*bertytypes.GroupMetadataEvent
+}
+
+func (s *testMetadataSubscribeServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testMetadataSubscribeServer) Send(evt *bertytypes.GroupMetadataEvent) error {
+	s.events = append(s.events, evt)
+	return nil
+}
+
+func TestClient_GroupMetadataSubscribeHistory(t *testing.T) {
+	ctx := context.Background()
+
+	c, cleanup := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	res, err := c.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	cg, err := c.(*client).instance().getContextGroupForID(res.GroupPK)
+	require.NoError(t, err)
+
+	var last []byte
+	for evt := range cg.MetadataStore().ListEvents(ctx) {
+		last = evt.EventContext.ID
+	}
+	require.NotNil(t, last)
+
+	sub := &testMetadataSubscribeServer{ctx: ctx}
+	err = c.GroupMetadataSubscribe(&GroupMetadataSubscribe_Request{
+		GroupPK:    res.GroupPK,
+		Until:      last,
+		EventTypes: []bertytypes.EventType{bertytypes.EventTypeMultiMemberGroupInitialMemberAnnounced},
+	}, sub)
+	require.NoError(t, err)
+	require.Len(t, sub.events, 1)
+	require.Equal(t, bertytypes.EventTypeMultiMemberGroupInitialMemberAnnounced, sub.events[0].Metadata.EventType)
+
+	sub = &testMetadataSubscribeServer{ctx: ctx}
+	err = c.GroupMetadataSubscribe(&GroupMetadataSubscribe_Request{
+		GroupPK: res.GroupPK,
+		Since:   last,
+		Until:   last,
+	}, sub)
+	require.NoError(t, err)
+	require.Len(t, sub.events, 0)
+
+	// an unknown cursor is rejected
+	err = c.GroupMetadataSubscribe(&GroupMetadataSubscribe_Request{
+		GroupPK: res.GroupPK,
+		Since:   cg.MessageStore().Address().Root().Bytes(),
+		Until:   last,
+	}, &testMetadataSubscribeServer{ctx: ctx})
+	require.Error(t, err)
+}
+
+func TestClient_GroupMessageSubscribeHistory(t *testing.T) {
+	ctx := context.Background()
+
+	c, cleanup := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	res, err := c.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	cg, err := c.(*client).instance().getContextGroupForID(res.GroupPK)
+	require.NoError(t, err)
+
+	var ids [][]byte
+	for i := 0; i < 3; i++ {
+		op, err := cg.MessageStore().AddMessage(ctx, []byte(fmt.Sprintf("test%d", i)))
+		require.NoError(t, err)
+
+		ids = append(ids, op.GetEntry().GetHash().Bytes())
+	}
+
+	sub := &testMessageSubscribeServer{ctx: ctx}
+	err = c.GroupMessageSubscribe(&GroupMessageSubscribe_Request{
+		GroupPK: res.GroupPK,
+		Until:   ids[2],
+		Limit:   2,
+	}, sub)
+	require.NoError(t, err)
+	require.Len(t, sub.events, 2)
+	require.Equal(t, []byte("test1"), sub.events[0].Message)
+	require.Equal(t, []byte("test2"), sub.events[1].Message)
+
+	sub = &testMessageSubscribeServer{ctx: ctx}
+	err = c.GroupMessageSubscribe(&GroupMessageSubscribe_Request{
+		GroupPK:     res.GroupPK,
+		Since:       ids[0],
+		Until:       ids[2],
+		GoBackwards: true,
+	}, sub)
+	require.NoError(t, err)
+	require.Len(t, sub.events, 2)
+	require.Equal(t, []byte("test2"), sub.events[0].Message)
+	require.Equal(t, []byte("test1"), sub.events[1].Message)
+
+	// paging forward from since keeps the oldest messages
+	sub = &testMessageSubscribeServer{ctx: ctx}
+	err = c.GroupMessageSubscribe(&GroupMessageSubscribe_Request{
+		GroupPK: res.GroupPK,
+		Since:   ids[0],
+		Until:   ids[2],
+		Limit:   1,
+	}, sub)
+	require.NoError(t, err)
+	require.Len(t, sub.events, 1)
+	require.Equal(t, []byte("test1"), sub.events[0].Message)
+
+	sub = &testMessageSubscribeServer{ctx: ctx}
+	err = c.GroupMessageSubscribe(&GroupMessageSubscribe_Request{
+		GroupPK:     res.GroupPK,
+		Since:       ids[0],
+		Until:       ids[2],
+		Limit:       1,
+		GoBackwards: true,
+	}, sub)
+	require.NoError(t, err)
+	require.Len(t, sub.events, 1)
+	require.Equal(t, []byte("test2"), sub.events[0].Message)
+
+	// an unknown cursor is rejected
+	var metadataID []byte
+	for evt := range cg.MetadataStore().ListEvents(ctx) {
+		metadataID = evt.EventContext.ID
+	}
+
+	err = c.GroupMessageSubscribe(&GroupMessageSubscribe_Request{
+		GroupPK: res.GroupPK,
+		Since:   metadataID,
+		Until:   ids[2],
+	}, &testMessageSubscribeServer{ctx: ctx})
+	require.Error(t, err)
+}
+
+func TestClient_GroupMessageSubscribeLive(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, cleanup := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	res, err := c.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	cg, err := c.(*client).instance().getContextGroupForID(res.GroupPK)
+	require.NoError(t, err)
+
+	_, err = cg.MessageStore().AddMessage(ctx, []byte("history"))
+	require.NoError(t, err)
+
+	sub := &testMessageSubscribeServer{ctx: ctx, live: make(chan *bertytypes.GroupMessageEvent)}
+	done := make(chan error, 1)
+
+	go func() {
+		done <- c.GroupMessageSubscribe(&GroupMessageSubscribe_Request{GroupPK: res.GroupPK}, sub)
+	}()
+
+	receive := func() *bertytypes.GroupMessageEvent {
+		select {
+		case evt := <-sub.live:
+			return evt
+		case <-time.After(10 * time.Second):
+			require.FailNow(t, "timed out waiting for a message")
+			return nil
+		}
+	}
+
+	require.Equal(t, []byte("history"), receive().Message)
+
+	// messages added once the history has been sent are streamed
+	_, err = cg.MessageStore().AddMessage(ctx, []byte("live"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("live"), receive().Message)
+
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		require.FailNow(t, "subscription not stopped after cancellation")
+	}
+}
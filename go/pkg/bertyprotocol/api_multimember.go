@@ -1,24 +1,122 @@
 package bertyprotocol
 
 import (
+	"bytes"
 	"context"
+	"time"
 
+	"github.com/libp2p/go-libp2p-core/crypto"
+
+	"berty.tech/berty/go/internal/orbitutil"
+	"berty.tech/berty/go/pkg/bertytypes"
 	"berty.tech/berty/go/pkg/errcode"
 )
 
 // MultiMemberGroupCreate creates a new MultiMember group
-func (c *client) MultiMemberGroupCreate(context.Context, *MultiMemberGroupCreate_Request) (*MultiMemberGroupCreate_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) MultiMemberGroupCreate(ctx context.Context, req *MultiMemberGroupCreate_Request) (*MultiMemberGroupCreate_Reply, error) {
+	inst := c.instance()
+
+	g, sk, err := bertytypes.NewGroupMultiMember()
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	// the policy is sent to the invitees with the rest of the group
+	g.AdminMessagesOnly = req.AdminMessagesOnly
+
+	cg, err := inst.activateGroup(g)
+	if err != nil {
+		return nil, err
+	}
+
+	// the group is only recorded in the account group once it is ready, so
+	// a failure doesn't leave a group the account can't open
+	if _, err = cg.MetadataStore().ClaimGroupOwnership(ctx, sk); err != nil {
+		_ = inst.deactivateGroup(g.PublicKey)
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	if _, err = inst.accContextGroup.MetadataStore().GroupJoin(ctx, g); err != nil {
+		_ = inst.deactivateGroup(g.PublicKey)
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	return &MultiMemberGroupCreate_Reply{
+		GroupPK: g.PublicKey,
+	}, nil
 }
 
-// MultiMemberGroupJoin joins an existing MultiMember group using an invitation
-func (c *client) MultiMemberGroupJoin(context.Context, *MultiMemberGroupJoin_Request) (*MultiMemberGroupJoin_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+// MultiMemberGroupJoin joins an existing MultiMember group using an invitation,
+// the group is sent by an admin once it has checked and recorded the use of
+// the invitation
+func (c *client) MultiMemberGroupJoin(ctx context.Context, req *MultiMemberGroupJoin_Request) (*MultiMemberGroupJoin_Reply, error) {
+	inst := c.instance()
+
+	if req.Invitation == nil || len(req.Invitation.InvitationSK) == 0 {
+		return nil, errcode.ErrMissingInput
+	}
+
+	// the expiry and the signature can be checked before the group is
+	// requested
+	if err := req.Invitation.CheckSignature(); err != nil {
+		return nil, err
+	}
+
+	if req.Invitation.IsExpired(time.Now()) {
+		return nil, errcode.ErrGroupInvitationExpired
+	}
+
+	// the admins are found using the discovery drivers
+	if inst.groupInvitations == nil {
+		return nil, errcode.ErrGroupMemberUnknownGroupID
+	}
+
+	md, err := inst.account.MemberDeviceForGroup(&bertytypes.Group{
+		PublicKey: req.Invitation.GroupPK,
+		GroupType: bertytypes.GroupTypeMultiMember,
+	})
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	g, err := inst.groupInvitations.RequestGroup(ctx, req.Invitation, md.Member)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := inst.activateGroup(g); err != nil {
+		return nil, err
+	}
+
+	if _, err := inst.accContextGroup.MetadataStore().GroupJoin(ctx, g); err != nil {
+		if !inst.isMultiMemberGroupJoined(g) {
+			_ = inst.deactivateGroup(g.PublicKey)
+		}
+
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	return &MultiMemberGroupJoin_Reply{}, nil
 }
 
 // MultiMemberGroupLeave leaves a previously joined MultiMember group
-func (c *client) MultiMemberGroupLeave(context.Context, *MultiMemberGroupLeave_Request) (*MultiMemberGroupLeave_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) MultiMemberGroupLeave(ctx context.Context, req *MultiMemberGroupLeave_Request) (*MultiMemberGroupLeave_Reply, error) {
+	inst := c.instance()
+
+	pk, err := crypto.UnmarshalEd25519PublicKey(req.GroupPK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, err := inst.accContextGroup.MetadataStore().GroupLeave(ctx, pk); err != nil {
+		return nil, errcode.ErrOrbitDBAppend.Wrap(err)
+	}
+
+	if err := inst.deactivateGroup(req.GroupPK); err != nil {
+		return nil, err
+	}
+
+	return &MultiMemberGroupLeave_Reply{}, nil
 }
 
 // MultiMemberGroupAliasResolverDisclose sends an account identity proof to the group members
@@ -27,11 +125,111 @@ func (c *client) MultiMemberGroupAliasResolverDisclose(context.Context, *MultiMe
 }
 
 // MultiMemberGroupAdminRoleGrant grants admin role to another member of the group
-func (c *client) MultiMemberGroupAdminRoleGrant(context.Context, *MultiMemberGroupAdminRoleGrant_Request) (*MultiMemberGroupAdminRoleGrant_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) MultiMemberGroupAdminRoleGrant(ctx context.Context, req *MultiMemberGroupAdminRoleGrant_Request) (*MultiMemberGroupAdminRoleGrant_Reply, error) {
+	cg, err := c.instance().getContextGroupForID(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	memberPK, err := crypto.UnmarshalEd25519PublicKey(req.MemberPK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, err := cg.MetadataStore().AdminRoleGrant(ctx, memberPK); err != nil {
+		return nil, err
+	}
+
+	return &MultiMemberGroupAdminRoleGrant_Reply{}, nil
+}
+
+// MultiMemberGroupAdminRoleRevoke revokes the admin role of another member of the group
+func (c *client) MultiMemberGroupAdminRoleRevoke(ctx context.Context, req *MultiMemberGroupAdminRoleRevoke_Request) (*MultiMemberGroupAdminRoleRevoke_Reply, error) {
+	cg, err := c.instance().getContextGroupForID(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	memberPK, err := crypto.UnmarshalEd25519PublicKey(req.MemberPK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, err := cg.MetadataStore().AdminRoleRevoke(ctx, memberPK); err != nil {
+		return nil, err
+	}
+
+	return &MultiMemberGroupAdminRoleRevoke_Reply{}, nil
+}
+
+// MultiMemberGroupMemberRemove removes a member from the group, the secrets
+// of the remaining devices are rotated so the removed member can't read the
+// messages sent afterwards
+func (c *client) MultiMemberGroupMemberRemove(ctx context.Context, req *MultiMemberGroupMemberRemove_Request) (*MultiMemberGroupMemberRemove_Reply, error) {
+	cg, err := c.instance().getContextGroupForID(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	memberPK, err := crypto.UnmarshalEd25519PublicKey(req.MemberPK)
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if _, err := cg.MetadataStore().MemberRemove(ctx, memberPK); err != nil {
+		return nil, err
+	}
+
+	if err := orbitutil.RotateSecretIfNeeded(ctx, cg); err != nil {
+		return nil, err
+	}
+
+	return &MultiMemberGroupMemberRemove_Reply{}, nil
 }
 
 // MultiMemberGroupInvitationCreate creates a group invitation
-func (c *client) MultiMemberGroupInvitationCreate(context.Context, *MultiMemberGroupInvitationCreate_Request) (*MultiMemberGroupInvitationCreate_Reply, error) {
-	return nil, errcode.ErrNotImplemented
+func (c *client) MultiMemberGroupInvitationCreate(ctx context.Context, req *MultiMemberGroupInvitationCreate_Request) (*MultiMemberGroupInvitationCreate_Reply, error) {
+	cg, err := c.instance().getContextGroupForID(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt := time.Time{}
+	if req.ExpiresAt != 0 {
+		expiresAt = time.Unix(req.ExpiresAt, 0)
+	}
+
+	invitation, err := cg.MetadataStore().InvitationCreate(expiresAt, req.MaxUses)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MultiMemberGroupInvitationCreate_Reply{
+		Invitation: invitation,
+	}, nil
+}
+
+// MultiMemberGroupInvitationRevoke revokes a group invitation
+func (c *client) MultiMemberGroupInvitationRevoke(ctx context.Context, req *MultiMemberGroupInvitationRevoke_Request) (*MultiMemberGroupInvitationRevoke_Reply, error) {
+	cg, err := c.instance().getContextGroupForID(req.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := cg.MetadataStore().InvitationRevoke(ctx, req.InvitationID); err != nil {
+		return nil, err
+	}
+
+	return &MultiMemberGroupInvitationRevoke_Reply{}, nil
+}
+
+// isMultiMemberGroupJoined returns whether the account has joined a group
+func (i *instance) isMultiMemberGroupJoined(g *bertytypes.Group) bool {
+	for _, joined := range i.accContextGroup.MetadataStore().ListMultiMemberGroups() {
+		if bytes.Equal(joined.PublicKey, g.PublicKey) {
+			return true
+		}
+	}
+
+	return false
 }
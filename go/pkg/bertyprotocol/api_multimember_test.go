@@ -0,0 +1,258 @@
+package bertyprotocol
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/require"
+
+	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/internal/testutil"
+	"berty.tech/berty/go/internal/tinder"
+	"berty.tech/berty/go/pkg/bertytypes"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+func TestClient_MultiMemberGroupCreateLeave(t *testing.T) {
+	ctx := context.Background()
+
+	c, cleanup := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanup()
+
+	res, err := c.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+	require.Len(t, res.GroupPK, 32)
+
+	typed := c.(*client)
+	require.Len(t, typed.instance().accContextGroup.MetadataStore().ListMultiMemberGroups(), 1)
+
+	cg, err := typed.instance().getContextGroupForID(res.GroupPK)
+	require.NoError(t, err)
+	require.Len(t, cg.MetadataStore().ListAdmins(), 1)
+
+	_, err = c.MultiMemberGroupLeave(ctx, &MultiMemberGroupLeave_Request{GroupPK: res.GroupPK})
+	require.NoError(t, err)
+	require.Len(t, typed.instance().accContextGroup.MetadataStore().ListMultiMemberGroups(), 0)
+
+	_, err = typed.instance().getContextGroupForID(res.GroupPK)
+	testSameErrcodes(t, errcode.ErrGroupMemberUnknownGroupID, err)
+}
+
+func TestClient_MultiMemberGroupReopen(t *testing.T) {
+	ctx := context.Background()
+	opts := Opts{
+		Logger:        testutil.Logger(t),
+		IpfsCoreAPI:   ipfsutil.TestingCoreAPI(ctx, t),
+		RootDatastore: ds_sync.MutexWrap(datastore.NewMapDatastore()),
+	}
+
+	c, cleanup := TestingClient(t, opts)
+
+	res, err := c.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	cleanup()
+
+	c, cleanup = TestingClient(t, opts)
+	defer cleanup()
+
+	_, err = c.(*client).instance().getContextGroupForID(res.GroupPK)
+	require.NoError(t, err)
+}
+
+func TestClient_MultiMemberGroupInvitation(t *testing.T) {
+	testutil.SkipSlow(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	server := tinder.NewMockedDriverServer()
+
+	apiA := ipfsutil.TestingCoreAPI(ctx, t)
+	apiB := ipfsutil.TestingCoreAPIUsingMockNet(ctx, t, apiA.MockNetwork())
+	require.NoError(t, apiA.MockNetwork().LinkAll())
+
+	hostA, hostB := apiA.MockNode().PeerHost, apiB.MockNode().PeerHost
+
+	a, cleanupA := TestingClient(t, Opts{
+		Logger:       testutil.Logger(t),
+		RootContext:  ctx,
+		IpfsCoreAPI:  apiA,
+		TinderDriver: tinder.NewMockedDriverClient(hostA, server),
+	})
+	defer cleanupA()
+
+	b, cleanupB := TestingClient(t, Opts{
+		Logger:       testutil.Logger(t),
+		RootContext:  ctx,
+		IpfsCoreAPI:  apiB,
+		TinderDriver: tinder.NewMockedDriverClient(hostB, server),
+	})
+	defer cleanupB()
+
+	res, err := a.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	expired, err := a.MultiMemberGroupInvitationCreate(ctx, &MultiMemberGroupInvitationCreate_Request{
+		GroupPK:   res.GroupPK,
+		ExpiresAt: time.Now().Add(-time.Minute).Unix(),
+	})
+	require.NoError(t, err)
+
+	_, err = b.MultiMemberGroupJoin(ctx, &MultiMemberGroupJoin_Request{Invitation: expired.Invitation})
+	testSameErrcodes(t, errcode.ErrGroupInvitationExpired, err)
+
+	invitation, err := a.MultiMemberGroupInvitationCreate(ctx, &MultiMemberGroupInvitationCreate_Request{
+		GroupPK:   res.GroupPK,
+		ExpiresAt: time.Now().Add(time.Hour).Unix(),
+		MaxUses:   1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, res.GroupPK, invitation.Invitation.GroupPK)
+
+	// the invitation only holds the public key of the group
+	cgA, err := a.(*client).instance().getContextGroupForID(res.GroupPK)
+	require.NoError(t, err)
+
+	data, err := invitation.Invitation.Marshal()
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(data, cgA.Group().Secret))
+
+	// the private key of the invitation is needed to request the group
+	_, err = b.MultiMemberGroupJoin(ctx, &MultiMemberGroupJoin_Request{Invitation: invitation.Invitation.Public()})
+	testSameErrcodes(t, errcode.ErrMissingInput, err)
+
+	require.Eventually(t, func() bool {
+		return server.HasPeerRecord(groupInvitationPoint(invitation.Invitation.RendezvousSeed).NamespaceAt(time.Now()), hostA.ID())
+	}, time.Second*5, time.Millisecond*100)
+
+	joinCtx, joinCancel := context.WithTimeout(ctx, time.Second*10)
+	defer joinCancel()
+
+	_, err = b.MultiMemberGroupJoin(joinCtx, &MultiMemberGroupJoin_Request{Invitation: invitation.Invitation})
+	require.NoError(t, err)
+	require.Len(t, b.(*client).instance().accContextGroup.MetadataStore().ListMultiMemberGroups(), 1)
+
+	cgB, err := b.(*client).instance().getContextGroupForID(res.GroupPK)
+	require.NoError(t, err)
+	require.Equal(t, cgA.Group().Secret, cgB.Group().Secret)
+
+	// the admin recorded the use of the invitation by the member
+	require.True(t, cgA.MetadataStore().IsMemberAdmitted(cgB.MemberPubKey()))
+	require.NoError(t, cgA.MetadataStore().InvitationCheck(invitation.Invitation, cgB.MemberPubKey()))
+
+	_, otherPK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	err = cgA.MetadataStore().InvitationCheck(invitation.Invitation, otherPK)
+	testSameErrcodes(t, errcode.ErrGroupInvitationExhausted, err)
+
+	// the used up invitation can't be replayed naming the member key of b
+	// without its private key
+	forgerSK, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	replayed, _, err := bertytypes.NewGroupInvitationJoinRequest(invitation.Invitation, forgerSK)
+	require.NoError(t, err)
+
+	replayed.MemberPK, err = cgB.MemberPubKey().Raw()
+	require.NoError(t, err)
+
+	replayed.Sig, replayed.MemberSig = nil, nil
+
+	data, err = replayed.Marshal()
+	require.NoError(t, err)
+
+	invitationSK, err := invitation.Invitation.GetInvitationPrivKey()
+	require.NoError(t, err)
+
+	replayed.Sig, err = invitationSK.Sign(data)
+	require.NoError(t, err)
+
+	replayed.MemberSig, err = forgerSK.Sign(data)
+	require.NoError(t, err)
+
+	_, err = a.(*client).instance().groupInvitations.acceptRequest(ctx, replayed)
+	testSameErrcodes(t, errcode.ErrSignatureVerificationFailed, err)
+
+	// b may request the group again using its member key
+	mdB, err := b.(*client).instance().account.MemberDeviceForGroup(cgB.Group())
+	require.NoError(t, err)
+
+	rejoin, _, err := bertytypes.NewGroupInvitationJoinRequest(invitation.Invitation, mdB.Member)
+	require.NoError(t, err)
+
+	_, err = a.(*client).instance().groupInvitations.acceptRequest(ctx, rejoin)
+	require.NoError(t, err)
+
+	unlimited, err := a.MultiMemberGroupInvitationCreate(ctx, &MultiMemberGroupInvitationCreate_Request{GroupPK: res.GroupPK})
+	require.NoError(t, err)
+	require.NoError(t, cgA.MetadataStore().InvitationCheck(unlimited.Invitation, otherPK))
+
+	_, err = a.MultiMemberGroupInvitationRevoke(ctx, &MultiMemberGroupInvitationRevoke_Request{
+		GroupPK:      res.GroupPK,
+		InvitationID: unlimited.Invitation.ID,
+	})
+	require.NoError(t, err)
+
+	err = cgA.MetadataStore().InvitationCheck(unlimited.Invitation, otherPK)
+	testSameErrcodes(t, errcode.ErrGroupInvitationRevoked, err)
+
+	// only admins can create invitations
+	_, err = b.MultiMemberGroupInvitationCreate(ctx, &MultiMemberGroupInvitationCreate_Request{GroupPK: res.GroupPK})
+	require.Error(t, err)
+}
+
+func TestClient_MultiMemberGroupRoles(t *testing.T) {
+	ctx := context.Background()
+
+	a, cleanupA := TestingClient(t, Opts{Logger: testutil.Logger(t)})
+	defer cleanupA()
+
+	res, err := a.MultiMemberGroupCreate(ctx, &MultiMemberGroupCreate_Request{})
+	require.NoError(t, err)
+
+	cgA, err := a.(*client).instance().getContextGroupForID(res.GroupPK)
+	require.NoError(t, err)
+
+	ownPK, err := cgA.MemberPubKey().Raw()
+	require.NoError(t, err)
+
+	_, otherPK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	otherPKBytes, err := otherPK.Raw()
+	require.NoError(t, err)
+
+	_, err = a.MultiMemberGroupAdminRoleGrant(ctx, &MultiMemberGroupAdminRoleGrant_Request{GroupPK: res.GroupPK, MemberPK: otherPKBytes})
+	require.NoError(t, err)
+	require.Len(t, cgA.MetadataStore().ListAdmins(), 2)
+
+	_, err = a.MultiMemberGroupAdminRoleRevoke(ctx, &MultiMemberGroupAdminRoleRevoke_Request{GroupPK: res.GroupPK, MemberPK: otherPKBytes})
+	require.NoError(t, err)
+	require.Len(t, cgA.MetadataStore().ListAdmins(), 1)
+
+	// the last admin of the group can't lose its role
+	_, err = a.MultiMemberGroupAdminRoleRevoke(ctx, &MultiMemberGroupAdminRoleRevoke_Request{GroupPK: res.GroupPK, MemberPK: ownPK})
+	testSameErrcodes(t, errcode.ErrGroupLastAdmin, err)
+
+	_, err = a.MultiMemberGroupMemberRemove(ctx, &MultiMemberGroupMemberRemove_Request{GroupPK: res.GroupPK, MemberPK: ownPK})
+	testSameErrcodes(t, errcode.ErrGroupLastAdmin, err)
+
+	require.Equal(t, uint64(0), cgA.MetadataStore().KeyGeneration())
+
+	_, err = a.MultiMemberGroupMemberRemove(ctx, &MultiMemberGroupMemberRemove_Request{GroupPK: res.GroupPK, MemberPK: otherPKBytes})
+	require.NoError(t, err)
+	require.True(t, cgA.MetadataStore().IsMemberRemoved(otherPK))
+	require.Equal(t, uint64(1), cgA.MetadataStore().KeyGeneration())
+
+	// a removed member can't be granted a role anymore
+	_, err = a.MultiMemberGroupAdminRoleGrant(ctx, &MultiMemberGroupAdminRoleGrant_Request{GroupPK: res.GroupPK, MemberPK: otherPKBytes})
+	testSameErrcodes(t, errcode.ErrGroupMemberRemoved, err)
+}
@@ -10,6 +10,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	bertytypes "berty.tech/berty/go/pkg/bertytypes"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -28,180 +29,6 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-type GroupType int32
-
-const (
-	// GroupTypeUndefined indicates that the value has not been set. Should not happen.
-	GroupTypeUndefined GroupType = 0
-	// GroupTypeAccount is the group managing an account, available to all its devices.
-	GroupTypeAccount GroupType = 1
-	// GroupTypeContact is the group created between two accounts, available to all their devices.
-	GroupTypeContact GroupType = 2
-	// GroupTypeMultiMember is a group containing an undefined number of members.
-	GroupTypeMultiMember GroupType = 3
-)
-
-var GroupType_name = map[int32]string{
-	0: "GroupTypeUndefined",
-	1: "GroupTypeAccount",
-	2: "GroupTypeContact",
-	3: "GroupTypeMultiMember",
-}
-
-var GroupType_value = map[string]int32{
-	"GroupTypeUndefined":   0,
-	"GroupTypeAccount":     1,
-	"GroupTypeContact":     2,
-	"GroupTypeMultiMember": 3,
-}
-
-func (x GroupType) String() string {
-	return proto.EnumName(GroupType_name, int32(x))
-}
-
-func (GroupType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{0}
-}
-
-type EventType int32
-
-const (
-	// EventTypeUndefined indicates that the value has not been set. Should not happen.
-	EventTypeUndefined EventType = 0
-	// EventTypeGroupMemberDeviceAdded indicates the payload includes that a member has added their device to the group
-	EventTypeGroupMemberDeviceAdded EventType = 1
-	// EventTypeGroupDeviceSecretAdded indicates the payload includes that a member has sent their device secret to another member
-	EventTypeGroupDeviceSecretAdded EventType = 2
-	// EventTypeAccountGroupJoined indicates the payload includes that the account has joined a group
-	EventTypeAccountGroupJoined EventType = 101
-	// EventTypeAccountGroupLeft indicates the payload includes that the account has left a group
-	EventTypeAccountGroupLeft EventType = 102
-	// EventTypeAccountContactRequestDisabled indicates the payload includes that the account has disabled incoming contact requests
-	EventTypeAccountContactRequestDisabled EventType = 103
-	// EventTypeAccountContactRequestEnabled indicates the payload includes that the account has enabled incoming contact requests
-	EventTypeAccountContactRequestEnabled EventType = 104
-	// EventTypeAccountContactRequestReferenceReset indicates the payload includes that the account has a new contact request reference
-	EventTypeAccountContactRequestReferenceReset EventType = 105
-	// EventTypeAccountContactRequestEnqueued indicates the payload includes that the account will attempt to send a new contact request
-	EventTypeAccountContactRequestOutgoingEnqueued EventType = 106
-	// EventTypeAccountContactRequestSent indicates the payload includes that the account has sent a contact request
-	EventTypeAccountContactRequestOutgoingSent EventType = 107
-	// EventTypeAccountContactRequestReceived indicates the payload includes that the account has received a contact request
-	EventTypeAccountContactRequestIncomingReceived EventType = 108
-	// EventTypeAccountContactRequestIncomingDiscarded indicates the payload includes that the account has ignored a contact request
-	EventTypeAccountContactRequestIncomingDiscarded EventType = 109
-	// EventTypeAccountContactRequestAccepted indicates the payload includes that the account has accepted a contact request
-	EventTypeAccountContactRequestIncomingAccepted EventType = 110
-	// EventTypeAccountContactBlocked indicates the payload includes that the account has blocked a contact
-	EventTypeAccountContactBlocked EventType = 111
-	// EventTypeAccountContactUnblocked indicates the payload includes that the account has unblocked a contact
-	EventTypeAccountContactUnblocked EventType = 112
-	// EventTypeContactAliasKeyAdded indicates the payload includes that the contact group has received an alias key
-	EventTypeContactAliasKeyAdded EventType = 201
-	// EventTypeMultiMemberGroupAliasResolverAdded indicates the payload includes that a member of the group sent their alias proof
-	EventTypeMultiMemberGroupAliasResolverAdded EventType = 301
-	// EventTypeMultiMemberGroupInitialMemberAnnounced indicates the payload includes that a member has authenticated themselves as the group owner
-	EventTypeMultiMemberGroupInitialMemberAnnounced EventType = 302
-	// EventTypeMultiMemberGroupAdminRoleGranted indicates the payload includes that an admin of the group granted another member as an admin
-	EventTypeMultiMemberGroupAdminRoleGranted EventType = 303
-	// EventTypeGroupMetadataPayloadSent indicates the payload includes an app specific event, unlike messages stored on the message store it is encrypted using a static key
-	EventTypeGroupMetadataPayloadSent EventType = 1001
-)
-
-var EventType_name = map[int32]string{
-	0:    "EventTypeUndefined",
-	1:    "EventTypeGroupMemberDeviceAdded",
-	2:    "EventTypeGroupDeviceSecretAdded",
-	101:  "EventTypeAccountGroupJoined",
-	102:  "EventTypeAccountGroupLeft",
-	103:  "EventTypeAccountContactRequestDisabled",
-	104:  "EventTypeAccountContactRequestEnabled",
-	105:  "EventTypeAccountContactRequestReferenceReset",
-	106:  "EventTypeAccountContactRequestOutgoingEnqueued",
-	107:  "EventTypeAccountContactRequestOutgoingSent",
-	108:  "EventTypeAccountContactRequestIncomingReceived",
-	109:  "EventTypeAccountContactRequestIncomingDiscarded",
-	110:  "EventTypeAccountContactRequestIncomingAccepted",
-	111:  "EventTypeAccountContactBlocked",
-	112:  "EventTypeAccountContactUnblocked",
-	201:  "EventTypeContactAliasKeyAdded",
-	301:  "EventTypeMultiMemberGroupAliasResolverAdded",
-	302:  "EventTypeMultiMemberGroupInitialMemberAnnounced",
-	303:  "EventTypeMultiMemberGroupAdminRoleGranted",
-	1001: "EventTypeGroupMetadataPayloadSent",
-}
-
-var EventType_value = map[string]int32{
-	"EventTypeUndefined":                              0,
-	"EventTypeGroupMemberDeviceAdded":                 1,
-	"EventTypeGroupDeviceSecretAdded":                 2,
-	"EventTypeAccountGroupJoined":                     101,
-	"EventTypeAccountGroupLeft":                       102,
-	"EventTypeAccountContactRequestDisabled":          103,
-	"EventTypeAccountContactRequestEnabled":           104,
-	"EventTypeAccountContactRequestReferenceReset":    105,
-	"EventTypeAccountContactRequestOutgoingEnqueued":  106,
-	"EventTypeAccountContactRequestOutgoingSent":      107,
-	"EventTypeAccountContactRequestIncomingReceived":  108,
-	"EventTypeAccountContactRequestIncomingDiscarded": 109,
-	"EventTypeAccountContactRequestIncomingAccepted":  110,
-	"EventTypeAccountContactBlocked":                  111,
-	"EventTypeAccountContactUnblocked":                112,
-	"EventTypeContactAliasKeyAdded":                   201,
-	"EventTypeMultiMemberGroupAliasResolverAdded":     301,
-	"EventTypeMultiMemberGroupInitialMemberAnnounced": 302,
-	"EventTypeMultiMemberGroupAdminRoleGranted":       303,
-	"EventTypeGroupMetadataPayloadSent":               1001,
-}
-
-func (x EventType) String() string {
-	return proto.EnumName(EventType_name, int32(x))
-}
-
-func (EventType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{1}
-}
-
-type ContactState int32
-
-const (
-	ContactStateUndefined ContactState = 0
-	ContactStateToRequest ContactState = 1
-	ContactStateReceived  ContactState = 2
-	ContactStateAdded     ContactState = 3
-	ContactStateRemoved   ContactState = 4
-	ContactStateDiscarded ContactState = 5
-	ContactStateBlocked   ContactState = 6
-)
-
-var ContactState_name = map[int32]string{
-	0: "ContactStateUndefined",
-	1: "ContactStateToRequest",
-	2: "ContactStateReceived",
-	3: "ContactStateAdded",
-	4: "ContactStateRemoved",
-	5: "ContactStateDiscarded",
-	6: "ContactStateBlocked",
-}
-
-var ContactState_value = map[string]int32{
-	"ContactStateUndefined": 0,
-	"ContactStateToRequest": 1,
-	"ContactStateReceived":  2,
-	"ContactStateAdded":     3,
-	"ContactStateRemoved":   4,
-	"ContactStateDiscarded": 5,
-	"ContactStateBlocked":   6,
-}
-
-func (x ContactState) String() string {
-	return proto.EnumName(ContactState_name, int32(x))
-}
-
-func (ContactState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{2}
-}
-
 type InstanceGetConfiguration_SettingState int32
 
 const (
@@ -230,36 +57,27 @@ func (x InstanceGetConfiguration_SettingState) String() string {
 }
 
 func (InstanceGetConfiguration_SettingState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{30, 0}
-}
-
-// Account describes all the secrets that identifies an Account
-type Account struct {
-	// group specifies which group is used to manage the account
-	Group *Group `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
-	// account_private_key, private part is used to signs handshake, signs device, create contacts group keys via ECDH -- public part is used to have a shareable identity
-	AccountPrivateKey []byte `protobuf:"bytes,2,opt,name=account_private_key,json=accountPrivateKey,proto3" json:"account_private_key,omitempty"`
-	// alias_private_key, private part is use to derive group members private keys, signs alias proofs, public part can be shared to contacts to prove identity
-	AliasPrivateKey []byte `protobuf:"bytes,3,opt,name=alias_private_key,json=aliasPrivateKey,proto3" json:"alias_private_key,omitempty"`
-	// public_rendezvous_seed, rendezvous seed used for direct communication
-	PublicRendezvousSeed []byte   `protobuf:"bytes,4,opt,name=public_rendezvous_seed,json=publicRendezvousSeed,proto3" json:"public_rendezvous_seed,omitempty"`
+	return fileDescriptor_047e04c733cf8554, []int{2, 0}
+}
+
+type InstanceExportData struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Account) Reset()         { *m = Account{} }
-func (m *Account) String() string { return proto.CompactTextString(m) }
-func (*Account) ProtoMessage()    {}
-func (*Account) Descriptor() ([]byte, []int) {
+func (m *InstanceExportData) Reset()         { *m = InstanceExportData{} }
+func (m *InstanceExportData) String() string { return proto.CompactTextString(m) }
+func (*InstanceExportData) ProtoMessage()    {}
+func (*InstanceExportData) Descriptor() ([]byte, []int) {
 	return fileDescriptor_047e04c733cf8554, []int{0}
 }
-func (m *Account) XXX_Unmarshal(b []byte) error {
+func (m *InstanceExportData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstanceExportData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Account.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstanceExportData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -269,73 +87,38 @@ func (m *Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Account) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Account.Merge(m, src)
+func (m *InstanceExportData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceExportData.Merge(m, src)
 }
-func (m *Account) XXX_Size() int {
+func (m *InstanceExportData) XXX_Size() int {
 	return m.Size()
 }
-func (m *Account) XXX_DiscardUnknown() {
-	xxx_messageInfo_Account.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Account proto.InternalMessageInfo
-
-func (m *Account) GetGroup() *Group {
-	if m != nil {
-		return m.Group
-	}
-	return nil
-}
-
-func (m *Account) GetAccountPrivateKey() []byte {
-	if m != nil {
-		return m.AccountPrivateKey
-	}
-	return nil
+func (m *InstanceExportData) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceExportData.DiscardUnknown(m)
 }
 
-func (m *Account) GetAliasPrivateKey() []byte {
-	if m != nil {
-		return m.AliasPrivateKey
-	}
-	return nil
-}
+var xxx_messageInfo_InstanceExportData proto.InternalMessageInfo
 
-func (m *Account) GetPublicRendezvousSeed() []byte {
-	if m != nil {
-		return m.PublicRendezvousSeed
-	}
-	return nil
+type InstanceExportData_Request struct {
+	// passphrase is used to encrypt the exported data
+	Passphrase           []byte   `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Group define a group and is enough to invite someone to it
-type Group struct {
-	// public_key is the identifier of the group, it signs the group secret and the initial member of a multi-member group
-	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	// secret is the symmetric secret of the group, which is used to encrypt the metadata
-	Secret []byte `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
-	// secret_sig is the signature of the secret used to ensure the validity of the group
-	SecretSig []byte `protobuf:"bytes,3,opt,name=secret_sig,json=secretSig,proto3" json:"secret_sig,omitempty"`
-	// group_type specifies the type of the group
-	GroupType            GroupType `protobuf:"varint,4,opt,name=group_type,json=groupType,proto3,enum=berty.protocol.GroupType" json:"group_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
-}
-
-func (m *Group) Reset()         { *m = Group{} }
-func (m *Group) String() string { return proto.CompactTextString(m) }
-func (*Group) ProtoMessage()    {}
-func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{1}
+func (m *InstanceExportData_Request) Reset()         { *m = InstanceExportData_Request{} }
+func (m *InstanceExportData_Request) String() string { return proto.CompactTextString(m) }
+func (*InstanceExportData_Request) ProtoMessage()    {}
+func (*InstanceExportData_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{0, 0}
 }
-func (m *Group) XXX_Unmarshal(b []byte) error {
+func (m *InstanceExportData_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstanceExportData_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Group.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstanceExportData_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -345,71 +128,45 @@ func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Group) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Group.Merge(m, src)
+func (m *InstanceExportData_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceExportData_Request.Merge(m, src)
 }
-func (m *Group) XXX_Size() int {
+func (m *InstanceExportData_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *Group) XXX_DiscardUnknown() {
-	xxx_messageInfo_Group.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Group proto.InternalMessageInfo
-
-func (m *Group) GetPublicKey() []byte {
-	if m != nil {
-		return m.PublicKey
-	}
-	return nil
+func (m *InstanceExportData_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceExportData_Request.DiscardUnknown(m)
 }
 
-func (m *Group) GetSecret() []byte {
-	if m != nil {
-		return m.Secret
-	}
-	return nil
-}
+var xxx_messageInfo_InstanceExportData_Request proto.InternalMessageInfo
 
-func (m *Group) GetSecretSig() []byte {
+func (m *InstanceExportData_Request) GetPassphrase() []byte {
 	if m != nil {
-		return m.SecretSig
+		return m.Passphrase
 	}
 	return nil
 }
 
-func (m *Group) GetGroupType() GroupType {
-	if m != nil {
-		return m.GroupType
-	}
-	return GroupTypeUndefined
-}
-
-// GroupMetadata is used in GroupEnvelope and only readable by invited group members
-type GroupMetadata struct {
-	// event_type defines which event type is used
-	EventType EventType `protobuf:"varint,1,opt,name=event_type,json=eventType,proto3,enum=berty.protocol.EventType" json:"event_type,omitempty"`
-	// the serialization depends on event_type, event is symmetrically encrypted
-	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	// sig is the signature of the payload, it depends on the event_type for the used key
-	Sig                  []byte   `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+type InstanceExportData_Reply struct {
+	// exported_data is a chunk of the archive, the chunks have to be concatenated in the order they are received
+	ExportedData         []byte   `protobuf:"bytes,1,opt,name=exported_data,json=exportedData,proto3" json:"exported_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GroupMetadata) Reset()         { *m = GroupMetadata{} }
-func (m *GroupMetadata) String() string { return proto.CompactTextString(m) }
-func (*GroupMetadata) ProtoMessage()    {}
-func (*GroupMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{2}
+func (m *InstanceExportData_Reply) Reset()         { *m = InstanceExportData_Reply{} }
+func (m *InstanceExportData_Reply) String() string { return proto.CompactTextString(m) }
+func (*InstanceExportData_Reply) ProtoMessage()    {}
+func (*InstanceExportData_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{0, 1}
 }
-func (m *GroupMetadata) XXX_Unmarshal(b []byte) error {
+func (m *InstanceExportData_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstanceExportData_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstanceExportData_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -419,62 +176,43 @@ func (m *GroupMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *GroupMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupMetadata.Merge(m, src)
+func (m *InstanceExportData_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceExportData_Reply.Merge(m, src)
 }
-func (m *GroupMetadata) XXX_Size() int {
+func (m *InstanceExportData_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupMetadata.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GroupMetadata proto.InternalMessageInfo
-
-func (m *GroupMetadata) GetEventType() EventType {
-	if m != nil {
-		return m.EventType
-	}
-	return EventTypeUndefined
+func (m *InstanceExportData_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceExportData_Reply.DiscardUnknown(m)
 }
 
-func (m *GroupMetadata) GetPayload() []byte {
-	if m != nil {
-		return m.Payload
-	}
-	return nil
-}
+var xxx_messageInfo_InstanceExportData_Reply proto.InternalMessageInfo
 
-func (m *GroupMetadata) GetSig() []byte {
+func (m *InstanceExportData_Reply) GetExportedData() []byte {
 	if m != nil {
-		return m.Sig
+		return m.ExportedData
 	}
 	return nil
 }
 
-// GroupEnvelope is a publicly exposed structure containing a group metadata event
-type GroupEnvelope struct {
-	// nonce is used to encrypt the message
-	Nonce []byte `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	// event is encrypted using a symmetric key shared among group members
-	Event                []byte   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+type InstanceImportData struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GroupEnvelope) Reset()         { *m = GroupEnvelope{} }
-func (m *GroupEnvelope) String() string { return proto.CompactTextString(m) }
-func (*GroupEnvelope) ProtoMessage()    {}
-func (*GroupEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{3}
+func (m *InstanceImportData) Reset()         { *m = InstanceImportData{} }
+func (m *InstanceImportData) String() string { return proto.CompactTextString(m) }
+func (*InstanceImportData) ProtoMessage()    {}
+func (*InstanceImportData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{1}
 }
-func (m *GroupEnvelope) XXX_Unmarshal(b []byte) error {
+func (m *InstanceImportData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstanceImportData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupEnvelope.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstanceImportData.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -484,57 +222,40 @@ func (m *GroupEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *GroupEnvelope) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupEnvelope.Merge(m, src)
+func (m *InstanceImportData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceImportData.Merge(m, src)
 }
-func (m *GroupEnvelope) XXX_Size() int {
+func (m *InstanceImportData) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupEnvelope) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupEnvelope.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GroupEnvelope proto.InternalMessageInfo
-
-func (m *GroupEnvelope) GetNonce() []byte {
-	if m != nil {
-		return m.Nonce
-	}
-	return nil
+func (m *InstanceImportData) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceImportData.DiscardUnknown(m)
 }
 
-func (m *GroupEnvelope) GetEvent() []byte {
-	if m != nil {
-		return m.Event
-	}
-	return nil
-}
+var xxx_messageInfo_InstanceImportData proto.InternalMessageInfo
 
-// MessageHeaders is used in MessageEnvelope and only readable by invited group members
-type MessageHeaders struct {
-	// counter is the current counter value for the specified device
-	Counter uint64 `protobuf:"varint,1,opt,name=counter,proto3" json:"counter,omitempty"`
-	// device_pk is the public key of the device sending the message
-	DevicePK []byte `protobuf:"bytes,2,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// sig is the signature of the encrypted message using the device's private key
-	Sig                  []byte   `protobuf:"bytes,3,opt,name=sig,proto3" json:"sig,omitempty"`
+type InstanceImportData_Request struct {
+	// exported_data is a chunk of the data returned by InstanceExportData, chunks are sent in order
+	ExportedData []byte `protobuf:"bytes,1,opt,name=exported_data,json=exportedData,proto3" json:"exported_data,omitempty"`
+	// passphrase is the passphrase used to encrypt the exported data, it is only read from the first request
+	Passphrase           []byte   `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MessageHeaders) Reset()         { *m = MessageHeaders{} }
-func (m *MessageHeaders) String() string { return proto.CompactTextString(m) }
-func (*MessageHeaders) ProtoMessage()    {}
-func (*MessageHeaders) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{4}
+func (m *InstanceImportData_Request) Reset()         { *m = InstanceImportData_Request{} }
+func (m *InstanceImportData_Request) String() string { return proto.CompactTextString(m) }
+func (*InstanceImportData_Request) ProtoMessage()    {}
+func (*InstanceImportData_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{1, 0}
 }
-func (m *MessageHeaders) XXX_Unmarshal(b []byte) error {
+func (m *InstanceImportData_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstanceImportData_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MessageHeaders.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstanceImportData_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -544,64 +265,50 @@ func (m *MessageHeaders) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MessageHeaders) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageHeaders.Merge(m, src)
+func (m *InstanceImportData_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceImportData_Request.Merge(m, src)
 }
-func (m *MessageHeaders) XXX_Size() int {
+func (m *InstanceImportData_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageHeaders) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageHeaders.DiscardUnknown(m)
+func (m *InstanceImportData_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceImportData_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MessageHeaders proto.InternalMessageInfo
-
-func (m *MessageHeaders) GetCounter() uint64 {
-	if m != nil {
-		return m.Counter
-	}
-	return 0
-}
+var xxx_messageInfo_InstanceImportData_Request proto.InternalMessageInfo
 
-func (m *MessageHeaders) GetDevicePK() []byte {
+func (m *InstanceImportData_Request) GetExportedData() []byte {
 	if m != nil {
-		return m.DevicePK
+		return m.ExportedData
 	}
 	return nil
 }
 
-func (m *MessageHeaders) GetSig() []byte {
+func (m *InstanceImportData_Request) GetPassphrase() []byte {
 	if m != nil {
-		return m.Sig
+		return m.Passphrase
 	}
 	return nil
 }
 
-// MessageEnvelope is a publicly exposed structure containing a group secure message
-type MessageEnvelope struct {
-	// message_headers is an encrypted serialization using a symmetric key of a MessageHeaders message
-	MessageHeaders []byte `protobuf:"bytes,1,opt,name=message_headers,json=messageHeaders,proto3" json:"message_headers,omitempty"`
-	// message is an encrypted message, only readable by group members who previously received the appropriate chain key
-	Message []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	// nonce is a nonce for message headers
-	Nonce                []byte   `protobuf:"bytes,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+type InstanceImportData_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MessageEnvelope) Reset()         { *m = MessageEnvelope{} }
-func (m *MessageEnvelope) String() string { return proto.CompactTextString(m) }
-func (*MessageEnvelope) ProtoMessage()    {}
-func (*MessageEnvelope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{5}
+func (m *InstanceImportData_Reply) Reset()         { *m = InstanceImportData_Reply{} }
+func (m *InstanceImportData_Reply) String() string { return proto.CompactTextString(m) }
+func (*InstanceImportData_Reply) ProtoMessage()    {}
+func (*InstanceImportData_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{1, 1}
 }
-func (m *MessageEnvelope) XXX_Unmarshal(b []byte) error {
+func (m *InstanceImportData_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MessageEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstanceImportData_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MessageEnvelope.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstanceImportData_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -611,64 +318,36 @@ func (m *MessageEnvelope) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MessageEnvelope) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MessageEnvelope.Merge(m, src)
+func (m *InstanceImportData_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceImportData_Reply.Merge(m, src)
 }
-func (m *MessageEnvelope) XXX_Size() int {
+func (m *InstanceImportData_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *MessageEnvelope) XXX_DiscardUnknown() {
-	xxx_messageInfo_MessageEnvelope.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MessageEnvelope proto.InternalMessageInfo
-
-func (m *MessageEnvelope) GetMessageHeaders() []byte {
-	if m != nil {
-		return m.MessageHeaders
-	}
-	return nil
-}
-
-func (m *MessageEnvelope) GetMessage() []byte {
-	if m != nil {
-		return m.Message
-	}
-	return nil
+func (m *InstanceImportData_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceImportData_Reply.DiscardUnknown(m)
 }
 
-func (m *MessageEnvelope) GetNonce() []byte {
-	if m != nil {
-		return m.Nonce
-	}
-	return nil
-}
+var xxx_messageInfo_InstanceImportData_Reply proto.InternalMessageInfo
 
-// EventContext adds context (its id and its parents) to an event
-type EventContext struct {
-	// id is the CID of the underlying OrbitDB event
-	ID []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// id are the the CIDs of the underlying parents of the OrbitDB event
-	ParentIDs [][]byte `protobuf:"bytes,2,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
-	// group_pk receiving the event
-	GroupPK              []byte   `protobuf:"bytes,3,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+type InstanceGetConfiguration struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EventContext) Reset()         { *m = EventContext{} }
-func (m *EventContext) String() string { return proto.CompactTextString(m) }
-func (*EventContext) ProtoMessage()    {}
-func (*EventContext) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{6}
+func (m *InstanceGetConfiguration) Reset()         { *m = InstanceGetConfiguration{} }
+func (m *InstanceGetConfiguration) String() string { return proto.CompactTextString(m) }
+func (*InstanceGetConfiguration) ProtoMessage()    {}
+func (*InstanceGetConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{2}
 }
-func (m *EventContext) XXX_Unmarshal(b []byte) error {
+func (m *InstanceGetConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstanceGetConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventContext.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstanceGetConfiguration.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -678,62 +357,36 @@ func (m *EventContext) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventContext) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventContext.Merge(m, src)
+func (m *InstanceGetConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceGetConfiguration.Merge(m, src)
 }
-func (m *EventContext) XXX_Size() int {
+func (m *InstanceGetConfiguration) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventContext) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventContext.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventContext proto.InternalMessageInfo
-
-func (m *EventContext) GetID() []byte {
-	if m != nil {
-		return m.ID
-	}
-	return nil
-}
-
-func (m *EventContext) GetParentIDs() [][]byte {
-	if m != nil {
-		return m.ParentIDs
-	}
-	return nil
+func (m *InstanceGetConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceGetConfiguration.DiscardUnknown(m)
 }
 
-func (m *EventContext) GetGroupPK() []byte {
-	if m != nil {
-		return m.GroupPK
-	}
-	return nil
-}
+var xxx_messageInfo_InstanceGetConfiguration proto.InternalMessageInfo
 
-// AppMetadata is an app defined message, accessible to future group members
-type AppMetadata struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// message is the payload
-	Message              []byte   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+type InstanceGetConfiguration_Request struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AppMetadata) Reset()         { *m = AppMetadata{} }
-func (m *AppMetadata) String() string { return proto.CompactTextString(m) }
-func (*AppMetadata) ProtoMessage()    {}
-func (*AppMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{7}
+func (m *InstanceGetConfiguration_Request) Reset()         { *m = InstanceGetConfiguration_Request{} }
+func (m *InstanceGetConfiguration_Request) String() string { return proto.CompactTextString(m) }
+func (*InstanceGetConfiguration_Request) ProtoMessage()    {}
+func (*InstanceGetConfiguration_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{2, 0}
 }
-func (m *AppMetadata) XXX_Unmarshal(b []byte) error {
+func (m *InstanceGetConfiguration_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AppMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstanceGetConfiguration_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AppMetadata.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstanceGetConfiguration_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -743,55 +396,48 @@ func (m *AppMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AppMetadata) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppMetadata.Merge(m, src)
+func (m *InstanceGetConfiguration_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceGetConfiguration_Request.Merge(m, src)
 }
-func (m *AppMetadata) XXX_Size() int {
+func (m *InstanceGetConfiguration_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *AppMetadata) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppMetadata.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AppMetadata proto.InternalMessageInfo
-
-func (m *AppMetadata) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+func (m *InstanceGetConfiguration_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceGetConfiguration_Request.DiscardUnknown(m)
 }
 
-func (m *AppMetadata) GetMessage() []byte {
-	if m != nil {
-		return m.Message
-	}
-	return nil
-}
+var xxx_messageInfo_InstanceGetConfiguration_Request proto.InternalMessageInfo
 
-// ContactAddAliasKey is an event type where ones shares their alias public key
-type ContactAddAliasKey struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// alias_pk is the alias key which will be used to verify a contact identity
-	AliasPK              []byte   `protobuf:"bytes,2,opt,name=alias_pk,json=aliasPk,proto3" json:"alias_pk,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type InstanceGetConfiguration_Reply struct {
+	// account_pk is the public key of the current account
+	AccountPK []byte `protobuf:"bytes,1,opt,name=account_pk,json=accountPk,proto3" json:"account_pk,omitempty"`
+	// device_pk is the public key of the current device
+	DevicePK []byte `protobuf:"bytes,2,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
+	// account_group_pk is the public key of the account group
+	AccountGroupPK       []byte                                `protobuf:"bytes,3,opt,name=account_group_pk,json=accountGroupPk,proto3" json:"account_group_pk,omitempty"`
+	PeerID               string                                `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
+	Listeners            []string                              `protobuf:"bytes,5,rep,name=listeners,proto3" json:"listeners,omitempty"`
+	BleEnabled           InstanceGetConfiguration_SettingState `protobuf:"varint,6,opt,name=ble_enabled,json=bleEnabled,proto3,enum=berty.protocol.InstanceGetConfiguration_SettingState" json:"ble_enabled,omitempty"`
+	WifiP2PEnabled       InstanceGetConfiguration_SettingState `protobuf:"varint,7,opt,name=wifi_p2p_enabled,json=wifiP2pEnabled,proto3,enum=berty.protocol.InstanceGetConfiguration_SettingState" json:"wifi_p2p_enabled,omitempty"`
+	MdnsEnabled          InstanceGetConfiguration_SettingState `protobuf:"varint,8,opt,name=mdns_enabled,json=mdnsEnabled,proto3,enum=berty.protocol.InstanceGetConfiguration_SettingState" json:"mdns_enabled,omitempty"`
+	RelayEnabled         InstanceGetConfiguration_SettingState `protobuf:"varint,9,opt,name=relay_enabled,json=relayEnabled,proto3,enum=berty.protocol.InstanceGetConfiguration_SettingState" json:"relay_enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
+	XXX_unrecognized     []byte                                `json:"-"`
+	XXX_sizecache        int32                                 `json:"-"`
 }
 
-func (m *ContactAddAliasKey) Reset()         { *m = ContactAddAliasKey{} }
-func (m *ContactAddAliasKey) String() string { return proto.CompactTextString(m) }
-func (*ContactAddAliasKey) ProtoMessage()    {}
-func (*ContactAddAliasKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{8}
+func (m *InstanceGetConfiguration_Reply) Reset()         { *m = InstanceGetConfiguration_Reply{} }
+func (m *InstanceGetConfiguration_Reply) String() string { return proto.CompactTextString(m) }
+func (*InstanceGetConfiguration_Reply) ProtoMessage()    {}
+func (*InstanceGetConfiguration_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{2, 1}
 }
-func (m *ContactAddAliasKey) XXX_Unmarshal(b []byte) error {
+func (m *InstanceGetConfiguration_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactAddAliasKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *InstanceGetConfiguration_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactAddAliasKey.Marshal(b, m, deterministic)
+		return xxx_messageInfo_InstanceGetConfiguration_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -801,123 +447,99 @@ func (m *ContactAddAliasKey) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ContactAddAliasKey) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactAddAliasKey.Merge(m, src)
+func (m *InstanceGetConfiguration_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceGetConfiguration_Reply.Merge(m, src)
 }
-func (m *ContactAddAliasKey) XXX_Size() int {
+func (m *InstanceGetConfiguration_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactAddAliasKey) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactAddAliasKey.DiscardUnknown(m)
+func (m *InstanceGetConfiguration_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceGetConfiguration_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactAddAliasKey proto.InternalMessageInfo
+var xxx_messageInfo_InstanceGetConfiguration_Reply proto.InternalMessageInfo
 
-func (m *ContactAddAliasKey) GetDevicePK() []byte {
+func (m *InstanceGetConfiguration_Reply) GetAccountPK() []byte {
 	if m != nil {
-		return m.DevicePK
+		return m.AccountPK
 	}
 	return nil
 }
 
-func (m *ContactAddAliasKey) GetAliasPK() []byte {
+func (m *InstanceGetConfiguration_Reply) GetDevicePK() []byte {
 	if m != nil {
-		return m.AliasPK
+		return m.DevicePK
 	}
 	return nil
 }
 
-// GroupAddMemberDevice is an event which indicates to a group a new device (and eventually a new member) is joining it
-// When added on AccountGroup, this event should be followed by appropriate GroupAddMemberDevice and GroupAddDeviceSecret events
-type GroupAddMemberDevice struct {
-	// member_pk is the member sending the event
-	MemberPK []byte `protobuf:"bytes,1,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,2,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// member_sig is used to prove the ownership of the member pk
-	MemberSig            []byte   `protobuf:"bytes,3,opt,name=member_sig,json=memberSig,proto3" json:"member_sig,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *InstanceGetConfiguration_Reply) GetAccountGroupPK() []byte {
+	if m != nil {
+		return m.AccountGroupPK
+	}
+	return nil
 }
 
-func (m *GroupAddMemberDevice) Reset()         { *m = GroupAddMemberDevice{} }
-func (m *GroupAddMemberDevice) String() string { return proto.CompactTextString(m) }
-func (*GroupAddMemberDevice) ProtoMessage()    {}
-func (*GroupAddMemberDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{9}
-}
-func (m *GroupAddMemberDevice) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GroupAddMemberDevice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GroupAddMemberDevice.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *InstanceGetConfiguration_Reply) GetPeerID() string {
+	if m != nil {
+		return m.PeerID
 	}
+	return ""
 }
-func (m *GroupAddMemberDevice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupAddMemberDevice.Merge(m, src)
-}
-func (m *GroupAddMemberDevice) XXX_Size() int {
-	return m.Size()
-}
-func (m *GroupAddMemberDevice) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupAddMemberDevice.DiscardUnknown(m)
+
+func (m *InstanceGetConfiguration_Reply) GetListeners() []string {
+	if m != nil {
+		return m.Listeners
+	}
+	return nil
 }
 
-var xxx_messageInfo_GroupAddMemberDevice proto.InternalMessageInfo
+func (m *InstanceGetConfiguration_Reply) GetBleEnabled() InstanceGetConfiguration_SettingState {
+	if m != nil {
+		return m.BleEnabled
+	}
+	return Unknown
+}
 
-func (m *GroupAddMemberDevice) GetMemberPK() []byte {
+func (m *InstanceGetConfiguration_Reply) GetWifiP2PEnabled() InstanceGetConfiguration_SettingState {
 	if m != nil {
-		return m.MemberPK
+		return m.WifiP2PEnabled
 	}
-	return nil
+	return Unknown
 }
 
-func (m *GroupAddMemberDevice) GetDevicePK() []byte {
+func (m *InstanceGetConfiguration_Reply) GetMdnsEnabled() InstanceGetConfiguration_SettingState {
 	if m != nil {
-		return m.DevicePK
+		return m.MdnsEnabled
 	}
-	return nil
+	return Unknown
 }
 
-func (m *GroupAddMemberDevice) GetMemberSig() []byte {
+func (m *InstanceGetConfiguration_Reply) GetRelayEnabled() InstanceGetConfiguration_SettingState {
 	if m != nil {
-		return m.MemberSig
+		return m.RelayEnabled
 	}
-	return nil
+	return Unknown
 }
 
-// DeviceSecret is encrypted for a specific member of the group
-type DeviceSecret struct {
-	// chain_key is the current value of the chain key of the group device
-	ChainKey []byte `protobuf:"bytes,1,opt,name=chain_key,json=chainKey,proto3" json:"chain_key,omitempty"`
-	// counter is the current value of the counter of the group device
-	Counter              uint64   `protobuf:"varint,2,opt,name=counter,proto3" json:"counter,omitempty"`
+type DeviceLinkReference struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeviceSecret) Reset()         { *m = DeviceSecret{} }
-func (m *DeviceSecret) String() string { return proto.CompactTextString(m) }
-func (*DeviceSecret) ProtoMessage()    {}
-func (*DeviceSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{10}
+func (m *DeviceLinkReference) Reset()         { *m = DeviceLinkReference{} }
+func (m *DeviceLinkReference) String() string { return proto.CompactTextString(m) }
+func (*DeviceLinkReference) ProtoMessage()    {}
+func (*DeviceLinkReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{3}
 }
-func (m *DeviceSecret) XXX_Unmarshal(b []byte) error {
+func (m *DeviceLinkReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DeviceSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeviceLinkReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DeviceSecret.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeviceLinkReference.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -927,57 +549,36 @@ func (m *DeviceSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *DeviceSecret) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeviceSecret.Merge(m, src)
+func (m *DeviceLinkReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLinkReference.Merge(m, src)
 }
-func (m *DeviceSecret) XXX_Size() int {
+func (m *DeviceLinkReference) XXX_Size() int {
 	return m.Size()
 }
-func (m *DeviceSecret) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeviceSecret.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_DeviceSecret proto.InternalMessageInfo
-
-func (m *DeviceSecret) GetChainKey() []byte {
-	if m != nil {
-		return m.ChainKey
-	}
-	return nil
+func (m *DeviceLinkReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLinkReference.DiscardUnknown(m)
 }
 
-func (m *DeviceSecret) GetCounter() uint64 {
-	if m != nil {
-		return m.Counter
-	}
-	return 0
-}
+var xxx_messageInfo_DeviceLinkReference proto.InternalMessageInfo
 
-// GroupAddDeviceSecret is an event which indicates to a group member a device secret
-type GroupAddDeviceSecret struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// dest_member_pk is the member who should receive the secret
-	DestMemberPK []byte `protobuf:"bytes,2,opt,name=dest_member_pk,json=destMemberPk,proto3" json:"dest_member_pk,omitempty"`
-	// payload is the serialization of Payload encrypted for the specified member
-	Payload              []byte   `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+type DeviceLinkReference_Request struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GroupAddDeviceSecret) Reset()         { *m = GroupAddDeviceSecret{} }
-func (m *GroupAddDeviceSecret) String() string { return proto.CompactTextString(m) }
-func (*GroupAddDeviceSecret) ProtoMessage()    {}
-func (*GroupAddDeviceSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{11}
+func (m *DeviceLinkReference_Request) Reset()         { *m = DeviceLinkReference_Request{} }
+func (m *DeviceLinkReference_Request) String() string { return proto.CompactTextString(m) }
+func (*DeviceLinkReference_Request) ProtoMessage()    {}
+func (*DeviceLinkReference_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{3, 0}
 }
-func (m *GroupAddDeviceSecret) XXX_Unmarshal(b []byte) error {
+func (m *DeviceLinkReference_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupAddDeviceSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeviceLinkReference_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupAddDeviceSecret.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeviceLinkReference_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -987,66 +588,38 @@ func (m *GroupAddDeviceSecret) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GroupAddDeviceSecret) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupAddDeviceSecret.Merge(m, src)
+func (m *DeviceLinkReference_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLinkReference_Request.Merge(m, src)
 }
-func (m *GroupAddDeviceSecret) XXX_Size() int {
+func (m *DeviceLinkReference_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupAddDeviceSecret) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupAddDeviceSecret.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GroupAddDeviceSecret proto.InternalMessageInfo
-
-func (m *GroupAddDeviceSecret) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
-}
-
-func (m *GroupAddDeviceSecret) GetDestMemberPK() []byte {
-	if m != nil {
-		return m.DestMemberPK
-	}
-	return nil
+func (m *DeviceLinkReference_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLinkReference_Request.DiscardUnknown(m)
 }
 
-func (m *GroupAddDeviceSecret) GetPayload() []byte {
-	if m != nil {
-		return m.Payload
-	}
-	return nil
-}
+var xxx_messageInfo_DeviceLinkReference_Request proto.InternalMessageInfo
 
-// MultiMemberGroupAddAliasResolver indicates that a group member want to disclose their presence in the group to their contacts
-type MultiMemberGroupAddAliasResolver struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// alias_resolver allows contact of an account to resolve the real identity behind an alias (Multi-Member Group Member)
-	// Generated by both contacts and account independently using: hmac(aliasPK, GroupID)
-	AliasResolver []byte `protobuf:"bytes,2,opt,name=alias_resolver,json=aliasResolver,proto3" json:"alias_resolver,omitempty"`
-	// alias_proof ensures that the associated alias_resolver has been issued by the right account
-	// Generated using aliasSKSig(GroupID)
-	AliasProof           []byte   `protobuf:"bytes,3,opt,name=alias_proof,json=aliasProof,proto3" json:"alias_proof,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DeviceLinkReference_Reply struct {
+	// reference describes how to connect to the current device, it must only be shared with the device to link
+	Reference            *bertytypes.ShareableDeviceLink `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *MultiMemberGroupAddAliasResolver) Reset()         { *m = MultiMemberGroupAddAliasResolver{} }
-func (m *MultiMemberGroupAddAliasResolver) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupAddAliasResolver) ProtoMessage()    {}
-func (*MultiMemberGroupAddAliasResolver) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{12}
+func (m *DeviceLinkReference_Reply) Reset()         { *m = DeviceLinkReference_Reply{} }
+func (m *DeviceLinkReference_Reply) String() string { return proto.CompactTextString(m) }
+func (*DeviceLinkReference_Reply) ProtoMessage()    {}
+func (*DeviceLinkReference_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{3, 1}
 }
-func (m *MultiMemberGroupAddAliasResolver) XXX_Unmarshal(b []byte) error {
+func (m *DeviceLinkReference_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupAddAliasResolver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeviceLinkReference_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupAddAliasResolver.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeviceLinkReference_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1056,62 +629,43 @@ func (m *MultiMemberGroupAddAliasResolver) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupAddAliasResolver) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupAddAliasResolver.Merge(m, src)
+func (m *DeviceLinkReference_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLinkReference_Reply.Merge(m, src)
 }
-func (m *MultiMemberGroupAddAliasResolver) XXX_Size() int {
+func (m *DeviceLinkReference_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupAddAliasResolver) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupAddAliasResolver.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MultiMemberGroupAddAliasResolver proto.InternalMessageInfo
-
-func (m *MultiMemberGroupAddAliasResolver) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+func (m *DeviceLinkReference_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLinkReference_Reply.DiscardUnknown(m)
 }
 
-func (m *MultiMemberGroupAddAliasResolver) GetAliasResolver() []byte {
-	if m != nil {
-		return m.AliasResolver
-	}
-	return nil
-}
+var xxx_messageInfo_DeviceLinkReference_Reply proto.InternalMessageInfo
 
-func (m *MultiMemberGroupAddAliasResolver) GetAliasProof() []byte {
+func (m *DeviceLinkReference_Reply) GetReference() *bertytypes.ShareableDeviceLink {
 	if m != nil {
-		return m.AliasProof
+		return m.Reference
 	}
 	return nil
 }
 
-// MultiMemberGrantAdminRole indicates that a group admin allows another group member to act as an admin
-type MultiMemberGrantAdminRole struct {
-	// device_pk is the device sending the event, signs the message, must be the device of an admin of the group
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// grantee_member_pk is the member public key of the member granted of the admin role
-	GranteeMemberPK      []byte   `protobuf:"bytes,2,opt,name=grantee_member_pk,json=granteeMemberPk,proto3" json:"grantee_member_pk,omitempty"`
+type DeviceLinkJoin struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGrantAdminRole) Reset()         { *m = MultiMemberGrantAdminRole{} }
-func (m *MultiMemberGrantAdminRole) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGrantAdminRole) ProtoMessage()    {}
-func (*MultiMemberGrantAdminRole) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{13}
+func (m *DeviceLinkJoin) Reset()         { *m = DeviceLinkJoin{} }
+func (m *DeviceLinkJoin) String() string { return proto.CompactTextString(m) }
+func (*DeviceLinkJoin) ProtoMessage()    {}
+func (*DeviceLinkJoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{4}
 }
-func (m *MultiMemberGrantAdminRole) XXX_Unmarshal(b []byte) error {
+func (m *DeviceLinkJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGrantAdminRole) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeviceLinkJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGrantAdminRole.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeviceLinkJoin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1121,53 +675,38 @@ func (m *MultiMemberGrantAdminRole) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGrantAdminRole) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGrantAdminRole.Merge(m, src)
+func (m *DeviceLinkJoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLinkJoin.Merge(m, src)
 }
-func (m *MultiMemberGrantAdminRole) XXX_Size() int {
+func (m *DeviceLinkJoin) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGrantAdminRole) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGrantAdminRole.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MultiMemberGrantAdminRole proto.InternalMessageInfo
-
-func (m *MultiMemberGrantAdminRole) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+func (m *DeviceLinkJoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLinkJoin.DiscardUnknown(m)
 }
 
-func (m *MultiMemberGrantAdminRole) GetGranteeMemberPK() []byte {
-	if m != nil {
-		return m.GranteeMemberPK
-	}
-	return nil
-}
+var xxx_messageInfo_DeviceLinkJoin proto.InternalMessageInfo
 
-// MultiMemberInitialMember indicates that a member is the group creator, this event is signed using the group ID private key
-type MultiMemberInitialMember struct {
-	// member_pk is the public key of the member who is the group creator
-	MemberPK             []byte   `protobuf:"bytes,1,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type DeviceLinkJoin_Request struct {
+	// reference is the reference created by a device of the account to join
+	Reference            *bertytypes.ShareableDeviceLink `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *MultiMemberInitialMember) Reset()         { *m = MultiMemberInitialMember{} }
-func (m *MultiMemberInitialMember) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberInitialMember) ProtoMessage()    {}
-func (*MultiMemberInitialMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{14}
+func (m *DeviceLinkJoin_Request) Reset()         { *m = DeviceLinkJoin_Request{} }
+func (m *DeviceLinkJoin_Request) String() string { return proto.CompactTextString(m) }
+func (*DeviceLinkJoin_Request) ProtoMessage()    {}
+func (*DeviceLinkJoin_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{4, 0}
 }
-func (m *MultiMemberInitialMember) XXX_Unmarshal(b []byte) error {
+func (m *DeviceLinkJoin_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberInitialMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeviceLinkJoin_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberInitialMember.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeviceLinkJoin_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1177,48 +716,43 @@ func (m *MultiMemberInitialMember) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberInitialMember) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberInitialMember.Merge(m, src)
+func (m *DeviceLinkJoin_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLinkJoin_Request.Merge(m, src)
 }
-func (m *MultiMemberInitialMember) XXX_Size() int {
+func (m *DeviceLinkJoin_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberInitialMember) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberInitialMember.DiscardUnknown(m)
+func (m *DeviceLinkJoin_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLinkJoin_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberInitialMember proto.InternalMessageInfo
+var xxx_messageInfo_DeviceLinkJoin_Request proto.InternalMessageInfo
 
-func (m *MultiMemberInitialMember) GetMemberPK() []byte {
+func (m *DeviceLinkJoin_Request) GetReference() *bertytypes.ShareableDeviceLink {
 	if m != nil {
-		return m.MemberPK
+		return m.Reference
 	}
 	return nil
 }
 
-// GroupAddAdditionalRendezvousSeed indicates that an additional rendezvous point should be used for data synchronization
-type GroupAddAdditionalRendezvousSeed struct {
-	// device_pk is the device sending the event, signs the message, must be the device of an admin of the group
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// seed is the additional rendezvous point seed which should be used
-	Seed                 []byte   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+type DeviceLinkJoin_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GroupAddAdditionalRendezvousSeed) Reset()         { *m = GroupAddAdditionalRendezvousSeed{} }
-func (m *GroupAddAdditionalRendezvousSeed) String() string { return proto.CompactTextString(m) }
-func (*GroupAddAdditionalRendezvousSeed) ProtoMessage()    {}
-func (*GroupAddAdditionalRendezvousSeed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{15}
+func (m *DeviceLinkJoin_Reply) Reset()         { *m = DeviceLinkJoin_Reply{} }
+func (m *DeviceLinkJoin_Reply) String() string { return proto.CompactTextString(m) }
+func (*DeviceLinkJoin_Reply) ProtoMessage()    {}
+func (*DeviceLinkJoin_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{4, 1}
 }
-func (m *GroupAddAdditionalRendezvousSeed) XXX_Unmarshal(b []byte) error {
+func (m *DeviceLinkJoin_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupAddAdditionalRendezvousSeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeviceLinkJoin_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupAddAdditionalRendezvousSeed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeviceLinkJoin_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1228,55 +762,36 @@ func (m *GroupAddAdditionalRendezvousSeed) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *GroupAddAdditionalRendezvousSeed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupAddAdditionalRendezvousSeed.Merge(m, src)
+func (m *DeviceLinkJoin_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceLinkJoin_Reply.Merge(m, src)
 }
-func (m *GroupAddAdditionalRendezvousSeed) XXX_Size() int {
+func (m *DeviceLinkJoin_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupAddAdditionalRendezvousSeed) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupAddAdditionalRendezvousSeed.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GroupAddAdditionalRendezvousSeed proto.InternalMessageInfo
-
-func (m *GroupAddAdditionalRendezvousSeed) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+func (m *DeviceLinkJoin_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceLinkJoin_Reply.DiscardUnknown(m)
 }
 
-func (m *GroupAddAdditionalRendezvousSeed) GetSeed() []byte {
-	if m != nil {
-		return m.Seed
-	}
-	return nil
-}
+var xxx_messageInfo_DeviceLinkJoin_Reply proto.InternalMessageInfo
 
-// GroupRemoveAdditionalRendezvousSeed indicates that a previously added rendezvous point should be removed
-type GroupRemoveAdditionalRendezvousSeed struct {
-	// device_pk is the device sending the event, signs the message, must be the device of an admin of the group
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// seed is the additional rendezvous point seed which should be removed
-	Seed                 []byte   `protobuf:"bytes,2,opt,name=seed,proto3" json:"seed,omitempty"`
+type DeviceRevoke struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GroupRemoveAdditionalRendezvousSeed) Reset()         { *m = GroupRemoveAdditionalRendezvousSeed{} }
-func (m *GroupRemoveAdditionalRendezvousSeed) String() string { return proto.CompactTextString(m) }
-func (*GroupRemoveAdditionalRendezvousSeed) ProtoMessage()    {}
-func (*GroupRemoveAdditionalRendezvousSeed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{16}
+func (m *DeviceRevoke) Reset()         { *m = DeviceRevoke{} }
+func (m *DeviceRevoke) String() string { return proto.CompactTextString(m) }
+func (*DeviceRevoke) ProtoMessage()    {}
+func (*DeviceRevoke) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{5}
 }
-func (m *GroupRemoveAdditionalRendezvousSeed) XXX_Unmarshal(b []byte) error {
+func (m *DeviceRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GroupRemoveAdditionalRendezvousSeed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeviceRevoke) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GroupRemoveAdditionalRendezvousSeed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeviceRevoke.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1286,55 +801,38 @@ func (m *GroupRemoveAdditionalRendezvousSeed) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *GroupRemoveAdditionalRendezvousSeed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupRemoveAdditionalRendezvousSeed.Merge(m, src)
+func (m *DeviceRevoke) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceRevoke.Merge(m, src)
 }
-func (m *GroupRemoveAdditionalRendezvousSeed) XXX_Size() int {
+func (m *DeviceRevoke) XXX_Size() int {
 	return m.Size()
 }
-func (m *GroupRemoveAdditionalRendezvousSeed) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupRemoveAdditionalRendezvousSeed.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GroupRemoveAdditionalRendezvousSeed proto.InternalMessageInfo
-
-func (m *GroupRemoveAdditionalRendezvousSeed) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+func (m *DeviceRevoke) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceRevoke.DiscardUnknown(m)
 }
 
-func (m *GroupRemoveAdditionalRendezvousSeed) GetSeed() []byte {
-	if m != nil {
-		return m.Seed
-	}
-	return nil
-}
+var xxx_messageInfo_DeviceRevoke proto.InternalMessageInfo
 
-// AccountGroupJoined indicates that the account is now part of a new group
-type AccountGroupJoined struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// group describe the joined group
-	Group                *Group   `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
+type DeviceRevoke_Request struct {
+	// device_pk is the public key of the device to revoke
+	DevicePK             []byte   `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountGroupJoined) Reset()         { *m = AccountGroupJoined{} }
-func (m *AccountGroupJoined) String() string { return proto.CompactTextString(m) }
-func (*AccountGroupJoined) ProtoMessage()    {}
-func (*AccountGroupJoined) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{17}
+func (m *DeviceRevoke_Request) Reset()         { *m = DeviceRevoke_Request{} }
+func (m *DeviceRevoke_Request) String() string { return proto.CompactTextString(m) }
+func (*DeviceRevoke_Request) ProtoMessage()    {}
+func (*DeviceRevoke_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{5, 0}
 }
-func (m *AccountGroupJoined) XXX_Unmarshal(b []byte) error {
+func (m *DeviceRevoke_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountGroupJoined) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeviceRevoke_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountGroupJoined.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeviceRevoke_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1344,55 +842,43 @@ func (m *AccountGroupJoined) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *AccountGroupJoined) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountGroupJoined.Merge(m, src)
+func (m *DeviceRevoke_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceRevoke_Request.Merge(m, src)
 }
-func (m *AccountGroupJoined) XXX_Size() int {
+func (m *DeviceRevoke_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountGroupJoined) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountGroupJoined.DiscardUnknown(m)
+func (m *DeviceRevoke_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceRevoke_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountGroupJoined proto.InternalMessageInfo
+var xxx_messageInfo_DeviceRevoke_Request proto.InternalMessageInfo
 
-func (m *AccountGroupJoined) GetDevicePK() []byte {
+func (m *DeviceRevoke_Request) GetDevicePK() []byte {
 	if m != nil {
 		return m.DevicePK
 	}
 	return nil
 }
 
-func (m *AccountGroupJoined) GetGroup() *Group {
-	if m != nil {
-		return m.Group
-	}
-	return nil
-}
-
-// AccountGroupJoined indicates that the account has left a group
-type AccountGroupLeft struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// group_pk references the group left
-	GroupPK              []byte   `protobuf:"bytes,2,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+type DeviceRevoke_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountGroupLeft) Reset()         { *m = AccountGroupLeft{} }
-func (m *AccountGroupLeft) String() string { return proto.CompactTextString(m) }
-func (*AccountGroupLeft) ProtoMessage()    {}
-func (*AccountGroupLeft) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{18}
+func (m *DeviceRevoke_Reply) Reset()         { *m = DeviceRevoke_Reply{} }
+func (m *DeviceRevoke_Reply) String() string { return proto.CompactTextString(m) }
+func (*DeviceRevoke_Reply) ProtoMessage()    {}
+func (*DeviceRevoke_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{5, 1}
 }
-func (m *AccountGroupLeft) XXX_Unmarshal(b []byte) error {
+func (m *DeviceRevoke_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountGroupLeft) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DeviceRevoke_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountGroupLeft.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DeviceRevoke_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1402,53 +888,36 @@ func (m *AccountGroupLeft) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *AccountGroupLeft) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountGroupLeft.Merge(m, src)
+func (m *DeviceRevoke_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeviceRevoke_Reply.Merge(m, src)
 }
-func (m *AccountGroupLeft) XXX_Size() int {
+func (m *DeviceRevoke_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountGroupLeft) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountGroupLeft.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AccountGroupLeft proto.InternalMessageInfo
-
-func (m *AccountGroupLeft) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+func (m *DeviceRevoke_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeviceRevoke_Reply.DiscardUnknown(m)
 }
 
-func (m *AccountGroupLeft) GetGroupPK() []byte {
-	if m != nil {
-		return m.GroupPK
-	}
-	return nil
-}
+var xxx_messageInfo_DeviceRevoke_Reply proto.InternalMessageInfo
 
-// AccountContactRequestDisabled indicates that the account should not be advertised on a public rendezvous point
-type AccountContactRequestDisabled struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK             []byte   `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
+type ContactRequestReference struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactRequestDisabled) Reset()         { *m = AccountContactRequestDisabled{} }
-func (m *AccountContactRequestDisabled) String() string { return proto.CompactTextString(m) }
-func (*AccountContactRequestDisabled) ProtoMessage()    {}
-func (*AccountContactRequestDisabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{19}
+func (m *ContactRequestReference) Reset()         { *m = ContactRequestReference{} }
+func (m *ContactRequestReference) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestReference) ProtoMessage()    {}
+func (*ContactRequestReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{6}
 }
-func (m *AccountContactRequestDisabled) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactRequestDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactRequestDisabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestReference.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1458,46 +927,36 @@ func (m *AccountContactRequestDisabled) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *AccountContactRequestDisabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactRequestDisabled.Merge(m, src)
+func (m *ContactRequestReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestReference.Merge(m, src)
 }
-func (m *AccountContactRequestDisabled) XXX_Size() int {
+func (m *ContactRequestReference) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactRequestDisabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactRequestDisabled.DiscardUnknown(m)
+func (m *ContactRequestReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestReference.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountContactRequestDisabled proto.InternalMessageInfo
-
-func (m *AccountContactRequestDisabled) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestReference proto.InternalMessageInfo
 
-// AccountContactRequestDisabled indicates that the account should be advertised on a public rendezvous point
-type AccountContactRequestEnabled struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK             []byte   `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
+type ContactRequestReference_Request struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactRequestEnabled) Reset()         { *m = AccountContactRequestEnabled{} }
-func (m *AccountContactRequestEnabled) String() string { return proto.CompactTextString(m) }
-func (*AccountContactRequestEnabled) ProtoMessage()    {}
-func (*AccountContactRequestEnabled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{20}
+func (m *ContactRequestReference_Request) Reset()         { *m = ContactRequestReference_Request{} }
+func (m *ContactRequestReference_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestReference_Request) ProtoMessage()    {}
+func (*ContactRequestReference_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{6, 0}
 }
-func (m *AccountContactRequestEnabled) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestReference_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactRequestEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestReference_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactRequestEnabled.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestReference_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1507,48 +966,38 @@ func (m *AccountContactRequestEnabled) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *AccountContactRequestEnabled) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactRequestEnabled.Merge(m, src)
+func (m *ContactRequestReference_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestReference_Request.Merge(m, src)
 }
-func (m *AccountContactRequestEnabled) XXX_Size() int {
+func (m *ContactRequestReference_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactRequestEnabled) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactRequestEnabled.DiscardUnknown(m)
+func (m *ContactRequestReference_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestReference_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountContactRequestEnabled proto.InternalMessageInfo
-
-func (m *AccountContactRequestEnabled) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestReference_Request proto.InternalMessageInfo
 
-// AccountContactRequestDisabled indicates that the account should be advertised on different public rendezvous points
-type AccountContactRequestReferenceReset struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// rendezvous_seed is the new rendezvous point seed
-	RendezvousSeed       []byte   `protobuf:"bytes,2,opt,name=rendezvous_seed,json=rendezvousSeed,proto3" json:"rendezvous_seed,omitempty"`
+type ContactRequestReference_Reply struct {
+	// reference is an opaque message describing how to connect to the current account
+	Reference            []byte   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactRequestReferenceReset) Reset()         { *m = AccountContactRequestReferenceReset{} }
-func (m *AccountContactRequestReferenceReset) String() string { return proto.CompactTextString(m) }
-func (*AccountContactRequestReferenceReset) ProtoMessage()    {}
-func (*AccountContactRequestReferenceReset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{21}
+func (m *ContactRequestReference_Reply) Reset()         { *m = ContactRequestReference_Reply{} }
+func (m *ContactRequestReference_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestReference_Reply) ProtoMessage()    {}
+func (*ContactRequestReference_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{6, 1}
 }
-func (m *AccountContactRequestReferenceReset) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestReference_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactRequestReferenceReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestReference_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactRequestReferenceReset.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestReference_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1558,65 +1007,43 @@ func (m *AccountContactRequestReferenceReset) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *AccountContactRequestReferenceReset) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactRequestReferenceReset.Merge(m, src)
+func (m *ContactRequestReference_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestReference_Reply.Merge(m, src)
 }
-func (m *AccountContactRequestReferenceReset) XXX_Size() int {
+func (m *ContactRequestReference_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactRequestReferenceReset) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactRequestReferenceReset.DiscardUnknown(m)
+func (m *ContactRequestReference_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestReference_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountContactRequestReferenceReset proto.InternalMessageInfo
-
-func (m *AccountContactRequestReferenceReset) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestReference_Reply proto.InternalMessageInfo
 
-func (m *AccountContactRequestReferenceReset) GetRendezvousSeed() []byte {
+func (m *ContactRequestReference_Reply) GetReference() []byte {
 	if m != nil {
-		return m.RendezvousSeed
+		return m.Reference
 	}
 	return nil
 }
 
-// This event should be followed by an AccountGroupJoined event
-// This event should be followed by a GroupAddMemberDevice event within the AccountGroup
-// This event should be followed by a GroupAddDeviceSecret event within the AccountGroup
-// AccountContactRequestEnqueued indicates that the account will attempt to send a contact request when a matching peer is discovered
-type AccountContactRequestEnqueued struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// contact_pk is the account to send a contact request to
-	ContactPK []byte `protobuf:"bytes,2,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
-	// group_pk is the 1to1 group with the requested user
-	GroupPK []byte `protobuf:"bytes,5,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
-	// contact_rendezvous_seed is the rendezvous seed used by the other account
-	ContactRendezvousSeed []byte `protobuf:"bytes,3,opt,name=contact_rendezvous_seed,json=contactRendezvousSeed,proto3" json:"contact_rendezvous_seed,omitempty"`
-	// TODO: is this necessary?
-	// contact_metadata is the metadata specific to the app to identify the contact for the request
-	ContactMetadata      []byte   `protobuf:"bytes,4,opt,name=contact_metadata,json=contactMetadata,proto3" json:"contact_metadata,omitempty"`
+type ContactRequestDisable struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactRequestEnqueued) Reset()         { *m = AccountContactRequestEnqueued{} }
-func (m *AccountContactRequestEnqueued) String() string { return proto.CompactTextString(m) }
-func (*AccountContactRequestEnqueued) ProtoMessage()    {}
-func (*AccountContactRequestEnqueued) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{22}
+func (m *ContactRequestDisable) Reset()         { *m = ContactRequestDisable{} }
+func (m *ContactRequestDisable) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestDisable) ProtoMessage()    {}
+func (*ContactRequestDisable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{7}
 }
-func (m *AccountContactRequestEnqueued) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestDisable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactRequestEnqueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestDisable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactRequestEnqueued.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestDisable.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1626,76 +1053,36 @@ func (m *AccountContactRequestEnqueued) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *AccountContactRequestEnqueued) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactRequestEnqueued.Merge(m, src)
+func (m *ContactRequestDisable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestDisable.Merge(m, src)
 }
-func (m *AccountContactRequestEnqueued) XXX_Size() int {
+func (m *ContactRequestDisable) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactRequestEnqueued) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactRequestEnqueued.DiscardUnknown(m)
+func (m *ContactRequestDisable) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestDisable.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountContactRequestEnqueued proto.InternalMessageInfo
+var xxx_messageInfo_ContactRequestDisable proto.InternalMessageInfo
 
-func (m *AccountContactRequestEnqueued) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
-}
-
-func (m *AccountContactRequestEnqueued) GetContactPK() []byte {
-	if m != nil {
-		return m.ContactPK
-	}
-	return nil
-}
-
-func (m *AccountContactRequestEnqueued) GetGroupPK() []byte {
-	if m != nil {
-		return m.GroupPK
-	}
-	return nil
-}
-
-func (m *AccountContactRequestEnqueued) GetContactRendezvousSeed() []byte {
-	if m != nil {
-		return m.ContactRendezvousSeed
-	}
-	return nil
-}
-
-func (m *AccountContactRequestEnqueued) GetContactMetadata() []byte {
-	if m != nil {
-		return m.ContactMetadata
-	}
-	return nil
-}
-
-// AccountContactRequestSent indicates that the account has sent a contact request
-type AccountContactRequestSent struct {
-	// device_pk is the device sending the account event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// contact_pk is the contacted account
-	ContactPK            []byte   `protobuf:"bytes,2,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
+type ContactRequestDisable_Request struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactRequestSent) Reset()         { *m = AccountContactRequestSent{} }
-func (m *AccountContactRequestSent) String() string { return proto.CompactTextString(m) }
-func (*AccountContactRequestSent) ProtoMessage()    {}
-func (*AccountContactRequestSent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{23}
+func (m *ContactRequestDisable_Request) Reset()         { *m = ContactRequestDisable_Request{} }
+func (m *ContactRequestDisable_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestDisable_Request) ProtoMessage()    {}
+func (*ContactRequestDisable_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{7, 0}
 }
-func (m *AccountContactRequestSent) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestDisable_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactRequestSent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestDisable_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactRequestSent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestDisable_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1705,61 +1092,36 @@ func (m *AccountContactRequestSent) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *AccountContactRequestSent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactRequestSent.Merge(m, src)
+func (m *ContactRequestDisable_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestDisable_Request.Merge(m, src)
 }
-func (m *AccountContactRequestSent) XXX_Size() int {
+func (m *ContactRequestDisable_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactRequestSent) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactRequestSent.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AccountContactRequestSent proto.InternalMessageInfo
-
-func (m *AccountContactRequestSent) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+func (m *ContactRequestDisable_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestDisable_Request.DiscardUnknown(m)
 }
 
-func (m *AccountContactRequestSent) GetContactPK() []byte {
-	if m != nil {
-		return m.ContactPK
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestDisable_Request proto.InternalMessageInfo
 
-// AccountContactRequestReceived indicates that the account has received a new contact request
-type AccountContactRequestReceived struct {
-	// device_pk is the device sending the account event (which received the contact request), signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// contact_pk is the account sending the request
-	ContactPK []byte `protobuf:"bytes,2,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
-	// TODO: is this necessary?
-	// contact_rendezvous_seed is the rendezvous seed of the contact sending the request
-	ContactRendezvousSeed []byte `protobuf:"bytes,3,opt,name=contact_rendezvous_seed,json=contactRendezvousSeed,proto3" json:"contact_rendezvous_seed,omitempty"`
-	// TODO: is this necessary?
-	// contact_metadata is the metadata specific to the app to identify the contact for the request
-	ContactMetadata      []byte   `protobuf:"bytes,4,opt,name=contact_metadata,json=contactMetadata,proto3" json:"contact_metadata,omitempty"`
+type ContactRequestDisable_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactRequestReceived) Reset()         { *m = AccountContactRequestReceived{} }
-func (m *AccountContactRequestReceived) String() string { return proto.CompactTextString(m) }
-func (*AccountContactRequestReceived) ProtoMessage()    {}
-func (*AccountContactRequestReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{24}
+func (m *ContactRequestDisable_Reply) Reset()         { *m = ContactRequestDisable_Reply{} }
+func (m *ContactRequestDisable_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestDisable_Reply) ProtoMessage()    {}
+func (*ContactRequestDisable_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{7, 1}
 }
-func (m *AccountContactRequestReceived) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestDisable_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactRequestReceived) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestDisable_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactRequestReceived.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestDisable_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1769,69 +1131,36 @@ func (m *AccountContactRequestReceived) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *AccountContactRequestReceived) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactRequestReceived.Merge(m, src)
+func (m *ContactRequestDisable_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestDisable_Reply.Merge(m, src)
 }
-func (m *AccountContactRequestReceived) XXX_Size() int {
+func (m *ContactRequestDisable_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactRequestReceived) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactRequestReceived.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AccountContactRequestReceived proto.InternalMessageInfo
-
-func (m *AccountContactRequestReceived) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
-}
-
-func (m *AccountContactRequestReceived) GetContactPK() []byte {
-	if m != nil {
-		return m.ContactPK
-	}
-	return nil
-}
-
-func (m *AccountContactRequestReceived) GetContactRendezvousSeed() []byte {
-	if m != nil {
-		return m.ContactRendezvousSeed
-	}
-	return nil
+func (m *ContactRequestDisable_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestDisable_Reply.DiscardUnknown(m)
 }
 
-func (m *AccountContactRequestReceived) GetContactMetadata() []byte {
-	if m != nil {
-		return m.ContactMetadata
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestDisable_Reply proto.InternalMessageInfo
 
-// AccountContactRequestDiscarded indicates that a contact request has been refused
-type AccountContactRequestDiscarded struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// contact_pk is the contact whom request is refused
-	ContactPK            []byte   `protobuf:"bytes,2,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
+type ContactRequestEnable struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactRequestDiscarded) Reset()         { *m = AccountContactRequestDiscarded{} }
-func (m *AccountContactRequestDiscarded) String() string { return proto.CompactTextString(m) }
-func (*AccountContactRequestDiscarded) ProtoMessage()    {}
-func (*AccountContactRequestDiscarded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{25}
+func (m *ContactRequestEnable) Reset()         { *m = ContactRequestEnable{} }
+func (m *ContactRequestEnable) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestEnable) ProtoMessage()    {}
+func (*ContactRequestEnable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{8}
 }
-func (m *AccountContactRequestDiscarded) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestEnable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactRequestDiscarded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestEnable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactRequestDiscarded.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestEnable.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1841,59 +1170,36 @@ func (m *AccountContactRequestDiscarded) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *AccountContactRequestDiscarded) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactRequestDiscarded.Merge(m, src)
+func (m *ContactRequestEnable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestEnable.Merge(m, src)
 }
-func (m *AccountContactRequestDiscarded) XXX_Size() int {
+func (m *ContactRequestEnable) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactRequestDiscarded) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactRequestDiscarded.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AccountContactRequestDiscarded proto.InternalMessageInfo
-
-func (m *AccountContactRequestDiscarded) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+func (m *ContactRequestEnable) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestEnable.DiscardUnknown(m)
 }
 
-func (m *AccountContactRequestDiscarded) GetContactPK() []byte {
-	if m != nil {
-		return m.ContactPK
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestEnable proto.InternalMessageInfo
 
-// This event should be followed by an AccountGroupJoined event
-// This event should be followed by GroupAddMemberDevice and GroupAddDeviceSecret events within the AccountGroup
-// AccountContactRequestAccepted indicates that a contact request has been accepted
-type AccountContactRequestAccepted struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// contact_pk is the contact whom request is accepted
-	ContactPK []byte `protobuf:"bytes,2,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
-	// group_pk is the 1to1 group with the requester user
-	GroupPK              []byte   `protobuf:"bytes,3,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+type ContactRequestEnable_Request struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactRequestAccepted) Reset()         { *m = AccountContactRequestAccepted{} }
-func (m *AccountContactRequestAccepted) String() string { return proto.CompactTextString(m) }
-func (*AccountContactRequestAccepted) ProtoMessage()    {}
-func (*AccountContactRequestAccepted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{26}
+func (m *ContactRequestEnable_Request) Reset()         { *m = ContactRequestEnable_Request{} }
+func (m *ContactRequestEnable_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestEnable_Request) ProtoMessage()    {}
+func (*ContactRequestEnable_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{8, 0}
 }
-func (m *AccountContactRequestAccepted) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestEnable_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactRequestAccepted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestEnable_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactRequestAccepted.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestEnable_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1903,62 +1209,38 @@ func (m *AccountContactRequestAccepted) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *AccountContactRequestAccepted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactRequestAccepted.Merge(m, src)
+func (m *ContactRequestEnable_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestEnable_Request.Merge(m, src)
 }
-func (m *AccountContactRequestAccepted) XXX_Size() int {
+func (m *ContactRequestEnable_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactRequestAccepted) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactRequestAccepted.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AccountContactRequestAccepted proto.InternalMessageInfo
-
-func (m *AccountContactRequestAccepted) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
-}
-
-func (m *AccountContactRequestAccepted) GetContactPK() []byte {
-	if m != nil {
-		return m.ContactPK
-	}
-	return nil
+func (m *ContactRequestEnable_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestEnable_Request.DiscardUnknown(m)
 }
 
-func (m *AccountContactRequestAccepted) GetGroupPK() []byte {
-	if m != nil {
-		return m.GroupPK
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestEnable_Request proto.InternalMessageInfo
 
-// AccountContactBlocked indicates that a contact is blocked
-type AccountContactBlocked struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// contact_pk is the contact blocked
-	ContactPK            []byte   `protobuf:"bytes,2,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
+type ContactRequestEnable_Reply struct {
+	// reference is an opaque message describing how to connect to the current account
+	Reference            []byte   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactBlocked) Reset()         { *m = AccountContactBlocked{} }
-func (m *AccountContactBlocked) String() string { return proto.CompactTextString(m) }
-func (*AccountContactBlocked) ProtoMessage()    {}
-func (*AccountContactBlocked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{27}
+func (m *ContactRequestEnable_Reply) Reset()         { *m = ContactRequestEnable_Reply{} }
+func (m *ContactRequestEnable_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestEnable_Reply) ProtoMessage()    {}
+func (*ContactRequestEnable_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{8, 1}
 }
-func (m *AccountContactBlocked) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestEnable_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactBlocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestEnable_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactBlocked.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestEnable_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1968,55 +1250,43 @@ func (m *AccountContactBlocked) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *AccountContactBlocked) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactBlocked.Merge(m, src)
+func (m *ContactRequestEnable_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestEnable_Reply.Merge(m, src)
 }
-func (m *AccountContactBlocked) XXX_Size() int {
+func (m *ContactRequestEnable_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactBlocked) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactBlocked.DiscardUnknown(m)
+func (m *ContactRequestEnable_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestEnable_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AccountContactBlocked proto.InternalMessageInfo
-
-func (m *AccountContactBlocked) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestEnable_Reply proto.InternalMessageInfo
 
-func (m *AccountContactBlocked) GetContactPK() []byte {
+func (m *ContactRequestEnable_Reply) GetReference() []byte {
 	if m != nil {
-		return m.ContactPK
+		return m.Reference
 	}
 	return nil
 }
 
-// AccountContactUnblocked indicates that a contact is unblocked
-type AccountContactUnblocked struct {
-	// device_pk is the device sending the event, signs the message
-	DevicePK []byte `protobuf:"bytes,1,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// contact_pk is the contact unblocked
-	ContactPK            []byte   `protobuf:"bytes,2,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
+type ContactRequestResetReference struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AccountContactUnblocked) Reset()         { *m = AccountContactUnblocked{} }
-func (m *AccountContactUnblocked) String() string { return proto.CompactTextString(m) }
-func (*AccountContactUnblocked) ProtoMessage()    {}
-func (*AccountContactUnblocked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{28}
+func (m *ContactRequestResetReference) Reset()         { *m = ContactRequestResetReference{} }
+func (m *ContactRequestResetReference) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestResetReference) ProtoMessage()    {}
+func (*ContactRequestResetReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{9}
 }
-func (m *AccountContactUnblocked) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestResetReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AccountContactUnblocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestResetReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AccountContactUnblocked.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestResetReference.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2026,50 +1296,36 @@ func (m *AccountContactUnblocked) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *AccountContactUnblocked) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AccountContactUnblocked.Merge(m, src)
+func (m *ContactRequestResetReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestResetReference.Merge(m, src)
 }
-func (m *AccountContactUnblocked) XXX_Size() int {
+func (m *ContactRequestResetReference) XXX_Size() int {
 	return m.Size()
 }
-func (m *AccountContactUnblocked) XXX_DiscardUnknown() {
-	xxx_messageInfo_AccountContactUnblocked.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AccountContactUnblocked proto.InternalMessageInfo
-
-func (m *AccountContactUnblocked) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+func (m *ContactRequestResetReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestResetReference.DiscardUnknown(m)
 }
 
-func (m *AccountContactUnblocked) GetContactPK() []byte {
-	if m != nil {
-		return m.ContactPK
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestResetReference proto.InternalMessageInfo
 
-type InstanceExportData struct {
+type ContactRequestResetReference_Request struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InstanceExportData) Reset()         { *m = InstanceExportData{} }
-func (m *InstanceExportData) String() string { return proto.CompactTextString(m) }
-func (*InstanceExportData) ProtoMessage()    {}
-func (*InstanceExportData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{29}
+func (m *ContactRequestResetReference_Request) Reset()         { *m = ContactRequestResetReference_Request{} }
+func (m *ContactRequestResetReference_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestResetReference_Request) ProtoMessage()    {}
+func (*ContactRequestResetReference_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{9, 0}
 }
-func (m *InstanceExportData) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestResetReference_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstanceExportData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestResetReference_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstanceExportData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestResetReference_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2079,36 +1335,38 @@ func (m *InstanceExportData) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *InstanceExportData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstanceExportData.Merge(m, src)
+func (m *ContactRequestResetReference_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestResetReference_Request.Merge(m, src)
 }
-func (m *InstanceExportData) XXX_Size() int {
+func (m *ContactRequestResetReference_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *InstanceExportData) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstanceExportData.DiscardUnknown(m)
+func (m *ContactRequestResetReference_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestResetReference_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstanceExportData proto.InternalMessageInfo
+var xxx_messageInfo_ContactRequestResetReference_Request proto.InternalMessageInfo
 
-type InstanceExportData_Request struct {
+type ContactRequestResetReference_Reply struct {
+	// reference is an opaque message describing how to connect to the current account
+	Reference            []byte   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InstanceExportData_Request) Reset()         { *m = InstanceExportData_Request{} }
-func (m *InstanceExportData_Request) String() string { return proto.CompactTextString(m) }
-func (*InstanceExportData_Request) ProtoMessage()    {}
-func (*InstanceExportData_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{29, 0}
+func (m *ContactRequestResetReference_Reply) Reset()         { *m = ContactRequestResetReference_Reply{} }
+func (m *ContactRequestResetReference_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestResetReference_Reply) ProtoMessage()    {}
+func (*ContactRequestResetReference_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{9, 1}
 }
-func (m *InstanceExportData_Request) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestResetReference_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstanceExportData_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestResetReference_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstanceExportData_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestResetReference_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2118,37 +1376,43 @@ func (m *InstanceExportData_Request) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *InstanceExportData_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstanceExportData_Request.Merge(m, src)
+func (m *ContactRequestResetReference_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestResetReference_Reply.Merge(m, src)
 }
-func (m *InstanceExportData_Request) XXX_Size() int {
+func (m *ContactRequestResetReference_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *InstanceExportData_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstanceExportData_Request.DiscardUnknown(m)
+func (m *ContactRequestResetReference_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestResetReference_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstanceExportData_Request proto.InternalMessageInfo
+var xxx_messageInfo_ContactRequestResetReference_Reply proto.InternalMessageInfo
 
-type InstanceExportData_Reply struct {
-	ExportedData         []byte   `protobuf:"bytes,1,opt,name=exported_data,json=exportedData,proto3" json:"exported_data,omitempty"`
+func (m *ContactRequestResetReference_Reply) GetReference() []byte {
+	if m != nil {
+		return m.Reference
+	}
+	return nil
+}
+
+type ContactRequestSend struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InstanceExportData_Reply) Reset()         { *m = InstanceExportData_Reply{} }
-func (m *InstanceExportData_Reply) String() string { return proto.CompactTextString(m) }
-func (*InstanceExportData_Reply) ProtoMessage()    {}
-func (*InstanceExportData_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{29, 1}
+func (m *ContactRequestSend) Reset()         { *m = ContactRequestSend{} }
+func (m *ContactRequestSend) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestSend) ProtoMessage()    {}
+func (*ContactRequestSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{10}
 }
-func (m *InstanceExportData_Reply) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstanceExportData_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstanceExportData_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestSend.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2158,43 +1422,102 @@ func (m *InstanceExportData_Reply) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *InstanceExportData_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstanceExportData_Reply.Merge(m, src)
+func (m *ContactRequestSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestSend.Merge(m, src)
 }
-func (m *InstanceExportData_Reply) XXX_Size() int {
+func (m *ContactRequestSend) XXX_Size() int {
 	return m.Size()
 }
-func (m *InstanceExportData_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstanceExportData_Reply.DiscardUnknown(m)
+func (m *ContactRequestSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestSend.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstanceExportData_Reply proto.InternalMessageInfo
+var xxx_messageInfo_ContactRequestSend proto.InternalMessageInfo
 
-func (m *InstanceExportData_Reply) GetExportedData() []byte {
+type ContactRequestSend_Request struct {
+	// reference is an opaque message describing how to connect to the other account
+	Reference []byte `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	// contact_metadata is the metadata specific to the app to identify the contact for the request
+	ContactMetadata []byte `protobuf:"bytes,2,opt,name=contact_metadata,json=contactMetadata,proto3" json:"contact_metadata,omitempty"`
+	// own_metadata is the metadata sent to the contact along with the request, it is used by the app to identify the current account
+	OwnMetadata          []byte   `protobuf:"bytes,3,opt,name=own_metadata,json=ownMetadata,proto3" json:"own_metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContactRequestSend_Request) Reset()         { *m = ContactRequestSend_Request{} }
+func (m *ContactRequestSend_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestSend_Request) ProtoMessage()    {}
+func (*ContactRequestSend_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{10, 0}
+}
+func (m *ContactRequestSend_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContactRequestSend_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContactRequestSend_Request.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContactRequestSend_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestSend_Request.Merge(m, src)
+}
+func (m *ContactRequestSend_Request) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContactRequestSend_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestSend_Request.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContactRequestSend_Request proto.InternalMessageInfo
+
+func (m *ContactRequestSend_Request) GetReference() []byte {
 	if m != nil {
-		return m.ExportedData
+		return m.Reference
 	}
 	return nil
 }
 
-type InstanceGetConfiguration struct {
+func (m *ContactRequestSend_Request) GetContactMetadata() []byte {
+	if m != nil {
+		return m.ContactMetadata
+	}
+	return nil
+}
+
+func (m *ContactRequestSend_Request) GetOwnMetadata() []byte {
+	if m != nil {
+		return m.OwnMetadata
+	}
+	return nil
+}
+
+type ContactRequestSend_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InstanceGetConfiguration) Reset()         { *m = InstanceGetConfiguration{} }
-func (m *InstanceGetConfiguration) String() string { return proto.CompactTextString(m) }
-func (*InstanceGetConfiguration) ProtoMessage()    {}
-func (*InstanceGetConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{30}
+func (m *ContactRequestSend_Reply) Reset()         { *m = ContactRequestSend_Reply{} }
+func (m *ContactRequestSend_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestSend_Reply) ProtoMessage()    {}
+func (*ContactRequestSend_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{10, 1}
 }
-func (m *InstanceGetConfiguration) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestSend_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstanceGetConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestSend_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstanceGetConfiguration.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestSend_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2204,36 +1527,36 @@ func (m *InstanceGetConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *InstanceGetConfiguration) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstanceGetConfiguration.Merge(m, src)
+func (m *ContactRequestSend_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestSend_Reply.Merge(m, src)
 }
-func (m *InstanceGetConfiguration) XXX_Size() int {
+func (m *ContactRequestSend_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *InstanceGetConfiguration) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstanceGetConfiguration.DiscardUnknown(m)
+func (m *ContactRequestSend_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestSend_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstanceGetConfiguration proto.InternalMessageInfo
+var xxx_messageInfo_ContactRequestSend_Reply proto.InternalMessageInfo
 
-type InstanceGetConfiguration_Request struct {
+type ContactRequestAccept struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InstanceGetConfiguration_Request) Reset()         { *m = InstanceGetConfiguration_Request{} }
-func (m *InstanceGetConfiguration_Request) String() string { return proto.CompactTextString(m) }
-func (*InstanceGetConfiguration_Request) ProtoMessage()    {}
-func (*InstanceGetConfiguration_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{30, 0}
+func (m *ContactRequestAccept) Reset()         { *m = ContactRequestAccept{} }
+func (m *ContactRequestAccept) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestAccept) ProtoMessage()    {}
+func (*ContactRequestAccept) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{11}
 }
-func (m *InstanceGetConfiguration_Request) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestAccept) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstanceGetConfiguration_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstanceGetConfiguration_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestAccept.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2243,48 +1566,38 @@ func (m *InstanceGetConfiguration_Request) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *InstanceGetConfiguration_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstanceGetConfiguration_Request.Merge(m, src)
+func (m *ContactRequestAccept) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestAccept.Merge(m, src)
 }
-func (m *InstanceGetConfiguration_Request) XXX_Size() int {
+func (m *ContactRequestAccept) XXX_Size() int {
 	return m.Size()
 }
-func (m *InstanceGetConfiguration_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstanceGetConfiguration_Request.DiscardUnknown(m)
+func (m *ContactRequestAccept) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestAccept.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstanceGetConfiguration_Request proto.InternalMessageInfo
+var xxx_messageInfo_ContactRequestAccept proto.InternalMessageInfo
 
-type InstanceGetConfiguration_Reply struct {
-	// account_pk is the public key of the current account
-	AccountPK []byte `protobuf:"bytes,1,opt,name=account_pk,json=accountPk,proto3" json:"account_pk,omitempty"`
-	// device_pk is the public key of the current device
-	DevicePK []byte `protobuf:"bytes,2,opt,name=device_pk,json=devicePk,proto3" json:"device_pk,omitempty"`
-	// account_group_pk is the public key of the account group
-	AccountGroupPK       []byte                                `protobuf:"bytes,3,opt,name=account_group_pk,json=accountGroupPk,proto3" json:"account_group_pk,omitempty"`
-	PeerID               string                                `protobuf:"bytes,4,opt,name=peer_id,json=peerId,proto3" json:"peer_id,omitempty"`
-	Listeners            []string                              `protobuf:"bytes,5,rep,name=listeners,proto3" json:"listeners,omitempty"`
-	BleEnabled           InstanceGetConfiguration_SettingState `protobuf:"varint,6,opt,name=ble_enabled,json=bleEnabled,proto3,enum=berty.protocol.InstanceGetConfiguration_SettingState" json:"ble_enabled,omitempty"`
-	WifiP2PEnabled       InstanceGetConfiguration_SettingState `protobuf:"varint,7,opt,name=wifi_p2p_enabled,json=wifiP2pEnabled,proto3,enum=berty.protocol.InstanceGetConfiguration_SettingState" json:"wifi_p2p_enabled,omitempty"`
-	MdnsEnabled          InstanceGetConfiguration_SettingState `protobuf:"varint,8,opt,name=mdns_enabled,json=mdnsEnabled,proto3,enum=berty.protocol.InstanceGetConfiguration_SettingState" json:"mdns_enabled,omitempty"`
-	RelayEnabled         InstanceGetConfiguration_SettingState `protobuf:"varint,9,opt,name=relay_enabled,json=relayEnabled,proto3,enum=berty.protocol.InstanceGetConfiguration_SettingState" json:"relay_enabled,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                              `json:"-"`
-	XXX_unrecognized     []byte                                `json:"-"`
-	XXX_sizecache        int32                                 `json:"-"`
+type ContactRequestAccept_Request struct {
+	// contact_pk is the identifier of the contact to accept the request from
+	ContactPK            []byte   `protobuf:"bytes,1,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InstanceGetConfiguration_Reply) Reset()         { *m = InstanceGetConfiguration_Reply{} }
-func (m *InstanceGetConfiguration_Reply) String() string { return proto.CompactTextString(m) }
-func (*InstanceGetConfiguration_Reply) ProtoMessage()    {}
-func (*InstanceGetConfiguration_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{30, 1}
+func (m *ContactRequestAccept_Request) Reset()         { *m = ContactRequestAccept_Request{} }
+func (m *ContactRequestAccept_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestAccept_Request) ProtoMessage()    {}
+func (*ContactRequestAccept_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{11, 0}
 }
-func (m *InstanceGetConfiguration_Reply) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestAccept_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *InstanceGetConfiguration_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestAccept_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_InstanceGetConfiguration_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestAccept_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2294,99 +1607,82 @@ func (m *InstanceGetConfiguration_Reply) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *InstanceGetConfiguration_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InstanceGetConfiguration_Reply.Merge(m, src)
+func (m *ContactRequestAccept_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestAccept_Request.Merge(m, src)
 }
-func (m *InstanceGetConfiguration_Reply) XXX_Size() int {
+func (m *ContactRequestAccept_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *InstanceGetConfiguration_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_InstanceGetConfiguration_Reply.DiscardUnknown(m)
+func (m *ContactRequestAccept_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestAccept_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InstanceGetConfiguration_Reply proto.InternalMessageInfo
+var xxx_messageInfo_ContactRequestAccept_Request proto.InternalMessageInfo
 
-func (m *InstanceGetConfiguration_Reply) GetAccountPK() []byte {
+func (m *ContactRequestAccept_Request) GetContactPK() []byte {
 	if m != nil {
-		return m.AccountPK
+		return m.ContactPK
 	}
 	return nil
 }
 
-func (m *InstanceGetConfiguration_Reply) GetDevicePK() []byte {
-	if m != nil {
-		return m.DevicePK
-	}
-	return nil
+type ContactRequestAccept_Reply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InstanceGetConfiguration_Reply) GetAccountGroupPK() []byte {
-	if m != nil {
-		return m.AccountGroupPK
-	}
-	return nil
+func (m *ContactRequestAccept_Reply) Reset()         { *m = ContactRequestAccept_Reply{} }
+func (m *ContactRequestAccept_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestAccept_Reply) ProtoMessage()    {}
+func (*ContactRequestAccept_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{11, 1}
 }
-
-func (m *InstanceGetConfiguration_Reply) GetPeerID() string {
-	if m != nil {
-		return m.PeerID
-	}
-	return ""
+func (m *ContactRequestAccept_Reply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *InstanceGetConfiguration_Reply) GetListeners() []string {
-	if m != nil {
-		return m.Listeners
+func (m *ContactRequestAccept_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContactRequestAccept_Reply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *InstanceGetConfiguration_Reply) GetBleEnabled() InstanceGetConfiguration_SettingState {
-	if m != nil {
-		return m.BleEnabled
-	}
-	return Unknown
+func (m *ContactRequestAccept_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestAccept_Reply.Merge(m, src)
 }
-
-func (m *InstanceGetConfiguration_Reply) GetWifiP2PEnabled() InstanceGetConfiguration_SettingState {
-	if m != nil {
-		return m.WifiP2PEnabled
-	}
-	return Unknown
+func (m *ContactRequestAccept_Reply) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *InstanceGetConfiguration_Reply) GetMdnsEnabled() InstanceGetConfiguration_SettingState {
-	if m != nil {
-		return m.MdnsEnabled
-	}
-	return Unknown
+func (m *ContactRequestAccept_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestAccept_Reply.DiscardUnknown(m)
 }
 
-func (m *InstanceGetConfiguration_Reply) GetRelayEnabled() InstanceGetConfiguration_SettingState {
-	if m != nil {
-		return m.RelayEnabled
-	}
-	return Unknown
-}
+var xxx_messageInfo_ContactRequestAccept_Reply proto.InternalMessageInfo
 
-type ContactRequestReference struct {
+type ContactRequestDiscard struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestReference) Reset()         { *m = ContactRequestReference{} }
-func (m *ContactRequestReference) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestReference) ProtoMessage()    {}
-func (*ContactRequestReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{31}
+func (m *ContactRequestDiscard) Reset()         { *m = ContactRequestDiscard{} }
+func (m *ContactRequestDiscard) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestDiscard) ProtoMessage()    {}
+func (*ContactRequestDiscard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{12}
 }
-func (m *ContactRequestReference) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestDiscard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestDiscard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestReference.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestDiscard.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2396,36 +1692,38 @@ func (m *ContactRequestReference) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestReference) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestReference.Merge(m, src)
+func (m *ContactRequestDiscard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestDiscard.Merge(m, src)
 }
-func (m *ContactRequestReference) XXX_Size() int {
+func (m *ContactRequestDiscard) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestReference) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestReference.DiscardUnknown(m)
+func (m *ContactRequestDiscard) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestDiscard.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestReference proto.InternalMessageInfo
+var xxx_messageInfo_ContactRequestDiscard proto.InternalMessageInfo
 
-type ContactRequestReference_Request struct {
+type ContactRequestDiscard_Request struct {
+	// contact_pk is the identifier of the contact to ignore the request from
+	ContactPK            []byte   `protobuf:"bytes,1,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestReference_Request) Reset()         { *m = ContactRequestReference_Request{} }
-func (m *ContactRequestReference_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestReference_Request) ProtoMessage()    {}
-func (*ContactRequestReference_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{31, 0}
+func (m *ContactRequestDiscard_Request) Reset()         { *m = ContactRequestDiscard_Request{} }
+func (m *ContactRequestDiscard_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestDiscard_Request) ProtoMessage()    {}
+func (*ContactRequestDiscard_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{12, 0}
 }
-func (m *ContactRequestReference_Request) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestDiscard_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestReference_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestDiscard_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestReference_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestDiscard_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2435,38 +1733,43 @@ func (m *ContactRequestReference_Request) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestReference_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestReference_Request.Merge(m, src)
+func (m *ContactRequestDiscard_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestDiscard_Request.Merge(m, src)
 }
-func (m *ContactRequestReference_Request) XXX_Size() int {
+func (m *ContactRequestDiscard_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestReference_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestReference_Request.DiscardUnknown(m)
+func (m *ContactRequestDiscard_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestDiscard_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestReference_Request proto.InternalMessageInfo
+var xxx_messageInfo_ContactRequestDiscard_Request proto.InternalMessageInfo
 
-type ContactRequestReference_Reply struct {
-	// reference is an opaque message describing how to connect to the current account
-	Reference            []byte   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+func (m *ContactRequestDiscard_Request) GetContactPK() []byte {
+	if m != nil {
+		return m.ContactPK
+	}
+	return nil
+}
+
+type ContactRequestDiscard_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestReference_Reply) Reset()         { *m = ContactRequestReference_Reply{} }
-func (m *ContactRequestReference_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestReference_Reply) ProtoMessage()    {}
-func (*ContactRequestReference_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{31, 1}
+func (m *ContactRequestDiscard_Reply) Reset()         { *m = ContactRequestDiscard_Reply{} }
+func (m *ContactRequestDiscard_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactRequestDiscard_Reply) ProtoMessage()    {}
+func (*ContactRequestDiscard_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{12, 1}
 }
-func (m *ContactRequestReference_Reply) XXX_Unmarshal(b []byte) error {
+func (m *ContactRequestDiscard_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestReference_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactRequestDiscard_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestReference_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactRequestDiscard_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2476,43 +1779,36 @@ func (m *ContactRequestReference_Reply) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestReference_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestReference_Reply.Merge(m, src)
+func (m *ContactRequestDiscard_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactRequestDiscard_Reply.Merge(m, src)
 }
-func (m *ContactRequestReference_Reply) XXX_Size() int {
+func (m *ContactRequestDiscard_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestReference_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestReference_Reply.DiscardUnknown(m)
+func (m *ContactRequestDiscard_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactRequestDiscard_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestReference_Reply proto.InternalMessageInfo
-
-func (m *ContactRequestReference_Reply) GetReference() []byte {
-	if m != nil {
-		return m.Reference
-	}
-	return nil
-}
+var xxx_messageInfo_ContactRequestDiscard_Reply proto.InternalMessageInfo
 
-type ContactRequestDisable struct {
+type ContactBlock struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestDisable) Reset()         { *m = ContactRequestDisable{} }
-func (m *ContactRequestDisable) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestDisable) ProtoMessage()    {}
-func (*ContactRequestDisable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{32}
+func (m *ContactBlock) Reset()         { *m = ContactBlock{} }
+func (m *ContactBlock) String() string { return proto.CompactTextString(m) }
+func (*ContactBlock) ProtoMessage()    {}
+func (*ContactBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{13}
 }
-func (m *ContactRequestDisable) XXX_Unmarshal(b []byte) error {
+func (m *ContactBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestDisable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestDisable.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactBlock.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2522,36 +1818,38 @@ func (m *ContactRequestDisable) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestDisable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestDisable.Merge(m, src)
+func (m *ContactBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactBlock.Merge(m, src)
 }
-func (m *ContactRequestDisable) XXX_Size() int {
+func (m *ContactBlock) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestDisable) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestDisable.DiscardUnknown(m)
+func (m *ContactBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactBlock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestDisable proto.InternalMessageInfo
+var xxx_messageInfo_ContactBlock proto.InternalMessageInfo
 
-type ContactRequestDisable_Request struct {
+type ContactBlock_Request struct {
+	// contact_pk is the identifier of the contact to block
+	ContactPK            []byte   `protobuf:"bytes,1,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestDisable_Request) Reset()         { *m = ContactRequestDisable_Request{} }
-func (m *ContactRequestDisable_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestDisable_Request) ProtoMessage()    {}
-func (*ContactRequestDisable_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{32, 0}
+func (m *ContactBlock_Request) Reset()         { *m = ContactBlock_Request{} }
+func (m *ContactBlock_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactBlock_Request) ProtoMessage()    {}
+func (*ContactBlock_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{13, 0}
 }
-func (m *ContactRequestDisable_Request) XXX_Unmarshal(b []byte) error {
+func (m *ContactBlock_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestDisable_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactBlock_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestDisable_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactBlock_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2561,36 +1859,43 @@ func (m *ContactRequestDisable_Request) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestDisable_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestDisable_Request.Merge(m, src)
+func (m *ContactBlock_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactBlock_Request.Merge(m, src)
 }
-func (m *ContactRequestDisable_Request) XXX_Size() int {
+func (m *ContactBlock_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestDisable_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestDisable_Request.DiscardUnknown(m)
+func (m *ContactBlock_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactBlock_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestDisable_Request proto.InternalMessageInfo
+var xxx_messageInfo_ContactBlock_Request proto.InternalMessageInfo
 
-type ContactRequestDisable_Reply struct {
+func (m *ContactBlock_Request) GetContactPK() []byte {
+	if m != nil {
+		return m.ContactPK
+	}
+	return nil
+}
+
+type ContactBlock_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestDisable_Reply) Reset()         { *m = ContactRequestDisable_Reply{} }
-func (m *ContactRequestDisable_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestDisable_Reply) ProtoMessage()    {}
-func (*ContactRequestDisable_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{32, 1}
+func (m *ContactBlock_Reply) Reset()         { *m = ContactBlock_Reply{} }
+func (m *ContactBlock_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactBlock_Reply) ProtoMessage()    {}
+func (*ContactBlock_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{13, 1}
 }
-func (m *ContactRequestDisable_Reply) XXX_Unmarshal(b []byte) error {
+func (m *ContactBlock_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestDisable_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactBlock_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestDisable_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactBlock_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2600,36 +1905,36 @@ func (m *ContactRequestDisable_Reply) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestDisable_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestDisable_Reply.Merge(m, src)
+func (m *ContactBlock_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactBlock_Reply.Merge(m, src)
 }
-func (m *ContactRequestDisable_Reply) XXX_Size() int {
+func (m *ContactBlock_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestDisable_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestDisable_Reply.DiscardUnknown(m)
+func (m *ContactBlock_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactBlock_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestDisable_Reply proto.InternalMessageInfo
+var xxx_messageInfo_ContactBlock_Reply proto.InternalMessageInfo
 
-type ContactRequestEnable struct {
+type ContactUnblock struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestEnable) Reset()         { *m = ContactRequestEnable{} }
-func (m *ContactRequestEnable) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestEnable) ProtoMessage()    {}
-func (*ContactRequestEnable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{33}
+func (m *ContactUnblock) Reset()         { *m = ContactUnblock{} }
+func (m *ContactUnblock) String() string { return proto.CompactTextString(m) }
+func (*ContactUnblock) ProtoMessage()    {}
+func (*ContactUnblock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{14}
 }
-func (m *ContactRequestEnable) XXX_Unmarshal(b []byte) error {
+func (m *ContactUnblock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestEnable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactUnblock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestEnable.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactUnblock.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2639,36 +1944,38 @@ func (m *ContactRequestEnable) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestEnable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestEnable.Merge(m, src)
+func (m *ContactUnblock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactUnblock.Merge(m, src)
 }
-func (m *ContactRequestEnable) XXX_Size() int {
+func (m *ContactUnblock) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestEnable) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestEnable.DiscardUnknown(m)
+func (m *ContactUnblock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactUnblock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestEnable proto.InternalMessageInfo
+var xxx_messageInfo_ContactUnblock proto.InternalMessageInfo
 
-type ContactRequestEnable_Request struct {
+type ContactUnblock_Request struct {
+	// contact_pk is the identifier of the contact to unblock
+	ContactPK            []byte   `protobuf:"bytes,1,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestEnable_Request) Reset()         { *m = ContactRequestEnable_Request{} }
-func (m *ContactRequestEnable_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestEnable_Request) ProtoMessage()    {}
-func (*ContactRequestEnable_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{33, 0}
+func (m *ContactUnblock_Request) Reset()         { *m = ContactUnblock_Request{} }
+func (m *ContactUnblock_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactUnblock_Request) ProtoMessage()    {}
+func (*ContactUnblock_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{14, 0}
 }
-func (m *ContactRequestEnable_Request) XXX_Unmarshal(b []byte) error {
+func (m *ContactUnblock_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestEnable_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactUnblock_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestEnable_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactUnblock_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2678,38 +1985,43 @@ func (m *ContactRequestEnable_Request) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestEnable_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestEnable_Request.Merge(m, src)
+func (m *ContactUnblock_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactUnblock_Request.Merge(m, src)
 }
-func (m *ContactRequestEnable_Request) XXX_Size() int {
+func (m *ContactUnblock_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestEnable_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestEnable_Request.DiscardUnknown(m)
+func (m *ContactUnblock_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactUnblock_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestEnable_Request proto.InternalMessageInfo
+var xxx_messageInfo_ContactUnblock_Request proto.InternalMessageInfo
 
-type ContactRequestEnable_Reply struct {
-	// reference is an opaque message describing how to connect to the current account
-	Reference            []byte   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+func (m *ContactUnblock_Request) GetContactPK() []byte {
+	if m != nil {
+		return m.ContactPK
+	}
+	return nil
+}
+
+type ContactUnblock_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestEnable_Reply) Reset()         { *m = ContactRequestEnable_Reply{} }
-func (m *ContactRequestEnable_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestEnable_Reply) ProtoMessage()    {}
-func (*ContactRequestEnable_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{33, 1}
+func (m *ContactUnblock_Reply) Reset()         { *m = ContactUnblock_Reply{} }
+func (m *ContactUnblock_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactUnblock_Reply) ProtoMessage()    {}
+func (*ContactUnblock_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{14, 1}
 }
-func (m *ContactRequestEnable_Reply) XXX_Unmarshal(b []byte) error {
+func (m *ContactUnblock_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestEnable_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactUnblock_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestEnable_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactUnblock_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2719,43 +2031,36 @@ func (m *ContactRequestEnable_Reply) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestEnable_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestEnable_Reply.Merge(m, src)
+func (m *ContactUnblock_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactUnblock_Reply.Merge(m, src)
 }
-func (m *ContactRequestEnable_Reply) XXX_Size() int {
+func (m *ContactUnblock_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestEnable_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestEnable_Reply.DiscardUnknown(m)
+func (m *ContactUnblock_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactUnblock_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestEnable_Reply proto.InternalMessageInfo
-
-func (m *ContactRequestEnable_Reply) GetReference() []byte {
-	if m != nil {
-		return m.Reference
-	}
-	return nil
-}
+var xxx_messageInfo_ContactUnblock_Reply proto.InternalMessageInfo
 
-type ContactRequestResetReference struct {
+type ContactAliasKeySend struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestResetReference) Reset()         { *m = ContactRequestResetReference{} }
-func (m *ContactRequestResetReference) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestResetReference) ProtoMessage()    {}
-func (*ContactRequestResetReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{34}
+func (m *ContactAliasKeySend) Reset()         { *m = ContactAliasKeySend{} }
+func (m *ContactAliasKeySend) String() string { return proto.CompactTextString(m) }
+func (*ContactAliasKeySend) ProtoMessage()    {}
+func (*ContactAliasKeySend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{15}
 }
-func (m *ContactRequestResetReference) XXX_Unmarshal(b []byte) error {
+func (m *ContactAliasKeySend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestResetReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactAliasKeySend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestResetReference.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactAliasKeySend.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2765,36 +2070,38 @@ func (m *ContactRequestResetReference) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestResetReference) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestResetReference.Merge(m, src)
+func (m *ContactAliasKeySend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactAliasKeySend.Merge(m, src)
 }
-func (m *ContactRequestResetReference) XXX_Size() int {
+func (m *ContactAliasKeySend) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestResetReference) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestResetReference.DiscardUnknown(m)
+func (m *ContactAliasKeySend) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactAliasKeySend.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestResetReference proto.InternalMessageInfo
+var xxx_messageInfo_ContactAliasKeySend proto.InternalMessageInfo
 
-type ContactRequestResetReference_Request struct {
+type ContactAliasKeySend_Request struct {
+	// contact_pk is the identifier of the contact to send the alias public key to
+	GroupPK              []byte   `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestResetReference_Request) Reset()         { *m = ContactRequestResetReference_Request{} }
-func (m *ContactRequestResetReference_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestResetReference_Request) ProtoMessage()    {}
-func (*ContactRequestResetReference_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{34, 0}
+func (m *ContactAliasKeySend_Request) Reset()         { *m = ContactAliasKeySend_Request{} }
+func (m *ContactAliasKeySend_Request) String() string { return proto.CompactTextString(m) }
+func (*ContactAliasKeySend_Request) ProtoMessage()    {}
+func (*ContactAliasKeySend_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{15, 0}
 }
-func (m *ContactRequestResetReference_Request) XXX_Unmarshal(b []byte) error {
+func (m *ContactAliasKeySend_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestResetReference_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactAliasKeySend_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestResetReference_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactAliasKeySend_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2804,38 +2111,43 @@ func (m *ContactRequestResetReference_Request) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestResetReference_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestResetReference_Request.Merge(m, src)
+func (m *ContactAliasKeySend_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactAliasKeySend_Request.Merge(m, src)
 }
-func (m *ContactRequestResetReference_Request) XXX_Size() int {
+func (m *ContactAliasKeySend_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestResetReference_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestResetReference_Request.DiscardUnknown(m)
+func (m *ContactAliasKeySend_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactAliasKeySend_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestResetReference_Request proto.InternalMessageInfo
+var xxx_messageInfo_ContactAliasKeySend_Request proto.InternalMessageInfo
 
-type ContactRequestResetReference_Reply struct {
-	// reference is an opaque message describing how to connect to the current account
-	Reference            []byte   `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+func (m *ContactAliasKeySend_Request) GetGroupPK() []byte {
+	if m != nil {
+		return m.GroupPK
+	}
+	return nil
+}
+
+type ContactAliasKeySend_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestResetReference_Reply) Reset()         { *m = ContactRequestResetReference_Reply{} }
-func (m *ContactRequestResetReference_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestResetReference_Reply) ProtoMessage()    {}
-func (*ContactRequestResetReference_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{34, 1}
+func (m *ContactAliasKeySend_Reply) Reset()         { *m = ContactAliasKeySend_Reply{} }
+func (m *ContactAliasKeySend_Reply) String() string { return proto.CompactTextString(m) }
+func (*ContactAliasKeySend_Reply) ProtoMessage()    {}
+func (*ContactAliasKeySend_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{15, 1}
 }
-func (m *ContactRequestResetReference_Reply) XXX_Unmarshal(b []byte) error {
+func (m *ContactAliasKeySend_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestResetReference_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ContactAliasKeySend_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestResetReference_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ContactAliasKeySend_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2845,43 +2157,36 @@ func (m *ContactRequestResetReference_Reply) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestResetReference_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestResetReference_Reply.Merge(m, src)
+func (m *ContactAliasKeySend_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContactAliasKeySend_Reply.Merge(m, src)
 }
-func (m *ContactRequestResetReference_Reply) XXX_Size() int {
+func (m *ContactAliasKeySend_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestResetReference_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestResetReference_Reply.DiscardUnknown(m)
+func (m *ContactAliasKeySend_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContactAliasKeySend_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestResetReference_Reply proto.InternalMessageInfo
-
-func (m *ContactRequestResetReference_Reply) GetReference() []byte {
-	if m != nil {
-		return m.Reference
-	}
-	return nil
-}
+var xxx_messageInfo_ContactAliasKeySend_Reply proto.InternalMessageInfo
 
-type ContactRequestSend struct {
+type MultiMemberGroupCreate struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestSend) Reset()         { *m = ContactRequestSend{} }
-func (m *ContactRequestSend) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestSend) ProtoMessage()    {}
-func (*ContactRequestSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{35}
+func (m *MultiMemberGroupCreate) Reset()         { *m = MultiMemberGroupCreate{} }
+func (m *MultiMemberGroupCreate) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupCreate) ProtoMessage()    {}
+func (*MultiMemberGroupCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{16}
 }
-func (m *ContactRequestSend) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupCreate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestSend.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupCreate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2891,40 +2196,38 @@ func (m *ContactRequestSend) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestSend) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestSend.Merge(m, src)
+func (m *MultiMemberGroupCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupCreate.Merge(m, src)
 }
-func (m *ContactRequestSend) XXX_Size() int {
+func (m *MultiMemberGroupCreate) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestSend) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestSend.DiscardUnknown(m)
+func (m *MultiMemberGroupCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupCreate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestSend proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupCreate proto.InternalMessageInfo
 
-type ContactRequestSend_Request struct {
-	// reference is an opaque message describing how to connect to the other account
-	Reference []byte `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
-	// contact_metadata is the metadata specific to the app to identify the contact for the request
-	ContactMetadata      []byte   `protobuf:"bytes,2,opt,name=contact_metadata,json=contactMetadata,proto3" json:"contact_metadata,omitempty"`
+type MultiMemberGroupCreate_Request struct {
+	// admin_messages_only restricts the messages of the group to its admins
+	AdminMessagesOnly    bool     `protobuf:"varint,1,opt,name=admin_messages_only,json=adminMessagesOnly,proto3" json:"admin_messages_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestSend_Request) Reset()         { *m = ContactRequestSend_Request{} }
-func (m *ContactRequestSend_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestSend_Request) ProtoMessage()    {}
-func (*ContactRequestSend_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{35, 0}
+func (m *MultiMemberGroupCreate_Request) Reset()         { *m = MultiMemberGroupCreate_Request{} }
+func (m *MultiMemberGroupCreate_Request) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupCreate_Request) ProtoMessage()    {}
+func (*MultiMemberGroupCreate_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{16, 0}
 }
-func (m *ContactRequestSend_Request) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupCreate_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestSend_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupCreate_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestSend_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupCreate_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2934,50 +2237,45 @@ func (m *ContactRequestSend_Request) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestSend_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestSend_Request.Merge(m, src)
+func (m *MultiMemberGroupCreate_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupCreate_Request.Merge(m, src)
 }
-func (m *ContactRequestSend_Request) XXX_Size() int {
+func (m *MultiMemberGroupCreate_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestSend_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestSend_Request.DiscardUnknown(m)
+func (m *MultiMemberGroupCreate_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupCreate_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestSend_Request proto.InternalMessageInfo
-
-func (m *ContactRequestSend_Request) GetReference() []byte {
-	if m != nil {
-		return m.Reference
-	}
-	return nil
-}
+var xxx_messageInfo_MultiMemberGroupCreate_Request proto.InternalMessageInfo
 
-func (m *ContactRequestSend_Request) GetContactMetadata() []byte {
+func (m *MultiMemberGroupCreate_Request) GetAdminMessagesOnly() bool {
 	if m != nil {
-		return m.ContactMetadata
+		return m.AdminMessagesOnly
 	}
-	return nil
+	return false
 }
 
-type ContactRequestSend_Reply struct {
+type MultiMemberGroupCreate_Reply struct {
+	// group_pk is the identifier of the newly created group
+	GroupPK              []byte   `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestSend_Reply) Reset()         { *m = ContactRequestSend_Reply{} }
-func (m *ContactRequestSend_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestSend_Reply) ProtoMessage()    {}
-func (*ContactRequestSend_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{35, 1}
+func (m *MultiMemberGroupCreate_Reply) Reset()         { *m = MultiMemberGroupCreate_Reply{} }
+func (m *MultiMemberGroupCreate_Reply) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupCreate_Reply) ProtoMessage()    {}
+func (*MultiMemberGroupCreate_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{16, 1}
 }
-func (m *ContactRequestSend_Reply) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupCreate_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestSend_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupCreate_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestSend_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupCreate_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2987,36 +2285,43 @@ func (m *ContactRequestSend_Reply) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestSend_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestSend_Reply.Merge(m, src)
+func (m *MultiMemberGroupCreate_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupCreate_Reply.Merge(m, src)
 }
-func (m *ContactRequestSend_Reply) XXX_Size() int {
+func (m *MultiMemberGroupCreate_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestSend_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestSend_Reply.DiscardUnknown(m)
+func (m *MultiMemberGroupCreate_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupCreate_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestSend_Reply proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupCreate_Reply proto.InternalMessageInfo
 
-type ContactRequestAccept struct {
+func (m *MultiMemberGroupCreate_Reply) GetGroupPK() []byte {
+	if m != nil {
+		return m.GroupPK
+	}
+	return nil
+}
+
+type MultiMemberGroupJoin struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestAccept) Reset()         { *m = ContactRequestAccept{} }
-func (m *ContactRequestAccept) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestAccept) ProtoMessage()    {}
-func (*ContactRequestAccept) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{36}
+func (m *MultiMemberGroupJoin) Reset()         { *m = MultiMemberGroupJoin{} }
+func (m *MultiMemberGroupJoin) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupJoin) ProtoMessage()    {}
+func (*MultiMemberGroupJoin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{17}
 }
-func (m *ContactRequestAccept) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestAccept.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupJoin.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3026,38 +2331,38 @@ func (m *ContactRequestAccept) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestAccept) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestAccept.Merge(m, src)
+func (m *MultiMemberGroupJoin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupJoin.Merge(m, src)
 }
-func (m *ContactRequestAccept) XXX_Size() int {
+func (m *MultiMemberGroupJoin) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestAccept) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestAccept.DiscardUnknown(m)
+func (m *MultiMemberGroupJoin) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupJoin.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestAccept proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupJoin proto.InternalMessageInfo
 
-type ContactRequestAccept_Request struct {
-	// contact_pk is the identifier of the contact to accept the request from
-	ContactPK            []byte   `protobuf:"bytes,1,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type MultiMemberGroupJoin_Request struct {
+	// invitation is the invitation used to join the group, the group is sent by an admin once it has been checked
+	Invitation           *bertytypes.GroupInvitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
 }
 
-func (m *ContactRequestAccept_Request) Reset()         { *m = ContactRequestAccept_Request{} }
-func (m *ContactRequestAccept_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestAccept_Request) ProtoMessage()    {}
-func (*ContactRequestAccept_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{36, 0}
+func (m *MultiMemberGroupJoin_Request) Reset()         { *m = MultiMemberGroupJoin_Request{} }
+func (m *MultiMemberGroupJoin_Request) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupJoin_Request) ProtoMessage()    {}
+func (*MultiMemberGroupJoin_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{17, 0}
 }
-func (m *ContactRequestAccept_Request) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupJoin_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestAccept_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupJoin_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestAccept_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupJoin_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3067,43 +2372,43 @@ func (m *ContactRequestAccept_Request) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestAccept_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestAccept_Request.Merge(m, src)
+func (m *MultiMemberGroupJoin_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupJoin_Request.Merge(m, src)
 }
-func (m *ContactRequestAccept_Request) XXX_Size() int {
+func (m *MultiMemberGroupJoin_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestAccept_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestAccept_Request.DiscardUnknown(m)
+func (m *MultiMemberGroupJoin_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupJoin_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestAccept_Request proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupJoin_Request proto.InternalMessageInfo
 
-func (m *ContactRequestAccept_Request) GetContactPK() []byte {
+func (m *MultiMemberGroupJoin_Request) GetInvitation() *bertytypes.GroupInvitation {
 	if m != nil {
-		return m.ContactPK
+		return m.Invitation
 	}
 	return nil
 }
 
-type ContactRequestAccept_Reply struct {
+type MultiMemberGroupJoin_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestAccept_Reply) Reset()         { *m = ContactRequestAccept_Reply{} }
-func (m *ContactRequestAccept_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestAccept_Reply) ProtoMessage()    {}
-func (*ContactRequestAccept_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{36, 1}
+func (m *MultiMemberGroupJoin_Reply) Reset()         { *m = MultiMemberGroupJoin_Reply{} }
+func (m *MultiMemberGroupJoin_Reply) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupJoin_Reply) ProtoMessage()    {}
+func (*MultiMemberGroupJoin_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{17, 1}
 }
-func (m *ContactRequestAccept_Reply) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupJoin_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestAccept_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupJoin_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestAccept_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupJoin_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3113,36 +2418,36 @@ func (m *ContactRequestAccept_Reply) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestAccept_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestAccept_Reply.Merge(m, src)
+func (m *MultiMemberGroupJoin_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupJoin_Reply.Merge(m, src)
 }
-func (m *ContactRequestAccept_Reply) XXX_Size() int {
+func (m *MultiMemberGroupJoin_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestAccept_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestAccept_Reply.DiscardUnknown(m)
+func (m *MultiMemberGroupJoin_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupJoin_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestAccept_Reply proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupJoin_Reply proto.InternalMessageInfo
 
-type ContactRequestDiscard struct {
+type MultiMemberGroupLeave struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestDiscard) Reset()         { *m = ContactRequestDiscard{} }
-func (m *ContactRequestDiscard) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestDiscard) ProtoMessage()    {}
-func (*ContactRequestDiscard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{37}
+func (m *MultiMemberGroupLeave) Reset()         { *m = MultiMemberGroupLeave{} }
+func (m *MultiMemberGroupLeave) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupLeave) ProtoMessage()    {}
+func (*MultiMemberGroupLeave) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{18}
 }
-func (m *ContactRequestDiscard) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupLeave) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestDiscard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupLeave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestDiscard.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupLeave.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3152,38 +2457,37 @@ func (m *ContactRequestDiscard) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestDiscard) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestDiscard.Merge(m, src)
+func (m *MultiMemberGroupLeave) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupLeave.Merge(m, src)
 }
-func (m *ContactRequestDiscard) XXX_Size() int {
+func (m *MultiMemberGroupLeave) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestDiscard) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestDiscard.DiscardUnknown(m)
+func (m *MultiMemberGroupLeave) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupLeave.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestDiscard proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupLeave proto.InternalMessageInfo
 
-type ContactRequestDiscard_Request struct {
-	// contact_pk is the identifier of the contact to ignore the request from
-	ContactPK            []byte   `protobuf:"bytes,1,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
+type MultiMemberGroupLeave_Request struct {
+	GroupPK              []byte   `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestDiscard_Request) Reset()         { *m = ContactRequestDiscard_Request{} }
-func (m *ContactRequestDiscard_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestDiscard_Request) ProtoMessage()    {}
-func (*ContactRequestDiscard_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{37, 0}
+func (m *MultiMemberGroupLeave_Request) Reset()         { *m = MultiMemberGroupLeave_Request{} }
+func (m *MultiMemberGroupLeave_Request) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupLeave_Request) ProtoMessage()    {}
+func (*MultiMemberGroupLeave_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{18, 0}
 }
-func (m *ContactRequestDiscard_Request) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupLeave_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestDiscard_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupLeave_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestDiscard_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupLeave_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3193,43 +2497,43 @@ func (m *ContactRequestDiscard_Request) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestDiscard_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestDiscard_Request.Merge(m, src)
+func (m *MultiMemberGroupLeave_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupLeave_Request.Merge(m, src)
 }
-func (m *ContactRequestDiscard_Request) XXX_Size() int {
+func (m *MultiMemberGroupLeave_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestDiscard_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestDiscard_Request.DiscardUnknown(m)
+func (m *MultiMemberGroupLeave_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupLeave_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestDiscard_Request proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupLeave_Request proto.InternalMessageInfo
 
-func (m *ContactRequestDiscard_Request) GetContactPK() []byte {
+func (m *MultiMemberGroupLeave_Request) GetGroupPK() []byte {
 	if m != nil {
-		return m.ContactPK
+		return m.GroupPK
 	}
 	return nil
 }
 
-type ContactRequestDiscard_Reply struct {
+type MultiMemberGroupLeave_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactRequestDiscard_Reply) Reset()         { *m = ContactRequestDiscard_Reply{} }
-func (m *ContactRequestDiscard_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactRequestDiscard_Reply) ProtoMessage()    {}
-func (*ContactRequestDiscard_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{37, 1}
+func (m *MultiMemberGroupLeave_Reply) Reset()         { *m = MultiMemberGroupLeave_Reply{} }
+func (m *MultiMemberGroupLeave_Reply) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupLeave_Reply) ProtoMessage()    {}
+func (*MultiMemberGroupLeave_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{18, 1}
 }
-func (m *ContactRequestDiscard_Reply) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupLeave_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactRequestDiscard_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupLeave_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactRequestDiscard_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupLeave_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3239,36 +2543,36 @@ func (m *ContactRequestDiscard_Reply) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContactRequestDiscard_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactRequestDiscard_Reply.Merge(m, src)
+func (m *MultiMemberGroupLeave_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupLeave_Reply.Merge(m, src)
 }
-func (m *ContactRequestDiscard_Reply) XXX_Size() int {
+func (m *MultiMemberGroupLeave_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactRequestDiscard_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactRequestDiscard_Reply.DiscardUnknown(m)
+func (m *MultiMemberGroupLeave_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupLeave_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactRequestDiscard_Reply proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupLeave_Reply proto.InternalMessageInfo
 
-type ContactBlock struct {
+type MultiMemberGroupAliasResolverDisclose struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactBlock) Reset()         { *m = ContactBlock{} }
-func (m *ContactBlock) String() string { return proto.CompactTextString(m) }
-func (*ContactBlock) ProtoMessage()    {}
-func (*ContactBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{38}
+func (m *MultiMemberGroupAliasResolverDisclose) Reset()         { *m = MultiMemberGroupAliasResolverDisclose{} }
+func (m *MultiMemberGroupAliasResolverDisclose) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupAliasResolverDisclose) ProtoMessage()    {}
+func (*MultiMemberGroupAliasResolverDisclose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{19}
 }
-func (m *ContactBlock) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupAliasResolverDisclose) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupAliasResolverDisclose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactBlock.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupAliasResolverDisclose.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3278,38 +2582,41 @@ func (m *ContactBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *ContactBlock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactBlock.Merge(m, src)
+func (m *MultiMemberGroupAliasResolverDisclose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose.Merge(m, src)
 }
-func (m *ContactBlock) XXX_Size() int {
+func (m *MultiMemberGroupAliasResolverDisclose) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactBlock) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactBlock.DiscardUnknown(m)
+func (m *MultiMemberGroupAliasResolverDisclose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactBlock proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupAliasResolverDisclose proto.InternalMessageInfo
 
-type ContactBlock_Request struct {
-	// contact_pk is the identifier of the contact to block
-	ContactPK            []byte   `protobuf:"bytes,1,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
+type MultiMemberGroupAliasResolverDisclose_Request struct {
+	GroupPK              []byte   `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactBlock_Request) Reset()         { *m = ContactBlock_Request{} }
-func (m *ContactBlock_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactBlock_Request) ProtoMessage()    {}
-func (*ContactBlock_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{38, 0}
-}
-func (m *ContactBlock_Request) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *MultiMemberGroupAliasResolverDisclose_Request) Reset() {
+	*m = MultiMemberGroupAliasResolverDisclose_Request{}
 }
-func (m *ContactBlock_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupAliasResolverDisclose_Request) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MultiMemberGroupAliasResolverDisclose_Request) ProtoMessage() {}
+func (*MultiMemberGroupAliasResolverDisclose_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{19, 0}
+}
+func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactBlock_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3319,43 +2626,47 @@ func (m *ContactBlock_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ContactBlock_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactBlock_Request.Merge(m, src)
+func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Request.Merge(m, src)
 }
-func (m *ContactBlock_Request) XXX_Size() int {
+func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactBlock_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactBlock_Request.DiscardUnknown(m)
+func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactBlock_Request proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Request proto.InternalMessageInfo
 
-func (m *ContactBlock_Request) GetContactPK() []byte {
+func (m *MultiMemberGroupAliasResolverDisclose_Request) GetGroupPK() []byte {
 	if m != nil {
-		return m.ContactPK
+		return m.GroupPK
 	}
 	return nil
 }
 
-type ContactBlock_Reply struct {
+type MultiMemberGroupAliasResolverDisclose_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactBlock_Reply) Reset()         { *m = ContactBlock_Reply{} }
-func (m *ContactBlock_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactBlock_Reply) ProtoMessage()    {}
-func (*ContactBlock_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{38, 1}
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) Reset() {
+	*m = MultiMemberGroupAliasResolverDisclose_Reply{}
 }
-func (m *ContactBlock_Reply) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MultiMemberGroupAliasResolverDisclose_Reply) ProtoMessage() {}
+func (*MultiMemberGroupAliasResolverDisclose_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{19, 1}
+}
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactBlock_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactBlock_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3365,36 +2676,36 @@ func (m *ContactBlock_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ContactBlock_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactBlock_Reply.Merge(m, src)
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Reply.Merge(m, src)
 }
-func (m *ContactBlock_Reply) XXX_Size() int {
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactBlock_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactBlock_Reply.DiscardUnknown(m)
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactBlock_Reply proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Reply proto.InternalMessageInfo
 
-type ContactUnblock struct {
+type MultiMemberGroupAdminRoleGrant struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactUnblock) Reset()         { *m = ContactUnblock{} }
-func (m *ContactUnblock) String() string { return proto.CompactTextString(m) }
-func (*ContactUnblock) ProtoMessage()    {}
-func (*ContactUnblock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{39}
+func (m *MultiMemberGroupAdminRoleGrant) Reset()         { *m = MultiMemberGroupAdminRoleGrant{} }
+func (m *MultiMemberGroupAdminRoleGrant) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupAdminRoleGrant) ProtoMessage()    {}
+func (*MultiMemberGroupAdminRoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{20}
 }
-func (m *ContactUnblock) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupAdminRoleGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactUnblock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupAdminRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactUnblock.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupAdminRoleGrant.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3404,38 +2715,42 @@ func (m *ContactUnblock) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ContactUnblock) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactUnblock.Merge(m, src)
+func (m *MultiMemberGroupAdminRoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupAdminRoleGrant.Merge(m, src)
 }
-func (m *ContactUnblock) XXX_Size() int {
+func (m *MultiMemberGroupAdminRoleGrant) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactUnblock) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactUnblock.DiscardUnknown(m)
+func (m *MultiMemberGroupAdminRoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupAdminRoleGrant.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactUnblock proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupAdminRoleGrant proto.InternalMessageInfo
 
-type ContactUnblock_Request struct {
-	// contact_pk is the identifier of the contact to unblock
-	ContactPK            []byte   `protobuf:"bytes,1,opt,name=contact_pk,json=contactPk,proto3" json:"contact_pk,omitempty"`
+type MultiMemberGroupAdminRoleGrant_Request struct {
+	// group_pk is the identifier of the group
+	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+	// member_pk is the identifier of the member which will be granted the admin role
+	MemberPK             []byte   `protobuf:"bytes,2,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactUnblock_Request) Reset()         { *m = ContactUnblock_Request{} }
-func (m *ContactUnblock_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactUnblock_Request) ProtoMessage()    {}
-func (*ContactUnblock_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{39, 0}
+func (m *MultiMemberGroupAdminRoleGrant_Request) Reset() {
+	*m = MultiMemberGroupAdminRoleGrant_Request{}
 }
-func (m *ContactUnblock_Request) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupAdminRoleGrant_Request) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupAdminRoleGrant_Request) ProtoMessage()    {}
+func (*MultiMemberGroupAdminRoleGrant_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{20, 0}
+}
+func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactUnblock_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactUnblock_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3445,43 +2760,50 @@ func (m *ContactUnblock_Request) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *ContactUnblock_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactUnblock_Request.Merge(m, src)
+func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Request.Merge(m, src)
 }
-func (m *ContactUnblock_Request) XXX_Size() int {
+func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactUnblock_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactUnblock_Request.DiscardUnknown(m)
+func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactUnblock_Request proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Request proto.InternalMessageInfo
 
-func (m *ContactUnblock_Request) GetContactPK() []byte {
+func (m *MultiMemberGroupAdminRoleGrant_Request) GetGroupPK() []byte {
 	if m != nil {
-		return m.ContactPK
+		return m.GroupPK
 	}
 	return nil
 }
 
-type ContactUnblock_Reply struct {
+func (m *MultiMemberGroupAdminRoleGrant_Request) GetMemberPK() []byte {
+	if m != nil {
+		return m.MemberPK
+	}
+	return nil
+}
+
+type MultiMemberGroupAdminRoleGrant_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactUnblock_Reply) Reset()         { *m = ContactUnblock_Reply{} }
-func (m *ContactUnblock_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactUnblock_Reply) ProtoMessage()    {}
-func (*ContactUnblock_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{39, 1}
+func (m *MultiMemberGroupAdminRoleGrant_Reply) Reset()         { *m = MultiMemberGroupAdminRoleGrant_Reply{} }
+func (m *MultiMemberGroupAdminRoleGrant_Reply) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupAdminRoleGrant_Reply) ProtoMessage()    {}
+func (*MultiMemberGroupAdminRoleGrant_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{20, 1}
 }
-func (m *ContactUnblock_Reply) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactUnblock_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactUnblock_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3491,36 +2813,36 @@ func (m *ContactUnblock_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ContactUnblock_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactUnblock_Reply.Merge(m, src)
+func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Reply.Merge(m, src)
 }
-func (m *ContactUnblock_Reply) XXX_Size() int {
+func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactUnblock_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactUnblock_Reply.DiscardUnknown(m)
+func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactUnblock_Reply proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Reply proto.InternalMessageInfo
 
-type ContactAliasKeySend struct {
+type MultiMemberGroupAdminRoleRevoke struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactAliasKeySend) Reset()         { *m = ContactAliasKeySend{} }
-func (m *ContactAliasKeySend) String() string { return proto.CompactTextString(m) }
-func (*ContactAliasKeySend) ProtoMessage()    {}
-func (*ContactAliasKeySend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{40}
+func (m *MultiMemberGroupAdminRoleRevoke) Reset()         { *m = MultiMemberGroupAdminRoleRevoke{} }
+func (m *MultiMemberGroupAdminRoleRevoke) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupAdminRoleRevoke) ProtoMessage()    {}
+func (*MultiMemberGroupAdminRoleRevoke) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{21}
 }
-func (m *ContactAliasKeySend) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupAdminRoleRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactAliasKeySend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupAdminRoleRevoke) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactAliasKeySend.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupAdminRoleRevoke.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3530,38 +2852,42 @@ func (m *ContactAliasKeySend) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ContactAliasKeySend) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactAliasKeySend.Merge(m, src)
+func (m *MultiMemberGroupAdminRoleRevoke) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupAdminRoleRevoke.Merge(m, src)
 }
-func (m *ContactAliasKeySend) XXX_Size() int {
+func (m *MultiMemberGroupAdminRoleRevoke) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactAliasKeySend) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactAliasKeySend.DiscardUnknown(m)
+func (m *MultiMemberGroupAdminRoleRevoke) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupAdminRoleRevoke.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactAliasKeySend proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupAdminRoleRevoke proto.InternalMessageInfo
 
-type ContactAliasKeySend_Request struct {
-	// contact_pk is the identifier of the contact to send the alias public key to
-	GroupPK              []byte   `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+type MultiMemberGroupAdminRoleRevoke_Request struct {
+	// group_pk is the identifier of the group
+	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+	// member_pk is the identifier of the member whose admin role will be revoked
+	MemberPK             []byte   `protobuf:"bytes,2,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactAliasKeySend_Request) Reset()         { *m = ContactAliasKeySend_Request{} }
-func (m *ContactAliasKeySend_Request) String() string { return proto.CompactTextString(m) }
-func (*ContactAliasKeySend_Request) ProtoMessage()    {}
-func (*ContactAliasKeySend_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{40, 0}
+func (m *MultiMemberGroupAdminRoleRevoke_Request) Reset() {
+	*m = MultiMemberGroupAdminRoleRevoke_Request{}
 }
-func (m *ContactAliasKeySend_Request) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupAdminRoleRevoke_Request) ProtoMessage()    {}
+func (*MultiMemberGroupAdminRoleRevoke_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{21, 0}
+}
+func (m *MultiMemberGroupAdminRoleRevoke_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactAliasKeySend_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactAliasKeySend_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupAdminRoleRevoke_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3571,43 +2897,50 @@ func (m *ContactAliasKeySend_Request) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *ContactAliasKeySend_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactAliasKeySend_Request.Merge(m, src)
+func (m *MultiMemberGroupAdminRoleRevoke_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupAdminRoleRevoke_Request.Merge(m, src)
 }
-func (m *ContactAliasKeySend_Request) XXX_Size() int {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactAliasKeySend_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactAliasKeySend_Request.DiscardUnknown(m)
+func (m *MultiMemberGroupAdminRoleRevoke_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupAdminRoleRevoke_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactAliasKeySend_Request proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupAdminRoleRevoke_Request proto.InternalMessageInfo
 
-func (m *ContactAliasKeySend_Request) GetGroupPK() []byte {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) GetGroupPK() []byte {
 	if m != nil {
 		return m.GroupPK
 	}
 	return nil
 }
 
-type ContactAliasKeySend_Reply struct {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) GetMemberPK() []byte {
+	if m != nil {
+		return m.MemberPK
+	}
+	return nil
+}
+
+type MultiMemberGroupAdminRoleRevoke_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContactAliasKeySend_Reply) Reset()         { *m = ContactAliasKeySend_Reply{} }
-func (m *ContactAliasKeySend_Reply) String() string { return proto.CompactTextString(m) }
-func (*ContactAliasKeySend_Reply) ProtoMessage()    {}
-func (*ContactAliasKeySend_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{40, 1}
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) Reset()         { *m = MultiMemberGroupAdminRoleRevoke_Reply{} }
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupAdminRoleRevoke_Reply) ProtoMessage()    {}
+func (*MultiMemberGroupAdminRoleRevoke_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{21, 1}
 }
-func (m *ContactAliasKeySend_Reply) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContactAliasKeySend_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContactAliasKeySend_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupAdminRoleRevoke_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3617,36 +2950,36 @@ func (m *ContactAliasKeySend_Reply) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *ContactAliasKeySend_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContactAliasKeySend_Reply.Merge(m, src)
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupAdminRoleRevoke_Reply.Merge(m, src)
 }
-func (m *ContactAliasKeySend_Reply) XXX_Size() int {
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContactAliasKeySend_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContactAliasKeySend_Reply.DiscardUnknown(m)
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupAdminRoleRevoke_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContactAliasKeySend_Reply proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupAdminRoleRevoke_Reply proto.InternalMessageInfo
 
-type MultiMemberGroupCreate struct {
+type MultiMemberGroupMemberRemove struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupCreate) Reset()         { *m = MultiMemberGroupCreate{} }
-func (m *MultiMemberGroupCreate) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupCreate) ProtoMessage()    {}
-func (*MultiMemberGroupCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{41}
+func (m *MultiMemberGroupMemberRemove) Reset()         { *m = MultiMemberGroupMemberRemove{} }
+func (m *MultiMemberGroupMemberRemove) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupMemberRemove) ProtoMessage()    {}
+func (*MultiMemberGroupMemberRemove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{22}
 }
-func (m *MultiMemberGroupCreate) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupMemberRemove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupMemberRemove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupCreate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupMemberRemove.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3656,36 +2989,40 @@ func (m *MultiMemberGroupCreate) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupCreate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupCreate.Merge(m, src)
+func (m *MultiMemberGroupMemberRemove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupMemberRemove.Merge(m, src)
 }
-func (m *MultiMemberGroupCreate) XXX_Size() int {
+func (m *MultiMemberGroupMemberRemove) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupCreate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupCreate.DiscardUnknown(m)
+func (m *MultiMemberGroupMemberRemove) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupMemberRemove.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupCreate proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupMemberRemove proto.InternalMessageInfo
 
-type MultiMemberGroupCreate_Request struct {
+type MultiMemberGroupMemberRemove_Request struct {
+	// group_pk is the identifier of the group
+	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+	// member_pk is the identifier of the member to remove
+	MemberPK             []byte   `protobuf:"bytes,2,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupCreate_Request) Reset()         { *m = MultiMemberGroupCreate_Request{} }
-func (m *MultiMemberGroupCreate_Request) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupCreate_Request) ProtoMessage()    {}
-func (*MultiMemberGroupCreate_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{41, 0}
+func (m *MultiMemberGroupMemberRemove_Request) Reset()         { *m = MultiMemberGroupMemberRemove_Request{} }
+func (m *MultiMemberGroupMemberRemove_Request) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupMemberRemove_Request) ProtoMessage()    {}
+func (*MultiMemberGroupMemberRemove_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{22, 0}
 }
-func (m *MultiMemberGroupCreate_Request) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupMemberRemove_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupCreate_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupMemberRemove_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupCreate_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupMemberRemove_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3695,38 +3032,50 @@ func (m *MultiMemberGroupCreate_Request) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupCreate_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupCreate_Request.Merge(m, src)
+func (m *MultiMemberGroupMemberRemove_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupMemberRemove_Request.Merge(m, src)
 }
-func (m *MultiMemberGroupCreate_Request) XXX_Size() int {
+func (m *MultiMemberGroupMemberRemove_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupCreate_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupCreate_Request.DiscardUnknown(m)
+func (m *MultiMemberGroupMemberRemove_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupMemberRemove_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupCreate_Request proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupMemberRemove_Request proto.InternalMessageInfo
 
-type MultiMemberGroupCreate_Reply struct {
-	// group_pk is the identifier of the newly created group
-	GroupPK              []byte   `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+func (m *MultiMemberGroupMemberRemove_Request) GetGroupPK() []byte {
+	if m != nil {
+		return m.GroupPK
+	}
+	return nil
+}
+
+func (m *MultiMemberGroupMemberRemove_Request) GetMemberPK() []byte {
+	if m != nil {
+		return m.MemberPK
+	}
+	return nil
+}
+
+type MultiMemberGroupMemberRemove_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupCreate_Reply) Reset()         { *m = MultiMemberGroupCreate_Reply{} }
-func (m *MultiMemberGroupCreate_Reply) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupCreate_Reply) ProtoMessage()    {}
-func (*MultiMemberGroupCreate_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{41, 1}
+func (m *MultiMemberGroupMemberRemove_Reply) Reset()         { *m = MultiMemberGroupMemberRemove_Reply{} }
+func (m *MultiMemberGroupMemberRemove_Reply) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupMemberRemove_Reply) ProtoMessage()    {}
+func (*MultiMemberGroupMemberRemove_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{22, 1}
 }
-func (m *MultiMemberGroupCreate_Reply) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupMemberRemove_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupCreate_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupMemberRemove_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupCreate_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupMemberRemove_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3736,43 +3085,36 @@ func (m *MultiMemberGroupCreate_Reply) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupCreate_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupCreate_Reply.Merge(m, src)
+func (m *MultiMemberGroupMemberRemove_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupMemberRemove_Reply.Merge(m, src)
 }
-func (m *MultiMemberGroupCreate_Reply) XXX_Size() int {
+func (m *MultiMemberGroupMemberRemove_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupCreate_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupCreate_Reply.DiscardUnknown(m)
+func (m *MultiMemberGroupMemberRemove_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupMemberRemove_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupCreate_Reply proto.InternalMessageInfo
-
-func (m *MultiMemberGroupCreate_Reply) GetGroupPK() []byte {
-	if m != nil {
-		return m.GroupPK
-	}
-	return nil
-}
+var xxx_messageInfo_MultiMemberGroupMemberRemove_Reply proto.InternalMessageInfo
 
-type MultiMemberGroupJoin struct {
+type MultiMemberGroupInvitationCreate struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupJoin) Reset()         { *m = MultiMemberGroupJoin{} }
-func (m *MultiMemberGroupJoin) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupJoin) ProtoMessage()    {}
-func (*MultiMemberGroupJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{42}
+func (m *MultiMemberGroupInvitationCreate) Reset()         { *m = MultiMemberGroupInvitationCreate{} }
+func (m *MultiMemberGroupInvitationCreate) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupInvitationCreate) ProtoMessage()    {}
+func (*MultiMemberGroupInvitationCreate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{23}
 }
-func (m *MultiMemberGroupJoin) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupInvitationCreate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupInvitationCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupJoin.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupInvitationCreate.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3782,38 +3124,44 @@ func (m *MultiMemberGroupJoin) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupJoin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupJoin.Merge(m, src)
+func (m *MultiMemberGroupInvitationCreate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupInvitationCreate.Merge(m, src)
 }
-func (m *MultiMemberGroupJoin) XXX_Size() int {
+func (m *MultiMemberGroupInvitationCreate) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupJoin) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupJoin.DiscardUnknown(m)
+func (m *MultiMemberGroupInvitationCreate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupInvitationCreate.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupJoin proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupInvitationCreate proto.InternalMessageInfo
 
-type MultiMemberGroupJoin_Request struct {
-	// group is the information of the group to join
-	Group                *Group   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+type MultiMemberGroupInvitationCreate_Request struct {
+	// group_pk is the identifier of the group
+	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+	// expires_at is the unix timestamp after which the invitation can't be used, it never expires if not set
+	ExpiresAt int64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// max_uses is the maximum number of members who can join the group using the invitation, it is unlimited if not set
+	MaxUses              uint32   `protobuf:"varint,3,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupJoin_Request) Reset()         { *m = MultiMemberGroupJoin_Request{} }
-func (m *MultiMemberGroupJoin_Request) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupJoin_Request) ProtoMessage()    {}
-func (*MultiMemberGroupJoin_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{42, 0}
+func (m *MultiMemberGroupInvitationCreate_Request) Reset() {
+	*m = MultiMemberGroupInvitationCreate_Request{}
 }
-func (m *MultiMemberGroupJoin_Request) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupInvitationCreate_Request) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupInvitationCreate_Request) ProtoMessage()    {}
+func (*MultiMemberGroupInvitationCreate_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{23, 0}
+}
+func (m *MultiMemberGroupInvitationCreate_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupJoin_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupInvitationCreate_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupJoin_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupInvitationCreate_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3823,43 +3171,61 @@ func (m *MultiMemberGroupJoin_Request) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupJoin_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupJoin_Request.Merge(m, src)
+func (m *MultiMemberGroupInvitationCreate_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupInvitationCreate_Request.Merge(m, src)
 }
-func (m *MultiMemberGroupJoin_Request) XXX_Size() int {
+func (m *MultiMemberGroupInvitationCreate_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupJoin_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupJoin_Request.DiscardUnknown(m)
+func (m *MultiMemberGroupInvitationCreate_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupInvitationCreate_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupJoin_Request proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupInvitationCreate_Request proto.InternalMessageInfo
 
-func (m *MultiMemberGroupJoin_Request) GetGroup() *Group {
+func (m *MultiMemberGroupInvitationCreate_Request) GetGroupPK() []byte {
 	if m != nil {
-		return m.Group
+		return m.GroupPK
 	}
 	return nil
 }
 
-type MultiMemberGroupJoin_Reply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *MultiMemberGroupInvitationCreate_Request) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
 
-func (m *MultiMemberGroupJoin_Reply) Reset()         { *m = MultiMemberGroupJoin_Reply{} }
-func (m *MultiMemberGroupJoin_Reply) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupJoin_Reply) ProtoMessage()    {}
-func (*MultiMemberGroupJoin_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{42, 1}
+func (m *MultiMemberGroupInvitationCreate_Request) GetMaxUses() uint32 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
 }
-func (m *MultiMemberGroupJoin_Reply) XXX_Unmarshal(b []byte) error {
+
+type MultiMemberGroupInvitationCreate_Reply struct {
+	// invitation is the signed invitation to the group, it doesn't include the secret of the group
+	Invitation           *bertytypes.GroupInvitation `protobuf:"bytes,2,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *MultiMemberGroupInvitationCreate_Reply) Reset() {
+	*m = MultiMemberGroupInvitationCreate_Reply{}
+}
+func (m *MultiMemberGroupInvitationCreate_Reply) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupInvitationCreate_Reply) ProtoMessage()    {}
+func (*MultiMemberGroupInvitationCreate_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{23, 1}
+}
+func (m *MultiMemberGroupInvitationCreate_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupJoin_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupInvitationCreate_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupJoin_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupInvitationCreate_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3869,36 +3235,43 @@ func (m *MultiMemberGroupJoin_Reply) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupJoin_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupJoin_Reply.Merge(m, src)
+func (m *MultiMemberGroupInvitationCreate_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupInvitationCreate_Reply.Merge(m, src)
 }
-func (m *MultiMemberGroupJoin_Reply) XXX_Size() int {
+func (m *MultiMemberGroupInvitationCreate_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupJoin_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupJoin_Reply.DiscardUnknown(m)
+func (m *MultiMemberGroupInvitationCreate_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupInvitationCreate_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupJoin_Reply proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupInvitationCreate_Reply proto.InternalMessageInfo
 
-type MultiMemberGroupLeave struct {
+func (m *MultiMemberGroupInvitationCreate_Reply) GetInvitation() *bertytypes.GroupInvitation {
+	if m != nil {
+		return m.Invitation
+	}
+	return nil
+}
+
+type MultiMemberGroupInvitationRevoke struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupLeave) Reset()         { *m = MultiMemberGroupLeave{} }
-func (m *MultiMemberGroupLeave) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupLeave) ProtoMessage()    {}
-func (*MultiMemberGroupLeave) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{43}
+func (m *MultiMemberGroupInvitationRevoke) Reset()         { *m = MultiMemberGroupInvitationRevoke{} }
+func (m *MultiMemberGroupInvitationRevoke) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupInvitationRevoke) ProtoMessage()    {}
+func (*MultiMemberGroupInvitationRevoke) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{24}
 }
-func (m *MultiMemberGroupLeave) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupInvitationRevoke) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupLeave) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupInvitationRevoke) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupLeave.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupInvitationRevoke.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3908,37 +3281,42 @@ func (m *MultiMemberGroupLeave) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupLeave) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupLeave.Merge(m, src)
+func (m *MultiMemberGroupInvitationRevoke) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupInvitationRevoke.Merge(m, src)
 }
-func (m *MultiMemberGroupLeave) XXX_Size() int {
+func (m *MultiMemberGroupInvitationRevoke) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupLeave) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupLeave.DiscardUnknown(m)
+func (m *MultiMemberGroupInvitationRevoke) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupInvitationRevoke.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupLeave proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupInvitationRevoke proto.InternalMessageInfo
 
-type MultiMemberGroupLeave_Request struct {
-	GroupPK              []byte   `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+type MultiMemberGroupInvitationRevoke_Request struct {
+	// group_pk is the identifier of the group
+	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+	// invitation_id is the identifier of the invitation to revoke
+	InvitationID         []byte   `protobuf:"bytes,2,opt,name=invitation_id,json=invitationId,proto3" json:"invitation_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupLeave_Request) Reset()         { *m = MultiMemberGroupLeave_Request{} }
-func (m *MultiMemberGroupLeave_Request) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupLeave_Request) ProtoMessage()    {}
-func (*MultiMemberGroupLeave_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{43, 0}
+func (m *MultiMemberGroupInvitationRevoke_Request) Reset() {
+	*m = MultiMemberGroupInvitationRevoke_Request{}
 }
-func (m *MultiMemberGroupLeave_Request) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupInvitationRevoke_Request) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupInvitationRevoke_Request) ProtoMessage()    {}
+func (*MultiMemberGroupInvitationRevoke_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{24, 0}
+}
+func (m *MultiMemberGroupInvitationRevoke_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupLeave_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupInvitationRevoke_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupLeave_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupInvitationRevoke_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3948,43 +3326,52 @@ func (m *MultiMemberGroupLeave_Request) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupLeave_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupLeave_Request.Merge(m, src)
+func (m *MultiMemberGroupInvitationRevoke_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupInvitationRevoke_Request.Merge(m, src)
 }
-func (m *MultiMemberGroupLeave_Request) XXX_Size() int {
+func (m *MultiMemberGroupInvitationRevoke_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupLeave_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupLeave_Request.DiscardUnknown(m)
+func (m *MultiMemberGroupInvitationRevoke_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupInvitationRevoke_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupLeave_Request proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupInvitationRevoke_Request proto.InternalMessageInfo
 
-func (m *MultiMemberGroupLeave_Request) GetGroupPK() []byte {
+func (m *MultiMemberGroupInvitationRevoke_Request) GetGroupPK() []byte {
 	if m != nil {
 		return m.GroupPK
 	}
 	return nil
 }
 
-type MultiMemberGroupLeave_Reply struct {
+func (m *MultiMemberGroupInvitationRevoke_Request) GetInvitationID() []byte {
+	if m != nil {
+		return m.InvitationID
+	}
+	return nil
+}
+
+type MultiMemberGroupInvitationRevoke_Reply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupLeave_Reply) Reset()         { *m = MultiMemberGroupLeave_Reply{} }
-func (m *MultiMemberGroupLeave_Reply) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupLeave_Reply) ProtoMessage()    {}
-func (*MultiMemberGroupLeave_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{43, 1}
+func (m *MultiMemberGroupInvitationRevoke_Reply) Reset() {
+	*m = MultiMemberGroupInvitationRevoke_Reply{}
 }
-func (m *MultiMemberGroupLeave_Reply) XXX_Unmarshal(b []byte) error {
+func (m *MultiMemberGroupInvitationRevoke_Reply) String() string { return proto.CompactTextString(m) }
+func (*MultiMemberGroupInvitationRevoke_Reply) ProtoMessage()    {}
+func (*MultiMemberGroupInvitationRevoke_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{24, 1}
+}
+func (m *MultiMemberGroupInvitationRevoke_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupLeave_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MultiMemberGroupInvitationRevoke_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupLeave_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MultiMemberGroupInvitationRevoke_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3994,36 +3381,36 @@ func (m *MultiMemberGroupLeave_Reply) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupLeave_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupLeave_Reply.Merge(m, src)
+func (m *MultiMemberGroupInvitationRevoke_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiMemberGroupInvitationRevoke_Reply.Merge(m, src)
 }
-func (m *MultiMemberGroupLeave_Reply) XXX_Size() int {
+func (m *MultiMemberGroupInvitationRevoke_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupLeave_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupLeave_Reply.DiscardUnknown(m)
+func (m *MultiMemberGroupInvitationRevoke_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiMemberGroupInvitationRevoke_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupLeave_Reply proto.InternalMessageInfo
+var xxx_messageInfo_MultiMemberGroupInvitationRevoke_Reply proto.InternalMessageInfo
 
-type MultiMemberGroupAliasResolverDisclose struct {
+type AppMetadataSend struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose) Reset()         { *m = MultiMemberGroupAliasResolverDisclose{} }
-func (m *MultiMemberGroupAliasResolverDisclose) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupAliasResolverDisclose) ProtoMessage()    {}
-func (*MultiMemberGroupAliasResolverDisclose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{44}
+func (m *AppMetadataSend) Reset()         { *m = AppMetadataSend{} }
+func (m *AppMetadataSend) String() string { return proto.CompactTextString(m) }
+func (*AppMetadataSend) ProtoMessage()    {}
+func (*AppMetadataSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{25}
 }
-func (m *MultiMemberGroupAliasResolverDisclose) XXX_Unmarshal(b []byte) error {
+func (m *AppMetadataSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupAliasResolverDisclose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AppMetadataSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupAliasResolverDisclose.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AppMetadataSend.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4033,41 +3420,40 @@ func (m *MultiMemberGroupAliasResolverDisclose) XXX_Marshal(b []byte, determinis
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupAliasResolverDisclose) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose.Merge(m, src)
+func (m *AppMetadataSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppMetadataSend.Merge(m, src)
 }
-func (m *MultiMemberGroupAliasResolverDisclose) XXX_Size() int {
+func (m *AppMetadataSend) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupAliasResolverDisclose) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose.DiscardUnknown(m)
+func (m *AppMetadataSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppMetadataSend.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupAliasResolverDisclose proto.InternalMessageInfo
+var xxx_messageInfo_AppMetadataSend proto.InternalMessageInfo
 
-type MultiMemberGroupAliasResolverDisclose_Request struct {
-	GroupPK              []byte   `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+type AppMetadataSend_Request struct {
+	// group_pk is the identifier of the group
+	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+	// payload is the payload to send
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose_Request) Reset() {
-	*m = MultiMemberGroupAliasResolverDisclose_Request{}
-}
-func (m *MultiMemberGroupAliasResolverDisclose_Request) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MultiMemberGroupAliasResolverDisclose_Request) ProtoMessage() {}
-func (*MultiMemberGroupAliasResolverDisclose_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{44, 0}
+func (m *AppMetadataSend_Request) Reset()         { *m = AppMetadataSend_Request{} }
+func (m *AppMetadataSend_Request) String() string { return proto.CompactTextString(m) }
+func (*AppMetadataSend_Request) ProtoMessage()    {}
+func (*AppMetadataSend_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{25, 0}
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_Unmarshal(b []byte) error {
+func (m *AppMetadataSend_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AppMetadataSend_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AppMetadataSend_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4077,47 +3463,52 @@ func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_Marshal(b []byte, de
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Request.Merge(m, src)
+func (m *AppMetadataSend_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppMetadataSend_Request.Merge(m, src)
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_Size() int {
+func (m *AppMetadataSend_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Request.DiscardUnknown(m)
+func (m *AppMetadataSend_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppMetadataSend_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Request proto.InternalMessageInfo
+var xxx_messageInfo_AppMetadataSend_Request proto.InternalMessageInfo
 
-func (m *MultiMemberGroupAliasResolverDisclose_Request) GetGroupPK() []byte {
+func (m *AppMetadataSend_Request) GetGroupPK() []byte {
 	if m != nil {
 		return m.GroupPK
 	}
 	return nil
 }
 
-type MultiMemberGroupAliasResolverDisclose_Reply struct {
+func (m *AppMetadataSend_Request) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type AppMetadataSend_Reply struct {
+	// cid is the CID of the created OrbitDB entry, it matches the event_context id of the subscription event
+	CID                  []byte   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) Reset() {
-	*m = MultiMemberGroupAliasResolverDisclose_Reply{}
-}
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) String() string {
-	return proto.CompactTextString(m)
-}
-func (*MultiMemberGroupAliasResolverDisclose_Reply) ProtoMessage() {}
-func (*MultiMemberGroupAliasResolverDisclose_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{44, 1}
+func (m *AppMetadataSend_Reply) Reset()         { *m = AppMetadataSend_Reply{} }
+func (m *AppMetadataSend_Reply) String() string { return proto.CompactTextString(m) }
+func (*AppMetadataSend_Reply) ProtoMessage()    {}
+func (*AppMetadataSend_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{25, 1}
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_Unmarshal(b []byte) error {
+func (m *AppMetadataSend_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AppMetadataSend_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AppMetadataSend_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4127,36 +3518,43 @@ func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_Marshal(b []byte, dete
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Reply.Merge(m, src)
+func (m *AppMetadataSend_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppMetadataSend_Reply.Merge(m, src)
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_Size() int {
+func (m *AppMetadataSend_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Reply.DiscardUnknown(m)
+func (m *AppMetadataSend_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppMetadataSend_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupAliasResolverDisclose_Reply proto.InternalMessageInfo
+var xxx_messageInfo_AppMetadataSend_Reply proto.InternalMessageInfo
 
-type MultiMemberGroupAdminRoleGrant struct {
+func (m *AppMetadataSend_Reply) GetCID() []byte {
+	if m != nil {
+		return m.CID
+	}
+	return nil
+}
+
+type AppMessageSend struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupAdminRoleGrant) Reset()         { *m = MultiMemberGroupAdminRoleGrant{} }
-func (m *MultiMemberGroupAdminRoleGrant) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupAdminRoleGrant) ProtoMessage()    {}
-func (*MultiMemberGroupAdminRoleGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{45}
+func (m *AppMessageSend) Reset()         { *m = AppMessageSend{} }
+func (m *AppMessageSend) String() string { return proto.CompactTextString(m) }
+func (*AppMessageSend) ProtoMessage()    {}
+func (*AppMessageSend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{26}
 }
-func (m *MultiMemberGroupAdminRoleGrant) XXX_Unmarshal(b []byte) error {
+func (m *AppMessageSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupAdminRoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AppMessageSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupAdminRoleGrant.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AppMessageSend.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4166,42 +3564,40 @@ func (m *MultiMemberGroupAdminRoleGrant) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupAdminRoleGrant) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupAdminRoleGrant.Merge(m, src)
+func (m *AppMessageSend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppMessageSend.Merge(m, src)
 }
-func (m *MultiMemberGroupAdminRoleGrant) XXX_Size() int {
+func (m *AppMessageSend) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupAdminRoleGrant) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupAdminRoleGrant.DiscardUnknown(m)
+func (m *AppMessageSend) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppMessageSend.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupAdminRoleGrant proto.InternalMessageInfo
+var xxx_messageInfo_AppMessageSend proto.InternalMessageInfo
 
-type MultiMemberGroupAdminRoleGrant_Request struct {
+type AppMessageSend_Request struct {
 	// group_pk is the identifier of the group
 	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
-	// member_pk is the identifier of the member which will be granted the admin role
-	MemberPK             []byte   `protobuf:"bytes,2,opt,name=member_pk,json=memberPk,proto3" json:"member_pk,omitempty"`
+	// payload is the payload to send
+	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Request) Reset() {
-	*m = MultiMemberGroupAdminRoleGrant_Request{}
-}
-func (m *MultiMemberGroupAdminRoleGrant_Request) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupAdminRoleGrant_Request) ProtoMessage()    {}
-func (*MultiMemberGroupAdminRoleGrant_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{45, 0}
+func (m *AppMessageSend_Request) Reset()         { *m = AppMessageSend_Request{} }
+func (m *AppMessageSend_Request) String() string { return proto.CompactTextString(m) }
+func (*AppMessageSend_Request) ProtoMessage()    {}
+func (*AppMessageSend_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{26, 0}
 }
-func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_Unmarshal(b []byte) error {
+func (m *AppMessageSend_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AppMessageSend_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AppMessageSend_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4211,50 +3607,52 @@ func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_Marshal(b []byte, determini
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Request.Merge(m, src)
+func (m *AppMessageSend_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppMessageSend_Request.Merge(m, src)
 }
-func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_Size() int {
+func (m *AppMessageSend_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupAdminRoleGrant_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Request.DiscardUnknown(m)
+func (m *AppMessageSend_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppMessageSend_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Request proto.InternalMessageInfo
+var xxx_messageInfo_AppMessageSend_Request proto.InternalMessageInfo
 
-func (m *MultiMemberGroupAdminRoleGrant_Request) GetGroupPK() []byte {
+func (m *AppMessageSend_Request) GetGroupPK() []byte {
 	if m != nil {
 		return m.GroupPK
 	}
 	return nil
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Request) GetMemberPK() []byte {
+func (m *AppMessageSend_Request) GetPayload() []byte {
 	if m != nil {
-		return m.MemberPK
+		return m.Payload
 	}
 	return nil
 }
 
-type MultiMemberGroupAdminRoleGrant_Reply struct {
+type AppMessageSend_Reply struct {
+	// cid is the CID of the created OrbitDB entry, it matches the event_context id of the subscription event
+	CID                  []byte   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Reply) Reset()         { *m = MultiMemberGroupAdminRoleGrant_Reply{} }
-func (m *MultiMemberGroupAdminRoleGrant_Reply) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupAdminRoleGrant_Reply) ProtoMessage()    {}
-func (*MultiMemberGroupAdminRoleGrant_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{45, 1}
+func (m *AppMessageSend_Reply) Reset()         { *m = AppMessageSend_Reply{} }
+func (m *AppMessageSend_Reply) String() string { return proto.CompactTextString(m) }
+func (*AppMessageSend_Reply) ProtoMessage()    {}
+func (*AppMessageSend_Reply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{26, 1}
 }
-func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_Unmarshal(b []byte) error {
+func (m *AppMessageSend_Reply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AppMessageSend_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Reply.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AppMessageSend_Reply.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4264,36 +3662,43 @@ func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Reply.Merge(m, src)
+func (m *AppMessageSend_Reply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppMessageSend_Reply.Merge(m, src)
 }
-func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_Size() int {
+func (m *AppMessageSend_Reply) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupAdminRoleGrant_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Reply.DiscardUnknown(m)
+func (m *AppMessageSend_Reply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppMessageSend_Reply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupAdminRoleGrant_Reply proto.InternalMessageInfo
+var xxx_messageInfo_AppMessageSend_Reply proto.InternalMessageInfo
 
-type MultiMemberGroupInvitationCreate struct {
+func (m *AppMessageSend_Reply) GetCID() []byte {
+	if m != nil {
+		return m.CID
+	}
+	return nil
+}
+
+type GroupMetadataSubscribe struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MultiMemberGroupInvitationCreate) Reset()         { *m = MultiMemberGroupInvitationCreate{} }
-func (m *MultiMemberGroupInvitationCreate) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupInvitationCreate) ProtoMessage()    {}
-func (*MultiMemberGroupInvitationCreate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{46}
+func (m *GroupMetadataSubscribe) Reset()         { *m = GroupMetadataSubscribe{} }
+func (m *GroupMetadataSubscribe) String() string { return proto.CompactTextString(m) }
+func (*GroupMetadataSubscribe) ProtoMessage()    {}
+func (*GroupMetadataSubscribe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{27}
 }
-func (m *MultiMemberGroupInvitationCreate) XXX_Unmarshal(b []byte) error {
+func (m *GroupMetadataSubscribe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupInvitationCreate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupMetadataSubscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupInvitationCreate.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GroupMetadataSubscribe.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4303,40 +3708,46 @@ func (m *MultiMemberGroupInvitationCreate) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupInvitationCreate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupInvitationCreate.Merge(m, src)
+func (m *GroupMetadataSubscribe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMetadataSubscribe.Merge(m, src)
 }
-func (m *MultiMemberGroupInvitationCreate) XXX_Size() int {
+func (m *GroupMetadataSubscribe) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupInvitationCreate) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupInvitationCreate.DiscardUnknown(m)
+func (m *GroupMetadataSubscribe) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMetadataSubscribe.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupInvitationCreate proto.InternalMessageInfo
+var xxx_messageInfo_GroupMetadataSubscribe proto.InternalMessageInfo
 
-type MultiMemberGroupInvitationCreate_Request struct {
+type GroupMetadataSubscribe_Request struct {
 	// group_pk is the identifier of the group
-	GroupPK              []byte   `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
+	// since is the lower ID bound used to filter events, the event matching this ID is excluded
+	Since []byte `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	// until is the upper ID bound used to filter events, the event matching this ID is included, live events are not returned when it is set
+	Until []byte `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	// go_backwards indicates whether the events should be returned in reverse order
+	GoBackwards bool `protobuf:"varint,4,opt,name=go_backwards,json=goBackwards,proto3" json:"go_backwards,omitempty"`
+	// event_types is used to filter events by type, all events are returned when empty
+	EventTypes           []bertytypes.EventType `protobuf:"varint,5,rep,packed,name=event_types,json=eventTypes,proto3,enum=berty.protocol.EventType" json:"event_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *MultiMemberGroupInvitationCreate_Request) Reset() {
-	*m = MultiMemberGroupInvitationCreate_Request{}
-}
-func (m *MultiMemberGroupInvitationCreate_Request) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupInvitationCreate_Request) ProtoMessage()    {}
-func (*MultiMemberGroupInvitationCreate_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{46, 0}
+func (m *GroupMetadataSubscribe_Request) Reset()         { *m = GroupMetadataSubscribe_Request{} }
+func (m *GroupMetadataSubscribe_Request) String() string { return proto.CompactTextString(m) }
+func (*GroupMetadataSubscribe_Request) ProtoMessage()    {}
+func (*GroupMetadataSubscribe_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{27, 0}
 }
-func (m *MultiMemberGroupInvitationCreate_Request) XXX_Unmarshal(b []byte) error {
+func (m *GroupMetadataSubscribe_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiMemberGroupInvitationCreate_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupMetadataSubscribe_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupInvitationCreate_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GroupMetadataSubscribe_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4346,93 +3757,71 @@ func (m *MultiMemberGroupInvitationCreate_Request) XXX_Marshal(b []byte, determi
 		return b[:n], nil
 	}
 }
-func (m *MultiMemberGroupInvitationCreate_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupInvitationCreate_Request.Merge(m, src)
+func (m *GroupMetadataSubscribe_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMetadataSubscribe_Request.Merge(m, src)
 }
-func (m *MultiMemberGroupInvitationCreate_Request) XXX_Size() int {
+func (m *GroupMetadataSubscribe_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiMemberGroupInvitationCreate_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupInvitationCreate_Request.DiscardUnknown(m)
+func (m *GroupMetadataSubscribe_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMetadataSubscribe_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiMemberGroupInvitationCreate_Request proto.InternalMessageInfo
+var xxx_messageInfo_GroupMetadataSubscribe_Request proto.InternalMessageInfo
 
-func (m *MultiMemberGroupInvitationCreate_Request) GetGroupPK() []byte {
+func (m *GroupMetadataSubscribe_Request) GetGroupPK() []byte {
 	if m != nil {
 		return m.GroupPK
 	}
 	return nil
 }
 
-type MultiMemberGroupInvitationCreate_Reply struct {
-	// group is the invitation to the group
-	Group                *Group   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GroupMetadataSubscribe_Request) GetSince() []byte {
+	if m != nil {
+		return m.Since
+	}
+	return nil
 }
 
-func (m *MultiMemberGroupInvitationCreate_Reply) Reset() {
-	*m = MultiMemberGroupInvitationCreate_Reply{}
+func (m *GroupMetadataSubscribe_Request) GetUntil() []byte {
+	if m != nil {
+		return m.Until
+	}
+	return nil
 }
-func (m *MultiMemberGroupInvitationCreate_Reply) String() string { return proto.CompactTextString(m) }
-func (*MultiMemberGroupInvitationCreate_Reply) ProtoMessage()    {}
-func (*MultiMemberGroupInvitationCreate_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{46, 1}
+
+func (m *GroupMetadataSubscribe_Request) GetGoBackwards() bool {
+	if m != nil {
+		return m.GoBackwards
+	}
+	return false
 }
-func (m *MultiMemberGroupInvitationCreate_Reply) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MultiMemberGroupInvitationCreate_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MultiMemberGroupInvitationCreate_Reply.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *MultiMemberGroupInvitationCreate_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiMemberGroupInvitationCreate_Reply.Merge(m, src)
-}
-func (m *MultiMemberGroupInvitationCreate_Reply) XXX_Size() int {
-	return m.Size()
-}
-func (m *MultiMemberGroupInvitationCreate_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiMemberGroupInvitationCreate_Reply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MultiMemberGroupInvitationCreate_Reply proto.InternalMessageInfo
-
-func (m *MultiMemberGroupInvitationCreate_Reply) GetGroup() *Group {
-	if m != nil {
-		return m.Group
-	}
-	return nil
+
+func (m *GroupMetadataSubscribe_Request) GetEventTypes() []bertytypes.EventType {
+	if m != nil {
+		return m.EventTypes
+	}
+	return nil
 }
 
-type AppMetadataSend struct {
+type GroupMessageSubscribe struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AppMetadataSend) Reset()         { *m = AppMetadataSend{} }
-func (m *AppMetadataSend) String() string { return proto.CompactTextString(m) }
-func (*AppMetadataSend) ProtoMessage()    {}
-func (*AppMetadataSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{47}
+func (m *GroupMessageSubscribe) Reset()         { *m = GroupMessageSubscribe{} }
+func (m *GroupMessageSubscribe) String() string { return proto.CompactTextString(m) }
+func (*GroupMessageSubscribe) ProtoMessage()    {}
+func (*GroupMessageSubscribe) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{28}
 }
-func (m *AppMetadataSend) XXX_Unmarshal(b []byte) error {
+func (m *GroupMessageSubscribe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AppMetadataSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupMessageSubscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AppMetadataSend.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GroupMessageSubscribe.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4442,40 +3831,48 @@ func (m *AppMetadataSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *AppMetadataSend) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppMetadataSend.Merge(m, src)
+func (m *GroupMessageSubscribe) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMessageSubscribe.Merge(m, src)
 }
-func (m *AppMetadataSend) XXX_Size() int {
+func (m *GroupMessageSubscribe) XXX_Size() int {
 	return m.Size()
 }
-func (m *AppMetadataSend) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppMetadataSend.DiscardUnknown(m)
+func (m *GroupMessageSubscribe) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMessageSubscribe.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AppMetadataSend proto.InternalMessageInfo
+var xxx_messageInfo_GroupMessageSubscribe proto.InternalMessageInfo
 
-type AppMetadataSend_Request struct {
+type GroupMessageSubscribe_Request struct {
 	// group_pk is the identifier of the group
 	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
-	// payload is the payload to send
-	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// since is the lower ID bound used to filter events, the event matching this ID is excluded
+	Since []byte `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	// until is the upper ID bound used to filter events, the event matching this ID is included, live events are not returned when it is set
+	Until []byte `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	// go_backwards indicates whether the events should be returned in reverse order
+	GoBackwards bool `protobuf:"varint,4,opt,name=go_backwards,json=goBackwards,proto3" json:"go_backwards,omitempty"`
+	// limit is the maximum number of events returned from the history, the oldest ones are kept when going forward from since and the most recent ones otherwise, 0 means no limit
+	Limit uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	// live_only indicates whether the history should be skipped, only new events are returned
+	LiveOnly             bool     `protobuf:"varint,6,opt,name=live_only,json=liveOnly,proto3" json:"live_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AppMetadataSend_Request) Reset()         { *m = AppMetadataSend_Request{} }
-func (m *AppMetadataSend_Request) String() string { return proto.CompactTextString(m) }
-func (*AppMetadataSend_Request) ProtoMessage()    {}
-func (*AppMetadataSend_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{47, 0}
+func (m *GroupMessageSubscribe_Request) Reset()         { *m = GroupMessageSubscribe_Request{} }
+func (m *GroupMessageSubscribe_Request) String() string { return proto.CompactTextString(m) }
+func (*GroupMessageSubscribe_Request) ProtoMessage()    {}
+func (*GroupMessageSubscribe_Request) Descriptor() ([]byte, []int) {
+	return fileDescriptor_047e04c733cf8554, []int{28, 0}
 }
-func (m *AppMetadataSend_Request) XXX_Unmarshal(b []byte) error {
+func (m *GroupMessageSubscribe_Request) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AppMetadataSend_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GroupMessageSubscribe_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AppMetadataSend_Request.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GroupMessageSubscribe_Request.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -4485,1274 +3882,787 @@ func (m *AppMetadataSend_Request) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *AppMetadataSend_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppMetadataSend_Request.Merge(m, src)
+func (m *GroupMessageSubscribe_Request) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GroupMessageSubscribe_Request.Merge(m, src)
 }
-func (m *AppMetadataSend_Request) XXX_Size() int {
+func (m *GroupMessageSubscribe_Request) XXX_Size() int {
 	return m.Size()
 }
-func (m *AppMetadataSend_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppMetadataSend_Request.DiscardUnknown(m)
+func (m *GroupMessageSubscribe_Request) XXX_DiscardUnknown() {
+	xxx_messageInfo_GroupMessageSubscribe_Request.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AppMetadataSend_Request proto.InternalMessageInfo
+var xxx_messageInfo_GroupMessageSubscribe_Request proto.InternalMessageInfo
 
-func (m *AppMetadataSend_Request) GetGroupPK() []byte {
+func (m *GroupMessageSubscribe_Request) GetGroupPK() []byte {
 	if m != nil {
 		return m.GroupPK
 	}
 	return nil
 }
 
-func (m *AppMetadataSend_Request) GetPayload() []byte {
+func (m *GroupMessageSubscribe_Request) GetSince() []byte {
 	if m != nil {
-		return m.Payload
+		return m.Since
 	}
 	return nil
 }
 
-type AppMetadataSend_Reply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *AppMetadataSend_Reply) Reset()         { *m = AppMetadataSend_Reply{} }
-func (m *AppMetadataSend_Reply) String() string { return proto.CompactTextString(m) }
-func (*AppMetadataSend_Reply) ProtoMessage()    {}
-func (*AppMetadataSend_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{47, 1}
-}
-func (m *AppMetadataSend_Reply) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AppMetadataSend_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AppMetadataSend_Reply.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *AppMetadataSend_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppMetadataSend_Reply.Merge(m, src)
-}
-func (m *AppMetadataSend_Reply) XXX_Size() int {
-	return m.Size()
-}
-func (m *AppMetadataSend_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppMetadataSend_Reply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AppMetadataSend_Reply proto.InternalMessageInfo
-
-type AppMessageSend struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *AppMessageSend) Reset()         { *m = AppMessageSend{} }
-func (m *AppMessageSend) String() string { return proto.CompactTextString(m) }
-func (*AppMessageSend) ProtoMessage()    {}
-func (*AppMessageSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{48}
-}
-func (m *AppMessageSend) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AppMessageSend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AppMessageSend.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *GroupMessageSubscribe_Request) GetUntil() []byte {
+	if m != nil {
+		return m.Until
 	}
-}
-func (m *AppMessageSend) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppMessageSend.Merge(m, src)
-}
-func (m *AppMessageSend) XXX_Size() int {
-	return m.Size()
-}
-func (m *AppMessageSend) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppMessageSend.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AppMessageSend proto.InternalMessageInfo
-
-type AppMessageSend_Request struct {
-	// group_pk is the identifier of the group
-	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
-	// payload is the payload to send
-	Payload              []byte   `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return nil
 }
 
-func (m *AppMessageSend_Request) Reset()         { *m = AppMessageSend_Request{} }
-func (m *AppMessageSend_Request) String() string { return proto.CompactTextString(m) }
-func (*AppMessageSend_Request) ProtoMessage()    {}
-func (*AppMessageSend_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{48, 0}
-}
-func (m *AppMessageSend_Request) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AppMessageSend_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AppMessageSend_Request.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *GroupMessageSubscribe_Request) GetGoBackwards() bool {
+	if m != nil {
+		return m.GoBackwards
 	}
+	return false
 }
-func (m *AppMessageSend_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppMessageSend_Request.Merge(m, src)
-}
-func (m *AppMessageSend_Request) XXX_Size() int {
-	return m.Size()
-}
-func (m *AppMessageSend_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppMessageSend_Request.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_AppMessageSend_Request proto.InternalMessageInfo
 
-func (m *AppMessageSend_Request) GetGroupPK() []byte {
+func (m *GroupMessageSubscribe_Request) GetLimit() uint32 {
 	if m != nil {
-		return m.GroupPK
+		return m.Limit
 	}
-	return nil
+	return 0
 }
 
-func (m *AppMessageSend_Request) GetPayload() []byte {
+func (m *GroupMessageSubscribe_Request) GetLiveOnly() bool {
 	if m != nil {
-		return m.Payload
+		return m.LiveOnly
 	}
-	return nil
-}
-
-type AppMessageSend_Reply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return false
 }
 
-func (m *AppMessageSend_Reply) Reset()         { *m = AppMessageSend_Reply{} }
-func (m *AppMessageSend_Reply) String() string { return proto.CompactTextString(m) }
-func (*AppMessageSend_Reply) ProtoMessage()    {}
-func (*AppMessageSend_Reply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{48, 1}
-}
-func (m *AppMessageSend_Reply) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *AppMessageSend_Reply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AppMessageSend_Reply.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *AppMessageSend_Reply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AppMessageSend_Reply.Merge(m, src)
-}
-func (m *AppMessageSend_Reply) XXX_Size() int {
-	return m.Size()
-}
-func (m *AppMessageSend_Reply) XXX_DiscardUnknown() {
-	xxx_messageInfo_AppMessageSend_Reply.DiscardUnknown(m)
+func init() {
+	proto.RegisterEnum("berty.protocol.InstanceGetConfiguration_SettingState", InstanceGetConfiguration_SettingState_name, InstanceGetConfiguration_SettingState_value)
+	proto.RegisterType((*InstanceExportData)(nil), "berty.protocol.InstanceExportData")
+	proto.RegisterType((*InstanceExportData_Request)(nil), "berty.protocol.InstanceExportData.Request")
+	proto.RegisterType((*InstanceExportData_Reply)(nil), "berty.protocol.InstanceExportData.Reply")
+	proto.RegisterType((*InstanceImportData)(nil), "berty.protocol.InstanceImportData")
+	proto.RegisterType((*InstanceImportData_Request)(nil), "berty.protocol.InstanceImportData.Request")
+	proto.RegisterType((*InstanceImportData_Reply)(nil), "berty.protocol.InstanceImportData.Reply")
+	proto.RegisterType((*InstanceGetConfiguration)(nil), "berty.protocol.InstanceGetConfiguration")
+	proto.RegisterType((*InstanceGetConfiguration_Request)(nil), "berty.protocol.InstanceGetConfiguration.Request")
+	proto.RegisterType((*InstanceGetConfiguration_Reply)(nil), "berty.protocol.InstanceGetConfiguration.Reply")
+	proto.RegisterType((*DeviceLinkReference)(nil), "berty.protocol.DeviceLinkReference")
+	proto.RegisterType((*DeviceLinkReference_Request)(nil), "berty.protocol.DeviceLinkReference.Request")
+	proto.RegisterType((*DeviceLinkReference_Reply)(nil), "berty.protocol.DeviceLinkReference.Reply")
+	proto.RegisterType((*DeviceLinkJoin)(nil), "berty.protocol.DeviceLinkJoin")
+	proto.RegisterType((*DeviceLinkJoin_Request)(nil), "berty.protocol.DeviceLinkJoin.Request")
+	proto.RegisterType((*DeviceLinkJoin_Reply)(nil), "berty.protocol.DeviceLinkJoin.Reply")
+	proto.RegisterType((*DeviceRevoke)(nil), "berty.protocol.DeviceRevoke")
+	proto.RegisterType((*DeviceRevoke_Request)(nil), "berty.protocol.DeviceRevoke.Request")
+	proto.RegisterType((*DeviceRevoke_Reply)(nil), "berty.protocol.DeviceRevoke.Reply")
+	proto.RegisterType((*ContactRequestReference)(nil), "berty.protocol.ContactRequestReference")
+	proto.RegisterType((*ContactRequestReference_Request)(nil), "berty.protocol.ContactRequestReference.Request")
+	proto.RegisterType((*ContactRequestReference_Reply)(nil), "berty.protocol.ContactRequestReference.Reply")
+	proto.RegisterType((*ContactRequestDisable)(nil), "berty.protocol.ContactRequestDisable")
+	proto.RegisterType((*ContactRequestDisable_Request)(nil), "berty.protocol.ContactRequestDisable.Request")
+	proto.RegisterType((*ContactRequestDisable_Reply)(nil), "berty.protocol.ContactRequestDisable.Reply")
+	proto.RegisterType((*ContactRequestEnable)(nil), "berty.protocol.ContactRequestEnable")
+	proto.RegisterType((*ContactRequestEnable_Request)(nil), "berty.protocol.ContactRequestEnable.Request")
+	proto.RegisterType((*ContactRequestEnable_Reply)(nil), "berty.protocol.ContactRequestEnable.Reply")
+	proto.RegisterType((*ContactRequestResetReference)(nil), "berty.protocol.ContactRequestResetReference")
+	proto.RegisterType((*ContactRequestResetReference_Request)(nil), "berty.protocol.ContactRequestResetReference.Request")
+	proto.RegisterType((*ContactRequestResetReference_Reply)(nil), "berty.protocol.ContactRequestResetReference.Reply")
+	proto.RegisterType((*ContactRequestSend)(nil), "berty.protocol.ContactRequestSend")
+	proto.RegisterType((*ContactRequestSend_Request)(nil), "berty.protocol.ContactRequestSend.Request")
+	proto.RegisterType((*ContactRequestSend_Reply)(nil), "berty.protocol.ContactRequestSend.Reply")
+	proto.RegisterType((*ContactRequestAccept)(nil), "berty.protocol.ContactRequestAccept")
+	proto.RegisterType((*ContactRequestAccept_Request)(nil), "berty.protocol.ContactRequestAccept.Request")
+	proto.RegisterType((*ContactRequestAccept_Reply)(nil), "berty.protocol.ContactRequestAccept.Reply")
+	proto.RegisterType((*ContactRequestDiscard)(nil), "berty.protocol.ContactRequestDiscard")
+	proto.RegisterType((*ContactRequestDiscard_Request)(nil), "berty.protocol.ContactRequestDiscard.Request")
+	proto.RegisterType((*ContactRequestDiscard_Reply)(nil), "berty.protocol.ContactRequestDiscard.Reply")
+	proto.RegisterType((*ContactBlock)(nil), "berty.protocol.ContactBlock")
+	proto.RegisterType((*ContactBlock_Request)(nil), "berty.protocol.ContactBlock.Request")
+	proto.RegisterType((*ContactBlock_Reply)(nil), "berty.protocol.ContactBlock.Reply")
+	proto.RegisterType((*ContactUnblock)(nil), "berty.protocol.ContactUnblock")
+	proto.RegisterType((*ContactUnblock_Request)(nil), "berty.protocol.ContactUnblock.Request")
+	proto.RegisterType((*ContactUnblock_Reply)(nil), "berty.protocol.ContactUnblock.Reply")
+	proto.RegisterType((*ContactAliasKeySend)(nil), "berty.protocol.ContactAliasKeySend")
+	proto.RegisterType((*ContactAliasKeySend_Request)(nil), "berty.protocol.ContactAliasKeySend.Request")
+	proto.RegisterType((*ContactAliasKeySend_Reply)(nil), "berty.protocol.ContactAliasKeySend.Reply")
+	proto.RegisterType((*MultiMemberGroupCreate)(nil), "berty.protocol.MultiMemberGroupCreate")
+	proto.RegisterType((*MultiMemberGroupCreate_Request)(nil), "berty.protocol.MultiMemberGroupCreate.Request")
+	proto.RegisterType((*MultiMemberGroupCreate_Reply)(nil), "berty.protocol.MultiMemberGroupCreate.Reply")
+	proto.RegisterType((*MultiMemberGroupJoin)(nil), "berty.protocol.MultiMemberGroupJoin")
+	proto.RegisterType((*MultiMemberGroupJoin_Request)(nil), "berty.protocol.MultiMemberGroupJoin.Request")
+	proto.RegisterType((*MultiMemberGroupJoin_Reply)(nil), "berty.protocol.MultiMemberGroupJoin.Reply")
+	proto.RegisterType((*MultiMemberGroupLeave)(nil), "berty.protocol.MultiMemberGroupLeave")
+	proto.RegisterType((*MultiMemberGroupLeave_Request)(nil), "berty.protocol.MultiMemberGroupLeave.Request")
+	proto.RegisterType((*MultiMemberGroupLeave_Reply)(nil), "berty.protocol.MultiMemberGroupLeave.Reply")
+	proto.RegisterType((*MultiMemberGroupAliasResolverDisclose)(nil), "berty.protocol.MultiMemberGroupAliasResolverDisclose")
+	proto.RegisterType((*MultiMemberGroupAliasResolverDisclose_Request)(nil), "berty.protocol.MultiMemberGroupAliasResolverDisclose.Request")
+	proto.RegisterType((*MultiMemberGroupAliasResolverDisclose_Reply)(nil), "berty.protocol.MultiMemberGroupAliasResolverDisclose.Reply")
+	proto.RegisterType((*MultiMemberGroupAdminRoleGrant)(nil), "berty.protocol.MultiMemberGroupAdminRoleGrant")
+	proto.RegisterType((*MultiMemberGroupAdminRoleGrant_Request)(nil), "berty.protocol.MultiMemberGroupAdminRoleGrant.Request")
+	proto.RegisterType((*MultiMemberGroupAdminRoleGrant_Reply)(nil), "berty.protocol.MultiMemberGroupAdminRoleGrant.Reply")
+	proto.RegisterType((*MultiMemberGroupAdminRoleRevoke)(nil), "berty.protocol.MultiMemberGroupAdminRoleRevoke")
+	proto.RegisterType((*MultiMemberGroupAdminRoleRevoke_Request)(nil), "berty.protocol.MultiMemberGroupAdminRoleRevoke.Request")
+	proto.RegisterType((*MultiMemberGroupAdminRoleRevoke_Reply)(nil), "berty.protocol.MultiMemberGroupAdminRoleRevoke.Reply")
+	proto.RegisterType((*MultiMemberGroupMemberRemove)(nil), "berty.protocol.MultiMemberGroupMemberRemove")
+	proto.RegisterType((*MultiMemberGroupMemberRemove_Request)(nil), "berty.protocol.MultiMemberGroupMemberRemove.Request")
+	proto.RegisterType((*MultiMemberGroupMemberRemove_Reply)(nil), "berty.protocol.MultiMemberGroupMemberRemove.Reply")
+	proto.RegisterType((*MultiMemberGroupInvitationCreate)(nil), "berty.protocol.MultiMemberGroupInvitationCreate")
+	proto.RegisterType((*MultiMemberGroupInvitationCreate_Request)(nil), "berty.protocol.MultiMemberGroupInvitationCreate.Request")
+	proto.RegisterType((*MultiMemberGroupInvitationCreate_Reply)(nil), "berty.protocol.MultiMemberGroupInvitationCreate.Reply")
+	proto.RegisterType((*MultiMemberGroupInvitationRevoke)(nil), "berty.protocol.MultiMemberGroupInvitationRevoke")
+	proto.RegisterType((*MultiMemberGroupInvitationRevoke_Request)(nil), "berty.protocol.MultiMemberGroupInvitationRevoke.Request")
+	proto.RegisterType((*MultiMemberGroupInvitationRevoke_Reply)(nil), "berty.protocol.MultiMemberGroupInvitationRevoke.Reply")
+	proto.RegisterType((*AppMetadataSend)(nil), "berty.protocol.AppMetadataSend")
+	proto.RegisterType((*AppMetadataSend_Request)(nil), "berty.protocol.AppMetadataSend.Request")
+	proto.RegisterType((*AppMetadataSend_Reply)(nil), "berty.protocol.AppMetadataSend.Reply")
+	proto.RegisterType((*AppMessageSend)(nil), "berty.protocol.AppMessageSend")
+	proto.RegisterType((*AppMessageSend_Request)(nil), "berty.protocol.AppMessageSend.Request")
+	proto.RegisterType((*AppMessageSend_Reply)(nil), "berty.protocol.AppMessageSend.Reply")
+	proto.RegisterType((*GroupMetadataSubscribe)(nil), "berty.protocol.GroupMetadataSubscribe")
+	proto.RegisterType((*GroupMetadataSubscribe_Request)(nil), "berty.protocol.GroupMetadataSubscribe.Request")
+	proto.RegisterType((*GroupMessageSubscribe)(nil), "berty.protocol.GroupMessageSubscribe")
+	proto.RegisterType((*GroupMessageSubscribe_Request)(nil), "berty.protocol.GroupMessageSubscribe.Request")
 }
 
-var xxx_messageInfo_AppMessageSend_Reply proto.InternalMessageInfo
+func init() { proto.RegisterFile("bertyprotocol.proto", fileDescriptor_047e04c733cf8554) }
 
-type GroupMetadataEvent struct {
-	// event_context contains context information about the event
-	EventContext *EventContext `protobuf:"bytes,1,opt,name=event_context,json=eventContext,proto3" json:"event_context,omitempty"`
-	// metadata contains the newly available metadata
-	Metadata *GroupMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// event_clear clear bytes for the event
-	Event                []byte   `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+var fileDescriptor_047e04c733cf8554 = []byte{
+	// 1770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc5, 0x59, 0x5b, 0x6f, 0x1b, 0x45,
+	0x14, 0xee, 0x36, 0x4d, 0x6c, 0x8f, 0x1d, 0xc7, 0x4c, 0x5a, 0x48, 0xb7, 0x21, 0x6d, 0x5d, 0x52,
+	0xd2, 0x36, 0x75, 0x8a, 0xe9, 0x85, 0xab, 0x50, 0xd2, 0x54, 0x69, 0x9a, 0x06, 0xc2, 0x86, 0xa0,
+	0x52, 0x21, 0xac, 0xf5, 0x7a, 0xe2, 0x2c, 0x5e, 0xef, 0x2e, 0xbb, 0x6b, 0xb7, 0x91, 0x78, 0x00,
+	0x04, 0x82, 0x27, 0x24, 0xa4, 0xf2, 0x0b, 0xf8, 0x09, 0xbc, 0xf2, 0xc2, 0x1b, 0x2f, 0x48, 0x7d,
+	0x43, 0xbc, 0x20, 0xe8, 0x2f, 0x61, 0x6e, 0x7b, 0xf5, 0x6e, 0x76, 0x1d, 0x57, 0xed, 0x43, 0x94,
+	0x9d, 0x99, 0xef, 0x7c, 0xe7, 0xcc, 0xcc, 0x99, 0x33, 0xf3, 0xc9, 0x60, 0xba, 0x89, 0x2c, 0x67,
+	0xdf, 0xb4, 0x0c, 0xc7, 0x50, 0x0c, 0xad, 0x46, 0x3f, 0x60, 0x99, 0x76, 0xd6, 0xdc, 0x5e, 0xf1,
+	0x72, 0x5b, 0x75, 0xf6, 0x7a, 0xcd, 0x9a, 0x62, 0x74, 0x97, 0xda, 0x46, 0xdb, 0x58, 0xa2, 0x23,
+	0xcd, 0xde, 0x2e, 0x6d, 0xd1, 0x06, 0xfd, 0x62, 0x16, 0x62, 0x85, 0x9a, 0x63, 0x56, 0x64, 0xb3,
+	0x9e, 0x6a, 0x17, 0xc0, 0x75, 0xdd, 0x76, 0x64, 0x5d, 0x41, 0xb7, 0x1e, 0x9a, 0x86, 0xe5, 0xac,
+	0xca, 0x8e, 0x2c, 0x5e, 0x00, 0x39, 0x09, 0x7d, 0xd1, 0x43, 0xb6, 0x03, 0xe7, 0x00, 0x30, 0x65,
+	0xdb, 0x36, 0xf7, 0x2c, 0xd9, 0x46, 0x33, 0xc2, 0x19, 0x61, 0xa1, 0x24, 0x05, 0x7a, 0xc4, 0x45,
+	0x30, 0x2e, 0x21, 0x53, 0xdb, 0x87, 0xe7, 0xc0, 0x24, 0xa2, 0x0c, 0xa8, 0xd5, 0x68, 0x61, 0x12,
+	0x8e, 0x2d, 0xb9, 0x9d, 0x84, 0x38, 0xe8, 0x6e, 0xbd, 0xeb, 0xb9, 0x7b, 0xdf, 0x77, 0x97, 0x85,
+	0x25, 0x12, 0xd3, 0xd1, 0x81, 0x98, 0x72, 0x3c, 0xa6, 0xea, 0xef, 0xe3, 0x60, 0xc6, 0xf5, 0xb7,
+	0x86, 0x9c, 0x9b, 0x86, 0xbe, 0xab, 0xb6, 0x7b, 0x96, 0xec, 0xa8, 0x86, 0x2e, 0x16, 0x3c, 0xaf,
+	0xe2, 0xe3, 0x63, 0xee, 0x2c, 0x16, 0x01, 0x90, 0x15, 0xc5, 0xe8, 0xe9, 0x4e, 0xc3, 0xec, 0x30,
+	0xe7, 0x2b, 0x93, 0x4f, 0xfe, 0x39, 0x5d, 0x58, 0x66, 0xbd, 0x5b, 0x1b, 0x52, 0x81, 0x03, 0xb6,
+	0x3a, 0xf0, 0x02, 0x28, 0xb4, 0x50, 0x5f, 0x55, 0x10, 0x01, 0xd3, 0x38, 0x56, 0x4a, 0x18, 0x9c,
+	0x5f, 0xa5, 0x9d, 0x18, 0x9b, 0x67, 0xc3, 0x18, 0xfa, 0x0e, 0xa8, 0xb8, 0xc4, 0x6d, 0xcb, 0xe8,
+	0x99, 0xc4, 0x62, 0x8c, 0x5a, 0x40, 0x6c, 0x51, 0xe6, 0xf4, 0x6b, 0x64, 0x08, 0xdb, 0x95, 0xe5,
+	0x60, 0xbb, 0x83, 0x97, 0x25, 0x67, 0x22, 0x64, 0x35, 0xd4, 0xd6, 0xcc, 0x31, 0x6c, 0x54, 0x58,
+	0x01, 0xd8, 0x68, 0x62, 0x0b, 0x77, 0xad, 0xaf, 0x4a, 0x13, 0x64, 0x68, 0xbd, 0x05, 0x67, 0x41,
+	0x41, 0x53, 0x6d, 0x07, 0xe9, 0xc8, 0xb2, 0x67, 0xc6, 0xcf, 0x8c, 0x2d, 0x14, 0x24, 0xbf, 0x03,
+	0x7e, 0x0c, 0x8a, 0x4d, 0x0d, 0x35, 0x90, 0x2e, 0xe3, 0x7f, 0xad, 0x99, 0x09, 0x4c, 0x53, 0xae,
+	0x5f, 0xab, 0x85, 0x13, 0xaa, 0x96, 0xb4, 0x5a, 0xb5, 0x6d, 0xe4, 0x38, 0xaa, 0xde, 0xde, 0x76,
+	0x64, 0x07, 0x49, 0x00, 0x53, 0xdc, 0x62, 0x44, 0xb0, 0x01, 0x2a, 0x0f, 0xd4, 0x5d, 0xb5, 0x61,
+	0xd6, 0x4d, 0x8f, 0x3c, 0x37, 0x0a, 0x79, 0x99, 0xd0, 0x6d, 0xd5, 0x4d, 0xd7, 0xc1, 0x3d, 0x50,
+	0xea, 0xb6, 0x74, 0xdb, 0x23, 0xcf, 0x8f, 0x42, 0x5e, 0x24, 0x54, 0x2e, 0xf3, 0x7d, 0x30, 0x69,
+	0x21, 0x4d, 0xde, 0xf7, 0xa8, 0x0b, 0xa3, 0x50, 0x97, 0x28, 0x17, 0xe7, 0xae, 0xae, 0x81, 0x52,
+	0x70, 0x14, 0x16, 0x41, 0x6e, 0x47, 0xef, 0xe8, 0xc6, 0x03, 0xbd, 0x72, 0x84, 0x34, 0x38, 0xae,
+	0x22, 0xc0, 0x12, 0xc8, 0xaf, 0xaa, 0x36, 0x6b, 0x1d, 0x85, 0x53, 0xa0, 0xb8, 0xa3, 0xcb, 0x7d,
+	0x59, 0xd5, 0x48, 0x4f, 0x65, 0xac, 0xaa, 0x81, 0x69, 0x96, 0x4e, 0x77, 0x55, 0xbd, 0x23, 0xa1,
+	0x5d, 0x64, 0x21, 0x1c, 0x4a, 0x30, 0x7b, 0xef, 0xb8, 0xc9, 0xbb, 0x0c, 0x0a, 0x96, 0x0b, 0xa0,
+	0xb9, 0x5b, 0xac, 0x9f, 0x8b, 0xce, 0x65, 0x7b, 0x4f, 0xb6, 0x10, 0xa1, 0x0e, 0x90, 0xfa, 0x56,
+	0xd5, 0x36, 0x28, 0xfb, 0x03, 0x77, 0x0c, 0x55, 0x17, 0xef, 0xfa, 0x87, 0x73, 0x74, 0x7e, 0xff,
+	0x68, 0x6e, 0x82, 0x12, 0x43, 0x48, 0xa8, 0x6f, 0x74, 0x90, 0x78, 0xd5, 0x77, 0x13, 0x3a, 0x55,
+	0xc2, 0x41, 0xa7, 0xca, 0xa7, 0xdb, 0x00, 0x2f, 0xe1, 0xad, 0x71, 0x64, 0xc5, 0xe1, 0x2c, 0xb1,
+	0x2b, 0x35, 0xef, 0xae, 0xd4, 0x6c, 0x74, 0x26, 0xa5, 0xe0, 0x22, 0x5c, 0x02, 0x27, 0xc2, 0x64,
+	0x7c, 0x7f, 0x82, 0x54, 0x9e, 0xe7, 0xdb, 0xe0, 0x78, 0x18, 0xcc, 0x76, 0xf6, 0x10, 0x6e, 0xb7,
+	0xc0, 0x6c, 0x74, 0x0e, 0x36, 0x1a, 0x69, 0x22, 0x8f, 0x04, 0x00, 0xc3, 0x94, 0xdb, 0x48, 0x6f,
+	0x89, 0x3d, 0x7f, 0xad, 0x0f, 0xb4, 0xc7, 0x3b, 0x51, 0x51, 0x98, 0x79, 0xa3, 0x8b, 0x1c, 0x99,
+	0x16, 0x64, 0x56, 0x6e, 0xa7, 0x78, 0xff, 0x26, 0xef, 0x86, 0x67, 0x41, 0x09, 0xe7, 0xb6, 0x0f,
+	0xa3, 0xb5, 0x4d, 0x2a, 0xe2, 0x3e, 0x17, 0xe2, 0x2f, 0xd9, 0xbd, 0xe8, 0x92, 0xe1, 0xea, 0x87,
+	0x4c, 0x47, 0xbc, 0xe1, 0xc7, 0x85, 0xeb, 0xb0, 0xeb, 0x39, 0x5c, 0x87, 0xb9, 0x21, 0xa9, 0xc3,
+	0x1c, 0x10, 0x4c, 0x83, 0x4f, 0x62, 0x76, 0x4e, 0x91, 0xad, 0xd6, 0x53, 0xa0, 0xde, 0x02, 0x25,
+	0x0e, 0x58, 0xd1, 0x0c, 0xa5, 0xf3, 0x14, 0x18, 0x25, 0x50, 0xe6, 0x80, 0x1d, 0xbd, 0xf9, 0x94,
+	0x38, 0x3f, 0x04, 0xd3, 0x1c, 0xb0, 0xac, 0xa9, 0xb2, 0xbd, 0x81, 0xf6, 0xe9, 0x8e, 0xbf, 0xe6,
+	0x13, 0x9f, 0x07, 0x79, 0xef, 0x02, 0x62, 0xb4, 0x45, 0x4c, 0x9b, 0x73, 0x6f, 0x9e, 0x5c, 0x9b,
+	0x5d, 0x39, 0x3e, 0xe5, 0xb7, 0x02, 0x78, 0x71, 0xb3, 0xa7, 0x39, 0xea, 0x26, 0xea, 0xe2, 0xc3,
+	0x4e, 0x81, 0x37, 0xf1, 0x19, 0x77, 0x90, 0xf8, 0xa6, 0x4f, 0x5b, 0x03, 0xd3, 0x72, 0xab, 0xab,
+	0x92, 0x0c, 0xb0, 0x6d, 0xb9, 0x8d, 0xec, 0x86, 0xa1, 0x6b, 0xfb, 0xd4, 0x43, 0x5e, 0x7a, 0x81,
+	0x0e, 0x6d, 0xf2, 0x91, 0x0f, 0xf0, 0x80, 0xb8, 0xe4, 0x66, 0x70, 0xc6, 0x78, 0xaa, 0x1d, 0x70,
+	0x3c, 0x1a, 0x05, 0xad, 0x4f, 0x77, 0xfc, 0x18, 0xde, 0x03, 0x40, 0xd5, 0xfb, 0xaa, 0x43, 0x8b,
+	0x33, 0x4d, 0xd4, 0x62, 0xfd, 0x74, 0xb4, 0x40, 0x51, 0xcb, 0x75, 0x0f, 0x26, 0x05, 0x4c, 0xfc,
+	0x39, 0x6f, 0x83, 0x13, 0x51, 0x67, 0x77, 0x91, 0xdc, 0x47, 0x23, 0x2d, 0xa4, 0x02, 0xe6, 0xa3,
+	0xa4, 0x74, 0x93, 0xf0, 0x31, 0x37, 0xb4, 0x3e, 0xb2, 0x48, 0xae, 0x6a, 0x86, 0x3d, 0x9a, 0x93,
+	0xef, 0x05, 0x30, 0x37, 0xe0, 0x85, 0xac, 0xbe, 0x64, 0x68, 0x68, 0xcd, 0x92, 0x75, 0x47, 0xfc,
+	0x74, 0x68, 0x7a, 0x52, 0x92, 0xbb, 0x94, 0x2f, 0xf2, 0xd0, 0x61, 0x4e, 0x48, 0x49, 0x66, 0xc3,
+	0xc1, 0x48, 0x7e, 0x10, 0xc0, 0xe9, 0xc4, 0x48, 0x78, 0xd5, 0x7f, 0x46, 0xa1, 0x7c, 0x27, 0x80,
+	0xd9, 0x68, 0x28, 0xec, 0x53, 0x42, 0x5d, 0xa3, 0xff, 0xcc, 0xe2, 0xf8, 0x5b, 0x00, 0x67, 0xa2,
+	0x71, 0xf8, 0xa9, 0xc8, 0x0f, 0x55, 0x67, 0xf8, 0x58, 0x5e, 0x06, 0x00, 0x3f, 0x90, 0x55, 0x0b,
+	0x9f, 0x3a, 0xd9, 0xa1, 0xc1, 0x8c, 0x49, 0x05, 0xde, 0xb3, 0xec, 0xc0, 0x93, 0x20, 0xdf, 0x95,
+	0x1f, 0x36, 0x7a, 0x36, 0xb2, 0x69, 0x5d, 0x9e, 0x94, 0x72, 0xb8, 0xbd, 0x83, 0x9b, 0xe2, 0x6d,
+	0xf7, 0x18, 0x8e, 0x7a, 0x76, 0xaa, 0x3f, 0x1f, 0x38, 0x37, 0xbe, 0xdf, 0x7b, 0xc3, 0xcf, 0xed,
+	0x1a, 0x98, 0xf4, 0xbd, 0x90, 0x07, 0x30, 0x5b, 0xeb, 0x0a, 0x06, 0x97, 0x7c, 0x7e, 0xfc, 0x0c,
+	0x2e, 0xf9, 0xb0, 0xf5, 0x96, 0xbf, 0xe6, 0xdf, 0x08, 0x60, 0x6a, 0xd9, 0x34, 0xdd, 0x5b, 0x88,
+	0x96, 0xc3, 0x8d, 0xe1, 0xc3, 0x98, 0xc1, 0x2f, 0x70, 0x79, 0x5f, 0x33, 0x64, 0x1e, 0x80, 0xe4,
+	0x36, 0xc5, 0xaa, 0xbb, 0x84, 0x27, 0xc1, 0x98, 0x82, 0xe3, 0x63, 0x2c, 0x39, 0xcc, 0x32, 0x76,
+	0x13, 0x87, 0x45, 0xfa, 0xaa, 0x5f, 0x0b, 0xa0, 0x4c, 0x83, 0xa0, 0x15, 0xf0, 0xf9, 0xc4, 0xf0,
+	0x17, 0xae, 0xe3, 0x3c, 0xf3, 0xf9, 0x52, 0xf4, 0x9a, 0xb6, 0x62, 0xa9, 0x4d, 0x24, 0xfe, 0x26,
+	0x0c, 0x1f, 0xcc, 0x71, 0x30, 0x6e, 0xab, 0xe4, 0xd5, 0xc0, 0x42, 0x61, 0x0d, 0xd2, 0x8b, 0x65,
+	0x8b, 0xaa, 0xf1, 0xfb, 0x9f, 0x35, 0xc8, 0xe3, 0xa0, 0x6d, 0x34, 0x9a, 0xb2, 0xd2, 0x79, 0x80,
+	0x2f, 0x63, 0x9b, 0x6a, 0x98, 0xbc, 0x54, 0x6c, 0x1b, 0x2b, 0x6e, 0x17, 0x7c, 0x0b, 0x14, 0x51,
+	0x1f, 0x61, 0x75, 0x44, 0xd5, 0x29, 0x95, 0x2f, 0xe5, 0xfa, 0xc9, 0x68, 0x02, 0xde, 0x22, 0x90,
+	0x8f, 0x30, 0x42, 0x02, 0xc8, 0xfd, 0xb4, 0xab, 0x7f, 0x0a, 0xe0, 0x04, 0x9f, 0x19, 0x5b, 0x5f,
+	0x6f, 0x62, 0xbf, 0x3e, 0xff, 0x89, 0x61, 0x43, 0x4d, 0xed, 0xaa, 0x0e, 0x9e, 0x12, 0x39, 0x79,
+	0xac, 0x01, 0x4f, 0x11, 0xad, 0xd6, 0x47, 0xec, 0x92, 0x9c, 0xa0, 0x56, 0x79, 0xd2, 0x41, 0xee,
+	0xc6, 0xfa, 0x57, 0xa7, 0xc0, 0xd4, 0x16, 0x9f, 0xf2, 0x36, 0xb2, 0xc8, 0x5b, 0x17, 0x6a, 0x71,
+	0x42, 0x1d, 0x5e, 0x4c, 0x92, 0x2a, 0x3e, 0xa6, 0xe6, 0xbe, 0x19, 0x17, 0x32, 0x61, 0x71, 0x12,
+	0x5d, 0x11, 0x82, 0xde, 0x7c, 0x9d, 0x9e, 0xec, 0xcd, 0xc7, 0xa4, 0x7b, 0x0b, 0x61, 0xb1, 0xb7,
+	0x05, 0x01, 0x7e, 0x99, 0xac, 0xd2, 0xe1, 0x95, 0xcc, 0x62, 0xcc, 0xf5, 0x5c, 0x1b, 0xc2, 0x82,
+	0x1c, 0x99, 0x6e, 0xac, 0xc0, 0x82, 0x97, 0xa2, 0x34, 0x31, 0x20, 0xcf, 0xe7, 0x85, 0x6c, 0x60,
+	0xe2, 0xee, 0xb3, 0xa8, 0xc2, 0x82, 0xe7, 0x93, 0x8d, 0xc9, 0xb8, 0xe7, 0xe4, 0x95, 0x54, 0x1c,
+	0xe1, 0xbf, 0x17, 0x16, 0x56, 0x30, 0xc1, 0x8a, 0x8d, 0x7a, 0xdc, 0xd5, 0x14, 0x14, 0x61, 0xde,
+	0x4f, 0xd4, 0x58, 0x70, 0x29, 0x6a, 0x9e, 0x00, 0xf4, 0xfc, 0x5d, 0xce, 0x6e, 0x40, 0x5c, 0xdb,
+	0x09, 0x8a, 0x0c, 0xa6, 0xf0, 0x70, 0x98, 0xe7, 0xf6, 0x52, 0x56, 0x38, 0x71, 0x6a, 0xc6, 0x2b,
+	0x3b, 0xb8, 0x78, 0x30, 0x09, 0x43, 0x79, 0x2e, 0x2f, 0x66, 0x44, 0x13, 0x8f, 0xf8, 0xc9, 0x74,
+	0xa0, 0x04, 0x84, 0x57, 0xd3, 0x96, 0x2d, 0x88, 0xf6, 0x42, 0xa8, 0x0f, 0x69, 0x45, 0x42, 0xf9,
+	0x3c, 0x4e, 0x39, 0xc2, 0x94, 0xc9, 0x10, 0x4c, 0x72, 0x05, 0x88, 0xc5, 0xc6, 0x2e, 0x34, 0xd3,
+	0x83, 0x69, 0x0b, 0xcd, 0x50, 0x59, 0x17, 0xda, 0x43, 0x27, 0xe5, 0x13, 0xd1, 0x89, 0x19, 0xf2,
+	0x89, 0xc0, 0x86, 0xc8, 0x27, 0x0e, 0xe7, 0x27, 0x33, 0xa8, 0x20, 0x07, 0x4f, 0x66, 0x70, 0x34,
+	0xf9, 0x64, 0x46, 0x50, 0xbc, 0xa6, 0x84, 0x95, 0xe4, 0x60, 0x4d, 0x09, 0x8f, 0x27, 0xd7, 0x94,
+	0x01, 0x1c, 0x2f, 0x91, 0x31, 0xaa, 0x12, 0x26, 0xcd, 0x3e, 0x08, 0x4a, 0x2e, 0x91, 0xf1, 0x60,
+	0xe2, 0xae, 0x9f, 0x24, 0x38, 0xe1, 0x40, 0x6d, 0x8f, 0xc7, 0x79, 0x4e, 0x17, 0x33, 0xe3, 0x79,
+	0x1e, 0xc6, 0x49, 0x4c, 0x98, 0xca, 0x12, 0x2a, 0xd3, 0x17, 0x33, 0xa2, 0x79, 0x1e, 0xc6, 0xea,
+	0xcc, 0xc1, 0x3c, 0x8c, 0x85, 0x25, 0xe7, 0x61, 0x12, 0x9c, 0x38, 0xfd, 0x45, 0xc8, 0x28, 0x44,
+	0xe1, 0xbb, 0x69, 0xb4, 0xb1, 0x66, 0x5e, 0x54, 0x6f, 0x1f, 0xd6, 0x9c, 0x44, 0xf9, 0x63, 0xaa,
+	0x90, 0x85, 0xd7, 0x53, 0xf9, 0x43, 0x78, 0x2f, 0xae, 0xab, 0x43, 0xdb, 0x91, 0x80, 0x7e, 0x4a,
+	0xd7, 0xb3, 0xf0, 0x46, 0x66, 0xe6, 0xc8, 0xfd, 0x7b, 0x6d, 0x78, 0x43, 0xf7, 0xc2, 0x38, 0x48,
+	0xd8, 0xc2, 0xd4, 0xa9, 0x06, 0xd1, 0xc9, 0x17, 0x46, 0x8a, 0x15, 0x09, 0xe5, 0x51, 0x06, 0x6d,
+	0x0b, 0xdf, 0x48, 0x23, 0x8e, 0x5a, 0x78, 0x21, 0x5d, 0x3f, 0x84, 0x65, 0x7a, 0x58, 0x7c, 0xdb,
+	0x86, 0x08, 0x2b, 0xb2, 0x6f, 0xd7, 0x0f, 0x61, 0x49, 0xc2, 0x92, 0x07, 0x44, 0x29, 0x7c, 0x35,
+	0x4a, 0x15, 0x01, 0x78, 0x3e, 0xe7, 0xd3, 0x81, 0xfc, 0x52, 0x08, 0x4b, 0xce, 0xc1, 0x4b, 0x21,
+	0x3c, 0x9e, 0x7c, 0x29, 0x0c, 0xe0, 0x08, 0xbf, 0x9e, 0x24, 0x27, 0x07, 0xab, 0x74, 0x3c, 0x2e,
+	0xf9, 0x8a, 0x0b, 0xe1, 0xa9, 0xe6, 0xc3, 0x9a, 0xa4, 0x93, 0x20, 0xf2, 0x06, 0x6b, 0x65, 0x2c,
+	0xcc, 0xf3, 0x76, 0xf6, 0x20, 0x38, 0x77, 0xb6, 0x72, 0xe3, 0xf1, 0x7f, 0x73, 0x47, 0xfe, 0x78,
+	0x32, 0x27, 0x3c, 0xc6, 0x7f, 0xff, 0xe2, 0xbf, 0xfb, 0xf3, 0xcc, 0xca, 0x41, 0xca, 0xde, 0x12,
+	0xfd, 0x5c, 0x22, 0x3f, 0xb7, 0x76, 0xda, 0x4b, 0xa1, 0xdf, 0x69, 0x9b, 0x13, 0xf4, 0xeb, 0xf5,
+	0xff, 0x01, 0xbd, 0x49, 0x06, 0x47, 0xbf, 0x1d, 0x00, 0x00,
 }
 
-func (m *GroupMetadataEvent) Reset()         { *m = GroupMetadataEvent{} }
-func (m *GroupMetadataEvent) String() string { return proto.CompactTextString(m) }
-func (*GroupMetadataEvent) ProtoMessage()    {}
-func (*GroupMetadataEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{49}
-}
-func (m *GroupMetadataEvent) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GroupMetadataEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GroupMetadataEvent.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// ProtocolServiceClient is the client API for ProtocolService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ProtocolServiceClient interface {
+	// InstanceExportData exports instance data, the encrypted archive is streamed in chunks
+	InstanceExportData(ctx context.Context, in *InstanceExportData_Request, opts ...grpc.CallOption) (ProtocolService_InstanceExportDataClient, error)
+	// InstanceImportData replaces the instance data with previously exported data, the archive is streamed in chunks
+	InstanceImportData(ctx context.Context, opts ...grpc.CallOption) (ProtocolService_InstanceImportDataClient, error)
+	// InstanceGetConfiguration gets current configuration of this protocol instance
+	InstanceGetConfiguration(ctx context.Context, in *InstanceGetConfiguration_Request, opts ...grpc.CallOption) (*InstanceGetConfiguration_Reply, error)
+	// DeviceLinkReference creates a one-time reference allowing another device to join the current account
+	DeviceLinkReference(ctx context.Context, in *DeviceLinkReference_Request, opts ...grpc.CallOption) (*DeviceLinkReference_Reply, error)
+	// DeviceLinkJoin replaces the account of the instance with the account of the device which created the reference
+	DeviceLinkJoin(ctx context.Context, in *DeviceLinkJoin_Request, opts ...grpc.CallOption) (*DeviceLinkJoin_Reply, error)
+	// DeviceRevoke revokes a lost or stolen device of the account, in the account group and in every group it has joined
+	DeviceRevoke(ctx context.Context, in *DeviceRevoke_Request, opts ...grpc.CallOption) (*DeviceRevoke_Reply, error)
+	// ContactRequestReference retrieves the information required to create a reference (ie. included in a shareable link) to the current account
+	ContactRequestReference(ctx context.Context, in *ContactRequestReference_Request, opts ...grpc.CallOption) (*ContactRequestReference_Reply, error)
+	// ContactRequestDisable disables incoming contact requests
+	ContactRequestDisable(ctx context.Context, in *ContactRequestDisable_Request, opts ...grpc.CallOption) (*ContactRequestDisable_Reply, error)
+	// ContactRequestEnable enables incoming contact requests
+	ContactRequestEnable(ctx context.Context, in *ContactRequestEnable_Request, opts ...grpc.CallOption) (*ContactRequestEnable_Reply, error)
+	// ContactRequestResetReference changes the contact request reference
+	ContactRequestResetReference(ctx context.Context, in *ContactRequestResetReference_Request, opts ...grpc.CallOption) (*ContactRequestResetReference_Reply, error)
+	// ContactRequestSend attempt to send a contact request
+	ContactRequestSend(ctx context.Context, in *ContactRequestSend_Request, opts ...grpc.CallOption) (*ContactRequestSend_Reply, error)
+	// ContactRequestAccept accepts a contact request
+	ContactRequestAccept(ctx context.Context, in *ContactRequestAccept_Request, opts ...grpc.CallOption) (*ContactRequestAccept_Reply, error)
+	// ContactRequestDiscard ignores a contact request, without informing the other user
+	ContactRequestDiscard(ctx context.Context, in *ContactRequestDiscard_Request, opts ...grpc.CallOption) (*ContactRequestDiscard_Reply, error)
+	// ContactBlock blocks a contact from sending requests
+	ContactBlock(ctx context.Context, in *ContactBlock_Request, opts ...grpc.CallOption) (*ContactBlock_Reply, error)
+	// ContactUnblock unblocks a contact from sending requests
+	ContactUnblock(ctx context.Context, in *ContactUnblock_Request, opts ...grpc.CallOption) (*ContactUnblock_Reply, error)
+	// ContactAliasKeySend send an alias key to a contact, the contact will be able to assert that your account is being present on a multi-member group
+	ContactAliasKeySend(ctx context.Context, in *ContactAliasKeySend_Request, opts ...grpc.CallOption) (*ContactAliasKeySend_Reply, error)
+	// MultiMemberGroupCreate creates a new multi-member group
+	MultiMemberGroupCreate(ctx context.Context, in *MultiMemberGroupCreate_Request, opts ...grpc.CallOption) (*MultiMemberGroupCreate_Reply, error)
+	// MultiMemberGroupJoin joins a multi-member group
+	MultiMemberGroupJoin(ctx context.Context, in *MultiMemberGroupJoin_Request, opts ...grpc.CallOption) (*MultiMemberGroupJoin_Reply, error)
+	// MultiMemberGroupLeave leaves a multi-member group
+	MultiMemberGroupLeave(ctx context.Context, in *MultiMemberGroupLeave_Request, opts ...grpc.CallOption) (*MultiMemberGroupLeave_Reply, error)
+	// MultiMemberGroupAliasResolverDisclose discloses your alias resolver key
+	MultiMemberGroupAliasResolverDisclose(ctx context.Context, in *MultiMemberGroupAliasResolverDisclose_Request, opts ...grpc.CallOption) (*MultiMemberGroupAliasResolverDisclose_Reply, error)
+	// MultiMemberGroupAdminRoleGrant grants an admin role to a group member
+	MultiMemberGroupAdminRoleGrant(ctx context.Context, in *MultiMemberGroupAdminRoleGrant_Request, opts ...grpc.CallOption) (*MultiMemberGroupAdminRoleGrant_Reply, error)
+	// MultiMemberGroupAdminRoleRevoke revokes the admin role of a group member
+	MultiMemberGroupAdminRoleRevoke(ctx context.Context, in *MultiMemberGroupAdminRoleRevoke_Request, opts ...grpc.CallOption) (*MultiMemberGroupAdminRoleRevoke_Reply, error)
+	// MultiMemberGroupMemberRemove removes a member from a group, the remaining members rotate their secrets
+	MultiMemberGroupMemberRemove(ctx context.Context, in *MultiMemberGroupMemberRemove_Request, opts ...grpc.CallOption) (*MultiMemberGroupMemberRemove_Reply, error)
+	// MultiMemberGroupInvitationCreate creates an invitation to a multi-member group
+	MultiMemberGroupInvitationCreate(ctx context.Context, in *MultiMemberGroupInvitationCreate_Request, opts ...grpc.CallOption) (*MultiMemberGroupInvitationCreate_Reply, error)
+	// MultiMemberGroupInvitationRevoke revokes an invitation to a multi-member group
+	MultiMemberGroupInvitationRevoke(ctx context.Context, in *MultiMemberGroupInvitationRevoke_Request, opts ...grpc.CallOption) (*MultiMemberGroupInvitationRevoke_Reply, error)
+	// AppMetadataSend adds an app event to the metadata store, the message is encrypted using a symmetric key and readable by future group members
+	AppMetadataSend(ctx context.Context, in *AppMetadataSend_Request, opts ...grpc.CallOption) (*AppMetadataSend_Reply, error)
+	// AppMessageSend adds an app event to the message store, the message is encrypted using a derived key and readable by current group members
+	AppMessageSend(ctx context.Context, in *AppMessageSend_Request, opts ...grpc.CallOption) (*AppMessageSend_Reply, error)
+	// GroupMetadataSubscribe subscribes to a group metadata updates (or it can also retrieve the history)
+	GroupMetadataSubscribe(ctx context.Context, in *GroupMetadataSubscribe_Request, opts ...grpc.CallOption) (ProtocolService_GroupMetadataSubscribeClient, error)
+	// GroupMessageSubscribe subscribes to a group message updates (or it can also retrieve the history)
+	GroupMessageSubscribe(ctx context.Context, in *GroupMessageSubscribe_Request, opts ...grpc.CallOption) (ProtocolService_GroupMessageSubscribeClient, error)
 }
-func (m *GroupMetadataEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupMetadataEvent.Merge(m, src)
+
+type protocolServiceClient struct {
+	cc *grpc.ClientConn
 }
-func (m *GroupMetadataEvent) XXX_Size() int {
-	return m.Size()
+
+func NewProtocolServiceClient(cc *grpc.ClientConn) ProtocolServiceClient {
+	return &protocolServiceClient{cc}
 }
-func (m *GroupMetadataEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupMetadataEvent.DiscardUnknown(m)
+
+func (c *protocolServiceClient) InstanceExportData(ctx context.Context, in *InstanceExportData_Request, opts ...grpc.CallOption) (ProtocolService_InstanceExportDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProtocolService_serviceDesc.Streams[0], "/berty.protocol.ProtocolService/InstanceExportData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &protocolServiceInstanceExportDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-var xxx_messageInfo_GroupMetadataEvent proto.InternalMessageInfo
+type ProtocolService_InstanceExportDataClient interface {
+	Recv() (*InstanceExportData_Reply, error)
+	grpc.ClientStream
+}
 
-func (m *GroupMetadataEvent) GetEventContext() *EventContext {
-	if m != nil {
-		return m.EventContext
-	}
-	return nil
+type protocolServiceInstanceExportDataClient struct {
+	grpc.ClientStream
 }
 
-func (m *GroupMetadataEvent) GetMetadata() *GroupMetadata {
-	if m != nil {
-		return m.Metadata
+func (x *protocolServiceInstanceExportDataClient) Recv() (*InstanceExportData_Reply, error) {
+	m := new(InstanceExportData_Reply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return nil
+	return m, nil
 }
 
-func (m *GroupMetadataEvent) GetEvent() []byte {
-	if m != nil {
-		return m.Event
+func (c *protocolServiceClient) InstanceImportData(ctx context.Context, opts ...grpc.CallOption) (ProtocolService_InstanceImportDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProtocolService_serviceDesc.Streams[1], "/berty.protocol.ProtocolService/InstanceImportData", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	x := &protocolServiceInstanceImportDataClient{stream}
+	return x, nil
 }
 
-type GroupMessageEvent struct {
-	// event_context contains context information about the event
-	EventContext *EventContext `protobuf:"bytes,1,opt,name=event_context,json=eventContext,proto3" json:"event_context,omitempty"`
-	// headers contains headers of the secure message
-	Headers *MessageHeaders `protobuf:"bytes,2,opt,name=headers,proto3" json:"headers,omitempty"`
-	// message contains the secure message payload
-	Message              []byte   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ProtocolService_InstanceImportDataClient interface {
+	Send(*InstanceImportData_Request) error
+	CloseAndRecv() (*InstanceImportData_Reply, error)
+	grpc.ClientStream
 }
 
-func (m *GroupMessageEvent) Reset()         { *m = GroupMessageEvent{} }
-func (m *GroupMessageEvent) String() string { return proto.CompactTextString(m) }
-func (*GroupMessageEvent) ProtoMessage()    {}
-func (*GroupMessageEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{50}
+type protocolServiceInstanceImportDataClient struct {
+	grpc.ClientStream
 }
-func (m *GroupMessageEvent) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (x *protocolServiceInstanceImportDataClient) Send(m *InstanceImportData_Request) error {
+	return x.ClientStream.SendMsg(m)
 }
-func (m *GroupMessageEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GroupMessageEvent.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (x *protocolServiceInstanceImportDataClient) CloseAndRecv() (*InstanceImportData_Reply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(InstanceImportData_Reply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
+	return m, nil
 }
-func (m *GroupMessageEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupMessageEvent.Merge(m, src)
+
+func (c *protocolServiceClient) InstanceGetConfiguration(ctx context.Context, in *InstanceGetConfiguration_Request, opts ...grpc.CallOption) (*InstanceGetConfiguration_Reply, error) {
+	out := new(InstanceGetConfiguration_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/InstanceGetConfiguration", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *GroupMessageEvent) XXX_Size() int {
-	return m.Size()
+
+func (c *protocolServiceClient) DeviceLinkReference(ctx context.Context, in *DeviceLinkReference_Request, opts ...grpc.CallOption) (*DeviceLinkReference_Reply, error) {
+	out := new(DeviceLinkReference_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/DeviceLinkReference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (m *GroupMessageEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupMessageEvent.DiscardUnknown(m)
+
+func (c *protocolServiceClient) DeviceLinkJoin(ctx context.Context, in *DeviceLinkJoin_Request, opts ...grpc.CallOption) (*DeviceLinkJoin_Reply, error) {
+	out := new(DeviceLinkJoin_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/DeviceLinkJoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-var xxx_messageInfo_GroupMessageEvent proto.InternalMessageInfo
+func (c *protocolServiceClient) DeviceRevoke(ctx context.Context, in *DeviceRevoke_Request, opts ...grpc.CallOption) (*DeviceRevoke_Reply, error) {
+	out := new(DeviceRevoke_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/DeviceRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func (m *GroupMessageEvent) GetEventContext() *EventContext {
-	if m != nil {
-		return m.EventContext
+func (c *protocolServiceClient) ContactRequestReference(ctx context.Context, in *ContactRequestReference_Request, opts ...grpc.CallOption) (*ContactRequestReference_Reply, error) {
+	out := new(ContactRequestReference_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestReference", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
 
-func (m *GroupMessageEvent) GetHeaders() *MessageHeaders {
-	if m != nil {
-		return m.Headers
+func (c *protocolServiceClient) ContactRequestDisable(ctx context.Context, in *ContactRequestDisable_Request, opts ...grpc.CallOption) (*ContactRequestDisable_Reply, error) {
+	out := new(ContactRequestDisable_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestDisable", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
 
-func (m *GroupMessageEvent) GetMessage() []byte {
-	if m != nil {
-		return m.Message
+func (c *protocolServiceClient) ContactRequestEnable(ctx context.Context, in *ContactRequestEnable_Request, opts ...grpc.CallOption) (*ContactRequestEnable_Reply, error) {
+	out := new(ContactRequestEnable_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestEnable", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
 
-type GroupMetadataSubscribe struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (c *protocolServiceClient) ContactRequestResetReference(ctx context.Context, in *ContactRequestResetReference_Request, opts ...grpc.CallOption) (*ContactRequestResetReference_Reply, error) {
+	out := new(ContactRequestResetReference_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestResetReference", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GroupMetadataSubscribe) Reset()         { *m = GroupMetadataSubscribe{} }
-func (m *GroupMetadataSubscribe) String() string { return proto.CompactTextString(m) }
-func (*GroupMetadataSubscribe) ProtoMessage()    {}
-func (*GroupMetadataSubscribe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{51}
-}
-func (m *GroupMetadataSubscribe) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GroupMetadataSubscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GroupMetadataSubscribe.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (c *protocolServiceClient) ContactRequestSend(ctx context.Context, in *ContactRequestSend_Request, opts ...grpc.CallOption) (*ContactRequestSend_Reply, error) {
+	out := new(ContactRequestSend_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestSend", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return out, nil
 }
-func (m *GroupMetadataSubscribe) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupMetadataSubscribe.Merge(m, src)
-}
-func (m *GroupMetadataSubscribe) XXX_Size() int {
-	return m.Size()
-}
-func (m *GroupMetadataSubscribe) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupMetadataSubscribe.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GroupMetadataSubscribe proto.InternalMessageInfo
 
-type GroupMetadataSubscribe_Request struct {
-	// group_pk is the identifier of the group
-	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
-	// since is the lower ID bound used to filter events
-	Since []byte `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
-	// until is the upper ID bound used to filter events
-	Until []byte `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
-	// go_backwards indicates whether the events should be returned in reverse order
-	GoBackwards          bool     `protobuf:"varint,4,opt,name=go_backwards,json=goBackwards,proto3" json:"go_backwards,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (c *protocolServiceClient) ContactRequestAccept(ctx context.Context, in *ContactRequestAccept_Request, opts ...grpc.CallOption) (*ContactRequestAccept_Reply, error) {
+	out := new(ContactRequestAccept_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestAccept", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GroupMetadataSubscribe_Request) Reset()         { *m = GroupMetadataSubscribe_Request{} }
-func (m *GroupMetadataSubscribe_Request) String() string { return proto.CompactTextString(m) }
-func (*GroupMetadataSubscribe_Request) ProtoMessage()    {}
-func (*GroupMetadataSubscribe_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{51, 0}
-}
-func (m *GroupMetadataSubscribe_Request) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GroupMetadataSubscribe_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GroupMetadataSubscribe_Request.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (c *protocolServiceClient) ContactRequestDiscard(ctx context.Context, in *ContactRequestDiscard_Request, opts ...grpc.CallOption) (*ContactRequestDiscard_Reply, error) {
+	out := new(ContactRequestDiscard_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestDiscard", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return out, nil
 }
-func (m *GroupMetadataSubscribe_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupMetadataSubscribe_Request.Merge(m, src)
-}
-func (m *GroupMetadataSubscribe_Request) XXX_Size() int {
-	return m.Size()
-}
-func (m *GroupMetadataSubscribe_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupMetadataSubscribe_Request.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GroupMetadataSubscribe_Request proto.InternalMessageInfo
 
-func (m *GroupMetadataSubscribe_Request) GetGroupPK() []byte {
-	if m != nil {
-		return m.GroupPK
+func (c *protocolServiceClient) ContactBlock(ctx context.Context, in *ContactBlock_Request, opts ...grpc.CallOption) (*ContactBlock_Reply, error) {
+	out := new(ContactBlock_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
 
-func (m *GroupMetadataSubscribe_Request) GetSince() []byte {
-	if m != nil {
-		return m.Since
+func (c *protocolServiceClient) ContactUnblock(ctx context.Context, in *ContactUnblock_Request, opts ...grpc.CallOption) (*ContactUnblock_Reply, error) {
+	out := new(ContactUnblock_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactUnblock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
 
-func (m *GroupMetadataSubscribe_Request) GetUntil() []byte {
-	if m != nil {
-		return m.Until
+func (c *protocolServiceClient) ContactAliasKeySend(ctx context.Context, in *ContactAliasKeySend_Request, opts ...grpc.CallOption) (*ContactAliasKeySend_Reply, error) {
+	out := new(ContactAliasKeySend_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactAliasKeySend", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
 
-func (m *GroupMetadataSubscribe_Request) GetGoBackwards() bool {
-	if m != nil {
-		return m.GoBackwards
+func (c *protocolServiceClient) MultiMemberGroupCreate(ctx context.Context, in *MultiMemberGroupCreate_Request, opts ...grpc.CallOption) (*MultiMemberGroupCreate_Reply, error) {
+	out := new(MultiMemberGroupCreate_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return false
+	return out, nil
 }
 
-type GroupMessageSubscribe struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (c *protocolServiceClient) MultiMemberGroupJoin(ctx context.Context, in *MultiMemberGroupJoin_Request, opts ...grpc.CallOption) (*MultiMemberGroupJoin_Reply, error) {
+	out := new(MultiMemberGroupJoin_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupJoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GroupMessageSubscribe) Reset()         { *m = GroupMessageSubscribe{} }
-func (m *GroupMessageSubscribe) String() string { return proto.CompactTextString(m) }
-func (*GroupMessageSubscribe) ProtoMessage()    {}
-func (*GroupMessageSubscribe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{52}
-}
-func (m *GroupMessageSubscribe) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GroupMessageSubscribe) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GroupMessageSubscribe.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (c *protocolServiceClient) MultiMemberGroupLeave(ctx context.Context, in *MultiMemberGroupLeave_Request, opts ...grpc.CallOption) (*MultiMemberGroupLeave_Reply, error) {
+	out := new(MultiMemberGroupLeave_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupLeave", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-}
-func (m *GroupMessageSubscribe) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupMessageSubscribe.Merge(m, src)
-}
-func (m *GroupMessageSubscribe) XXX_Size() int {
-	return m.Size()
-}
-func (m *GroupMessageSubscribe) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupMessageSubscribe.DiscardUnknown(m)
+	return out, nil
 }
 
-var xxx_messageInfo_GroupMessageSubscribe proto.InternalMessageInfo
-
-type GroupMessageSubscribe_Request struct {
-	// group_pk is the identifier of the group
-	GroupPK []byte `protobuf:"bytes,1,opt,name=group_pk,json=groupPk,proto3" json:"group_pk,omitempty"`
-	// since is the lower ID bound used to filter events
-	Since []byte `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
-	// until is the upper ID bound used to filter events
-	Until []byte `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
-	// go_backwards indicates whether the events should be returned in reverse order
-	GoBackwards          bool     `protobuf:"varint,4,opt,name=go_backwards,json=goBackwards,proto3" json:"go_backwards,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (c *protocolServiceClient) MultiMemberGroupAliasResolverDisclose(ctx context.Context, in *MultiMemberGroupAliasResolverDisclose_Request, opts ...grpc.CallOption) (*MultiMemberGroupAliasResolverDisclose_Reply, error) {
+	out := new(MultiMemberGroupAliasResolverDisclose_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupAliasResolverDisclose", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GroupMessageSubscribe_Request) Reset()         { *m = GroupMessageSubscribe_Request{} }
-func (m *GroupMessageSubscribe_Request) String() string { return proto.CompactTextString(m) }
-func (*GroupMessageSubscribe_Request) ProtoMessage()    {}
-func (*GroupMessageSubscribe_Request) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{52, 0}
-}
-func (m *GroupMessageSubscribe_Request) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GroupMessageSubscribe_Request) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GroupMessageSubscribe_Request.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (c *protocolServiceClient) MultiMemberGroupAdminRoleGrant(ctx context.Context, in *MultiMemberGroupAdminRoleGrant_Request, opts ...grpc.CallOption) (*MultiMemberGroupAdminRoleGrant_Reply, error) {
+	out := new(MultiMemberGroupAdminRoleGrant_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupAdminRoleGrant", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return out, nil
 }
-func (m *GroupMessageSubscribe_Request) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GroupMessageSubscribe_Request.Merge(m, src)
-}
-func (m *GroupMessageSubscribe_Request) XXX_Size() int {
-	return m.Size()
-}
-func (m *GroupMessageSubscribe_Request) XXX_DiscardUnknown() {
-	xxx_messageInfo_GroupMessageSubscribe_Request.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GroupMessageSubscribe_Request proto.InternalMessageInfo
 
-func (m *GroupMessageSubscribe_Request) GetGroupPK() []byte {
-	if m != nil {
-		return m.GroupPK
+func (c *protocolServiceClient) MultiMemberGroupAdminRoleRevoke(ctx context.Context, in *MultiMemberGroupAdminRoleRevoke_Request, opts ...grpc.CallOption) (*MultiMemberGroupAdminRoleRevoke_Reply, error) {
+	out := new(MultiMemberGroupAdminRoleRevoke_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupAdminRoleRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
 
-func (m *GroupMessageSubscribe_Request) GetSince() []byte {
-	if m != nil {
-		return m.Since
+func (c *protocolServiceClient) MultiMemberGroupMemberRemove(ctx context.Context, in *MultiMemberGroupMemberRemove_Request, opts ...grpc.CallOption) (*MultiMemberGroupMemberRemove_Reply, error) {
+	out := new(MultiMemberGroupMemberRemove_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupMemberRemove", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
 
-func (m *GroupMessageSubscribe_Request) GetUntil() []byte {
-	if m != nil {
-		return m.Until
+func (c *protocolServiceClient) MultiMemberGroupInvitationCreate(ctx context.Context, in *MultiMemberGroupInvitationCreate_Request, opts ...grpc.CallOption) (*MultiMemberGroupInvitationCreate_Reply, error) {
+	out := new(MultiMemberGroupInvitationCreate_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupInvitationCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	return out, nil
 }
 
-func (m *GroupMessageSubscribe_Request) GetGoBackwards() bool {
-	if m != nil {
-		return m.GoBackwards
+func (c *protocolServiceClient) MultiMemberGroupInvitationRevoke(ctx context.Context, in *MultiMemberGroupInvitationRevoke_Request, opts ...grpc.CallOption) (*MultiMemberGroupInvitationRevoke_Reply, error) {
+	out := new(MultiMemberGroupInvitationRevoke_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupInvitationRevoke", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return false
+	return out, nil
 }
 
-type ShareableContact struct {
-	// contact_pk is the account to send a contact request to
-	PK []byte `protobuf:"bytes,1,opt,name=pk,proto3" json:"pk,omitempty"`
-	// contact_rendezvous_seed is the rendezvous seed used by the other account
-	PublicRendezvousSeed []byte `protobuf:"bytes,2,opt,name=public_rendezvous_seed,json=publicRendezvousSeed,proto3" json:"public_rendezvous_seed,omitempty"`
-	// contact_metadata is the metadata specific to the app to identify the contact for the request
-	Metadata             []byte   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (c *protocolServiceClient) AppMetadataSend(ctx context.Context, in *AppMetadataSend_Request, opts ...grpc.CallOption) (*AppMetadataSend_Reply, error) {
+	out := new(AppMetadataSend_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/AppMetadataSend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *ShareableContact) Reset()         { *m = ShareableContact{} }
-func (m *ShareableContact) String() string { return proto.CompactTextString(m) }
-func (*ShareableContact) ProtoMessage()    {}
-func (*ShareableContact) Descriptor() ([]byte, []int) {
-	return fileDescriptor_047e04c733cf8554, []int{53}
-}
-func (m *ShareableContact) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ShareableContact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ShareableContact.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (c *protocolServiceClient) AppMessageSend(ctx context.Context, in *AppMessageSend_Request, opts ...grpc.CallOption) (*AppMessageSend_Reply, error) {
+	out := new(AppMessageSend_Reply)
+	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/AppMessageSend", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
+	return out, nil
 }
-func (m *ShareableContact) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShareableContact.Merge(m, src)
-}
-func (m *ShareableContact) XXX_Size() int {
-	return m.Size()
-}
-func (m *ShareableContact) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShareableContact.DiscardUnknown(m)
+
+func (c *protocolServiceClient) GroupMetadataSubscribe(ctx context.Context, in *GroupMetadataSubscribe_Request, opts ...grpc.CallOption) (ProtocolService_GroupMetadataSubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProtocolService_serviceDesc.Streams[2], "/berty.protocol.ProtocolService/GroupMetadataSubscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &protocolServiceGroupMetadataSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-var xxx_messageInfo_ShareableContact proto.InternalMessageInfo
+type ProtocolService_GroupMetadataSubscribeClient interface {
+	Recv() (*bertytypes.GroupMetadataEvent, error)
+	grpc.ClientStream
+}
 
-func (m *ShareableContact) GetPK() []byte {
-	if m != nil {
-		return m.PK
-	}
-	return nil
+type protocolServiceGroupMetadataSubscribeClient struct {
+	grpc.ClientStream
 }
 
-func (m *ShareableContact) GetPublicRendezvousSeed() []byte {
-	if m != nil {
-		return m.PublicRendezvousSeed
+func (x *protocolServiceGroupMetadataSubscribeClient) Recv() (*bertytypes.GroupMetadataEvent, error) {
+	m := new(bertytypes.GroupMetadataEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return nil
+	return m, nil
 }
 
-func (m *ShareableContact) GetMetadata() []byte {
-	if m != nil {
-		return m.Metadata
+func (c *protocolServiceClient) GroupMessageSubscribe(ctx context.Context, in *GroupMessageSubscribe_Request, opts ...grpc.CallOption) (ProtocolService_GroupMessageSubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_ProtocolService_serviceDesc.Streams[3], "/berty.protocol.ProtocolService/GroupMessageSubscribe", opts...)
+	if err != nil {
+		return nil, err
 	}
-	return nil
+	x := &protocolServiceGroupMessageSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func init() {
-	proto.RegisterEnum("berty.protocol.GroupType", GroupType_name, GroupType_value)
-	proto.RegisterEnum("berty.protocol.EventType", EventType_name, EventType_value)
-	proto.RegisterEnum("berty.protocol.ContactState", ContactState_name, ContactState_value)
-	proto.RegisterEnum("berty.protocol.InstanceGetConfiguration_SettingState", InstanceGetConfiguration_SettingState_name, InstanceGetConfiguration_SettingState_value)
-	proto.RegisterType((*Account)(nil), "berty.protocol.Account")
-	proto.RegisterType((*Group)(nil), "berty.protocol.Group")
-	proto.RegisterType((*GroupMetadata)(nil), "berty.protocol.GroupMetadata")
-	proto.RegisterType((*GroupEnvelope)(nil), "berty.protocol.GroupEnvelope")
-	proto.RegisterType((*MessageHeaders)(nil), "berty.protocol.MessageHeaders")
-	proto.RegisterType((*MessageEnvelope)(nil), "berty.protocol.MessageEnvelope")
-	proto.RegisterType((*EventContext)(nil), "berty.protocol.EventContext")
-	proto.RegisterType((*AppMetadata)(nil), "berty.protocol.AppMetadata")
-	proto.RegisterType((*ContactAddAliasKey)(nil), "berty.protocol.ContactAddAliasKey")
-	proto.RegisterType((*GroupAddMemberDevice)(nil), "berty.protocol.GroupAddMemberDevice")
-	proto.RegisterType((*DeviceSecret)(nil), "berty.protocol.DeviceSecret")
-	proto.RegisterType((*GroupAddDeviceSecret)(nil), "berty.protocol.GroupAddDeviceSecret")
-	proto.RegisterType((*MultiMemberGroupAddAliasResolver)(nil), "berty.protocol.MultiMemberGroupAddAliasResolver")
-	proto.RegisterType((*MultiMemberGrantAdminRole)(nil), "berty.protocol.MultiMemberGrantAdminRole")
-	proto.RegisterType((*MultiMemberInitialMember)(nil), "berty.protocol.MultiMemberInitialMember")
-	proto.RegisterType((*GroupAddAdditionalRendezvousSeed)(nil), "berty.protocol.GroupAddAdditionalRendezvousSeed")
-	proto.RegisterType((*GroupRemoveAdditionalRendezvousSeed)(nil), "berty.protocol.GroupRemoveAdditionalRendezvousSeed")
-	proto.RegisterType((*AccountGroupJoined)(nil), "berty.protocol.AccountGroupJoined")
-	proto.RegisterType((*AccountGroupLeft)(nil), "berty.protocol.AccountGroupLeft")
-	proto.RegisterType((*AccountContactRequestDisabled)(nil), "berty.protocol.AccountContactRequestDisabled")
-	proto.RegisterType((*AccountContactRequestEnabled)(nil), "berty.protocol.AccountContactRequestEnabled")
-	proto.RegisterType((*AccountContactRequestReferenceReset)(nil), "berty.protocol.AccountContactRequestReferenceReset")
-	proto.RegisterType((*AccountContactRequestEnqueued)(nil), "berty.protocol.AccountContactRequestEnqueued")
-	proto.RegisterType((*AccountContactRequestSent)(nil), "berty.protocol.AccountContactRequestSent")
-	proto.RegisterType((*AccountContactRequestReceived)(nil), "berty.protocol.AccountContactRequestReceived")
-	proto.RegisterType((*AccountContactRequestDiscarded)(nil), "berty.protocol.AccountContactRequestDiscarded")
-	proto.RegisterType((*AccountContactRequestAccepted)(nil), "berty.protocol.AccountContactRequestAccepted")
-	proto.RegisterType((*AccountContactBlocked)(nil), "berty.protocol.AccountContactBlocked")
-	proto.RegisterType((*AccountContactUnblocked)(nil), "berty.protocol.AccountContactUnblocked")
-	proto.RegisterType((*InstanceExportData)(nil), "berty.protocol.InstanceExportData")
-	proto.RegisterType((*InstanceExportData_Request)(nil), "berty.protocol.InstanceExportData.Request")
-	proto.RegisterType((*InstanceExportData_Reply)(nil), "berty.protocol.InstanceExportData.Reply")
-	proto.RegisterType((*InstanceGetConfiguration)(nil), "berty.protocol.InstanceGetConfiguration")
-	proto.RegisterType((*InstanceGetConfiguration_Request)(nil), "berty.protocol.InstanceGetConfiguration.Request")
-	proto.RegisterType((*InstanceGetConfiguration_Reply)(nil), "berty.protocol.InstanceGetConfiguration.Reply")
-	proto.RegisterType((*ContactRequestReference)(nil), "berty.protocol.ContactRequestReference")
-	proto.RegisterType((*ContactRequestReference_Request)(nil), "berty.protocol.ContactRequestReference.Request")
-	proto.RegisterType((*ContactRequestReference_Reply)(nil), "berty.protocol.ContactRequestReference.Reply")
-	proto.RegisterType((*ContactRequestDisable)(nil), "berty.protocol.ContactRequestDisable")
-	proto.RegisterType((*ContactRequestDisable_Request)(nil), "berty.protocol.ContactRequestDisable.Request")
-	proto.RegisterType((*ContactRequestDisable_Reply)(nil), "berty.protocol.ContactRequestDisable.Reply")
-	proto.RegisterType((*ContactRequestEnable)(nil), "berty.protocol.ContactRequestEnable")
-	proto.RegisterType((*ContactRequestEnable_Request)(nil), "berty.protocol.ContactRequestEnable.Request")
-	proto.RegisterType((*ContactRequestEnable_Reply)(nil), "berty.protocol.ContactRequestEnable.Reply")
-	proto.RegisterType((*ContactRequestResetReference)(nil), "berty.protocol.ContactRequestResetReference")
-	proto.RegisterType((*ContactRequestResetReference_Request)(nil), "berty.protocol.ContactRequestResetReference.Request")
-	proto.RegisterType((*ContactRequestResetReference_Reply)(nil), "berty.protocol.ContactRequestResetReference.Reply")
-	proto.RegisterType((*ContactRequestSend)(nil), "berty.protocol.ContactRequestSend")
-	proto.RegisterType((*ContactRequestSend_Request)(nil), "berty.protocol.ContactRequestSend.Request")
-	proto.RegisterType((*ContactRequestSend_Reply)(nil), "berty.protocol.ContactRequestSend.Reply")
-	proto.RegisterType((*ContactRequestAccept)(nil), "berty.protocol.ContactRequestAccept")
-	proto.RegisterType((*ContactRequestAccept_Request)(nil), "berty.protocol.ContactRequestAccept.Request")
-	proto.RegisterType((*ContactRequestAccept_Reply)(nil), "berty.protocol.ContactRequestAccept.Reply")
-	proto.RegisterType((*ContactRequestDiscard)(nil), "berty.protocol.ContactRequestDiscard")
-	proto.RegisterType((*ContactRequestDiscard_Request)(nil), "berty.protocol.ContactRequestDiscard.Request")
-	proto.RegisterType((*ContactRequestDiscard_Reply)(nil), "berty.protocol.ContactRequestDiscard.Reply")
-	proto.RegisterType((*ContactBlock)(nil), "berty.protocol.ContactBlock")
-	proto.RegisterType((*ContactBlock_Request)(nil), "berty.protocol.ContactBlock.Request")
-	proto.RegisterType((*ContactBlock_Reply)(nil), "berty.protocol.ContactBlock.Reply")
-	proto.RegisterType((*ContactUnblock)(nil), "berty.protocol.ContactUnblock")
-	proto.RegisterType((*ContactUnblock_Request)(nil), "berty.protocol.ContactUnblock.Request")
-	proto.RegisterType((*ContactUnblock_Reply)(nil), "berty.protocol.ContactUnblock.Reply")
-	proto.RegisterType((*ContactAliasKeySend)(nil), "berty.protocol.ContactAliasKeySend")
-	proto.RegisterType((*ContactAliasKeySend_Request)(nil), "berty.protocol.ContactAliasKeySend.Request")
-	proto.RegisterType((*ContactAliasKeySend_Reply)(nil), "berty.protocol.ContactAliasKeySend.Reply")
-	proto.RegisterType((*MultiMemberGroupCreate)(nil), "berty.protocol.MultiMemberGroupCreate")
-	proto.RegisterType((*MultiMemberGroupCreate_Request)(nil), "berty.protocol.MultiMemberGroupCreate.Request")
-	proto.RegisterType((*MultiMemberGroupCreate_Reply)(nil), "berty.protocol.MultiMemberGroupCreate.Reply")
-	proto.RegisterType((*MultiMemberGroupJoin)(nil), "berty.protocol.MultiMemberGroupJoin")
-	proto.RegisterType((*MultiMemberGroupJoin_Request)(nil), "berty.protocol.MultiMemberGroupJoin.Request")
-	proto.RegisterType((*MultiMemberGroupJoin_Reply)(nil), "berty.protocol.MultiMemberGroupJoin.Reply")
-	proto.RegisterType((*MultiMemberGroupLeave)(nil), "berty.protocol.MultiMemberGroupLeave")
-	proto.RegisterType((*MultiMemberGroupLeave_Request)(nil), "berty.protocol.MultiMemberGroupLeave.Request")
-	proto.RegisterType((*MultiMemberGroupLeave_Reply)(nil), "berty.protocol.MultiMemberGroupLeave.Reply")
-	proto.RegisterType((*MultiMemberGroupAliasResolverDisclose)(nil), "berty.protocol.MultiMemberGroupAliasResolverDisclose")
-	proto.RegisterType((*MultiMemberGroupAliasResolverDisclose_Request)(nil), "berty.protocol.MultiMemberGroupAliasResolverDisclose.Request")
-	proto.RegisterType((*MultiMemberGroupAliasResolverDisclose_Reply)(nil), "berty.protocol.MultiMemberGroupAliasResolverDisclose.Reply")
-	proto.RegisterType((*MultiMemberGroupAdminRoleGrant)(nil), "berty.protocol.MultiMemberGroupAdminRoleGrant")
-	proto.RegisterType((*MultiMemberGroupAdminRoleGrant_Request)(nil), "berty.protocol.MultiMemberGroupAdminRoleGrant.Request")
-	proto.RegisterType((*MultiMemberGroupAdminRoleGrant_Reply)(nil), "berty.protocol.MultiMemberGroupAdminRoleGrant.Reply")
-	proto.RegisterType((*MultiMemberGroupInvitationCreate)(nil), "berty.protocol.MultiMemberGroupInvitationCreate")
-	proto.RegisterType((*MultiMemberGroupInvitationCreate_Request)(nil), "berty.protocol.MultiMemberGroupInvitationCreate.Request")
-	proto.RegisterType((*MultiMemberGroupInvitationCreate_Reply)(nil), "berty.protocol.MultiMemberGroupInvitationCreate.Reply")
-	proto.RegisterType((*AppMetadataSend)(nil), "berty.protocol.AppMetadataSend")
-	proto.RegisterType((*AppMetadataSend_Request)(nil), "berty.protocol.AppMetadataSend.Request")
-	proto.RegisterType((*AppMetadataSend_Reply)(nil), "berty.protocol.AppMetadataSend.Reply")
-	proto.RegisterType((*AppMessageSend)(nil), "berty.protocol.AppMessageSend")
-	proto.RegisterType((*AppMessageSend_Request)(nil), "berty.protocol.AppMessageSend.Request")
-	proto.RegisterType((*AppMessageSend_Reply)(nil), "berty.protocol.AppMessageSend.Reply")
-	proto.RegisterType((*GroupMetadataEvent)(nil), "berty.protocol.GroupMetadataEvent")
-	proto.RegisterType((*GroupMessageEvent)(nil), "berty.protocol.GroupMessageEvent")
-	proto.RegisterType((*GroupMetadataSubscribe)(nil), "berty.protocol.GroupMetadataSubscribe")
-	proto.RegisterType((*GroupMetadataSubscribe_Request)(nil), "berty.protocol.GroupMetadataSubscribe.Request")
-	proto.RegisterType((*GroupMessageSubscribe)(nil), "berty.protocol.GroupMessageSubscribe")
-	proto.RegisterType((*GroupMessageSubscribe_Request)(nil), "berty.protocol.GroupMessageSubscribe.Request")
-	proto.RegisterType((*ShareableContact)(nil), "berty.protocol.ShareableContact")
+type ProtocolService_GroupMessageSubscribeClient interface {
+	Recv() (*bertytypes.GroupMessageEvent, error)
+	grpc.ClientStream
 }
 
-func init() { proto.RegisterFile("bertyprotocol.proto", fileDescriptor_047e04c733cf8554) }
-
-var fileDescriptor_047e04c733cf8554 = []byte{
-	// 2598 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0xdf, 0x1e, 0xc7, 0x1f, 0xf3, 0x3c, 0x1e, 0x77, 0x2a, 0xb6, 0xe3, 0x78, 0xe3, 0x8f, 0xed,
-	0xac, 0xf3, 0xe1, 0x78, 0xc7, 0xc1, 0x1b, 0xb2, 0x41, 0x0b, 0x42, 0xf6, 0xda, 0xca, 0x7a, 0x9d,
-	0x88, 0xa1, 0x27, 0x81, 0xb0, 0x42, 0x8c, 0x7a, 0xba, 0xcb, 0xed, 0xce, 0xcc, 0x74, 0x77, 0xba,
-	0x7b, 0x26, 0x6b, 0x94, 0x03, 0x12, 0x87, 0x45, 0x5a, 0x89, 0x0b, 0x20, 0x24, 0xb8, 0x20, 0x71,
-	0x41, 0x48, 0x80, 0xf8, 0x13, 0xe0, 0xb4, 0xdc, 0x72, 0x47, 0xb2, 0x60, 0x24, 0x0e, 0xfc, 0x17,
-	0xa8, 0x3e, 0xba, 0xa6, 0xbb, 0xa7, 0x7b, 0x3e, 0xe2, 0x78, 0xc5, 0xad, 0xab, 0xea, 0xf7, 0x7e,
-	0xef, 0xd5, 0xab, 0x57, 0xf5, 0xaa, 0xde, 0x0c, 0x5c, 0xaa, 0x61, 0x2f, 0x38, 0x71, 0x3d, 0x27,
-	0x70, 0x74, 0xa7, 0x51, 0xa2, 0x1f, 0xa8, 0x48, 0x3b, 0x4b, 0x61, 0xef, 0xd2, 0x7b, 0xa6, 0x15,
-	0x1c, 0xb7, 0x6a, 0x25, 0xdd, 0x69, 0x6e, 0x99, 0x8e, 0xe9, 0x6c, 0xd1, 0x91, 0x5a, 0xeb, 0x88,
-	0xb6, 0x68, 0x83, 0x7e, 0x31, 0x09, 0xe5, 0x4b, 0x09, 0x26, 0x77, 0x74, 0xdd, 0x69, 0xd9, 0x01,
-	0xba, 0x0d, 0xe3, 0xa6, 0xe7, 0xb4, 0xdc, 0x45, 0x69, 0x4d, 0xba, 0x39, 0xbd, 0x3d, 0x5f, 0x8a,
-	0x53, 0x97, 0x1e, 0x90, 0x41, 0x95, 0x61, 0x50, 0x09, 0x2e, 0x69, 0x4c, 0xae, 0xea, 0x7a, 0x56,
-	0x5b, 0x0b, 0x70, 0xb5, 0x8e, 0x4f, 0x16, 0x73, 0x6b, 0xd2, 0xcd, 0x82, 0x7a, 0x91, 0x0f, 0x95,
-	0xd9, 0xc8, 0x21, 0x3e, 0x41, 0x1b, 0x70, 0x51, 0x6b, 0x58, 0x9a, 0x1f, 0x43, 0x8f, 0x51, 0xf4,
-	0x2c, 0x1d, 0x88, 0x60, 0xef, 0xc2, 0x82, 0xdb, 0xaa, 0x35, 0x2c, 0xbd, 0xea, 0x61, 0xdb, 0xc0,
-	0x3f, 0x6e, 0x3b, 0x2d, 0xbf, 0xea, 0x63, 0x6c, 0x2c, 0x5e, 0xa0, 0x02, 0x73, 0x6c, 0x54, 0x15,
-	0x83, 0x15, 0x8c, 0x0d, 0xe5, 0xd7, 0x12, 0x8c, 0x53, 0x13, 0xd1, 0x32, 0x00, 0x97, 0x27, 0x4a,
-	0x24, 0x2a, 0x93, 0x67, 0x3d, 0x84, 0x7e, 0x01, 0x26, 0x7c, 0xac, 0x7b, 0x38, 0xe0, 0xd6, 0xf2,
-	0x16, 0x11, 0x63, 0x5f, 0x55, 0xdf, 0x32, 0xb9, 0x6d, 0x79, 0xd6, 0x53, 0xb1, 0x4c, 0x74, 0x1f,
-	0x80, 0x4e, 0xbd, 0x1a, 0x9c, 0xb8, 0x98, 0x5a, 0x52, 0xdc, 0xbe, 0x92, 0xea, 0xa3, 0xc7, 0x27,
-	0x2e, 0x56, 0xf3, 0x66, 0xf8, 0xa9, 0xb4, 0x60, 0x86, 0xf6, 0x3f, 0xc2, 0x81, 0x66, 0x68, 0x81,
-	0x46, 0xa8, 0x70, 0x1b, 0xdb, 0x01, 0xa3, 0x92, 0xd2, 0xa9, 0xf6, 0x09, 0x82, 0x51, 0xe1, 0xf0,
-	0x13, 0x2d, 0xc2, 0xa4, 0xab, 0x9d, 0x34, 0x1c, 0xcd, 0xe0, 0xc6, 0x87, 0x4d, 0x24, 0xc3, 0x58,
-	0xd7, 0x6c, 0xf2, 0xa9, 0x7c, 0xc8, 0xd5, 0xee, 0xdb, 0x6d, 0xdc, 0x70, 0x5c, 0x8c, 0xe6, 0x60,
-	0xdc, 0x76, 0x6c, 0x1d, 0x73, 0x97, 0xb0, 0x06, 0xe9, 0xa5, 0xfc, 0x9c, 0x90, 0x35, 0x14, 0x13,
-	0x8a, 0x8f, 0xb0, 0xef, 0x6b, 0x26, 0xfe, 0x18, 0x6b, 0x06, 0xf6, 0x7c, 0xa2, 0x9a, 0x2e, 0x2a,
-	0xf6, 0xa8, 0xfc, 0x05, 0x35, 0x6c, 0xa2, 0x5b, 0x90, 0x37, 0x70, 0xdb, 0xd2, 0x71, 0xd5, 0xad,
-	0x33, 0x96, 0xdd, 0x42, 0xe7, 0x74, 0x75, 0x6a, 0x8f, 0x76, 0x96, 0x0f, 0xd5, 0x29, 0x36, 0x5c,
-	0xae, 0xa7, 0x58, 0xf9, 0x0c, 0x66, 0xb9, 0x22, 0x61, 0xe7, 0x0d, 0x98, 0x6d, 0xb2, 0xae, 0xea,
-	0x31, 0x53, 0xce, 0x2d, 0x2e, 0x36, 0x7b, 0x4c, 0xe2, 0x3d, 0xa1, 0x37, 0x78, 0xb3, 0x3b, 0xd5,
-	0xb1, 0xc8, 0x54, 0x95, 0x97, 0x50, 0xa0, 0x5e, 0xfd, 0xc8, 0xb1, 0x03, 0xfc, 0x59, 0x80, 0x16,
-	0x20, 0x67, 0x19, 0x8c, 0x7b, 0x77, 0xa2, 0x73, 0xba, 0x9a, 0x3b, 0xd8, 0x53, 0x73, 0x96, 0x81,
-	0x36, 0x01, 0x5c, 0xcd, 0x23, 0x0b, 0x64, 0x19, 0xfe, 0x62, 0x6e, 0x6d, 0xec, 0x66, 0x61, 0x77,
-	0xa6, 0x73, 0xba, 0x9a, 0x2f, 0xd3, 0xde, 0x83, 0x3d, 0x5f, 0xcd, 0x33, 0xc0, 0x81, 0xe1, 0xa3,
-	0xeb, 0x30, 0xc5, 0x02, 0xc3, 0xad, 0x33, 0x75, 0xbb, 0xd3, 0x9d, 0xd3, 0xd5, 0x49, 0xea, 0xfb,
-	0xf2, 0xa1, 0x3a, 0x49, 0x07, 0xcb, 0x75, 0x45, 0x85, 0xe9, 0x1d, 0xb7, 0x1b, 0x04, 0x31, 0xaf,
-	0x49, 0x7d, 0xbd, 0x96, 0x39, 0x4f, 0xc5, 0x04, 0x44, 0x26, 0xa3, 0xe9, 0xc1, 0x8e, 0x61, 0xec,
-	0x90, 0x7d, 0x44, 0x22, 0x7c, 0x04, 0xea, 0xeb, 0x30, 0xc5, 0xf7, 0x65, 0xb8, 0x74, 0xd4, 0x78,
-	0x4a, 0x45, 0x8c, 0x67, 0x7b, 0xb3, 0xae, 0x7c, 0x21, 0xc1, 0x1c, 0x9d, 0xd1, 0x8e, 0x61, 0x3c,
-	0xc2, 0xcd, 0x1a, 0xf6, 0x18, 0x19, 0xd1, 0xd5, 0xa4, 0xed, 0x84, 0x2e, 0x06, 0x22, 0xba, 0xd8,
-	0x70, 0xb9, 0x3e, 0x4a, 0x9c, 0x2c, 0x03, 0x70, 0xd6, 0xc8, 0x5e, 0x64, 0x3d, 0x15, 0xcb, 0x54,
-	0xf6, 0xa1, 0xc0, 0x84, 0x2a, 0x6c, 0xeb, 0xbe, 0x0d, 0x79, 0xfd, 0x58, 0xb3, 0xec, 0xc8, 0x86,
-	0x9f, 0xa2, 0x1d, 0xc4, 0x1b, 0x91, 0xc0, 0xcd, 0xc5, 0x02, 0x57, 0xf9, 0x45, 0x64, 0x52, 0x31,
-	0xbe, 0x11, 0x1c, 0x78, 0x0f, 0x8a, 0x06, 0xf6, 0x83, 0x6a, 0xd7, 0x09, 0x6c, 0x66, 0x72, 0xe7,
-	0x74, 0xb5, 0xb0, 0x87, 0xfd, 0x40, 0x38, 0xa2, 0x60, 0x74, 0x5b, 0xf5, 0xe8, 0x4e, 0x1e, 0x8b,
-	0xed, 0x64, 0xe5, 0x57, 0x12, 0xac, 0x3d, 0x6a, 0x35, 0x02, 0x8b, 0x61, 0x43, 0x03, 0xe9, 0x92,
-	0xa8, 0xd8, 0x77, 0x1a, 0xed, 0xe4, 0x9e, 0xeb, 0x6f, 0xe1, 0x3a, 0x14, 0xd9, 0x12, 0x7b, 0x5c,
-	0x98, 0x07, 0xd1, 0x8c, 0x16, 0x63, 0x5c, 0x85, 0xe9, 0xf0, 0x84, 0x76, 0x9c, 0x23, 0x6e, 0x14,
-	0xf0, 0xb3, 0xd9, 0x71, 0x8e, 0x94, 0xcf, 0x25, 0xb8, 0x12, 0xb3, 0x4b, 0xb3, 0x83, 0x1d, 0xa3,
-	0x69, 0xd9, 0xaa, 0xd3, 0xc0, 0xa3, 0x18, 0xf4, 0x6d, 0xb8, 0x68, 0x12, 0x61, 0x8c, 0x7b, 0xbc,
-	0x76, 0xa9, 0x73, 0xba, 0x3a, 0xfb, 0x80, 0x0d, 0x0a, 0xc7, 0xcd, 0x9a, 0xb1, 0x8e, 0xba, 0xb2,
-	0x0f, 0x8b, 0x11, 0x43, 0x0e, 0x6c, 0x2b, 0xb0, 0xb4, 0x06, 0x6b, 0x8c, 0x10, 0x8f, 0x8a, 0x06,
-	0x6b, 0xc2, 0xb9, 0x86, 0x61, 0x05, 0x96, 0x63, 0x6b, 0x8d, 0x78, 0x56, 0x19, 0x65, 0x5a, 0x08,
-	0x2e, 0xd0, 0x24, 0xc5, 0xbc, 0x4b, 0xbf, 0x15, 0x03, 0xae, 0xb1, 0xb4, 0x89, 0x9b, 0x4e, 0x1b,
-	0x9f, 0x97, 0x96, 0x06, 0x20, 0x9e, 0xc4, 0xa9, 0xb2, 0x4f, 0x1c, 0xcb, 0x1e, 0x8d, 0x54, 0xa4,
-	0xfe, 0xdc, 0xe0, 0xd4, 0xaf, 0x60, 0x90, 0xa3, 0xda, 0x1e, 0xe2, 0xa3, 0x60, 0xc4, 0x13, 0x47,
-	0x1c, 0x97, 0xb9, 0x3e, 0xc7, 0xe5, 0x27, 0xb0, 0xcc, 0xd5, 0xf0, 0x13, 0x4e, 0xc5, 0xcf, 0x5b,
-	0xd8, 0x0f, 0xf6, 0x2c, 0x5f, 0xab, 0x35, 0x46, 0x9a, 0x9f, 0x72, 0x00, 0x57, 0x53, 0xb9, 0xf6,
-	0xed, 0x91, 0xa9, 0x4e, 0xe0, 0x5a, 0x2a, 0x95, 0x8a, 0x8f, 0xb0, 0x87, 0x6d, 0x1d, 0xab, 0xd8,
-	0x1f, 0xed, 0x04, 0xb9, 0x01, 0xb3, 0xc9, 0x7b, 0x0e, 0x5b, 0xdc, 0xa2, 0x17, 0xbf, 0xe1, 0xfc,
-	0x34, 0x97, 0xe1, 0x92, 0x7d, 0xfb, 0x79, 0x0b, 0xb7, 0x46, 0x5b, 0xf2, 0x4d, 0x00, 0x9d, 0x91,
-	0x74, 0x17, 0x82, 0xe6, 0x38, 0x4e, 0x5d, 0x3e, 0x54, 0xf3, 0x1c, 0x90, 0x58, 0xb4, 0xf1, 0xec,
-	0x45, 0x43, 0xf7, 0xe0, 0x72, 0xc8, 0x9a, 0x9c, 0x13, 0x3b, 0x50, 0xe6, 0xf5, 0xd0, 0xf2, 0xc4,
-	0x06, 0x90, 0x43, 0xb9, 0x26, 0x4f, 0x90, 0xfc, 0xb2, 0x37, 0xcb, 0xfb, 0xc3, 0xbc, 0xa9, 0x04,
-	0x70, 0x25, 0xd5, 0x09, 0x15, 0x6c, 0x07, 0xe7, 0xe6, 0x00, 0xe5, 0x9f, 0x52, 0x86, 0xef, 0x55,
-	0xac, 0x63, 0xab, 0x7d, 0x9e, 0xbe, 0xff, 0x0a, 0x7c, 0x7a, 0x02, 0x2b, 0x59, 0x7b, 0x4d, 0xd7,
-	0x3c, 0xe3, 0x1c, 0x67, 0xa7, 0xfc, 0x2e, 0xcb, 0xb1, 0x3b, 0xba, 0x8e, 0xdd, 0xe0, 0xab, 0x0a,
-	0xea, 0x7e, 0x17, 0x37, 0x17, 0xe6, 0xe3, 0x16, 0xee, 0x36, 0x1c, 0xbd, 0x7e, 0x9e, 0x4e, 0xf1,
-	0xe0, 0x72, 0x5c, 0xe3, 0x13, 0xbb, 0x76, 0xde, 0x3a, 0x1f, 0x01, 0x3a, 0xb0, 0xfd, 0x40, 0xb3,
-	0x75, 0xbc, 0xff, 0x99, 0xeb, 0x78, 0xc1, 0x9e, 0x16, 0x68, 0x4b, 0x79, 0x98, 0xe4, 0xeb, 0xb1,
-	0xb4, 0x09, 0xe3, 0x2a, 0x76, 0x1b, 0x27, 0xe8, 0x1a, 0xcc, 0x60, 0x8a, 0xc0, 0x46, 0x95, 0x46,
-	0x15, 0xbb, 0x71, 0x15, 0xc2, 0x4e, 0x22, 0xa8, 0xfc, 0x6d, 0x1c, 0x16, 0x43, 0xbe, 0x07, 0x98,
-	0xcc, 0xe3, 0xc8, 0x32, 0x5b, 0x9e, 0x46, 0xf2, 0x5f, 0x94, 0xf5, 0xd5, 0x85, 0x90, 0x76, 0x13,
-	0x40, 0x3c, 0x29, 0xc3, 0xa9, 0x51, 0x73, 0xb9, 0x2b, 0x88, 0xb9, 0xe1, 0xc3, 0x72, 0xa4, 0xcb,
-	0xe4, 0x37, 0x41, 0x0e, 0x89, 0x13, 0xeb, 0x8d, 0x3a, 0xa7, 0xab, 0xc5, 0x68, 0x32, 0x2b, 0x1f,
-	0xaa, 0x45, 0x2d, 0xda, 0xae, 0xa3, 0x6b, 0x30, 0xe9, 0x62, 0xec, 0x55, 0x2d, 0xf6, 0xfc, 0xcc,
-	0xef, 0x42, 0xe7, 0x74, 0x75, 0xa2, 0x8c, 0xb1, 0x77, 0xb0, 0xa7, 0x4e, 0x90, 0xa1, 0x03, 0x03,
-	0x5d, 0x85, 0x7c, 0xc3, 0xf2, 0x03, 0x6c, 0x93, 0xc7, 0xca, 0xf8, 0xda, 0xd8, 0xcd, 0xbc, 0xda,
-	0xed, 0x40, 0xdf, 0x83, 0xe9, 0x5a, 0x03, 0x57, 0x31, 0xcb, 0x36, 0x8b, 0x13, 0xf4, 0xc1, 0xf7,
-	0xf5, 0x64, 0x92, 0xcd, 0xf2, 0x56, 0xa9, 0x82, 0x83, 0xc0, 0xb2, 0xcd, 0x4a, 0xa0, 0x05, 0x58,
-	0x85, 0x5a, 0x03, 0x87, 0x69, 0xab, 0x0a, 0xf2, 0x0b, 0xeb, 0xc8, 0xaa, 0xba, 0xdb, 0xae, 0x20,
-	0x9f, 0x3c, 0x0b, 0x79, 0x91, 0xd0, 0x95, 0xb7, 0xdd, 0x50, 0xc1, 0x53, 0x28, 0x34, 0x0d, 0xdb,
-	0x17, 0xe4, 0x53, 0x67, 0x21, 0x9f, 0x26, 0x54, 0x21, 0xf3, 0xa7, 0x30, 0xe3, 0xe1, 0x86, 0x76,
-	0x22, 0xa8, 0xf3, 0x67, 0xa1, 0x2e, 0x50, 0x2e, 0xce, 0xad, 0x3c, 0x80, 0x42, 0x74, 0x14, 0x4d,
-	0xc3, 0xe4, 0x13, 0xbb, 0x6e, 0x3b, 0x2f, 0x6c, 0xf9, 0x2d, 0xd2, 0xe0, 0x38, 0x59, 0x42, 0x05,
-	0x98, 0x0a, 0xaf, 0x13, 0x72, 0x0e, 0xcd, 0xc2, 0xf4, 0x13, 0x5b, 0x6b, 0x6b, 0x56, 0x83, 0xf4,
-	0xc8, 0x63, 0xca, 0x21, 0x5c, 0xce, 0x48, 0xf2, 0xd1, 0x08, 0x5e, 0x0f, 0x03, 0xf8, 0x2a, 0xe4,
-	0xbd, 0x10, 0x10, 0x96, 0x1d, 0x44, 0x87, 0x72, 0x1b, 0xe6, 0x53, 0x2f, 0x32, 0x51, 0xaa, 0x49,
-	0x4e, 0xa5, 0x7c, 0x0c, 0x73, 0x69, 0x37, 0x95, 0xd7, 0x50, 0x5b, 0x86, 0xab, 0xc9, 0x39, 0xf8,
-	0xf8, 0x4c, 0x13, 0x79, 0x2e, 0xde, 0x9c, 0xdd, 0xcc, 0x6b, 0x2c, 0xa9, 0x82, 0xa7, 0xbf, 0x78,
-	0x6a, 0x5a, 0xca, 0xa5, 0xa6, 0xa5, 0xae, 0x3b, 0x9e, 0x26, 0xdd, 0xc1, 0x92, 0xc3, 0xd2, 0x07,
-	0x5d, 0xa5, 0xf1, 0xc3, 0x4e, 0xea, 0x7f, 0xd8, 0x75, 0x99, 0x7f, 0x90, 0xb2, 0x2a, 0x24, 0xe5,
-	0xbd, 0x01, 0xea, 0x32, 0x14, 0xa2, 0xf9, 0xe2, 0x0d, 0x30, 0xaa, 0x50, 0x8c, 0xe7, 0x83, 0x37,
-	0xc0, 0xf9, 0x5d, 0xb8, 0x14, 0x56, 0x10, 0x78, 0xf9, 0x80, 0x2e, 0xe7, 0xd7, 0xba, 0xc4, 0xd1,
-	0x34, 0x29, 0x65, 0xa7, 0xc9, 0x2e, 0xe5, 0x63, 0x58, 0x48, 0xbe, 0x5f, 0x3f, 0xf2, 0xb0, 0x16,
-	0xc4, 0x82, 0x6d, 0x2b, 0x0c, 0xb6, 0x21, 0xe9, 0x95, 0xef, 0xc3, 0x5c, 0x92, 0x95, 0x3c, 0x74,
-	0x96, 0xee, 0x75, 0x2d, 0x1d, 0xa5, 0x82, 0xd9, 0x35, 0xb7, 0x02, 0xf3, 0x49, 0xe2, 0x87, 0x58,
-	0x6b, 0xe3, 0x33, 0xf9, 0x40, 0x87, 0xf5, 0x9e, 0x37, 0x7c, 0xf4, 0xb9, 0x4d, 0xc2, 0xac, 0xe1,
-	0xf8, 0x67, 0x53, 0xf2, 0xb9, 0x04, 0x2b, 0xbd, 0x95, 0x02, 0xfe, 0x22, 0xa7, 0xaf, 0xe8, 0xa5,
-	0x1f, 0x8e, 0x4c, 0x1f, 0x7f, 0x41, 0xe7, 0xfa, 0xbd, 0xa0, 0xbb, 0x96, 0x7c, 0x91, 0x52, 0xb3,
-	0x38, 0xb0, 0xdb, 0x56, 0x40, 0x0f, 0x6b, 0xbe, 0xfa, 0xaf, 0x31, 0xd5, 0xbb, 0x61, 0x94, 0x8c,
-	0xb2, 0xb4, 0x8a, 0x09, 0xb3, 0x91, 0x4a, 0x1b, 0x8d, 0xe7, 0xc3, 0xd1, 0xfd, 0x90, 0x59, 0x6b,
-	0xed, 0x4e, 0xfb, 0x08, 0x8a, 0x54, 0x11, 0x2d, 0xc6, 0x9d, 0xa3, 0x9e, 0x3f, 0x48, 0x80, 0x62,
-	0x25, 0x64, 0x5a, 0xc6, 0x44, 0x3b, 0x30, 0xc3, 0xea, 0xc8, 0x3a, 0x2b, 0x68, 0x72, 0xe7, 0x5c,
-	0x4d, 0x2d, 0x25, 0xf3, 0xa2, 0xa7, 0x5a, 0xc0, 0xd1, 0x12, 0xe8, 0x37, 0x60, 0x2a, 0x76, 0x0a,
-	0x4f, 0x6f, 0x2f, 0xa7, 0xba, 0x36, 0x54, 0xac, 0x0a, 0x78, 0xb7, 0x70, 0x3c, 0x16, 0x2d, 0x1c,
-	0xff, 0x51, 0x82, 0x8b, 0x5c, 0x82, 0x55, 0x75, 0xdf, 0x94, 0xa5, 0xf7, 0x61, 0x32, 0xac, 0x06,
-	0x33, 0x43, 0x57, 0x92, 0xc2, 0xf1, 0x82, 0xb5, 0x1a, 0xc2, 0xa3, 0xe5, 0xd3, 0xb1, 0x78, 0xf9,
-	0xf4, 0xb7, 0x12, 0x2c, 0xc4, 0xa6, 0x57, 0x69, 0xd5, 0x7c, 0xdd, 0xb3, 0x6a, 0x78, 0xe9, 0x27,
-	0xd2, 0xe8, 0x2b, 0x39, 0x07, 0xe3, 0xbe, 0x45, 0x92, 0x1e, 0x2f, 0xa5, 0xd3, 0x06, 0xe9, 0x6d,
-	0xd9, 0x81, 0xd5, 0x08, 0xfd, 0x44, 0x1b, 0xe8, 0x1d, 0x28, 0x98, 0x4e, 0xb5, 0xa6, 0xe9, 0xf5,
-	0x17, 0x9a, 0x67, 0xf8, 0xf4, 0x6e, 0x39, 0xa5, 0x4e, 0x9b, 0xce, 0x6e, 0xd8, 0xa5, 0xfc, 0x46,
-	0x82, 0xf9, 0xa8, 0x2b, 0xff, 0xaf, 0x8c, 0x7b, 0x09, 0x72, 0xe5, 0x58, 0xf3, 0x30, 0xb9, 0x96,
-	0xf0, 0x04, 0x82, 0x16, 0x20, 0x27, 0x8c, 0xa0, 0xf5, 0xf4, 0xf2, 0xa1, 0x9a, 0x73, 0xeb, 0x7d,
-	0x7e, 0xd0, 0xc9, 0x65, 0xff, 0xa0, 0x83, 0x96, 0x22, 0xa1, 0xc9, 0xac, 0x13, 0xed, 0x0d, 0x0b,
-	0xf2, 0xe2, 0xa7, 0x16, 0xb4, 0xc0, 0x37, 0x07, 0x69, 0x3c, 0xb1, 0x0d, 0x7c, 0x64, 0xd9, 0xd8,
-	0x90, 0xdf, 0x42, 0x73, 0x20, 0x8b, 0x7e, 0x7e, 0xc9, 0x97, 0xa5, 0x58, 0x2f, 0x37, 0x5c, 0xce,
-	0xa1, 0x45, 0x5e, 0x09, 0x26, 0xbd, 0x91, 0x83, 0x4c, 0x1e, 0xdb, 0xf8, 0xcf, 0x04, 0xe4, 0xc5,
-	0x6f, 0x31, 0x44, 0x97, 0x68, 0x44, 0x75, 0x5d, 0x83, 0x55, 0xd1, 0xcf, 0xd7, 0xac, 0x5b, 0x24,
-	0xdf, 0x31, 0x0c, 0x7a, 0xdd, 0xec, 0x01, 0x45, 0x8b, 0xce, 0x0c, 0x94, 0x43, 0xab, 0xf0, 0xb6,
-	0x00, 0xf5, 0x56, 0xf5, 0x64, 0x8c, 0x96, 0xe1, 0x4a, 0x2a, 0xe0, 0x21, 0x3e, 0x0a, 0xe4, 0x23,
-	0xb4, 0x01, 0xd7, 0x93, 0xc3, 0xe9, 0x05, 0x34, 0xd9, 0x44, 0xb7, 0x60, 0xbd, 0x3f, 0x36, 0xbc,
-	0x2a, 0x1f, 0xa3, 0x3b, 0xb0, 0xd9, 0x1f, 0x1a, 0x2f, 0x80, 0xc9, 0x16, 0xda, 0x86, 0x52, 0x7f,
-	0x89, 0xef, 0xb4, 0x02, 0xd3, 0xb1, 0x6c, 0x33, 0x2c, 0x5f, 0xc9, 0xcf, 0x50, 0x09, 0x36, 0x86,
-	0x93, 0xa9, 0x60, 0x3b, 0x90, 0xeb, 0x83, 0x75, 0x1c, 0xd8, 0xba, 0xd3, 0xb4, 0x6c, 0x33, 0x2c,
-	0xd3, 0xc8, 0x0d, 0xf4, 0x3e, 0x6c, 0x0d, 0x27, 0x23, 0xaa, 0x1f, 0x72, 0x73, 0x78, 0x45, 0x61,
-	0xd9, 0x42, 0xb6, 0x91, 0x02, 0x2b, 0x19, 0x32, 0xbc, 0x80, 0x20, 0x3b, 0xe8, 0x5d, 0x58, 0xcb,
-	0xc0, 0x88, 0x27, 0xbf, 0xec, 0x22, 0x05, 0x96, 0x05, 0x2a, 0x71, 0x5b, 0x63, 0x61, 0xf3, 0x0f,
-	0x09, 0xdd, 0x81, 0xdb, 0x02, 0xd3, 0xf7, 0xea, 0xc1, 0x24, 0xfe, 0x94, 0x43, 0x77, 0x23, 0x8e,
-	0xe8, 0x4d, 0xde, 0x91, 0xa2, 0xfa, 0x8e, 0x6d, 0x3b, 0x2d, 0x5b, 0xc7, 0x86, 0xfc, 0xe7, 0x1c,
-	0x2a, 0xc1, 0xad, 0x6c, 0x3d, 0xb1, 0xcb, 0x07, 0x36, 0xe4, 0xbf, 0xe4, 0xd0, 0x75, 0x78, 0x27,
-	0xb9, 0x33, 0xd8, 0x26, 0x2e, 0xb3, 0x2c, 0x47, 0x57, 0xf2, 0xbf, 0x93, 0x1b, 0x7f, 0x97, 0xc4,
-	0x7d, 0x99, 0x3d, 0xdb, 0xae, 0x88, 0xab, 0x39, 0x6d, 0x47, 0x77, 0x5b, 0x62, 0xe8, 0xb1, 0xc3,
-	0x17, 0x41, 0x96, 0xc8, 0x46, 0x8e, 0x0e, 0x89, 0x75, 0xcf, 0xa1, 0x79, 0xb8, 0x18, 0x1d, 0x61,
-	0x6e, 0x18, 0x43, 0x97, 0xc5, 0x05, 0x98, 0x0b, 0x34, 0x1d, 0x82, 0xbf, 0x90, 0x54, 0xd2, 0x8d,
-	0x86, 0xf1, 0xa4, 0x4c, 0xb8, 0x9c, 0x13, 0xdb, 0x7f, 0x9d, 0x83, 0xd9, 0x32, 0xcf, 0x47, 0x15,
-	0xec, 0xd1, 0x5f, 0xc8, 0x9e, 0xa5, 0x15, 0x56, 0xd0, 0x46, 0xd6, 0x4b, 0xb7, 0x8b, 0x29, 0x85,
-	0x77, 0xe5, 0x9b, 0x43, 0x61, 0xc9, 0x35, 0xe9, 0x65, 0x76, 0xd1, 0x05, 0xdd, 0x19, 0xfa, 0x6d,
-	0x1d, 0xea, 0x2d, 0x8d, 0x20, 0x41, 0xb4, 0x9f, 0x64, 0xbe, 0x97, 0xd1, 0x56, 0x92, 0x2a, 0x03,
-	0x28, 0x74, 0xbf, 0x37, 0xbc, 0x00, 0x51, 0xed, 0x67, 0xbc, 0xae, 0xd1, 0x00, 0x1e, 0x0e, 0x13,
-	0x6a, 0x6f, 0x0f, 0x0b, 0x27, 0x4a, 0xdd, 0xf4, 0x57, 0x3a, 0xda, 0xec, 0x4f, 0xc2, 0x50, 0x42,
-	0xe5, 0xc6, 0x90, 0x68, 0xa2, 0xf1, 0x67, 0x52, 0xff, 0xe7, 0x3c, 0xba, 0x3b, 0xc8, 0x6d, 0x51,
-	0xb4, 0x30, 0x61, 0x7b, 0x44, 0x29, 0x62, 0xca, 0xb3, 0xb4, 0x32, 0x00, 0x1a, 0x30, 0x19, 0x82,
-	0xc9, 0x0e, 0xeb, 0x54, 0x6c, 0xaa, 0xa3, 0xd9, 0x29, 0x3b, 0xc8, 0xd1, 0x0c, 0x35, 0xac, 0xa3,
-	0x05, 0x3a, 0x2b, 0x9e, 0xc8, 0xf6, 0x1f, 0x22, 0x9e, 0x08, 0x6c, 0x84, 0x78, 0xe2, 0x70, 0xa2,
-	0xf4, 0x69, 0xbc, 0x62, 0x80, 0xde, 0xcd, 0x10, 0xa6, 0xa3, 0x42, 0x85, 0x32, 0x00, 0x45, 0x98,
-	0x7f, 0x94, 0xac, 0x1c, 0xa0, 0xeb, 0x19, 0x52, 0x7c, 0x5c, 0xb0, 0xbf, 0x3b, 0x10, 0x47, 0xf8,
-	0x9b, 0xa9, 0x55, 0x04, 0x94, 0x35, 0xfb, 0x28, 0x48, 0x68, 0xba, 0x35, 0x1c, 0x98, 0xa8, 0x6b,
-	0x67, 0x55, 0x18, 0x50, 0xcf, 0x91, 0x95, 0x8e, 0x13, 0x4a, 0x37, 0x87, 0xc6, 0xf3, 0x38, 0x4c,
-	0xab, 0x41, 0xa0, 0x81, 0x2c, 0x04, 0x95, 0x1d, 0x87, 0x19, 0x68, 0x1e, 0x87, 0xa9, 0xc5, 0x89,
-	0xde, 0x38, 0x4c, 0x85, 0x65, 0xc7, 0x61, 0x16, 0x9c, 0x28, 0xfd, 0xbd, 0x34, 0x64, 0xf5, 0x02,
-	0x7d, 0x6b, 0x10, 0x6d, 0xaa, 0x98, 0xb0, 0xea, 0xc3, 0xd7, 0x15, 0x27, 0x56, 0xfe, 0x7c, 0x60,
-	0xf5, 0x03, 0xdd, 0x1b, 0xc8, 0x1f, 0xc3, 0x0b, 0xbb, 0xee, 0x8e, 0x2c, 0x47, 0x0c, 0xfa, 0xe5,
-	0x10, 0x45, 0x10, 0x74, 0x7f, 0x10, 0x75, 0x52, 0x42, 0x18, 0x75, 0xef, 0x35, 0x24, 0x89, 0x59,
-	0x5a, 0x4f, 0x35, 0x04, 0xdd, 0x48, 0x52, 0x25, 0x00, 0x42, 0xe7, 0xfa, 0x60, 0x20, 0x3f, 0x5e,
-	0xe2, 0x75, 0x90, 0xde, 0xe3, 0x25, 0x3e, 0x9e, 0x7d, 0xbc, 0xf4, 0xe0, 0x08, 0xbf, 0x9d, 0xf5,
-	0x4c, 0xef, 0xdd, 0xef, 0xe9, 0xb8, 0xec, 0xc3, 0xb2, 0xb7, 0xac, 0x72, 0x47, 0x42, 0xf5, 0x8c,
-	0x87, 0x77, 0xef, 0xae, 0x4b, 0x85, 0x09, 0x6d, 0xef, 0xf4, 0x83, 0x73, 0x65, 0xbb, 0x1f, 0xbc,
-	0xfa, 0xf7, 0xca, 0x5b, 0x5f, 0x76, 0x56, 0xa4, 0x57, 0x9d, 0x15, 0xe9, 0x5f, 0x9d, 0x15, 0xe9,
-	0xd3, 0x75, 0x26, 0x15, 0x60, 0xfd, 0x78, 0x8b, 0x7e, 0x6e, 0x99, 0xce, 0x96, 0x5b, 0x37, 0xb7,
-	0x62, 0xff, 0x00, 0xad, 0x4d, 0xd0, 0xaf, 0xf7, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x75,
-	0xb3, 0xf8, 0x19, 0x2a, 0x00, 0x00,
+type protocolServiceGroupMessageSubscribeClient struct {
+	grpc.ClientStream
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (x *protocolServiceGroupMessageSubscribeClient) Recv() (*bertytypes.GroupMessageEvent, error) {
+	m := new(bertytypes.GroupMessageEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
 
-// ProtocolServiceClient is the client API for ProtocolService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type ProtocolServiceClient interface {
-	// InstanceExportData exports instance data
-	InstanceExportData(ctx context.Context, in *InstanceExportData_Request, opts ...grpc.CallOption) (*InstanceExportData_Reply, error)
+// ProtocolServiceServer is the server API for ProtocolService service.
+type ProtocolServiceServer interface {
+	// InstanceExportData exports instance data, the encrypted archive is streamed in chunks
+	InstanceExportData(*InstanceExportData_Request, ProtocolService_InstanceExportDataServer) error
+	// InstanceImportData replaces the instance data with previously exported data, the archive is streamed in chunks
+	InstanceImportData(ProtocolService_InstanceImportDataServer) error
 	// InstanceGetConfiguration gets current configuration of this protocol instance
-	InstanceGetConfiguration(ctx context.Context, in *InstanceGetConfiguration_Request, opts ...grpc.CallOption) (*InstanceGetConfiguration_Reply, error)
+	InstanceGetConfiguration(context.Context, *InstanceGetConfiguration_Request) (*InstanceGetConfiguration_Reply, error)
+	// DeviceLinkReference creates a one-time reference allowing another device to join the current account
+	DeviceLinkReference(context.Context, *DeviceLinkReference_Request) (*DeviceLinkReference_Reply, error)
+	// DeviceLinkJoin replaces the account of the instance with the account of the device which created the reference
+	DeviceLinkJoin(context.Context, *DeviceLinkJoin_Request) (*DeviceLinkJoin_Reply, error)
+	// DeviceRevoke revokes a lost or stolen device of the account, in the account group and in every group it has joined
+	DeviceRevoke(context.Context, *DeviceRevoke_Request) (*DeviceRevoke_Reply, error)
 	// ContactRequestReference retrieves the information required to create a reference (ie. included in a shareable link) to the current account
-	ContactRequestReference(ctx context.Context, in *ContactRequestReference_Request, opts ...grpc.CallOption) (*ContactRequestReference_Reply, error)
+	ContactRequestReference(context.Context, *ContactRequestReference_Request) (*ContactRequestReference_Reply, error)
 	// ContactRequestDisable disables incoming contact requests
-	ContactRequestDisable(ctx context.Context, in *ContactRequestDisable_Request, opts ...grpc.CallOption) (*ContactRequestDisable_Reply, error)
+	ContactRequestDisable(context.Context, *ContactRequestDisable_Request) (*ContactRequestDisable_Reply, error)
 	// ContactRequestEnable enables incoming contact requests
-	ContactRequestEnable(ctx context.Context, in *ContactRequestEnable_Request, opts ...grpc.CallOption) (*ContactRequestEnable_Reply, error)
+	ContactRequestEnable(context.Context, *ContactRequestEnable_Request) (*ContactRequestEnable_Reply, error)
 	// ContactRequestResetReference changes the contact request reference
-	ContactRequestResetReference(ctx context.Context, in *ContactRequestResetReference_Request, opts ...grpc.CallOption) (*ContactRequestResetReference_Reply, error)
+	ContactRequestResetReference(context.Context, *ContactRequestResetReference_Request) (*ContactRequestResetReference_Reply, error)
 	// ContactRequestSend attempt to send a contact request
-	ContactRequestSend(ctx context.Context, in *ContactRequestSend_Request, opts ...grpc.CallOption) (*ContactRequestSend_Reply, error)
+	ContactRequestSend(context.Context, *ContactRequestSend_Request) (*ContactRequestSend_Reply, error)
 	// ContactRequestAccept accepts a contact request
-	ContactRequestAccept(ctx context.Context, in *ContactRequestAccept_Request, opts ...grpc.CallOption) (*ContactRequestAccept_Reply, error)
+	ContactRequestAccept(context.Context, *ContactRequestAccept_Request) (*ContactRequestAccept_Reply, error)
 	// ContactRequestDiscard ignores a contact request, without informing the other user
-	ContactRequestDiscard(ctx context.Context, in *ContactRequestDiscard_Request, opts ...grpc.CallOption) (*ContactRequestDiscard_Reply, error)
+	ContactRequestDiscard(context.Context, *ContactRequestDiscard_Request) (*ContactRequestDiscard_Reply, error)
 	// ContactBlock blocks a contact from sending requests
-	ContactBlock(ctx context.Context, in *ContactBlock_Request, opts ...grpc.CallOption) (*ContactBlock_Reply, error)
+	ContactBlock(context.Context, *ContactBlock_Request) (*ContactBlock_Reply, error)
 	// ContactUnblock unblocks a contact from sending requests
-	ContactUnblock(ctx context.Context, in *ContactUnblock_Request, opts ...grpc.CallOption) (*ContactUnblock_Reply, error)
+	ContactUnblock(context.Context, *ContactUnblock_Request) (*ContactUnblock_Reply, error)
 	// ContactAliasKeySend send an alias key to a contact, the contact will be able to assert that your account is being present on a multi-member group
-	ContactAliasKeySend(ctx context.Context, in *ContactAliasKeySend_Request, opts ...grpc.CallOption) (*ContactAliasKeySend_Reply, error)
+	ContactAliasKeySend(context.Context, *ContactAliasKeySend_Request) (*ContactAliasKeySend_Reply, error)
 	// MultiMemberGroupCreate creates a new multi-member group
-	MultiMemberGroupCreate(ctx context.Context, in *MultiMemberGroupCreate_Request, opts ...grpc.CallOption) (*MultiMemberGroupCreate_Reply, error)
+	MultiMemberGroupCreate(context.Context, *MultiMemberGroupCreate_Request) (*MultiMemberGroupCreate_Reply, error)
 	// MultiMemberGroupJoin joins a multi-member group
-	MultiMemberGroupJoin(ctx context.Context, in *MultiMemberGroupJoin_Request, opts ...grpc.CallOption) (*MultiMemberGroupJoin_Reply, error)
+	MultiMemberGroupJoin(context.Context, *MultiMemberGroupJoin_Request) (*MultiMemberGroupJoin_Reply, error)
 	// MultiMemberGroupLeave leaves a multi-member group
-	MultiMemberGroupLeave(ctx context.Context, in *MultiMemberGroupLeave_Request, opts ...grpc.CallOption) (*MultiMemberGroupLeave_Reply, error)
+	MultiMemberGroupLeave(context.Context, *MultiMemberGroupLeave_Request) (*MultiMemberGroupLeave_Reply, error)
 	// MultiMemberGroupAliasResolverDisclose discloses your alias resolver key
-	MultiMemberGroupAliasResolverDisclose(ctx context.Context, in *MultiMemberGroupAliasResolverDisclose_Request, opts ...grpc.CallOption) (*MultiMemberGroupAliasResolverDisclose_Reply, error)
+	MultiMemberGroupAliasResolverDisclose(context.Context, *MultiMemberGroupAliasResolverDisclose_Request) (*MultiMemberGroupAliasResolverDisclose_Reply, error)
 	// MultiMemberGroupAdminRoleGrant grants an admin role to a group member
-	MultiMemberGroupAdminRoleGrant(ctx context.Context, in *MultiMemberGroupAdminRoleGrant_Request, opts ...grpc.CallOption) (*MultiMemberGroupAdminRoleGrant_Reply, error)
+	MultiMemberGroupAdminRoleGrant(context.Context, *MultiMemberGroupAdminRoleGrant_Request) (*MultiMemberGroupAdminRoleGrant_Reply, error)
+	// MultiMemberGroupAdminRoleRevoke revokes the admin role of a group member
+	MultiMemberGroupAdminRoleRevoke(context.Context, *MultiMemberGroupAdminRoleRevoke_Request) (*MultiMemberGroupAdminRoleRevoke_Reply, error)
+	// MultiMemberGroupMemberRemove removes a member from a group, the remaining members rotate their secrets
+	MultiMemberGroupMemberRemove(context.Context, *MultiMemberGroupMemberRemove_Request) (*MultiMemberGroupMemberRemove_Reply, error)
 	// MultiMemberGroupInvitationCreate creates an invitation to a multi-member group
-	MultiMemberGroupInvitationCreate(ctx context.Context, in *MultiMemberGroupInvitationCreate_Request, opts ...grpc.CallOption) (*MultiMemberGroupInvitationCreate_Reply, error)
+	MultiMemberGroupInvitationCreate(context.Context, *MultiMemberGroupInvitationCreate_Request) (*MultiMemberGroupInvitationCreate_Reply, error)
+	// MultiMemberGroupInvitationRevoke revokes an invitation to a multi-member group
+	MultiMemberGroupInvitationRevoke(context.Context, *MultiMemberGroupInvitationRevoke_Request) (*MultiMemberGroupInvitationRevoke_Reply, error)
 	// AppMetadataSend adds an app event to the metadata store, the message is encrypted using a symmetric key and readable by future group members
-	AppMetadataSend(ctx context.Context, in *AppMetadataSend_Request, opts ...grpc.CallOption) (*AppMetadataSend_Reply, error)
+	AppMetadataSend(context.Context, *AppMetadataSend_Request) (*AppMetadataSend_Reply, error)
 	// AppMessageSend adds an app event to the message store, the message is encrypted using a derived key and readable by current group members
-	AppMessageSend(ctx context.Context, in *AppMessageSend_Request, opts ...grpc.CallOption) (*AppMessageSend_Reply, error)
+	AppMessageSend(context.Context, *AppMessageSend_Request) (*AppMessageSend_Reply, error)
 	// GroupMetadataSubscribe subscribes to a group metadata updates (or it can also retrieve the history)
-	GroupMetadataSubscribe(ctx context.Context, in *GroupMetadataSubscribe_Request, opts ...grpc.CallOption) (ProtocolService_GroupMetadataSubscribeClient, error)
+	GroupMetadataSubscribe(*GroupMetadataSubscribe_Request, ProtocolService_GroupMetadataSubscribeServer) error
 	// GroupMessageSubscribe subscribes to a group message updates (or it can also retrieve the history)
-	GroupMessageSubscribe(ctx context.Context, in *GroupMessageSubscribe_Request, opts ...grpc.CallOption) (ProtocolService_GroupMessageSubscribeClient, error)
+	GroupMessageSubscribe(*GroupMessageSubscribe_Request, ProtocolService_GroupMessageSubscribeServer) error
 }
 
-type protocolServiceClient struct {
-	cc *grpc.ClientConn
+// UnimplementedProtocolServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedProtocolServiceServer struct {
 }
 
-func NewProtocolServiceClient(cc *grpc.ClientConn) ProtocolServiceClient {
-	return &protocolServiceClient{cc}
+func (*UnimplementedProtocolServiceServer) InstanceExportData(req *InstanceExportData_Request, srv ProtocolService_InstanceExportDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method InstanceExportData not implemented")
 }
-
-func (c *protocolServiceClient) InstanceExportData(ctx context.Context, in *InstanceExportData_Request, opts ...grpc.CallOption) (*InstanceExportData_Reply, error) {
-	out := new(InstanceExportData_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/InstanceExportData", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedProtocolServiceServer) InstanceImportData(srv ProtocolService_InstanceImportDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method InstanceImportData not implemented")
 }
-
-func (c *protocolServiceClient) InstanceGetConfiguration(ctx context.Context, in *InstanceGetConfiguration_Request, opts ...grpc.CallOption) (*InstanceGetConfiguration_Reply, error) {
-	out := new(InstanceGetConfiguration_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/InstanceGetConfiguration", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedProtocolServiceServer) InstanceGetConfiguration(ctx context.Context, req *InstanceGetConfiguration_Request) (*InstanceGetConfiguration_Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstanceGetConfiguration not implemented")
 }
-
-func (c *protocolServiceClient) ContactRequestReference(ctx context.Context, in *ContactRequestReference_Request, opts ...grpc.CallOption) (*ContactRequestReference_Reply, error) {
-	out := new(ContactRequestReference_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestReference", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedProtocolServiceServer) DeviceLinkReference(ctx context.Context, req *DeviceLinkReference_Request) (*DeviceLinkReference_Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceLinkReference not implemented")
 }
-
-func (c *protocolServiceClient) ContactRequestDisable(ctx context.Context, in *ContactRequestDisable_Request, opts ...grpc.CallOption) (*ContactRequestDisable_Reply, error) {
-	out := new(ContactRequestDisable_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestDisable", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedProtocolServiceServer) DeviceLinkJoin(ctx context.Context, req *DeviceLinkJoin_Request) (*DeviceLinkJoin_Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceLinkJoin not implemented")
 }
-
-func (c *protocolServiceClient) ContactRequestEnable(ctx context.Context, in *ContactRequestEnable_Request, opts ...grpc.CallOption) (*ContactRequestEnable_Reply, error) {
-	out := new(ContactRequestEnable_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestEnable", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*UnimplementedProtocolServiceServer) DeviceRevoke(ctx context.Context, req *DeviceRevoke_Request) (*DeviceRevoke_Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceRevoke not implemented")
 }
-
-func (c *protocolServiceClient) ContactRequestResetReference(ctx context.Context, in *ContactRequestResetReference_Request, opts ...grpc.CallOption) (*ContactRequestResetReference_Reply, error) {
-	out := new(ContactRequestResetReference_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestResetReference", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) ContactRequestSend(ctx context.Context, in *ContactRequestSend_Request, opts ...grpc.CallOption) (*ContactRequestSend_Reply, error) {
-	out := new(ContactRequestSend_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestSend", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) ContactRequestAccept(ctx context.Context, in *ContactRequestAccept_Request, opts ...grpc.CallOption) (*ContactRequestAccept_Reply, error) {
-	out := new(ContactRequestAccept_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestAccept", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) ContactRequestDiscard(ctx context.Context, in *ContactRequestDiscard_Request, opts ...grpc.CallOption) (*ContactRequestDiscard_Reply, error) {
-	out := new(ContactRequestDiscard_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactRequestDiscard", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) ContactBlock(ctx context.Context, in *ContactBlock_Request, opts ...grpc.CallOption) (*ContactBlock_Reply, error) {
-	out := new(ContactBlock_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) ContactUnblock(ctx context.Context, in *ContactUnblock_Request, opts ...grpc.CallOption) (*ContactUnblock_Reply, error) {
-	out := new(ContactUnblock_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactUnblock", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) ContactAliasKeySend(ctx context.Context, in *ContactAliasKeySend_Request, opts ...grpc.CallOption) (*ContactAliasKeySend_Reply, error) {
-	out := new(ContactAliasKeySend_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/ContactAliasKeySend", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) MultiMemberGroupCreate(ctx context.Context, in *MultiMemberGroupCreate_Request, opts ...grpc.CallOption) (*MultiMemberGroupCreate_Reply, error) {
-	out := new(MultiMemberGroupCreate_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) MultiMemberGroupJoin(ctx context.Context, in *MultiMemberGroupJoin_Request, opts ...grpc.CallOption) (*MultiMemberGroupJoin_Reply, error) {
-	out := new(MultiMemberGroupJoin_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupJoin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) MultiMemberGroupLeave(ctx context.Context, in *MultiMemberGroupLeave_Request, opts ...grpc.CallOption) (*MultiMemberGroupLeave_Reply, error) {
-	out := new(MultiMemberGroupLeave_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupLeave", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) MultiMemberGroupAliasResolverDisclose(ctx context.Context, in *MultiMemberGroupAliasResolverDisclose_Request, opts ...grpc.CallOption) (*MultiMemberGroupAliasResolverDisclose_Reply, error) {
-	out := new(MultiMemberGroupAliasResolverDisclose_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupAliasResolverDisclose", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) MultiMemberGroupAdminRoleGrant(ctx context.Context, in *MultiMemberGroupAdminRoleGrant_Request, opts ...grpc.CallOption) (*MultiMemberGroupAdminRoleGrant_Reply, error) {
-	out := new(MultiMemberGroupAdminRoleGrant_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupAdminRoleGrant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) MultiMemberGroupInvitationCreate(ctx context.Context, in *MultiMemberGroupInvitationCreate_Request, opts ...grpc.CallOption) (*MultiMemberGroupInvitationCreate_Reply, error) {
-	out := new(MultiMemberGroupInvitationCreate_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/MultiMemberGroupInvitationCreate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) AppMetadataSend(ctx context.Context, in *AppMetadataSend_Request, opts ...grpc.CallOption) (*AppMetadataSend_Reply, error) {
-	out := new(AppMetadataSend_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/AppMetadataSend", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) AppMessageSend(ctx context.Context, in *AppMessageSend_Request, opts ...grpc.CallOption) (*AppMessageSend_Reply, error) {
-	out := new(AppMessageSend_Reply)
-	err := c.cc.Invoke(ctx, "/berty.protocol.ProtocolService/AppMessageSend", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *protocolServiceClient) GroupMetadataSubscribe(ctx context.Context, in *GroupMetadataSubscribe_Request, opts ...grpc.CallOption) (ProtocolService_GroupMetadataSubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ProtocolService_serviceDesc.Streams[0], "/berty.protocol.ProtocolService/GroupMetadataSubscribe", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &protocolServiceGroupMetadataSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type ProtocolService_GroupMetadataSubscribeClient interface {
-	Recv() (*GroupMetadataEvent, error)
-	grpc.ClientStream
-}
-
-type protocolServiceGroupMetadataSubscribeClient struct {
-	grpc.ClientStream
-}
-
-func (x *protocolServiceGroupMetadataSubscribeClient) Recv() (*GroupMetadataEvent, error) {
-	m := new(GroupMetadataEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *protocolServiceClient) GroupMessageSubscribe(ctx context.Context, in *GroupMessageSubscribe_Request, opts ...grpc.CallOption) (ProtocolService_GroupMessageSubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_ProtocolService_serviceDesc.Streams[1], "/berty.protocol.ProtocolService/GroupMessageSubscribe", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &protocolServiceGroupMessageSubscribeClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
-}
-
-type ProtocolService_GroupMessageSubscribeClient interface {
-	Recv() (*GroupMessageEvent, error)
-	grpc.ClientStream
-}
-
-type protocolServiceGroupMessageSubscribeClient struct {
-	grpc.ClientStream
-}
-
-func (x *protocolServiceGroupMessageSubscribeClient) Recv() (*GroupMessageEvent, error) {
-	m := new(GroupMessageEvent)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-// ProtocolServiceServer is the server API for ProtocolService service.
-type ProtocolServiceServer interface {
-	// InstanceExportData exports instance data
-	InstanceExportData(context.Context, *InstanceExportData_Request) (*InstanceExportData_Reply, error)
-	// InstanceGetConfiguration gets current configuration of this protocol instance
-	InstanceGetConfiguration(context.Context, *InstanceGetConfiguration_Request) (*InstanceGetConfiguration_Reply, error)
-	// ContactRequestReference retrieves the information required to create a reference (ie. included in a shareable link) to the current account
-	ContactRequestReference(context.Context, *ContactRequestReference_Request) (*ContactRequestReference_Reply, error)
-	// ContactRequestDisable disables incoming contact requests
-	ContactRequestDisable(context.Context, *ContactRequestDisable_Request) (*ContactRequestDisable_Reply, error)
-	// ContactRequestEnable enables incoming contact requests
-	ContactRequestEnable(context.Context, *ContactRequestEnable_Request) (*ContactRequestEnable_Reply, error)
-	// ContactRequestResetReference changes the contact request reference
-	ContactRequestResetReference(context.Context, *ContactRequestResetReference_Request) (*ContactRequestResetReference_Reply, error)
-	// ContactRequestSend attempt to send a contact request
-	ContactRequestSend(context.Context, *ContactRequestSend_Request) (*ContactRequestSend_Reply, error)
-	// ContactRequestAccept accepts a contact request
-	ContactRequestAccept(context.Context, *ContactRequestAccept_Request) (*ContactRequestAccept_Reply, error)
-	// ContactRequestDiscard ignores a contact request, without informing the other user
-	ContactRequestDiscard(context.Context, *ContactRequestDiscard_Request) (*ContactRequestDiscard_Reply, error)
-	// ContactBlock blocks a contact from sending requests
-	ContactBlock(context.Context, *ContactBlock_Request) (*ContactBlock_Reply, error)
-	// ContactUnblock unblocks a contact from sending requests
-	ContactUnblock(context.Context, *ContactUnblock_Request) (*ContactUnblock_Reply, error)
-	// ContactAliasKeySend send an alias key to a contact, the contact will be able to assert that your account is being present on a multi-member group
-	ContactAliasKeySend(context.Context, *ContactAliasKeySend_Request) (*ContactAliasKeySend_Reply, error)
-	// MultiMemberGroupCreate creates a new multi-member group
-	MultiMemberGroupCreate(context.Context, *MultiMemberGroupCreate_Request) (*MultiMemberGroupCreate_Reply, error)
-	// MultiMemberGroupJoin joins a multi-member group
-	MultiMemberGroupJoin(context.Context, *MultiMemberGroupJoin_Request) (*MultiMemberGroupJoin_Reply, error)
-	// MultiMemberGroupLeave leaves a multi-member group
-	MultiMemberGroupLeave(context.Context, *MultiMemberGroupLeave_Request) (*MultiMemberGroupLeave_Reply, error)
-	// MultiMemberGroupAliasResolverDisclose discloses your alias resolver key
-	MultiMemberGroupAliasResolverDisclose(context.Context, *MultiMemberGroupAliasResolverDisclose_Request) (*MultiMemberGroupAliasResolverDisclose_Reply, error)
-	// MultiMemberGroupAdminRoleGrant grants an admin role to a group member
-	MultiMemberGroupAdminRoleGrant(context.Context, *MultiMemberGroupAdminRoleGrant_Request) (*MultiMemberGroupAdminRoleGrant_Reply, error)
-	// MultiMemberGroupInvitationCreate creates an invitation to a multi-member group
-	MultiMemberGroupInvitationCreate(context.Context, *MultiMemberGroupInvitationCreate_Request) (*MultiMemberGroupInvitationCreate_Reply, error)
-	// AppMetadataSend adds an app event to the metadata store, the message is encrypted using a symmetric key and readable by future group members
-	AppMetadataSend(context.Context, *AppMetadataSend_Request) (*AppMetadataSend_Reply, error)
-	// AppMessageSend adds an app event to the message store, the message is encrypted using a derived key and readable by current group members
-	AppMessageSend(context.Context, *AppMessageSend_Request) (*AppMessageSend_Reply, error)
-	// GroupMetadataSubscribe subscribes to a group metadata updates (or it can also retrieve the history)
-	GroupMetadataSubscribe(*GroupMetadataSubscribe_Request, ProtocolService_GroupMetadataSubscribeServer) error
-	// GroupMessageSubscribe subscribes to a group message updates (or it can also retrieve the history)
-	GroupMessageSubscribe(*GroupMessageSubscribe_Request, ProtocolService_GroupMessageSubscribeServer) error
-}
-
-// UnimplementedProtocolServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedProtocolServiceServer struct {
-}
-
-func (*UnimplementedProtocolServiceServer) InstanceExportData(ctx context.Context, req *InstanceExportData_Request) (*InstanceExportData_Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstanceExportData not implemented")
-}
-func (*UnimplementedProtocolServiceServer) InstanceGetConfiguration(ctx context.Context, req *InstanceGetConfiguration_Request) (*InstanceGetConfiguration_Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method InstanceGetConfiguration not implemented")
-}
-func (*UnimplementedProtocolServiceServer) ContactRequestReference(ctx context.Context, req *ContactRequestReference_Request) (*ContactRequestReference_Reply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContactRequestReference not implemented")
+func (*UnimplementedProtocolServiceServer) ContactRequestReference(ctx context.Context, req *ContactRequestReference_Request) (*ContactRequestReference_Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContactRequestReference not implemented")
 }
 func (*UnimplementedProtocolServiceServer) ContactRequestDisable(ctx context.Context, req *ContactRequestDisable_Request) (*ContactRequestDisable_Reply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ContactRequestDisable not implemented")
@@ -5796,9 +4706,18 @@ func (*UnimplementedProtocolServiceServer) MultiMemberGroupAliasResolverDisclose
 func (*UnimplementedProtocolServiceServer) MultiMemberGroupAdminRoleGrant(ctx context.Context, req *MultiMemberGroupAdminRoleGrant_Request) (*MultiMemberGroupAdminRoleGrant_Reply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiMemberGroupAdminRoleGrant not implemented")
 }
+func (*UnimplementedProtocolServiceServer) MultiMemberGroupAdminRoleRevoke(ctx context.Context, req *MultiMemberGroupAdminRoleRevoke_Request) (*MultiMemberGroupAdminRoleRevoke_Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiMemberGroupAdminRoleRevoke not implemented")
+}
+func (*UnimplementedProtocolServiceServer) MultiMemberGroupMemberRemove(ctx context.Context, req *MultiMemberGroupMemberRemove_Request) (*MultiMemberGroupMemberRemove_Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiMemberGroupMemberRemove not implemented")
+}
 func (*UnimplementedProtocolServiceServer) MultiMemberGroupInvitationCreate(ctx context.Context, req *MultiMemberGroupInvitationCreate_Request) (*MultiMemberGroupInvitationCreate_Reply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MultiMemberGroupInvitationCreate not implemented")
 }
+func (*UnimplementedProtocolServiceServer) MultiMemberGroupInvitationRevoke(ctx context.Context, req *MultiMemberGroupInvitationRevoke_Request) (*MultiMemberGroupInvitationRevoke_Reply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiMemberGroupInvitationRevoke not implemented")
+}
 func (*UnimplementedProtocolServiceServer) AppMetadataSend(ctx context.Context, req *AppMetadataSend_Request) (*AppMetadataSend_Reply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppMetadataSend not implemented")
 }
@@ -5816,27 +4735,56 @@ func RegisterProtocolServiceServer(s *grpc.Server, srv ProtocolServiceServer) {
 	s.RegisterService(&_ProtocolService_serviceDesc, srv)
 }
 
-func _ProtocolService_InstanceExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InstanceExportData_Request)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(ProtocolServiceServer).InstanceExportData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/berty.protocol.ProtocolService/InstanceExportData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ProtocolServiceServer).InstanceExportData(ctx, req.(*InstanceExportData_Request))
+func _ProtocolService_InstanceExportData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InstanceExportData_Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(ProtocolServiceServer).InstanceExportData(m, &protocolServiceInstanceExportDataServer{stream})
 }
 
-func _ProtocolService_InstanceGetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InstanceGetConfiguration_Request)
-	if err := dec(in); err != nil {
+type ProtocolService_InstanceExportDataServer interface {
+	Send(*InstanceExportData_Reply) error
+	grpc.ServerStream
+}
+
+type protocolServiceInstanceExportDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *protocolServiceInstanceExportDataServer) Send(m *InstanceExportData_Reply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ProtocolService_InstanceImportData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ProtocolServiceServer).InstanceImportData(&protocolServiceInstanceImportDataServer{stream})
+}
+
+type ProtocolService_InstanceImportDataServer interface {
+	SendAndClose(*InstanceImportData_Reply) error
+	Recv() (*InstanceImportData_Request, error)
+	grpc.ServerStream
+}
+
+type protocolServiceInstanceImportDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *protocolServiceInstanceImportDataServer) SendAndClose(m *InstanceImportData_Reply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *protocolServiceInstanceImportDataServer) Recv() (*InstanceImportData_Request, error) {
+	m := new(InstanceImportData_Request)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ProtocolService_InstanceGetConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceGetConfiguration_Request)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -5852,6 +4800,60 @@ func _ProtocolService_InstanceGetConfiguration_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProtocolService_DeviceLinkReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceLinkReference_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).DeviceLinkReference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/berty.protocol.ProtocolService/DeviceLinkReference",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).DeviceLinkReference(ctx, req.(*DeviceLinkReference_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_DeviceLinkJoin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceLinkJoin_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).DeviceLinkJoin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/berty.protocol.ProtocolService/DeviceLinkJoin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).DeviceLinkJoin(ctx, req.(*DeviceLinkJoin_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_DeviceRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceRevoke_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).DeviceRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/berty.protocol.ProtocolService/DeviceRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).DeviceRevoke(ctx, req.(*DeviceRevoke_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProtocolService_ContactRequestReference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ContactRequestReference_Request)
 	if err := dec(in); err != nil {
@@ -6122,6 +5124,42 @@ func _ProtocolService_MultiMemberGroupAdminRoleGrant_Handler(srv interface{}, ct
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProtocolService_MultiMemberGroupAdminRoleRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMemberGroupAdminRoleRevoke_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).MultiMemberGroupAdminRoleRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/berty.protocol.ProtocolService/MultiMemberGroupAdminRoleRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).MultiMemberGroupAdminRoleRevoke(ctx, req.(*MultiMemberGroupAdminRoleRevoke_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProtocolService_MultiMemberGroupMemberRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMemberGroupMemberRemove_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).MultiMemberGroupMemberRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/berty.protocol.ProtocolService/MultiMemberGroupMemberRemove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).MultiMemberGroupMemberRemove(ctx, req.(*MultiMemberGroupMemberRemove_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProtocolService_MultiMemberGroupInvitationCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MultiMemberGroupInvitationCreate_Request)
 	if err := dec(in); err != nil {
@@ -6140,6 +5178,24 @@ func _ProtocolService_MultiMemberGroupInvitationCreate_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProtocolService_MultiMemberGroupInvitationRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiMemberGroupInvitationRevoke_Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProtocolServiceServer).MultiMemberGroupInvitationRevoke(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/berty.protocol.ProtocolService/MultiMemberGroupInvitationRevoke",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProtocolServiceServer).MultiMemberGroupInvitationRevoke(ctx, req.(*MultiMemberGroupInvitationRevoke_Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProtocolService_AppMetadataSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppMetadataSend_Request)
 	if err := dec(in); err != nil {
@@ -6185,7 +5241,7 @@ func _ProtocolService_GroupMetadataSubscribe_Handler(srv interface{}, stream grp
 }
 
 type ProtocolService_GroupMetadataSubscribeServer interface {
-	Send(*GroupMetadataEvent) error
+	Send(*bertytypes.GroupMetadataEvent) error
 	grpc.ServerStream
 }
 
@@ -6193,7 +5249,7 @@ type protocolServiceGroupMetadataSubscribeServer struct {
 	grpc.ServerStream
 }
 
-func (x *protocolServiceGroupMetadataSubscribeServer) Send(m *GroupMetadataEvent) error {
+func (x *protocolServiceGroupMetadataSubscribeServer) Send(m *bertytypes.GroupMetadataEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -6206,7 +5262,7 @@ func _ProtocolService_GroupMessageSubscribe_Handler(srv interface{}, stream grpc
 }
 
 type ProtocolService_GroupMessageSubscribeServer interface {
-	Send(*GroupMessageEvent) error
+	Send(*bertytypes.GroupMessageEvent) error
 	grpc.ServerStream
 }
 
@@ -6214,7 +5270,7 @@ type protocolServiceGroupMessageSubscribeServer struct {
 	grpc.ServerStream
 }
 
-func (x *protocolServiceGroupMessageSubscribeServer) Send(m *GroupMessageEvent) error {
+func (x *protocolServiceGroupMessageSubscribeServer) Send(m *bertytypes.GroupMessageEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -6222,14 +5278,22 @@ var _ProtocolService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "berty.protocol.ProtocolService",
 	HandlerType: (*ProtocolServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "InstanceExportData",
-			Handler:    _ProtocolService_InstanceExportData_Handler,
-		},
 		{
 			MethodName: "InstanceGetConfiguration",
 			Handler:    _ProtocolService_InstanceGetConfiguration_Handler,
 		},
+		{
+			MethodName: "DeviceLinkReference",
+			Handler:    _ProtocolService_DeviceLinkReference_Handler,
+		},
+		{
+			MethodName: "DeviceLinkJoin",
+			Handler:    _ProtocolService_DeviceLinkJoin_Handler,
+		},
+		{
+			MethodName: "DeviceRevoke",
+			Handler:    _ProtocolService_DeviceRevoke_Handler,
+		},
 		{
 			MethodName: "ContactRequestReference",
 			Handler:    _ProtocolService_ContactRequestReference_Handler,
@@ -6290,10 +5354,22 @@ var _ProtocolService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MultiMemberGroupAdminRoleGrant",
 			Handler:    _ProtocolService_MultiMemberGroupAdminRoleGrant_Handler,
 		},
+		{
+			MethodName: "MultiMemberGroupAdminRoleRevoke",
+			Handler:    _ProtocolService_MultiMemberGroupAdminRoleRevoke_Handler,
+		},
+		{
+			MethodName: "MultiMemberGroupMemberRemove",
+			Handler:    _ProtocolService_MultiMemberGroupMemberRemove_Handler,
+		},
 		{
 			MethodName: "MultiMemberGroupInvitationCreate",
 			Handler:    _ProtocolService_MultiMemberGroupInvitationCreate_Handler,
 		},
+		{
+			MethodName: "MultiMemberGroupInvitationRevoke",
+			Handler:    _ProtocolService_MultiMemberGroupInvitationRevoke_Handler,
+		},
 		{
 			MethodName: "AppMetadataSend",
 			Handler:    _ProtocolService_AppMetadataSend_Handler,
@@ -6304,6 +5380,16 @@ var _ProtocolService_serviceDesc = grpc.ServiceDesc{
 		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "InstanceExportData",
+			Handler:       _ProtocolService_InstanceExportData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "InstanceImportData",
+			Handler:       _ProtocolService_InstanceImportData_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "GroupMetadataSubscribe",
 			Handler:       _ProtocolService_GroupMetadataSubscribe_Handler,
@@ -6318,7 +5404,7 @@ var _ProtocolService_serviceDesc = grpc.ServiceDesc{
 	Metadata: "bertyprotocol.proto",
 }
 
-func (m *Account) Marshal() (dAtA []byte, err error) {
+func (m *InstanceExportData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6328,12 +5414,12 @@ func (m *Account) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Account) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstanceExportData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstanceExportData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6342,43 +5428,10 @@ func (m *Account) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.PublicRendezvousSeed) > 0 {
-		i -= len(m.PublicRendezvousSeed)
-		copy(dAtA[i:], m.PublicRendezvousSeed)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.PublicRendezvousSeed)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AliasPrivateKey) > 0 {
-		i -= len(m.AliasPrivateKey)
-		copy(dAtA[i:], m.AliasPrivateKey)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.AliasPrivateKey)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AccountPrivateKey) > 0 {
-		i -= len(m.AccountPrivateKey)
-		copy(dAtA[i:], m.AccountPrivateKey)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.AccountPrivateKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Group != nil {
-		{
-			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Group) Marshal() (dAtA []byte, err error) {
+func (m *InstanceExportData_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6388,12 +5441,12 @@ func (m *Group) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Group) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstanceExportData_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstanceExportData_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6402,36 +5455,17 @@ func (m *Group) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.GroupType != 0 {
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.GroupType))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.SecretSig) > 0 {
-		i -= len(m.SecretSig)
-		copy(dAtA[i:], m.SecretSig)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.SecretSig)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Secret) > 0 {
-		i -= len(m.Secret)
-		copy(dAtA[i:], m.Secret)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Secret)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PublicKey) > 0 {
-		i -= len(m.PublicKey)
-		copy(dAtA[i:], m.PublicKey)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.PublicKey)))
+	if len(m.Passphrase) > 0 {
+		i -= len(m.Passphrase)
+		copy(dAtA[i:], m.Passphrase)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Passphrase)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupMetadata) Marshal() (dAtA []byte, err error) {
+func (m *InstanceExportData_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6441,12 +5475,12 @@ func (m *GroupMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstanceExportData_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstanceExportData_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6455,29 +5489,17 @@ func (m *GroupMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Sig) > 0 {
-		i -= len(m.Sig)
-		copy(dAtA[i:], m.Sig)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Sig)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.EventType != 0 {
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.EventType))
+	if len(m.ExportedData) > 0 {
+		i -= len(m.ExportedData)
+		copy(dAtA[i:], m.ExportedData)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ExportedData)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupEnvelope) Marshal() (dAtA []byte, err error) {
+func (m *InstanceImportData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6487,12 +5509,12 @@ func (m *GroupEnvelope) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupEnvelope) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstanceImportData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstanceImportData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6501,24 +5523,10 @@ func (m *GroupEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Event) > 0 {
-		i -= len(m.Event)
-		copy(dAtA[i:], m.Event)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Event)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageHeaders) Marshal() (dAtA []byte, err error) {
+func (m *InstanceImportData_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6528,12 +5536,12 @@ func (m *MessageHeaders) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageHeaders) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstanceImportData_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstanceImportData_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6542,29 +5550,24 @@ func (m *MessageHeaders) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Sig) > 0 {
-		i -= len(m.Sig)
-		copy(dAtA[i:], m.Sig)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Sig)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
+	if len(m.Passphrase) > 0 {
+		i -= len(m.Passphrase)
+		copy(dAtA[i:], m.Passphrase)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Passphrase)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Counter != 0 {
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.Counter))
+	if len(m.ExportedData) > 0 {
+		i -= len(m.ExportedData)
+		copy(dAtA[i:], m.ExportedData)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ExportedData)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MessageEnvelope) Marshal() (dAtA []byte, err error) {
+func (m *InstanceImportData_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6574,12 +5577,12 @@ func (m *MessageEnvelope) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MessageEnvelope) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstanceImportData_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MessageEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstanceImportData_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6588,31 +5591,10 @@ func (m *MessageEnvelope) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MessageHeaders) > 0 {
-		i -= len(m.MessageHeaders)
-		copy(dAtA[i:], m.MessageHeaders)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.MessageHeaders)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventContext) Marshal() (dAtA []byte, err error) {
+func (m *InstanceGetConfiguration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6622,12 +5604,12 @@ func (m *EventContext) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventContext) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstanceGetConfiguration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstanceGetConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6636,33 +5618,10 @@ func (m *EventContext) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.GroupPK) > 0 {
-		i -= len(m.GroupPK)
-		copy(dAtA[i:], m.GroupPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ParentIDs) > 0 {
-		for iNdEx := len(m.ParentIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ParentIDs[iNdEx])
-			copy(dAtA[i:], m.ParentIDs[iNdEx])
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ParentIDs[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ID) > 0 {
-		i -= len(m.ID)
-		copy(dAtA[i:], m.ID)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ID)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AppMetadata) Marshal() (dAtA []byte, err error) {
+func (m *InstanceGetConfiguration_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6672,12 +5631,12 @@ func (m *AppMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AppMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstanceGetConfiguration_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AppMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstanceGetConfiguration_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6686,24 +5645,10 @@ func (m *AppMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactAddAliasKey) Marshal() (dAtA []byte, err error) {
+func (m *InstanceGetConfiguration_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6713,12 +5658,12 @@ func (m *ContactAddAliasKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactAddAliasKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *InstanceGetConfiguration_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactAddAliasKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *InstanceGetConfiguration_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6727,51 +5672,46 @@ func (m *ContactAddAliasKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.AliasPK) > 0 {
-		i -= len(m.AliasPK)
-		copy(dAtA[i:], m.AliasPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.AliasPK)))
+	if m.RelayEnabled != 0 {
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.RelayEnabled))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x48
 	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
+	if m.MdnsEnabled != 0 {
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.MdnsEnabled))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x40
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GroupAddMemberDevice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.WifiP2PEnabled != 0 {
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.WifiP2PEnabled))
+		i--
+		dAtA[i] = 0x38
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GroupAddMemberDevice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GroupAddMemberDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.BleEnabled != 0 {
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.BleEnabled))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Listeners) > 0 {
+		for iNdEx := len(m.Listeners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Listeners[iNdEx])
+			copy(dAtA[i:], m.Listeners[iNdEx])
+			i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Listeners[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PeerID) > 0 {
+		i -= len(m.PeerID)
+		copy(dAtA[i:], m.PeerID)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.PeerID)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.MemberSig) > 0 {
-		i -= len(m.MemberSig)
-		copy(dAtA[i:], m.MemberSig)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.MemberSig)))
+	if len(m.AccountGroupPK) > 0 {
+		i -= len(m.AccountGroupPK)
+		copy(dAtA[i:], m.AccountGroupPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.AccountGroupPK)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -6782,17 +5722,17 @@ func (m *GroupAddMemberDevice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.MemberPK) > 0 {
-		i -= len(m.MemberPK)
-		copy(dAtA[i:], m.MemberPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.MemberPK)))
+	if len(m.AccountPK) > 0 {
+		i -= len(m.AccountPK)
+		copy(dAtA[i:], m.AccountPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.AccountPK)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *DeviceSecret) Marshal() (dAtA []byte, err error) {
+func (m *DeviceLinkReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6802,12 +5742,12 @@ func (m *DeviceSecret) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DeviceSecret) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeviceLinkReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DeviceSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeviceLinkReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6816,22 +5756,10 @@ func (m *DeviceSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Counter != 0 {
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.Counter))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainKey) > 0 {
-		i -= len(m.ChainKey)
-		copy(dAtA[i:], m.ChainKey)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ChainKey)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupAddDeviceSecret) Marshal() (dAtA []byte, err error) {
+func (m *DeviceLinkReference_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6841,12 +5769,12 @@ func (m *GroupAddDeviceSecret) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupAddDeviceSecret) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeviceLinkReference_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupAddDeviceSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeviceLinkReference_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6855,31 +5783,10 @@ func (m *GroupAddDeviceSecret) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DestMemberPK) > 0 {
-		i -= len(m.DestMemberPK)
-		copy(dAtA[i:], m.DestMemberPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DestMemberPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupAddAliasResolver) Marshal() (dAtA []byte, err error) {
+func (m *DeviceLinkReference_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6889,12 +5796,12 @@ func (m *MultiMemberGroupAddAliasResolver) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupAddAliasResolver) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeviceLinkReference_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupAddAliasResolver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeviceLinkReference_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6903,31 +5810,22 @@ func (m *MultiMemberGroupAddAliasResolver) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.AliasProof) > 0 {
-		i -= len(m.AliasProof)
-		copy(dAtA[i:], m.AliasProof)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.AliasProof)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AliasResolver) > 0 {
-		i -= len(m.AliasResolver)
-		copy(dAtA[i:], m.AliasResolver)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.AliasResolver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
+	if m.Reference != nil {
+		{
+			size, err := m.Reference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGrantAdminRole) Marshal() (dAtA []byte, err error) {
+func (m *DeviceLinkJoin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6937,12 +5835,12 @@ func (m *MultiMemberGrantAdminRole) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGrantAdminRole) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeviceLinkJoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGrantAdminRole) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeviceLinkJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6951,24 +5849,10 @@ func (m *MultiMemberGrantAdminRole) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.GranteeMemberPK) > 0 {
-		i -= len(m.GranteeMemberPK)
-		copy(dAtA[i:], m.GranteeMemberPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GranteeMemberPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberInitialMember) Marshal() (dAtA []byte, err error) {
+func (m *DeviceLinkJoin_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6978,12 +5862,12 @@ func (m *MultiMemberInitialMember) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberInitialMember) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeviceLinkJoin_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberInitialMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeviceLinkJoin_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6992,17 +5876,22 @@ func (m *MultiMemberInitialMember) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.MemberPK) > 0 {
-		i -= len(m.MemberPK)
-		copy(dAtA[i:], m.MemberPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.MemberPK)))
+	if m.Reference != nil {
+		{
+			size, err := m.Reference.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupAddAdditionalRendezvousSeed) Marshal() (dAtA []byte, err error) {
+func (m *DeviceLinkJoin_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7012,12 +5901,12 @@ func (m *GroupAddAdditionalRendezvousSeed) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GroupAddAdditionalRendezvousSeed) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeviceLinkJoin_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupAddAdditionalRendezvousSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeviceLinkJoin_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7026,24 +5915,10 @@ func (m *GroupAddAdditionalRendezvousSeed) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Seed) > 0 {
-		i -= len(m.Seed)
-		copy(dAtA[i:], m.Seed)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Seed)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GroupRemoveAdditionalRendezvousSeed) Marshal() (dAtA []byte, err error) {
+func (m *DeviceRevoke) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7053,12 +5928,12 @@ func (m *GroupRemoveAdditionalRendezvousSeed) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *GroupRemoveAdditionalRendezvousSeed) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeviceRevoke) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GroupRemoveAdditionalRendezvousSeed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeviceRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7067,24 +5942,10 @@ func (m *GroupRemoveAdditionalRendezvousSeed) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Seed) > 0 {
-		i -= len(m.Seed)
-		copy(dAtA[i:], m.Seed)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Seed)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountGroupJoined) Marshal() (dAtA []byte, err error) {
+func (m *DeviceRevoke_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7094,12 +5955,12 @@ func (m *AccountGroupJoined) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountGroupJoined) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeviceRevoke_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountGroupJoined) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeviceRevoke_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7108,18 +5969,6 @@ func (m *AccountGroupJoined) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Group != nil {
-		{
-			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.DevicePK) > 0 {
 		i -= len(m.DevicePK)
 		copy(dAtA[i:], m.DevicePK)
@@ -7130,7 +5979,7 @@ func (m *AccountGroupJoined) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountGroupLeft) Marshal() (dAtA []byte, err error) {
+func (m *DeviceRevoke_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7140,12 +5989,12 @@ func (m *AccountGroupLeft) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountGroupLeft) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeviceRevoke_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountGroupLeft) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeviceRevoke_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7154,24 +6003,10 @@ func (m *AccountGroupLeft) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.GroupPK) > 0 {
-		i -= len(m.GroupPK)
-		copy(dAtA[i:], m.GroupPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactRequestDisabled) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7181,12 +6016,12 @@ func (m *AccountContactRequestDisabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactRequestDisabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactRequestDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7195,17 +6030,10 @@ func (m *AccountContactRequestDisabled) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactRequestEnabled) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestReference_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7215,12 +6043,12 @@ func (m *AccountContactRequestEnabled) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactRequestEnabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestReference_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactRequestEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestReference_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7229,17 +6057,10 @@ func (m *AccountContactRequestEnabled) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactRequestReferenceReset) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestReference_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7249,12 +6070,12 @@ func (m *AccountContactRequestReferenceReset) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactRequestReferenceReset) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestReference_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactRequestReferenceReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestReference_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7263,24 +6084,17 @@ func (m *AccountContactRequestReferenceReset) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.RendezvousSeed) > 0 {
-		i -= len(m.RendezvousSeed)
-		copy(dAtA[i:], m.RendezvousSeed)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.RendezvousSeed)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Reference)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactRequestEnqueued) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestDisable) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7290,12 +6104,12 @@ func (m *AccountContactRequestEnqueued) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactRequestEnqueued) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestDisable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactRequestEnqueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestDisable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7304,45 +6118,10 @@ func (m *AccountContactRequestEnqueued) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.GroupPK) > 0 {
-		i -= len(m.GroupPK)
-		copy(dAtA[i:], m.GroupPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ContactMetadata) > 0 {
-		i -= len(m.ContactMetadata)
-		copy(dAtA[i:], m.ContactMetadata)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactMetadata)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ContactRendezvousSeed) > 0 {
-		i -= len(m.ContactRendezvousSeed)
-		copy(dAtA[i:], m.ContactRendezvousSeed)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactRendezvousSeed)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactRequestSent) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestDisable_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7352,12 +6131,12 @@ func (m *AccountContactRequestSent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactRequestSent) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestDisable_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactRequestSent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestDisable_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7366,24 +6145,10 @@ func (m *AccountContactRequestSent) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactRequestReceived) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestDisable_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7393,12 +6158,12 @@ func (m *AccountContactRequestReceived) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactRequestReceived) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestDisable_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactRequestReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestDisable_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7407,38 +6172,10 @@ func (m *AccountContactRequestReceived) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactMetadata) > 0 {
-		i -= len(m.ContactMetadata)
-		copy(dAtA[i:], m.ContactMetadata)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactMetadata)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ContactRendezvousSeed) > 0 {
-		i -= len(m.ContactRendezvousSeed)
-		copy(dAtA[i:], m.ContactRendezvousSeed)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactRendezvousSeed)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactRequestDiscarded) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestEnable) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7448,12 +6185,12 @@ func (m *AccountContactRequestDiscarded) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactRequestDiscarded) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestEnable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactRequestDiscarded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestEnable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7462,24 +6199,10 @@ func (m *AccountContactRequestDiscarded) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactRequestAccepted) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestEnable_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7489,12 +6212,12 @@ func (m *AccountContactRequestAccepted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactRequestAccepted) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestEnable_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactRequestAccepted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestEnable_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7503,31 +6226,10 @@ func (m *AccountContactRequestAccepted) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.GroupPK) > 0 {
-		i -= len(m.GroupPK)
-		copy(dAtA[i:], m.GroupPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactBlocked) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestEnable_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7537,12 +6239,12 @@ func (m *AccountContactBlocked) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactBlocked) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestEnable_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactBlocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestEnable_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7551,24 +6253,17 @@ func (m *AccountContactBlocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Reference)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountContactUnblocked) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestResetReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7578,12 +6273,12 @@ func (m *AccountContactUnblocked) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountContactUnblocked) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestResetReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountContactUnblocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestResetReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7592,24 +6287,10 @@ func (m *AccountContactUnblocked) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InstanceExportData) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestResetReference_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7619,12 +6300,12 @@ func (m *InstanceExportData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InstanceExportData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestResetReference_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InstanceExportData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestResetReference_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7636,7 +6317,7 @@ func (m *InstanceExportData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *InstanceExportData_Request) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestResetReference_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7646,12 +6327,12 @@ func (m *InstanceExportData_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InstanceExportData_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestResetReference_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InstanceExportData_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestResetReference_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7660,10 +6341,17 @@ func (m *InstanceExportData_Request) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InstanceExportData_Reply) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestSend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7673,12 +6361,12 @@ func (m *InstanceExportData_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InstanceExportData_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestSend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InstanceExportData_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7687,17 +6375,10 @@ func (m *InstanceExportData_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ExportedData) > 0 {
-		i -= len(m.ExportedData)
-		copy(dAtA[i:], m.ExportedData)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ExportedData)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InstanceGetConfiguration) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestSend_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7707,12 +6388,12 @@ func (m *InstanceGetConfiguration) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InstanceGetConfiguration) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestSend_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InstanceGetConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7721,10 +6402,31 @@ func (m *InstanceGetConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.OwnMetadata) > 0 {
+		i -= len(m.OwnMetadata)
+		copy(dAtA[i:], m.OwnMetadata)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.OwnMetadata)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ContactMetadata) > 0 {
+		i -= len(m.ContactMetadata)
+		copy(dAtA[i:], m.ContactMetadata)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactMetadata)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InstanceGetConfiguration_Request) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestSend_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7734,12 +6436,12 @@ func (m *InstanceGetConfiguration_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InstanceGetConfiguration_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestSend_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InstanceGetConfiguration_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestSend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7751,7 +6453,7 @@ func (m *InstanceGetConfiguration_Request) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *InstanceGetConfiguration_Reply) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestAccept) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7761,12 +6463,12 @@ func (m *InstanceGetConfiguration_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InstanceGetConfiguration_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestAccept) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InstanceGetConfiguration_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7775,67 +6477,10 @@ func (m *InstanceGetConfiguration_Reply) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.RelayEnabled != 0 {
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.RelayEnabled))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.MdnsEnabled != 0 {
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.MdnsEnabled))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.WifiP2PEnabled != 0 {
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.WifiP2PEnabled))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.BleEnabled != 0 {
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.BleEnabled))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Listeners) > 0 {
-		for iNdEx := len(m.Listeners) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Listeners[iNdEx])
-			copy(dAtA[i:], m.Listeners[iNdEx])
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Listeners[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.PeerID) > 0 {
-		i -= len(m.PeerID)
-		copy(dAtA[i:], m.PeerID)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.PeerID)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.AccountGroupPK) > 0 {
-		i -= len(m.AccountGroupPK)
-		copy(dAtA[i:], m.AccountGroupPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.AccountGroupPK)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DevicePK) > 0 {
-		i -= len(m.DevicePK)
-		copy(dAtA[i:], m.DevicePK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.DevicePK)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AccountPK) > 0 {
-		i -= len(m.AccountPK)
-		copy(dAtA[i:], m.AccountPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.AccountPK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestReference) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestAccept_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7845,12 +6490,12 @@ func (m *ContactRequestReference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestReference) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestAccept_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestAccept_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7859,10 +6504,17 @@ func (m *ContactRequestReference) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ContactPK) > 0 {
+		i -= len(m.ContactPK)
+		copy(dAtA[i:], m.ContactPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestReference_Request) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestAccept_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7872,12 +6524,12 @@ func (m *ContactRequestReference_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestReference_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestAccept_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestReference_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestAccept_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7889,7 +6541,7 @@ func (m *ContactRequestReference_Request) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestReference_Reply) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestDiscard) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7899,12 +6551,12 @@ func (m *ContactRequestReference_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestReference_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestDiscard) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestReference_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestDiscard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7913,17 +6565,10 @@ func (m *ContactRequestReference_Reply) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestDisable) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestDiscard_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7933,12 +6578,12 @@ func (m *ContactRequestDisable) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestDisable) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestDiscard_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestDisable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestDiscard_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -7947,37 +6592,17 @@ func (m *ContactRequestDisable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ContactRequestDisable_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ContactRequestDisable_Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *ContactRequestDisable_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if len(m.ContactPK) > 0 {
+		i -= len(m.ContactPK)
+		copy(dAtA[i:], m.ContactPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestDisable_Reply) Marshal() (dAtA []byte, err error) {
+func (m *ContactRequestDiscard_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7987,12 +6612,12 @@ func (m *ContactRequestDisable_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestDisable_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactRequestDiscard_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestDisable_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactRequestDiscard_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8004,7 +6629,7 @@ func (m *ContactRequestDisable_Reply) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestEnable) Marshal() (dAtA []byte, err error) {
+func (m *ContactBlock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8014,12 +6639,12 @@ func (m *ContactRequestEnable) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestEnable) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactBlock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestEnable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8031,7 +6656,7 @@ func (m *ContactRequestEnable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestEnable_Request) Marshal() (dAtA []byte, err error) {
+func (m *ContactBlock_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8041,12 +6666,12 @@ func (m *ContactRequestEnable_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestEnable_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactBlock_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestEnable_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactBlock_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8055,10 +6680,17 @@ func (m *ContactRequestEnable_Request) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ContactPK) > 0 {
+		i -= len(m.ContactPK)
+		copy(dAtA[i:], m.ContactPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestEnable_Reply) Marshal() (dAtA []byte, err error) {
+func (m *ContactBlock_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8068,12 +6700,12 @@ func (m *ContactRequestEnable_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestEnable_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactBlock_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestEnable_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactBlock_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8082,17 +6714,10 @@ func (m *ContactRequestEnable_Reply) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestResetReference) Marshal() (dAtA []byte, err error) {
+func (m *ContactUnblock) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8102,12 +6727,12 @@ func (m *ContactRequestResetReference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestResetReference) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactUnblock) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestResetReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactUnblock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8119,7 +6744,7 @@ func (m *ContactRequestResetReference) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestResetReference_Request) Marshal() (dAtA []byte, err error) {
+func (m *ContactUnblock_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8129,12 +6754,12 @@ func (m *ContactRequestResetReference_Request) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestResetReference_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactUnblock_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestResetReference_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactUnblock_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8143,10 +6768,17 @@ func (m *ContactRequestResetReference_Request) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ContactPK) > 0 {
+		i -= len(m.ContactPK)
+		copy(dAtA[i:], m.ContactPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestResetReference_Reply) Marshal() (dAtA []byte, err error) {
+func (m *ContactUnblock_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8156,12 +6788,12 @@ func (m *ContactRequestResetReference_Reply) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestResetReference_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactUnblock_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestResetReference_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactUnblock_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8170,17 +6802,10 @@ func (m *ContactRequestResetReference_Reply) MarshalToSizedBuffer(dAtA []byte) (
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Reference)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestSend) Marshal() (dAtA []byte, err error) {
+func (m *ContactAliasKeySend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8190,12 +6815,12 @@ func (m *ContactRequestSend) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestSend) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactAliasKeySend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactAliasKeySend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8207,7 +6832,7 @@ func (m *ContactRequestSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestSend_Request) Marshal() (dAtA []byte, err error) {
+func (m *ContactAliasKeySend_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8217,12 +6842,12 @@ func (m *ContactRequestSend_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestSend_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactAliasKeySend_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactAliasKeySend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8231,24 +6856,17 @@ func (m *ContactRequestSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactMetadata) > 0 {
-		i -= len(m.ContactMetadata)
-		copy(dAtA[i:], m.ContactMetadata)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactMetadata)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Reference) > 0 {
-		i -= len(m.Reference)
-		copy(dAtA[i:], m.Reference)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Reference)))
+	if len(m.GroupPK) > 0 {
+		i -= len(m.GroupPK)
+		copy(dAtA[i:], m.GroupPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestSend_Reply) Marshal() (dAtA []byte, err error) {
+func (m *ContactAliasKeySend_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8258,12 +6876,12 @@ func (m *ContactRequestSend_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestSend_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ContactAliasKeySend_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestSend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ContactAliasKeySend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8275,7 +6893,7 @@ func (m *ContactRequestSend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestAccept) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupCreate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8285,12 +6903,12 @@ func (m *ContactRequestAccept) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestAccept) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupCreate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8302,7 +6920,7 @@ func (m *ContactRequestAccept) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestAccept_Request) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupCreate_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8312,12 +6930,12 @@ func (m *ContactRequestAccept_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestAccept_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupCreate_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestAccept_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupCreate_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8326,17 +6944,20 @@ func (m *ContactRequestAccept_Request) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
+	if m.AdminMessagesOnly {
 		i--
-		dAtA[i] = 0xa
+		if m.AdminMessagesOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestAccept_Reply) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupCreate_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8346,12 +6967,12 @@ func (m *ContactRequestAccept_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestAccept_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupCreate_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestAccept_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupCreate_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8360,10 +6981,17 @@ func (m *ContactRequestAccept_Reply) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.GroupPK) > 0 {
+		i -= len(m.GroupPK)
+		copy(dAtA[i:], m.GroupPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestDiscard) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupJoin) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8373,12 +7001,12 @@ func (m *ContactRequestDiscard) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestDiscard) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupJoin) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestDiscard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8390,7 +7018,7 @@ func (m *ContactRequestDiscard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestDiscard_Request) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupJoin_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8400,12 +7028,12 @@ func (m *ContactRequestDiscard_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestDiscard_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupJoin_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestDiscard_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupJoin_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8414,17 +7042,22 @@ func (m *ContactRequestDiscard_Request) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
+	if m.Invitation != nil {
+		{
+			size, err := m.Invitation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactRequestDiscard_Reply) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupJoin_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8434,12 +7067,12 @@ func (m *ContactRequestDiscard_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactRequestDiscard_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupJoin_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactRequestDiscard_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupJoin_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8451,7 +7084,7 @@ func (m *ContactRequestDiscard_Reply) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactBlock) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupLeave) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8461,12 +7094,12 @@ func (m *ContactBlock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactBlock) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupLeave) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupLeave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8478,7 +7111,7 @@ func (m *ContactBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactBlock_Request) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupLeave_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8488,12 +7121,12 @@ func (m *ContactBlock_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactBlock_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupLeave_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactBlock_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupLeave_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8502,17 +7135,17 @@ func (m *ContactBlock_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
+	if len(m.GroupPK) > 0 {
+		i -= len(m.GroupPK)
+		copy(dAtA[i:], m.GroupPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactBlock_Reply) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupLeave_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8522,12 +7155,12 @@ func (m *ContactBlock_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactBlock_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupLeave_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactBlock_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupLeave_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8539,7 +7172,7 @@ func (m *ContactBlock_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactUnblock) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupAliasResolverDisclose) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8549,12 +7182,12 @@ func (m *ContactUnblock) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactUnblock) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAliasResolverDisclose) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactUnblock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAliasResolverDisclose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8566,7 +7199,7 @@ func (m *ContactUnblock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactUnblock_Request) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupAliasResolverDisclose_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8576,12 +7209,12 @@ func (m *ContactUnblock_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactUnblock_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAliasResolverDisclose_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactUnblock_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAliasResolverDisclose_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8590,17 +7223,17 @@ func (m *ContactUnblock_Request) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ContactPK) > 0 {
-		i -= len(m.ContactPK)
-		copy(dAtA[i:], m.ContactPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.ContactPK)))
+	if len(m.GroupPK) > 0 {
+		i -= len(m.GroupPK)
+		copy(dAtA[i:], m.GroupPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactUnblock_Reply) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8610,12 +7243,12 @@ func (m *ContactUnblock_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactUnblock_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactUnblock_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8627,7 +7260,7 @@ func (m *ContactUnblock_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactAliasKeySend) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupAdminRoleGrant) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8637,12 +7270,12 @@ func (m *ContactAliasKeySend) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactAliasKeySend) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleGrant) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactAliasKeySend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8654,7 +7287,7 @@ func (m *ContactAliasKeySend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactAliasKeySend_Request) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupAdminRoleGrant_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8664,12 +7297,12 @@ func (m *ContactAliasKeySend_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactAliasKeySend_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleGrant_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactAliasKeySend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleGrant_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8678,6 +7311,13 @@ func (m *ContactAliasKeySend_Request) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.MemberPK) > 0 {
+		i -= len(m.MemberPK)
+		copy(dAtA[i:], m.MemberPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.MemberPK)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.GroupPK) > 0 {
 		i -= len(m.GroupPK)
 		copy(dAtA[i:], m.GroupPK)
@@ -8688,7 +7328,7 @@ func (m *ContactAliasKeySend_Request) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *ContactAliasKeySend_Reply) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupAdminRoleGrant_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8698,12 +7338,12 @@ func (m *ContactAliasKeySend_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContactAliasKeySend_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleGrant_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContactAliasKeySend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleGrant_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8715,7 +7355,7 @@ func (m *ContactAliasKeySend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupCreate) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupAdminRoleRevoke) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8725,12 +7365,12 @@ func (m *MultiMemberGroupCreate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupCreate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleRevoke) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8742,7 +7382,7 @@ func (m *MultiMemberGroupCreate) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupCreate_Request) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8752,12 +7392,12 @@ func (m *MultiMemberGroupCreate_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupCreate_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupCreate_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8766,10 +7406,24 @@ func (m *MultiMemberGroupCreate_Request) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.MemberPK) > 0 {
+		i -= len(m.MemberPK)
+		copy(dAtA[i:], m.MemberPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.MemberPK)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupPK) > 0 {
+		i -= len(m.GroupPK)
+		copy(dAtA[i:], m.GroupPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupCreate_Reply) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8779,12 +7433,12 @@ func (m *MultiMemberGroupCreate_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupCreate_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupCreate_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8793,17 +7447,10 @@ func (m *MultiMemberGroupCreate_Reply) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.GroupPK) > 0 {
-		i -= len(m.GroupPK)
-		copy(dAtA[i:], m.GroupPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupJoin) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupMemberRemove) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8813,12 +7460,12 @@ func (m *MultiMemberGroupJoin) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupJoin) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupMemberRemove) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupMemberRemove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8830,7 +7477,7 @@ func (m *MultiMemberGroupJoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupJoin_Request) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupMemberRemove_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8840,12 +7487,12 @@ func (m *MultiMemberGroupJoin_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupJoin_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupMemberRemove_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupJoin_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupMemberRemove_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8854,22 +7501,24 @@ func (m *MultiMemberGroupJoin_Request) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Group != nil {
-		{
-			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
-		}
+	if len(m.MemberPK) > 0 {
+		i -= len(m.MemberPK)
+		copy(dAtA[i:], m.MemberPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.MemberPK)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GroupPK) > 0 {
+		i -= len(m.GroupPK)
+		copy(dAtA[i:], m.GroupPK)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupJoin_Reply) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupMemberRemove_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8879,12 +7528,12 @@ func (m *MultiMemberGroupJoin_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupJoin_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupMemberRemove_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupJoin_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupMemberRemove_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8896,7 +7545,7 @@ func (m *MultiMemberGroupJoin_Reply) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupLeave) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupInvitationCreate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8906,12 +7555,12 @@ func (m *MultiMemberGroupLeave) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupLeave) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationCreate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupLeave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8923,7 +7572,7 @@ func (m *MultiMemberGroupLeave) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupLeave_Request) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupInvitationCreate_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8933,12 +7582,12 @@ func (m *MultiMemberGroupLeave_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupLeave_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationCreate_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupLeave_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationCreate_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8947,6 +7596,16 @@ func (m *MultiMemberGroupLeave_Request) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.MaxUses != 0 {
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.GroupPK) > 0 {
 		i -= len(m.GroupPK)
 		copy(dAtA[i:], m.GroupPK)
@@ -8957,7 +7616,7 @@ func (m *MultiMemberGroupLeave_Request) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupLeave_Reply) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupInvitationCreate_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8967,12 +7626,12 @@ func (m *MultiMemberGroupLeave_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupLeave_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationCreate_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupLeave_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationCreate_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -8981,10 +7640,22 @@ func (m *MultiMemberGroupLeave_Reply) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Invitation != nil {
+		{
+			size, err := m.Invitation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupInvitationRevoke) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -8994,12 +7665,12 @@ func (m *MultiMemberGroupAliasResolverDisclose) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationRevoke) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationRevoke) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9011,7 +7682,7 @@ func (m *MultiMemberGroupAliasResolverDisclose) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose_Request) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupInvitationRevoke_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9021,12 +7692,12 @@ func (m *MultiMemberGroupAliasResolverDisclose_Request) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationRevoke_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationRevoke_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9035,6 +7706,13 @@ func (m *MultiMemberGroupAliasResolverDisclose_Request) MarshalToSizedBuffer(dAt
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.InvitationID) > 0 {
+		i -= len(m.InvitationID)
+		copy(dAtA[i:], m.InvitationID)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.InvitationID)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.GroupPK) > 0 {
 		i -= len(m.GroupPK)
 		copy(dAtA[i:], m.GroupPK)
@@ -9045,7 +7723,7 @@ func (m *MultiMemberGroupAliasResolverDisclose_Request) MarshalToSizedBuffer(dAt
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) Marshal() (dAtA []byte, err error) {
+func (m *MultiMemberGroupInvitationRevoke_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9055,12 +7733,12 @@ func (m *MultiMemberGroupAliasResolverDisclose_Reply) Marshal() (dAtA []byte, er
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationRevoke_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MultiMemberGroupInvitationRevoke_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9072,7 +7750,7 @@ func (m *MultiMemberGroupAliasResolverDisclose_Reply) MarshalToSizedBuffer(dAtA
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupAdminRoleGrant) Marshal() (dAtA []byte, err error) {
+func (m *AppMetadataSend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9082,12 +7760,12 @@ func (m *MultiMemberGroupAdminRoleGrant) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupAdminRoleGrant) MarshalTo(dAtA []byte) (int, error) {
+func (m *AppMetadataSend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupAdminRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AppMetadataSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9099,7 +7777,7 @@ func (m *MultiMemberGroupAdminRoleGrant) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Request) Marshal() (dAtA []byte, err error) {
+func (m *AppMetadataSend_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9109,12 +7787,12 @@ func (m *MultiMemberGroupAdminRoleGrant_Request) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *AppMetadataSend_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AppMetadataSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9123,10 +7801,10 @@ func (m *MultiMemberGroupAdminRoleGrant_Request) MarshalToSizedBuffer(dAtA []byt
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.MemberPK) > 0 {
-		i -= len(m.MemberPK)
-		copy(dAtA[i:], m.MemberPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.MemberPK)))
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Payload)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9140,7 +7818,7 @@ func (m *MultiMemberGroupAdminRoleGrant_Request) MarshalToSizedBuffer(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Reply) Marshal() (dAtA []byte, err error) {
+func (m *AppMetadataSend_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9150,12 +7828,12 @@ func (m *MultiMemberGroupAdminRoleGrant_Reply) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *AppMetadataSend_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AppMetadataSend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9164,10 +7842,17 @@ func (m *MultiMemberGroupAdminRoleGrant_Reply) MarshalToSizedBuffer(dAtA []byte)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CID) > 0 {
+		i -= len(m.CID)
+		copy(dAtA[i:], m.CID)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.CID)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupInvitationCreate) Marshal() (dAtA []byte, err error) {
+func (m *AppMessageSend) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9177,12 +7862,12 @@ func (m *MultiMemberGroupInvitationCreate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupInvitationCreate) MarshalTo(dAtA []byte) (int, error) {
+func (m *AppMessageSend) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupInvitationCreate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AppMessageSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9194,7 +7879,7 @@ func (m *MultiMemberGroupInvitationCreate) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupInvitationCreate_Request) Marshal() (dAtA []byte, err error) {
+func (m *AppMessageSend_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9204,12 +7889,12 @@ func (m *MultiMemberGroupInvitationCreate_Request) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupInvitationCreate_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *AppMessageSend_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupInvitationCreate_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AppMessageSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9218,6 +7903,13 @@ func (m *MultiMemberGroupInvitationCreate_Request) MarshalToSizedBuffer(dAtA []b
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.GroupPK) > 0 {
 		i -= len(m.GroupPK)
 		copy(dAtA[i:], m.GroupPK)
@@ -9228,7 +7920,7 @@ func (m *MultiMemberGroupInvitationCreate_Request) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *MultiMemberGroupInvitationCreate_Reply) Marshal() (dAtA []byte, err error) {
+func (m *AppMessageSend_Reply) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9238,12 +7930,12 @@ func (m *MultiMemberGroupInvitationCreate_Reply) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *MultiMemberGroupInvitationCreate_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *AppMessageSend_Reply) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MultiMemberGroupInvitationCreate_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AppMessageSend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9252,22 +7944,17 @@ func (m *MultiMemberGroupInvitationCreate_Reply) MarshalToSizedBuffer(dAtA []byt
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.Group != nil {
-		{
-			size, err := m.Group.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
-		}
+	if len(m.CID) > 0 {
+		i -= len(m.CID)
+		copy(dAtA[i:], m.CID)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.CID)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AppMetadataSend) Marshal() (dAtA []byte, err error) {
+func (m *GroupMetadataSubscribe) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9277,12 +7964,12 @@ func (m *AppMetadataSend) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AppMetadataSend) MarshalTo(dAtA []byte) (int, error) {
+func (m *GroupMetadataSubscribe) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AppMetadataSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GroupMetadataSubscribe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9294,7 +7981,7 @@ func (m *AppMetadataSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AppMetadataSend_Request) Marshal() (dAtA []byte, err error) {
+func (m *GroupMetadataSubscribe_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9304,12 +7991,12 @@ func (m *AppMetadataSend_Request) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AppMetadataSend_Request) MarshalTo(dAtA []byte) (int, error) {
+func (m *GroupMetadataSubscribe_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AppMetadataSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GroupMetadataSubscribe_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9318,10 +8005,45 @@ func (m *AppMetadataSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Payload)))
+	if len(m.EventTypes) > 0 {
+		dAtA8 := make([]byte, len(m.EventTypes)*10)
+		var j7 int
+		for _, num := range m.EventTypes {
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.GoBackwards {
+		i--
+		if m.GoBackwards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Since) > 0 {
+		i -= len(m.Since)
+		copy(dAtA[i:], m.Since)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Since)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9335,7 +8057,7 @@ func (m *AppMetadataSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *AppMetadataSend_Reply) Marshal() (dAtA []byte, err error) {
+func (m *GroupMessageSubscribe) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9345,12 +8067,12 @@ func (m *AppMetadataSend_Reply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AppMetadataSend_Reply) MarshalTo(dAtA []byte) (int, error) {
+func (m *GroupMessageSubscribe) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AppMetadataSend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GroupMessageSubscribe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9362,7 +8084,7 @@ func (m *AppMetadataSend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AppMessageSend) Marshal() (dAtA []byte, err error) {
+func (m *GroupMessageSubscribe_Request) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -9372,12 +8094,12 @@ func (m *AppMessageSend) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AppMessageSend) MarshalTo(dAtA []byte) (int, error) {
+func (m *GroupMessageSubscribe_Request) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AppMessageSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GroupMessageSubscribe_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -9386,37 +8108,42 @@ func (m *AppMessageSend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *AppMessageSend_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.LiveOnly {
+		i--
+		if m.LiveOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AppMessageSend_Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *AppMessageSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	if m.Limit != 0 {
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
 	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Payload)))
+	if m.GoBackwards {
+		i--
+		if m.GoBackwards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Until) > 0 {
+		i -= len(m.Until)
+		copy(dAtA[i:], m.Until)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Until)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Since) > 0 {
+		i -= len(m.Since)
+		copy(dAtA[i:], m.Since)
+		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Since)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -9430,468 +8157,235 @@ func (m *AppMessageSend_Request) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *AppMessageSend_Reply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func encodeVarintBertyprotocol(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBertyprotocol(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
-	return dAtA[:n], nil
-}
-
-func (m *AppMessageSend_Reply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	dAtA[offset] = uint8(v)
+	return base
 }
-
-func (m *AppMessageSend_Reply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *InstanceExportData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		n += len(m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *GroupMetadataEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InstanceExportData_Request) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GroupMetadataEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GroupMetadataEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
+	l = len(m.Passphrase)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		n += len(m.XXX_unrecognized)
 	}
-	if len(m.Event) > 0 {
-		i -= len(m.Event)
-		copy(dAtA[i:], m.Event)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Event)))
-		i--
-		dAtA[i] = 0x1a
+	return n
+}
+
+func (m *InstanceExportData_Reply) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	var l int
+	_ = l
+	l = len(m.ExportedData)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if m.EventContext != nil {
-		{
-			size, err := m.EventContext.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *GroupMessageEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InstanceImportData) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GroupMessageEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GroupMessageEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		n += len(m.XXX_unrecognized)
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x1a
+	return n
+}
+
+func (m *InstanceImportData_Request) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.Headers != nil {
-		{
-			size, err := m.Headers.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	var l int
+	_ = l
+	l = len(m.ExportedData)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if m.EventContext != nil {
-		{
-			size, err := m.EventContext.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintBertyprotocol(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	l = len(m.Passphrase)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GroupMetadataSubscribe) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GroupMetadataSubscribe) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return n
 }
 
-func (m *GroupMetadataSubscribe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *InstanceImportData_Reply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		n += len(m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func (m *GroupMetadataSubscribe_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InstanceGetConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GroupMetadataSubscribe_Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
-func (m *GroupMetadataSubscribe_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *InstanceGetConfiguration_Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+		n += len(m.XXX_unrecognized)
 	}
-	if m.GoBackwards {
-		i--
-		if m.GoBackwards {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	return n
+}
+
+func (m *InstanceGetConfiguration_Reply) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Until) > 0 {
-		i -= len(m.Until)
-		copy(dAtA[i:], m.Until)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Until)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Since) > 0 {
-		i -= len(m.Since)
-		copy(dAtA[i:], m.Since)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Since)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.GroupPK) > 0 {
-		i -= len(m.GroupPK)
-		copy(dAtA[i:], m.GroupPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GroupMessageSubscribe) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *GroupMessageSubscribe) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GroupMessageSubscribe) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
 	var l int
 	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	l = len(m.AccountPK)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *GroupMessageSubscribe_Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	l = len(m.DevicePK)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GroupMessageSubscribe_Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GroupMessageSubscribe_Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+	l = len(m.AccountGroupPK)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if m.GoBackwards {
-		i--
-		if m.GoBackwards {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	l = len(m.PeerID)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
+	if len(m.Listeners) > 0 {
+		for _, s := range m.Listeners {
+			l = len(s)
+			n += 1 + l + sovBertyprotocol(uint64(l))
 		}
-		i--
-		dAtA[i] = 0x20
 	}
-	if len(m.Until) > 0 {
-		i -= len(m.Until)
-		copy(dAtA[i:], m.Until)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Until)))
-		i--
-		dAtA[i] = 0x1a
+	if m.BleEnabled != 0 {
+		n += 1 + sovBertyprotocol(uint64(m.BleEnabled))
 	}
-	if len(m.Since) > 0 {
-		i -= len(m.Since)
-		copy(dAtA[i:], m.Since)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Since)))
-		i--
-		dAtA[i] = 0x12
+	if m.WifiP2PEnabled != 0 {
+		n += 1 + sovBertyprotocol(uint64(m.WifiP2PEnabled))
 	}
-	if len(m.GroupPK) > 0 {
-		i -= len(m.GroupPK)
-		copy(dAtA[i:], m.GroupPK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.GroupPK)))
-		i--
-		dAtA[i] = 0xa
+	if m.MdnsEnabled != 0 {
+		n += 1 + sovBertyprotocol(uint64(m.MdnsEnabled))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *ShareableContact) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.RelayEnabled != 0 {
+		n += 1 + sovBertyprotocol(uint64(m.RelayEnabled))
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ShareableContact) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
 
-func (m *ShareableContact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+func (m *DeviceLinkReference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PublicRendezvousSeed) > 0 {
-		i -= len(m.PublicRendezvousSeed)
-		copy(dAtA[i:], m.PublicRendezvousSeed)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.PublicRendezvousSeed)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PK) > 0 {
-		i -= len(m.PK)
-		copy(dAtA[i:], m.PK)
-		i = encodeVarintBertyprotocol(dAtA, i, uint64(len(m.PK)))
-		i--
-		dAtA[i] = 0xa
+		n += len(m.XXX_unrecognized)
 	}
-	return len(dAtA) - i, nil
+	return n
 }
 
-func encodeVarintBertyprotocol(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBertyprotocol(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Account) Size() (n int) {
+func (m *DeviceLinkReference_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Group != nil {
-		l = m.Group.Size()
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.AccountPrivateKey)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.AliasPrivateKey)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.PublicRendezvousSeed)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *Group) Size() (n int) {
+func (m *DeviceLinkReference_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.PublicKey)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Secret)
-	if l > 0 {
+	if m.Reference != nil {
+		l = m.Reference.Size()
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	l = len(m.SecretSig)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.GroupType != 0 {
-		n += 1 + sovBertyprotocol(uint64(m.GroupType))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GroupMetadata) Size() (n int) {
+func (m *DeviceLinkJoin) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EventType != 0 {
-		n += 1 + sovBertyprotocol(uint64(m.EventType))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Sig)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GroupEnvelope) Size() (n int) {
+func (m *DeviceLinkJoin_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Event)
-	if l > 0 {
+	if m.Reference != nil {
+		l = m.Reference.Size()
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -9900,70 +8394,37 @@ func (m *GroupEnvelope) Size() (n int) {
 	return n
 }
 
-func (m *MessageHeaders) Size() (n int) {
+func (m *DeviceLinkJoin_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Counter != 0 {
-		n += 1 + sovBertyprotocol(uint64(m.Counter))
-	}
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Sig)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MessageEnvelope) Size() (n int) {
+func (m *DeviceRevoke) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.MessageHeaders)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *EventContext) Size() (n int) {
+func (m *DeviceRevoke_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ID)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if len(m.ParentIDs) > 0 {
-		for _, b := range m.ParentIDs {
-			l = len(b)
-			n += 1 + l + sovBertyprotocol(uint64(l))
-		}
-	}
-	l = len(m.GroupPK)
+	l = len(m.DevicePK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -9973,276 +8434,165 @@ func (m *EventContext) Size() (n int) {
 	return n
 }
 
-func (m *AppMetadata) Size() (n int) {
+func (m *DeviceRevoke_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ContactAddAliasKey) Size() (n int) {
+func (m *ContactRequestReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.AliasPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GroupAddMemberDevice) Size() (n int) {
+func (m *ContactRequestReference_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.MemberPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.MemberSig)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *DeviceSecret) Size() (n int) {
+func (m *ContactRequestReference_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ChainKey)
+	l = len(m.Reference)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if m.Counter != 0 {
-		n += 1 + sovBertyprotocol(uint64(m.Counter))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GroupAddDeviceSecret) Size() (n int) {
+func (m *ContactRequestDisable) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.DestMemberPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *MultiMemberGroupAddAliasResolver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.AliasResolver)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.AliasProof)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MultiMemberGrantAdminRole) Size() (n int) {
+func (m *ContactRequestDisable_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.GranteeMemberPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MultiMemberInitialMember) Size() (n int) {
+func (m *ContactRequestDisable_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.MemberPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GroupAddAdditionalRendezvousSeed) Size() (n int) {
+func (m *ContactRequestEnable) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Seed)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *GroupRemoveAdditionalRendezvousSeed) Size() (n int) {
+func (m *ContactRequestEnable_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Seed)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AccountGroupJoined) Size() (n int) {
+func (m *ContactRequestEnable_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
+	l = len(m.Reference)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if m.Group != nil {
-		l = m.Group.Size()
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AccountGroupLeft) Size() (n int) {
+func (m *ContactRequestResetReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.GroupPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AccountContactRequestDisabled) Size() (n int) {
+func (m *ContactRequestResetReference_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AccountContactRequestEnabled) Size() (n int) {
+func (m *ContactRequestResetReference_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
+	l = len(m.Reference)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -10252,41 +8602,25 @@ func (m *AccountContactRequestEnabled) Size() (n int) {
 	return n
 }
 
-func (m *AccountContactRequestReferenceReset) Size() (n int) {
+func (m *ContactRequestSend) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.RendezvousSeed)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AccountContactRequestEnqueued) Size() (n int) {
+func (m *ContactRequestSend_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.ContactPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.ContactRendezvousSeed)
+	l = len(m.Reference)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -10294,7 +8628,7 @@ func (m *AccountContactRequestEnqueued) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	l = len(m.GroupPK)
+	l = len(m.OwnMetadata)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -10304,64 +8638,36 @@ func (m *AccountContactRequestEnqueued) Size() (n int) {
 	return n
 }
 
-func (m *AccountContactRequestSent) Size() (n int) {
+func (m *ContactRequestSend_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.ContactPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AccountContactRequestReceived) Size() (n int) {
+func (m *ContactRequestAccept) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.ContactPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.ContactRendezvousSeed)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.ContactMetadata)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AccountContactRequestDiscarded) Size() (n int) {
+func (m *ContactRequestAccept_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	l = len(m.ContactPK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
@@ -10372,60 +8678,36 @@ func (m *AccountContactRequestDiscarded) Size() (n int) {
 	return n
 }
 
-func (m *AccountContactRequestAccepted) Size() (n int) {
+func (m *ContactRequestAccept_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.ContactPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.GroupPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AccountContactBlocked) Size() (n int) {
+func (m *ContactRequestDiscard) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.ContactPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *AccountContactUnblocked) Size() (n int) {
+func (m *ContactRequestDiscard_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	l = len(m.ContactPK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
@@ -10436,7 +8718,7 @@ func (m *AccountContactUnblocked) Size() (n int) {
 	return n
 }
 
-func (m *InstanceExportData) Size() (n int) {
+func (m *ContactRequestDiscard_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10448,7 +8730,7 @@ func (m *InstanceExportData) Size() (n int) {
 	return n
 }
 
-func (m *InstanceExportData_Request) Size() (n int) {
+func (m *ContactBlock) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10460,13 +8742,13 @@ func (m *InstanceExportData_Request) Size() (n int) {
 	return n
 }
 
-func (m *InstanceExportData_Reply) Size() (n int) {
+func (m *ContactBlock_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ExportedData)
+	l = len(m.ContactPK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -10476,7 +8758,7 @@ func (m *InstanceExportData_Reply) Size() (n int) {
 	return n
 }
 
-func (m *InstanceGetConfiguration) Size() (n int) {
+func (m *ContactBlock_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10488,7 +8770,7 @@ func (m *InstanceGetConfiguration) Size() (n int) {
 	return n
 }
 
-func (m *InstanceGetConfiguration_Request) Size() (n int) {
+func (m *ContactUnblock) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10500,53 +8782,23 @@ func (m *InstanceGetConfiguration_Request) Size() (n int) {
 	return n
 }
 
-func (m *InstanceGetConfiguration_Reply) Size() (n int) {
+func (m *ContactUnblock_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.AccountPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.DevicePK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.AccountGroupPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.PeerID)
+	l = len(m.ContactPK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if len(m.Listeners) > 0 {
-		for _, s := range m.Listeners {
-			l = len(s)
-			n += 1 + l + sovBertyprotocol(uint64(l))
-		}
-	}
-	if m.BleEnabled != 0 {
-		n += 1 + sovBertyprotocol(uint64(m.BleEnabled))
-	}
-	if m.WifiP2PEnabled != 0 {
-		n += 1 + sovBertyprotocol(uint64(m.WifiP2PEnabled))
-	}
-	if m.MdnsEnabled != 0 {
-		n += 1 + sovBertyprotocol(uint64(m.MdnsEnabled))
-	}
-	if m.RelayEnabled != 0 {
-		n += 1 + sovBertyprotocol(uint64(m.RelayEnabled))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ContactRequestReference) Size() (n int) {
+func (m *ContactUnblock_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10558,7 +8810,7 @@ func (m *ContactRequestReference) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestReference_Request) Size() (n int) {
+func (m *ContactAliasKeySend) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10570,13 +8822,13 @@ func (m *ContactRequestReference_Request) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestReference_Reply) Size() (n int) {
+func (m *ContactAliasKeySend_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Reference)
+	l = len(m.GroupPK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -10586,7 +8838,7 @@ func (m *ContactRequestReference_Reply) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestDisable) Size() (n int) {
+func (m *ContactAliasKeySend_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10598,7 +8850,7 @@ func (m *ContactRequestDisable) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestDisable_Request) Size() (n int) {
+func (m *MultiMemberGroupCreate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10610,31 +8862,38 @@ func (m *ContactRequestDisable_Request) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestDisable_Reply) Size() (n int) {
+func (m *MultiMemberGroupCreate_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.AdminMessagesOnly {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ContactRequestEnable) Size() (n int) {
+func (m *MultiMemberGroupCreate_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.GroupPK)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ContactRequestEnable_Request) Size() (n int) {
+func (m *MultiMemberGroupJoin) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10646,14 +8905,14 @@ func (m *ContactRequestEnable_Request) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestEnable_Reply) Size() (n int) {
+func (m *MultiMemberGroupJoin_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Reference)
-	if l > 0 {
+	if m.Invitation != nil {
+		l = m.Invitation.Size()
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -10662,7 +8921,7 @@ func (m *ContactRequestEnable_Reply) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestResetReference) Size() (n int) {
+func (m *MultiMemberGroupJoin_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10674,7 +8933,7 @@ func (m *ContactRequestResetReference) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestResetReference_Request) Size() (n int) {
+func (m *MultiMemberGroupLeave) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10686,13 +8945,13 @@ func (m *ContactRequestResetReference_Request) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestResetReference_Reply) Size() (n int) {
+func (m *MultiMemberGroupLeave_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Reference)
+	l = len(m.GroupPK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -10702,7 +8961,7 @@ func (m *ContactRequestResetReference_Reply) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestSend) Size() (n int) {
+func (m *MultiMemberGroupLeave_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10714,67 +8973,47 @@ func (m *ContactRequestSend) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestSend_Request) Size() (n int) {
+func (m *MultiMemberGroupAliasResolverDisclose) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Reference)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.ContactMetadata)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ContactRequestSend_Reply) Size() (n int) {
+func (m *MultiMemberGroupAliasResolverDisclose_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = len(m.GroupPK)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ContactRequestAccept) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ContactRequestAccept_Request) Size() (n int) {
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ContactPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ContactRequestAccept_Reply) Size() (n int) {
+func (m *MultiMemberGroupAdminRoleGrant) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10786,25 +9025,17 @@ func (m *ContactRequestAccept_Reply) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestDiscard) Size() (n int) {
+func (m *MultiMemberGroupAdminRoleGrant_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ContactRequestDiscard_Request) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.GroupPK)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	var l int
-	_ = l
-	l = len(m.ContactPK)
+	l = len(m.MemberPK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -10814,7 +9045,7 @@ func (m *ContactRequestDiscard_Request) Size() (n int) {
 	return n
 }
 
-func (m *ContactRequestDiscard_Reply) Size() (n int) {
+func (m *MultiMemberGroupAdminRoleGrant_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10826,7 +9057,7 @@ func (m *ContactRequestDiscard_Reply) Size() (n int) {
 	return n
 }
 
-func (m *ContactBlock) Size() (n int) {
+func (m *MultiMemberGroupAdminRoleRevoke) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10838,53 +9069,17 @@ func (m *ContactBlock) Size() (n int) {
 	return n
 }
 
-func (m *ContactBlock_Request) Size() (n int) {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ContactPK)
+	l = len(m.GroupPK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ContactBlock_Reply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ContactUnblock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ContactUnblock_Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContactPK)
+	l = len(m.MemberPK)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -10894,7 +9089,7 @@ func (m *ContactUnblock_Request) Size() (n int) {
 	return n
 }
 
-func (m *ContactUnblock_Reply) Size() (n int) {
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10906,7 +9101,7 @@ func (m *ContactUnblock_Reply) Size() (n int) {
 	return n
 }
 
-func (m *ContactAliasKeySend) Size() (n int) {
+func (m *MultiMemberGroupMemberRemove) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10918,7 +9113,7 @@ func (m *ContactAliasKeySend) Size() (n int) {
 	return n
 }
 
-func (m *ContactAliasKeySend_Request) Size() (n int) {
+func (m *MultiMemberGroupMemberRemove_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10928,25 +9123,17 @@ func (m *ContactAliasKeySend_Request) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ContactAliasKeySend_Reply) Size() (n int) {
-	if m == nil {
-		return 0
+	l = len(m.MemberPK)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	var l int
-	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MultiMemberGroupCreate) Size() (n int) {
+func (m *MultiMemberGroupMemberRemove_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10958,7 +9145,7 @@ func (m *MultiMemberGroupCreate) Size() (n int) {
 	return n
 }
 
-func (m *MultiMemberGroupCreate_Request) Size() (n int) {
+func (m *MultiMemberGroupInvitationCreate) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10970,7 +9157,7 @@ func (m *MultiMemberGroupCreate_Request) Size() (n int) {
 	return n
 }
 
-func (m *MultiMemberGroupCreate_Reply) Size() (n int) {
+func (m *MultiMemberGroupInvitationCreate_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -10980,32 +9167,26 @@ func (m *MultiMemberGroupCreate_Reply) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.ExpiresAt != 0 {
+		n += 1 + sovBertyprotocol(uint64(m.ExpiresAt))
 	}
-	return n
-}
-
-func (m *MultiMemberGroupJoin) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxUses != 0 {
+		n += 1 + sovBertyprotocol(uint64(m.MaxUses))
 	}
-	var l int
-	_ = l
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *MultiMemberGroupJoin_Request) Size() (n int) {
+func (m *MultiMemberGroupInvitationCreate_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Group != nil {
-		l = m.Group.Size()
+	if m.Invitation != nil {
+		l = m.Invitation.Size()
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
@@ -11014,19 +9195,7 @@ func (m *MultiMemberGroupJoin_Request) Size() (n int) {
 	return n
 }
 
-func (m *MultiMemberGroupJoin_Reply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *MultiMemberGroupLeave) Size() (n int) {
+func (m *MultiMemberGroupInvitationRevoke) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11038,7 +9207,7 @@ func (m *MultiMemberGroupLeave) Size() (n int) {
 	return n
 }
 
-func (m *MultiMemberGroupLeave_Request) Size() (n int) {
+func (m *MultiMemberGroupInvitationRevoke_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11048,43 +9217,7 @@ func (m *MultiMemberGroupLeave_Request) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *MultiMemberGroupLeave_Reply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *MultiMemberGroupAliasResolverDisclose) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *MultiMemberGroupAliasResolverDisclose_Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GroupPK)
+	l = len(m.InvitationID)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -11094,7 +9227,7 @@ func (m *MultiMemberGroupAliasResolverDisclose_Request) Size() (n int) {
 	return n
 }
 
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) Size() (n int) {
+func (m *MultiMemberGroupInvitationRevoke_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11106,7 +9239,7 @@ func (m *MultiMemberGroupAliasResolverDisclose_Reply) Size() (n int) {
 	return n
 }
 
-func (m *MultiMemberGroupAdminRoleGrant) Size() (n int) {
+func (m *AppMetadataSend) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11118,7 +9251,7 @@ func (m *MultiMemberGroupAdminRoleGrant) Size() (n int) {
 	return n
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Request) Size() (n int) {
+func (m *AppMetadataSend_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11128,7 +9261,7 @@ func (m *MultiMemberGroupAdminRoleGrant_Request) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
-	l = len(m.MemberPK)
+	l = len(m.Payload)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -11138,37 +9271,13 @@ func (m *MultiMemberGroupAdminRoleGrant_Request) Size() (n int) {
 	return n
 }
 
-func (m *MultiMemberGroupAdminRoleGrant_Reply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *MultiMemberGroupInvitationCreate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *MultiMemberGroupInvitationCreate_Request) Size() (n int) {
+func (m *AppMetadataSend_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.GroupPK)
+	l = len(m.CID)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -11178,23 +9287,7 @@ func (m *MultiMemberGroupInvitationCreate_Request) Size() (n int) {
 	return n
 }
 
-func (m *MultiMemberGroupInvitationCreate_Reply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Group != nil {
-		l = m.Group.Size()
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *AppMetadataSend) Size() (n int) {
+func (m *AppMessageSend) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11206,7 +9299,7 @@ func (m *AppMetadataSend) Size() (n int) {
 	return n
 }
 
-func (m *AppMetadataSend_Request) Size() (n int) {
+func (m *AppMessageSend_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11226,41 +9319,13 @@ func (m *AppMetadataSend_Request) Size() (n int) {
 	return n
 }
 
-func (m *AppMetadataSend_Reply) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *AppMessageSend) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *AppMessageSend_Request) Size() (n int) {
+func (m *AppMessageSend_Reply) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.GroupPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Payload)
+	l = len(m.CID)
 	if l > 0 {
 		n += 1 + l + sovBertyprotocol(uint64(l))
 	}
@@ -11270,7 +9335,7 @@ func (m *AppMessageSend_Request) Size() (n int) {
 	return n
 }
 
-func (m *AppMessageSend_Reply) Size() (n int) {
+func (m *GroupMetadataSubscribe) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -11282,2273 +9347,92 @@ func (m *AppMessageSend_Reply) Size() (n int) {
 	return n
 }
 
-func (m *GroupMetadataEvent) Size() (n int) {
+func (m *GroupMetadataSubscribe_Request) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.EventContext != nil {
-		l = m.EventContext.Size()
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Event)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *GroupMessageEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventContext != nil {
-		l = m.EventContext.Size()
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.Headers != nil {
-		l = m.Headers.Size()
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *GroupMetadataSubscribe) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *GroupMetadataSubscribe_Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GroupPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Since)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Until)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.GoBackwards {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *GroupMessageSubscribe) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *GroupMessageSubscribe_Request) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.GroupPK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Since)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Until)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.GoBackwards {
-		n += 2
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *ShareableContact) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PK)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.PublicRendezvousSeed)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovBertyprotocol(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func sovBertyprotocol(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBertyprotocol(x uint64) (n int) {
-	return sovBertyprotocol(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Account) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Account: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Group == nil {
-				m.Group = &Group{}
-			}
-			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPrivateKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccountPrivateKey = append(m.AccountPrivateKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.AccountPrivateKey == nil {
-				m.AccountPrivateKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AliasPrivateKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AliasPrivateKey = append(m.AliasPrivateKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.AliasPrivateKey == nil {
-				m.AliasPrivateKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicRendezvousSeed", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicRendezvousSeed = append(m.PublicRendezvousSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicRendezvousSeed == nil {
-				m.PublicRendezvousSeed = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Group) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Group: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Group: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicKey == nil {
-				m.PublicKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Secret", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Secret = append(m.Secret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Secret == nil {
-				m.Secret = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SecretSig", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SecretSig = append(m.SecretSig[:0], dAtA[iNdEx:postIndex]...)
-			if m.SecretSig == nil {
-				m.SecretSig = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupType", wireType)
-			}
-			m.GroupType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GroupType |= GroupType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventType", wireType)
-			}
-			m.EventType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventType |= EventType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sig == nil {
-				m.Sig = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupEnvelope) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupEnvelope: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
-			if m.Nonce == nil {
-				m.Nonce = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Event = append(m.Event[:0], dAtA[iNdEx:postIndex]...)
-			if m.Event == nil {
-				m.Event = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MessageHeaders) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MessageHeaders: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageHeaders: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
-			}
-			m.Counter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Counter |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sig = append(m.Sig[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sig == nil {
-				m.Sig = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MessageEnvelope) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MessageEnvelope: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageEnvelope: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageHeaders", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MessageHeaders = append(m.MessageHeaders[:0], dAtA[iNdEx:postIndex]...)
-			if m.MessageHeaders == nil {
-				m.MessageHeaders = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
-			if m.Message == nil {
-				m.Message = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nonce = append(m.Nonce[:0], dAtA[iNdEx:postIndex]...)
-			if m.Nonce == nil {
-				m.Nonce = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventContext) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventContext: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventContext: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = append(m.ID[:0], dAtA[iNdEx:postIndex]...)
-			if m.ID == nil {
-				m.ID = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentIDs", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ParentIDs = append(m.ParentIDs, make([]byte, postIndex-iNdEx))
-			copy(m.ParentIDs[len(m.ParentIDs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupPK == nil {
-				m.GroupPK = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AppMetadata) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AppMetadata: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
-			if m.Message == nil {
-				m.Message = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContactAddAliasKey) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContactAddAliasKey: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactAddAliasKey: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AliasPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AliasPK = append(m.AliasPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.AliasPK == nil {
-				m.AliasPK = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupAddMemberDevice) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupAddMemberDevice: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupAddMemberDevice: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MemberPK = append(m.MemberPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.MemberPK == nil {
-				m.MemberPK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberSig", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MemberSig = append(m.MemberSig[:0], dAtA[iNdEx:postIndex]...)
-			if m.MemberSig == nil {
-				m.MemberSig = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DeviceSecret) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DeviceSecret: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeviceSecret: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainKey", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainKey = append(m.ChainKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.ChainKey == nil {
-				m.ChainKey = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counter", wireType)
-			}
-			m.Counter = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Counter |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GroupAddDeviceSecret) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GroupAddDeviceSecret: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupAddDeviceSecret: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestMemberPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DestMemberPK = append(m.DestMemberPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DestMemberPK == nil {
-				m.DestMemberPK = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MultiMemberGroupAddAliasResolver) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MultiMemberGroupAddAliasResolver: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiMemberGroupAddAliasResolver: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AliasResolver", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AliasResolver = append(m.AliasResolver[:0], dAtA[iNdEx:postIndex]...)
-			if m.AliasResolver == nil {
-				m.AliasResolver = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AliasProof", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AliasProof = append(m.AliasProof[:0], dAtA[iNdEx:postIndex]...)
-			if m.AliasProof == nil {
-				m.AliasProof = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MultiMemberGrantAdminRole) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MultiMemberGrantAdminRole: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiMemberGrantAdminRole: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GranteeMemberPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GranteeMemberPK = append(m.GranteeMemberPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.GranteeMemberPK == nil {
-				m.GranteeMemberPK = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
+	var l int
+	_ = l
+	l = len(m.GroupPK)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
+	l = len(m.Since)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
+	l = len(m.Until)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
+	if m.GoBackwards {
+		n += 2
+	}
+	if len(m.EventTypes) > 0 {
+		l = 0
+		for _, e := range m.EventTypes {
+			l += sovBertyprotocol(uint64(e))
 		}
+		n += 1 + sovBertyprotocol(uint64(l)) + l
 	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
-	return nil
+	return n
 }
-func (m *MultiMemberInitialMember) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MultiMemberInitialMember: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiMemberInitialMember: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MemberPK = append(m.MemberPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.MemberPK == nil {
-				m.MemberPK = []byte{}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
+
+func (m *GroupMessageSubscribe) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
 	}
+	return n
+}
 
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
+func (m *GroupMessageSubscribe_Request) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	return nil
+	var l int
+	_ = l
+	l = len(m.GroupPK)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
+	l = len(m.Since)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
+	l = len(m.Until)
+	if l > 0 {
+		n += 1 + l + sovBertyprotocol(uint64(l))
+	}
+	if m.GoBackwards {
+		n += 2
+	}
+	if m.Limit != 0 {
+		n += 1 + sovBertyprotocol(uint64(m.Limit))
+	}
+	if m.LiveOnly {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovBertyprotocol(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBertyprotocol(x uint64) (n int) {
+	return sovBertyprotocol(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *GroupAddAdditionalRendezvousSeed) Unmarshal(dAtA []byte) error {
+func (m *InstanceExportData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13571,80 +9455,12 @@ func (m *GroupAddAdditionalRendezvousSeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupAddAdditionalRendezvousSeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstanceExportData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupAddAdditionalRendezvousSeed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstanceExportData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
-			if m.Seed == nil {
-				m.Seed = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -13670,7 +9486,7 @@ func (m *GroupAddAdditionalRendezvousSeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupRemoveAdditionalRendezvousSeed) Unmarshal(dAtA []byte) error {
+func (m *InstanceExportData_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13693,49 +9509,15 @@ func (m *GroupRemoveAdditionalRendezvousSeed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupRemoveAdditionalRendezvousSeed: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupRemoveAdditionalRendezvousSeed: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13762,9 +9544,9 @@ func (m *GroupRemoveAdditionalRendezvousSeed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
-			if m.Seed == nil {
-				m.Seed = []byte{}
+			m.Passphrase = append(m.Passphrase[:0], dAtA[iNdEx:postIndex]...)
+			if m.Passphrase == nil {
+				m.Passphrase = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -13792,7 +9574,7 @@ func (m *GroupRemoveAdditionalRendezvousSeed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountGroupJoined) Unmarshal(dAtA []byte) error {
+func (m *InstanceExportData_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13815,15 +9597,15 @@ func (m *AccountGroupJoined) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountGroupJoined: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountGroupJoined: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportedData", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -13850,45 +9632,9 @@ func (m *AccountGroupJoined) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Group == nil {
-				m.Group = &Group{}
-			}
-			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ExportedData = append(m.ExportedData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExportedData == nil {
+				m.ExportedData = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -13916,7 +9662,7 @@ func (m *AccountGroupJoined) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountGroupLeft) Unmarshal(dAtA []byte) error {
+func (m *InstanceImportData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13939,80 +9685,12 @@ func (m *AccountGroupLeft) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountGroupLeft: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstanceImportData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountGroupLeft: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstanceImportData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupPK == nil {
-				m.GroupPK = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -14038,7 +9716,7 @@ func (m *AccountGroupLeft) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactRequestDisabled) Unmarshal(dAtA []byte) error {
+func (m *InstanceImportData_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14061,15 +9739,15 @@ func (m *AccountContactRequestDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactRequestDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactRequestDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportedData", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14096,9 +9774,43 @@ func (m *AccountContactRequestDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
+			m.ExportedData = append(m.ExportedData[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExportedData == nil {
+				m.ExportedData = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Passphrase", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Passphrase = append(m.Passphrase[:0], dAtA[iNdEx:postIndex]...)
+			if m.Passphrase == nil {
+				m.Passphrase = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -14126,7 +9838,7 @@ func (m *AccountContactRequestDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactRequestEnabled) Unmarshal(dAtA []byte) error {
+func (m *InstanceImportData_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14149,46 +9861,12 @@ func (m *AccountContactRequestEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactRequestEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactRequestEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -14214,7 +9892,7 @@ func (m *AccountContactRequestEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactRequestReferenceReset) Unmarshal(dAtA []byte) error {
+func (m *InstanceGetConfiguration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14237,80 +9915,66 @@ func (m *AccountContactRequestReferenceReset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactRequestReferenceReset: wiretype end group for non-group")
+			return fmt.Errorf("proto: InstanceGetConfiguration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactRequestReferenceReset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InstanceGetConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RendezvousSeed", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InstanceGetConfiguration_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBertyprotocol
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RendezvousSeed = append(m.RendezvousSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.RendezvousSeed == nil {
-				m.RendezvousSeed = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -14336,7 +10000,7 @@ func (m *AccountContactRequestReferenceReset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactRequestEnqueued) Unmarshal(dAtA []byte) error {
+func (m *InstanceGetConfiguration_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14359,15 +10023,15 @@ func (m *AccountContactRequestEnqueued) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactRequestEnqueued: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactRequestEnqueued: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountPK", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14394,14 +10058,14 @@ func (m *AccountContactRequestEnqueued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
+			m.AccountPK = append(m.AccountPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccountPK == nil {
+				m.AccountPK = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14428,14 +10092,14 @@ func (m *AccountContactRequestEnqueued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactPK == nil {
-				m.ContactPK = []byte{}
+			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
+			if m.DevicePK == nil {
+				m.DevicePK = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactRendezvousSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountGroupPK", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -14462,16 +10126,16 @@ func (m *AccountContactRequestEnqueued) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactRendezvousSeed = append(m.ContactRendezvousSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactRendezvousSeed == nil {
-				m.ContactRendezvousSeed = []byte{}
+			m.AccountGroupPK = append(m.AccountGroupPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.AccountGroupPK == nil {
+				m.AccountGroupPK = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeerID", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -14481,31 +10145,29 @@ func (m *AccountContactRequestEnqueued) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactMetadata = append(m.ContactMetadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactMetadata == nil {
-				m.ContactMetadata = []byte{}
-			}
+			m.PeerID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listeners", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -14515,26 +10177,208 @@ func (m *AccountContactRequestEnqueued) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupPK == nil {
-				m.GroupPK = []byte{}
+			m.Listeners = append(m.Listeners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BleEnabled", wireType)
+			}
+			m.BleEnabled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BleEnabled |= InstanceGetConfiguration_SettingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WifiP2PEnabled", wireType)
+			}
+			m.WifiP2PEnabled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WifiP2PEnabled |= InstanceGetConfiguration_SettingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MdnsEnabled", wireType)
+			}
+			m.MdnsEnabled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MdnsEnabled |= InstanceGetConfiguration_SettingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayEnabled", wireType)
+			}
+			m.RelayEnabled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RelayEnabled |= InstanceGetConfiguration_SettingState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceLinkReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBertyprotocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeviceLinkReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeviceLinkReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceLinkReference_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBertyprotocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -14560,7 +10404,7 @@ func (m *AccountContactRequestEnqueued) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactRequestSent) Unmarshal(dAtA []byte) error {
+func (m *DeviceLinkReference_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14583,17 +10427,17 @@ func (m *AccountContactRequestSent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactRequestSent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactRequestSent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -14603,58 +10447,26 @@ func (m *AccountContactRequestSent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Reference == nil {
+				m.Reference = &bertytypes.ShareableDeviceLink{}
 			}
-			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactPK == nil {
-				m.ContactPK = []byte{}
+			if err := m.Reference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -14682,7 +10494,7 @@ func (m *AccountContactRequestSent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactRequestReceived) Unmarshal(dAtA []byte) error {
+func (m *DeviceLinkJoin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14705,148 +10517,12 @@ func (m *AccountContactRequestReceived) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactRequestReceived: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceLinkJoin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactRequestReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceLinkJoin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactPK == nil {
-				m.ContactPK = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactRendezvousSeed", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContactRendezvousSeed = append(m.ContactRendezvousSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactRendezvousSeed == nil {
-				m.ContactRendezvousSeed = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactMetadata", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContactMetadata = append(m.ContactMetadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactMetadata == nil {
-				m.ContactMetadata = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -14872,7 +10548,7 @@ func (m *AccountContactRequestReceived) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactRequestDiscarded) Unmarshal(dAtA []byte) error {
+func (m *DeviceLinkJoin_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14895,17 +10571,17 @@ func (m *AccountContactRequestDiscarded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactRequestDiscarded: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactRequestDiscarded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -14915,60 +10591,82 @@ func (m *AccountContactRequestDiscarded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
+			if m.Reference == nil {
+				m.Reference = &bertytypes.ShareableDeviceLink{}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
+			if err := m.Reference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeviceLinkJoin_Reply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBertyprotocol
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactPK == nil {
-				m.ContactPK = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -14994,7 +10692,7 @@ func (m *AccountContactRequestDiscarded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactRequestAccepted) Unmarshal(dAtA []byte) error {
+func (m *DeviceRevoke) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15017,114 +10715,12 @@ func (m *AccountContactRequestAccepted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactRequestAccepted: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeviceRevoke: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactRequestAccepted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeviceRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactPK == nil {
-				m.ContactPK = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupPK == nil {
-				m.GroupPK = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -15150,7 +10746,7 @@ func (m *AccountContactRequestAccepted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactBlocked) Unmarshal(dAtA []byte) error {
+func (m *DeviceRevoke_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15173,10 +10769,10 @@ func (m *AccountContactBlocked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactBlocked: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactBlocked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -15213,40 +10809,6 @@ func (m *AccountContactBlocked) Unmarshal(dAtA []byte) error {
 				m.DevicePK = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactPK == nil {
-				m.ContactPK = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -15272,7 +10834,7 @@ func (m *AccountContactBlocked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountContactUnblocked) Unmarshal(dAtA []byte) error {
+func (m *DeviceRevoke_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15295,80 +10857,12 @@ func (m *AccountContactUnblocked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountContactUnblocked: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountContactUnblocked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactPK == nil {
-				m.ContactPK = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -15394,7 +10888,7 @@ func (m *AccountContactUnblocked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstanceExportData) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15417,10 +10911,10 @@ func (m *InstanceExportData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InstanceExportData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactRequestReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstanceExportData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactRequestReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15448,7 +10942,7 @@ func (m *InstanceExportData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstanceExportData_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestReference_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15502,7 +10996,7 @@ func (m *InstanceExportData_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstanceExportData_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestReference_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15533,7 +11027,7 @@ func (m *InstanceExportData_Reply) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExportedData", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -15560,9 +11054,9 @@ func (m *InstanceExportData_Reply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExportedData = append(m.ExportedData[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExportedData == nil {
-				m.ExportedData = []byte{}
+			m.Reference = append(m.Reference[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reference == nil {
+				m.Reference = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -15590,7 +11084,7 @@ func (m *InstanceExportData_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstanceGetConfiguration) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestDisable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15613,10 +11107,10 @@ func (m *InstanceGetConfiguration) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InstanceGetConfiguration: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactRequestDisable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InstanceGetConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactRequestDisable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -15644,7 +11138,7 @@ func (m *InstanceGetConfiguration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstanceGetConfiguration_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestDisable_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15698,7 +11192,7 @@ func (m *InstanceGetConfiguration_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InstanceGetConfiguration_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestDisable_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15721,254 +11215,12 @@ func (m *InstanceGetConfiguration_Reply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccountPK = append(m.AccountPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.AccountPK == nil {
-				m.AccountPK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DevicePK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DevicePK = append(m.DevicePK[:0], dAtA[iNdEx:postIndex]...)
-			if m.DevicePK == nil {
-				m.DevicePK = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountGroupPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AccountGroupPK = append(m.AccountGroupPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.AccountGroupPK == nil {
-				m.AccountGroupPK = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PeerID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PeerID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Listeners", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Listeners = append(m.Listeners, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BleEnabled", wireType)
-			}
-			m.BleEnabled = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BleEnabled |= InstanceGetConfiguration_SettingState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WifiP2PEnabled", wireType)
-			}
-			m.WifiP2PEnabled = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WifiP2PEnabled |= InstanceGetConfiguration_SettingState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MdnsEnabled", wireType)
-			}
-			m.MdnsEnabled = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MdnsEnabled |= InstanceGetConfiguration_SettingState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RelayEnabled", wireType)
-			}
-			m.RelayEnabled = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RelayEnabled |= InstanceGetConfiguration_SettingState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -15994,7 +11246,7 @@ func (m *InstanceGetConfiguration_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestReference) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestEnable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16017,10 +11269,10 @@ func (m *ContactRequestReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContactRequestReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactRequestEnable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactRequestReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactRequestEnable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16048,7 +11300,7 @@ func (m *ContactRequestReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestReference_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestEnable_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16102,7 +11354,7 @@ func (m *ContactRequestReference_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestReference_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestEnable_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16190,7 +11442,7 @@ func (m *ContactRequestReference_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestDisable) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestResetReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16213,10 +11465,10 @@ func (m *ContactRequestDisable) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContactRequestDisable: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactRequestResetReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactRequestDisable: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactRequestResetReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16244,7 +11496,7 @@ func (m *ContactRequestDisable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestDisable_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestResetReference_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16298,7 +11550,7 @@ func (m *ContactRequestDisable_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestDisable_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestResetReference_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16327,60 +11579,40 @@ func (m *ContactRequestDisable_Reply) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ContactRequestEnable) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBertyprotocol
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Reference = append(m.Reference[:0], dAtA[iNdEx:postIndex]...)
+			if m.Reference == nil {
+				m.Reference = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ContactRequestEnable: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactRequestEnable: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -16406,7 +11638,7 @@ func (m *ContactRequestEnable) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestEnable_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16429,10 +11661,10 @@ func (m *ContactRequestEnable_Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactRequestSend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactRequestSend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16460,7 +11692,7 @@ func (m *ContactRequestEnable_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestEnable_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestSend_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16483,10 +11715,10 @@ func (m *ContactRequestEnable_Reply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -16523,6 +11755,74 @@ func (m *ContactRequestEnable_Reply) Unmarshal(dAtA []byte) error {
 				m.Reference = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContactMetadata = append(m.ContactMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContactMetadata == nil {
+				m.ContactMetadata = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnMetadata = append(m.OwnMetadata[:0], dAtA[iNdEx:postIndex]...)
+			if m.OwnMetadata == nil {
+				m.OwnMetadata = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -16548,7 +11848,7 @@ func (m *ContactRequestEnable_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestResetReference) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestSend_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16571,10 +11871,10 @@ func (m *ContactRequestResetReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContactRequestResetReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactRequestResetReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16602,7 +11902,7 @@ func (m *ContactRequestResetReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestResetReference_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestAccept) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16625,10 +11925,10 @@ func (m *ContactRequestResetReference_Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactRequestAccept: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactRequestAccept: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16656,7 +11956,7 @@ func (m *ContactRequestResetReference_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestResetReference_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestAccept_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16679,15 +11979,15 @@ func (m *ContactRequestResetReference_Reply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -16714,9 +12014,9 @@ func (m *ContactRequestResetReference_Reply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reference = append(m.Reference[:0], dAtA[iNdEx:postIndex]...)
-			if m.Reference == nil {
-				m.Reference = []byte{}
+			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContactPK == nil {
+				m.ContactPK = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -16744,7 +12044,7 @@ func (m *ContactRequestResetReference_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestSend) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestAccept_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16767,10 +12067,10 @@ func (m *ContactRequestSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContactRequestSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactRequestSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -16798,7 +12098,7 @@ func (m *ContactRequestSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestSend_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestDiscard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16821,49 +12121,69 @@ func (m *ContactRequestSend_Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactRequestDiscard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactRequestDiscard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContactRequestDiscard_Request) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBertyprotocol
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reference = append(m.Reference[:0], dAtA[iNdEx:postIndex]...)
-			if m.Reference == nil {
-				m.Reference = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -16890,9 +12210,9 @@ func (m *ContactRequestSend_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactMetadata = append(m.ContactMetadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactMetadata == nil {
-				m.ContactMetadata = []byte{}
+			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.ContactPK == nil {
+				m.ContactPK = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -16920,7 +12240,7 @@ func (m *ContactRequestSend_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestSend_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactRequestDiscard_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16974,7 +12294,7 @@ func (m *ContactRequestSend_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestAccept) Unmarshal(dAtA []byte) error {
+func (m *ContactBlock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16997,10 +12317,10 @@ func (m *ContactRequestAccept) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContactRequestAccept: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactBlock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactRequestAccept: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17028,7 +12348,7 @@ func (m *ContactRequestAccept) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestAccept_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactBlock_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17116,7 +12436,7 @@ func (m *ContactRequestAccept_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestAccept_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactBlock_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17170,7 +12490,7 @@ func (m *ContactRequestAccept_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestDiscard) Unmarshal(dAtA []byte) error {
+func (m *ContactUnblock) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17193,10 +12513,10 @@ func (m *ContactRequestDiscard) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContactRequestDiscard: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactUnblock: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactRequestDiscard: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactUnblock: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17224,7 +12544,7 @@ func (m *ContactRequestDiscard) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestDiscard_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactUnblock_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17312,7 +12632,7 @@ func (m *ContactRequestDiscard_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactRequestDiscard_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactUnblock_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17366,7 +12686,7 @@ func (m *ContactRequestDiscard_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactBlock) Unmarshal(dAtA []byte) error {
+func (m *ContactAliasKeySend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17389,10 +12709,10 @@ func (m *ContactBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContactBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContactAliasKeySend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContactAliasKeySend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17420,7 +12740,7 @@ func (m *ContactBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactBlock_Request) Unmarshal(dAtA []byte) error {
+func (m *ContactAliasKeySend_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17451,7 +12771,7 @@ func (m *ContactBlock_Request) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -17478,9 +12798,9 @@ func (m *ContactBlock_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactPK == nil {
-				m.ContactPK = []byte{}
+			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPK == nil {
+				m.GroupPK = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -17508,7 +12828,7 @@ func (m *ContactBlock_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactBlock_Reply) Unmarshal(dAtA []byte) error {
+func (m *ContactAliasKeySend_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17562,7 +12882,7 @@ func (m *ContactBlock_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactUnblock) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupCreate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17585,10 +12905,10 @@ func (m *ContactUnblock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContactUnblock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiMemberGroupCreate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactUnblock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiMemberGroupCreate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17616,7 +12936,7 @@ func (m *ContactUnblock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactUnblock_Request) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupCreate_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17646,10 +12966,10 @@ func (m *ContactUnblock_Request) Unmarshal(dAtA []byte) error {
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContactPK", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminMessagesOnly", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -17659,26 +12979,12 @@ func (m *ContactUnblock_Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContactPK = append(m.ContactPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.ContactPK == nil {
-				m.ContactPK = []byte{}
-			}
-			iNdEx = postIndex
+			m.AdminMessagesOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -17704,7 +13010,7 @@ func (m *ContactUnblock_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactUnblock_Reply) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupCreate_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17733,6 +13039,40 @@ func (m *ContactUnblock_Reply) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPK == nil {
+				m.GroupPK = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -17758,7 +13098,7 @@ func (m *ContactUnblock_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactAliasKeySend) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupJoin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17781,10 +13121,10 @@ func (m *ContactAliasKeySend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContactAliasKeySend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiMemberGroupJoin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContactAliasKeySend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiMemberGroupJoin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -17812,7 +13152,7 @@ func (m *ContactAliasKeySend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactAliasKeySend_Request) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupJoin_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17841,11 +13181,11 @@ func (m *ContactAliasKeySend_Request) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitation", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -17855,24 +13195,26 @@ func (m *ContactAliasKeySend_Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupPK == nil {
-				m.GroupPK = []byte{}
+			if m.Invitation == nil {
+				m.Invitation = &bertytypes.GroupInvitation{}
+			}
+			if err := m.Invitation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -17900,7 +13242,7 @@ func (m *ContactAliasKeySend_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContactAliasKeySend_Reply) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupJoin_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17954,7 +13296,7 @@ func (m *ContactAliasKeySend_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupCreate) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupLeave) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17977,10 +13319,10 @@ func (m *MultiMemberGroupCreate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiMemberGroupCreate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiMemberGroupLeave: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiMemberGroupCreate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiMemberGroupLeave: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18008,7 +13350,7 @@ func (m *MultiMemberGroupCreate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupCreate_Request) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupLeave_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18037,6 +13379,40 @@ func (m *MultiMemberGroupCreate_Request) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPK == nil {
+				m.GroupPK = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -18062,7 +13438,7 @@ func (m *MultiMemberGroupCreate_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupCreate_Reply) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupLeave_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18083,48 +13459,14 @@ func (m *MultiMemberGroupCreate_Reply) Unmarshal(dAtA []byte) error {
 			}
 		}
 		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
-			if m.GroupPK == nil {
-				m.GroupPK = []byte{}
-			}
-			iNdEx = postIndex
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -18150,7 +13492,7 @@ func (m *MultiMemberGroupCreate_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupJoin) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupAliasResolverDisclose) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18173,10 +13515,10 @@ func (m *MultiMemberGroupJoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiMemberGroupJoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiMemberGroupAliasResolverDisclose: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiMemberGroupJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiMemberGroupAliasResolverDisclose: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18204,7 +13546,7 @@ func (m *MultiMemberGroupJoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupJoin_Request) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupAliasResolverDisclose_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18235,9 +13577,9 @@ func (m *MultiMemberGroupJoin_Request) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -18247,26 +13589,24 @@ func (m *MultiMemberGroupJoin_Request) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Group == nil {
-				m.Group = &Group{}
-			}
-			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPK == nil {
+				m.GroupPK = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -18294,7 +13634,7 @@ func (m *MultiMemberGroupJoin_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupJoin_Reply) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupAliasResolverDisclose_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18348,7 +13688,7 @@ func (m *MultiMemberGroupJoin_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupLeave) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupAdminRoleGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18371,10 +13711,10 @@ func (m *MultiMemberGroupLeave) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiMemberGroupLeave: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiMemberGroupAdminRoleGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiMemberGroupLeave: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiMemberGroupAdminRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18402,7 +13742,7 @@ func (m *MultiMemberGroupLeave) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupLeave_Request) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupAdminRoleGrant_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18465,6 +13805,40 @@ func (m *MultiMemberGroupLeave_Request) Unmarshal(dAtA []byte) error {
 				m.GroupPK = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberPK", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberPK = append(m.MemberPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.MemberPK == nil {
+				m.MemberPK = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -18490,7 +13864,7 @@ func (m *MultiMemberGroupLeave_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupLeave_Reply) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupAdminRoleGrant_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18544,7 +13918,7 @@ func (m *MultiMemberGroupLeave_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupAliasResolverDisclose) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupAdminRoleRevoke) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18567,10 +13941,10 @@ func (m *MultiMemberGroupAliasResolverDisclose) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiMemberGroupAliasResolverDisclose: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiMemberGroupAdminRoleRevoke: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiMemberGroupAliasResolverDisclose: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiMemberGroupAdminRoleRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18598,7 +13972,7 @@ func (m *MultiMemberGroupAliasResolverDisclose) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Request) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupAdminRoleRevoke_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18661,6 +14035,40 @@ func (m *MultiMemberGroupAliasResolverDisclose_Request) Unmarshal(dAtA []byte) e
 				m.GroupPK = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberPK", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberPK = append(m.MemberPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.MemberPK == nil {
+				m.MemberPK = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -18686,7 +14094,7 @@ func (m *MultiMemberGroupAliasResolverDisclose_Request) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *MultiMemberGroupAliasResolverDisclose_Reply) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupAdminRoleRevoke_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18740,7 +14148,7 @@ func (m *MultiMemberGroupAliasResolverDisclose_Reply) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *MultiMemberGroupAdminRoleGrant) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupMemberRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18763,10 +14171,10 @@ func (m *MultiMemberGroupAdminRoleGrant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiMemberGroupAdminRoleGrant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiMemberGroupMemberRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiMemberGroupAdminRoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiMemberGroupMemberRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18794,7 +14202,7 @@ func (m *MultiMemberGroupAdminRoleGrant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupAdminRoleGrant_Request) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupMemberRemove_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18916,7 +14324,7 @@ func (m *MultiMemberGroupAdminRoleGrant_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiMemberGroupAdminRoleGrant_Reply) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupMemberRemove_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19087,6 +14495,44 @@ func (m *MultiMemberGroupInvitationCreate_Request) Unmarshal(dAtA []byte) error
 				m.GroupPK = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -19141,9 +14587,9 @@ func (m *MultiMemberGroupInvitationCreate_Reply) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Group", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Invitation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19170,10 +14616,10 @@ func (m *MultiMemberGroupInvitationCreate_Reply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Group == nil {
-				m.Group = &Group{}
+			if m.Invitation == nil {
+				m.Invitation = &bertytypes.GroupInvitation{}
 			}
-			if err := m.Group.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Invitation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19202,7 +14648,7 @@ func (m *MultiMemberGroupInvitationCreate_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppMetadataSend) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupInvitationRevoke) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19225,10 +14671,10 @@ func (m *AppMetadataSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppMetadataSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: MultiMemberGroupInvitationRevoke: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppMetadataSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MultiMemberGroupInvitationRevoke: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19256,7 +14702,7 @@ func (m *AppMetadataSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppMetadataSend_Request) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupInvitationRevoke_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19321,7 +14767,7 @@ func (m *AppMetadataSend_Request) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvitationID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -19348,9 +14794,9 @@ func (m *AppMetadataSend_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			m.InvitationID = append(m.InvitationID[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvitationID == nil {
+				m.InvitationID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -19378,7 +14824,7 @@ func (m *AppMetadataSend_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppMetadataSend_Reply) Unmarshal(dAtA []byte) error {
+func (m *MultiMemberGroupInvitationRevoke_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19432,7 +14878,7 @@ func (m *AppMetadataSend_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppMessageSend) Unmarshal(dAtA []byte) error {
+func (m *AppMetadataSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19455,10 +14901,10 @@ func (m *AppMessageSend) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AppMessageSend: wiretype end group for non-group")
+			return fmt.Errorf("proto: AppMetadataSend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AppMessageSend: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AppMetadataSend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19486,7 +14932,7 @@ func (m *AppMessageSend) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppMessageSend_Request) Unmarshal(dAtA []byte) error {
+func (m *AppMetadataSend_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19551,7 +14997,95 @@ func (m *AppMessageSend_Request) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBertyprotocol
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBertyprotocol
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AppMetadataSend_Reply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBertyprotocol
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -19578,9 +15112,9 @@ func (m *AppMessageSend_Request) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
+			m.CID = append(m.CID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CID == nil {
+				m.CID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -19608,7 +15142,7 @@ func (m *AppMessageSend_Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AppMessageSend_Reply) Unmarshal(dAtA []byte) error {
+func (m *AppMessageSend) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19631,10 +15165,10 @@ func (m *AppMessageSend_Reply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
+			return fmt.Errorf("proto: AppMessageSend: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AppMessageSend: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -19662,7 +15196,7 @@ func (m *AppMessageSend_Reply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupMetadataEvent) Unmarshal(dAtA []byte) error {
+func (m *AppMessageSend_Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19685,17 +15219,17 @@ func (m *GroupMetadataEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMetadataEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMetadataEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventContext", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPK", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -19705,67 +15239,29 @@ func (m *GroupMetadataEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBertyprotocol
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EventContext == nil {
-				m.EventContext = &EventContext{}
-			}
-			if err := m.EventContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.GroupPK = append(m.GroupPK[:0], dAtA[iNdEx:postIndex]...)
+			if m.GroupPK == nil {
+				m.GroupPK = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Metadata == nil {
-				m.Metadata = &GroupMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -19792,9 +15288,9 @@ func (m *GroupMetadataEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Event = append(m.Event[:0], dAtA[iNdEx:postIndex]...)
-			if m.Event == nil {
-				m.Event = []byte{}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -19822,7 +15318,7 @@ func (m *GroupMetadataEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GroupMessageEvent) Unmarshal(dAtA []byte) error {
+func (m *AppMessageSend_Reply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19845,87 +15341,15 @@ func (m *GroupMessageEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GroupMessageEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GroupMessageEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventContext", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.EventContext == nil {
-				m.EventContext = &EventContext{}
-			}
-			if err := m.EventContext.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Headers == nil {
-				m.Headers = &MessageHeaders{}
-			}
-			if err := m.Headers.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CID", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -19952,9 +15376,9 @@ func (m *GroupMessageEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
-			if m.Message == nil {
-				m.Message = []byte{}
+			m.CID = append(m.CID[:0], dAtA[iNdEx:postIndex]...)
+			if m.CID == nil {
+				m.CID = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -20187,6 +15611,75 @@ func (m *GroupMetadataSubscribe_Request) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.GoBackwards = bool(v != 0)
+		case 5:
+			if wireType == 0 {
+				var v bertytypes.EventType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBertyprotocol
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= bertytypes.EventType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EventTypes = append(m.EventTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBertyprotocol
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBertyprotocol
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBertyprotocol
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.EventTypes) == 0 {
+					m.EventTypes = make([]bertytypes.EventType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v bertytypes.EventType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBertyprotocol
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= bertytypes.EventType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EventTypes = append(m.EventTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -20417,99 +15910,11 @@ func (m *GroupMessageSubscribe_Request) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.GoBackwards = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ShareableContact) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBertyprotocol
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ShareableContact: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShareableContact: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PK", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBertyprotocol
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PK = append(m.PK[:0], dAtA[iNdEx:postIndex]...)
-			if m.PK == nil {
-				m.PK = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicRendezvousSeed", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var byteLen int
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -20519,31 +15924,16 @@ func (m *ShareableContact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Limit |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PublicRendezvousSeed = append(m.PublicRendezvousSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.PublicRendezvousSeed == nil {
-				m.PublicRendezvousSeed = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiveOnly", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBertyprotocol
@@ -20553,26 +15943,12 @@ func (m *ShareableContact) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBertyprotocol
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = append(m.Metadata[:0], dAtA[iNdEx:postIndex]...)
-			if m.Metadata == nil {
-				m.Metadata = []byte{}
-			}
-			iNdEx = postIndex
+			m.LiveOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBertyprotocol(dAtA[iNdEx:])
@@ -2,12 +2,18 @@ package bertyprotocol
 
 import (
 	"context"
+	"sync"
 
 	"berty.tech/berty/go/internal/ipfsutil"
 	"berty.tech/berty/go/internal/protocoldb"
+	"berty.tech/berty/go/internal/tinder"
 	"berty.tech/berty/go/pkg/errcode"
+	"github.com/ipfs/go-datastore"
+	ds_sync "github.com/ipfs/go-datastore/sync"
+	ipfs_core "github.com/ipfs/go-ipfs/core"
 	ipfs_coreapi "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/jinzhu/gorm"
+	"github.com/libp2p/go-libp2p-core/host"
 	"go.uber.org/zap"
 )
 
@@ -23,24 +29,50 @@ type Client interface {
 
 type client struct {
 	// variables
-	db          *gorm.DB
-	logger      *zap.Logger
-	ipfsCoreAPI ipfs_coreapi.CoreAPI
+	db            *gorm.DB
+	logger        *zap.Logger
+	ipfsCoreAPI   ipfs_coreapi.CoreAPI
+	ipfsNode      *ipfs_core.IpfsNode
+	host          host.Host
+	tinderDriver  tinder.Driver
+	rootDatastore datastore.Batching
+	rootCtx       context.Context
+
+	// inst is the opened account, it is replaced as a whole when data is
+	// imported or when the device joins another account, swapLock
+	// serializes the replacements
+	instLock sync.RWMutex
+	inst     *instance
+	swapLock sync.Mutex
+
+	// deviceLinkSecret is the secret of the current device link reference,
+	// deviceLinkCancel expires it
+	deviceLinkLock   sync.Mutex
+	deviceLinkSecret []byte
+	deviceLinkCancel context.CancelFunc
 }
 
 // Opts contains optional configuration flags for building a new Client
 type Opts struct {
-	Logger      *zap.Logger
-	IpfsCoreAPI ipfs_coreapi.CoreAPI
-	RootContext context.Context
+	Logger        *zap.Logger
+	IpfsCoreAPI   ipfs_coreapi.CoreAPI
+	RootContext   context.Context
+	RootDatastore datastore.Batching
+
+	// Host and TinderDriver are used to send and receive contact requests,
+	// contact requests are disabled if one of them is missing
+	Host         host.Host
+	TinderDriver tinder.Driver
 }
 
 // New initializes a new Client
 func New(db *gorm.DB, opts Opts) (Client, error) {
 	client := &client{
-		db:          db,
-		ipfsCoreAPI: opts.IpfsCoreAPI,
-		logger:      opts.Logger,
+		db:           db,
+		ipfsCoreAPI:  opts.IpfsCoreAPI,
+		logger:       opts.Logger,
+		host:         opts.Host,
+		tinderDriver: opts.TinderDriver,
 	}
 
 	if opts.Logger == nil {
@@ -58,20 +90,147 @@ func New(db *gorm.DB, opts Opts) (Client, error) {
 		ctx = context.TODO()
 	}
 	if opts.IpfsCoreAPI == nil {
-		var err error
-		client.ipfsCoreAPI, _, err = ipfsutil.NewInMemoryCoreAPI(ctx)
+		api, node, err := ipfsutil.NewInMemoryCoreAPI(ctx)
 		if err != nil {
 			return nil, errcode.TODO.Wrap(err)
 		}
+
+		client.ipfsCoreAPI = api
+		client.ipfsNode = node
+		if client.host == nil {
+			client.host = node.PeerHost
+		}
+	}
+
+	if opts.RootDatastore == nil {
+		opts.RootDatastore = ds_sync.MutexWrap(datastore.NewMapDatastore())
+	}
+
+	client.rootCtx = ctx
+	client.rootDatastore = opts.RootDatastore
+
+	if err := client.open(); err != nil {
+		client.closeIpfsNode()
+		return nil, err
 	}
 
 	return client, nil
 }
 
-func (c *client) Close() error {
+// open loads the current account and its groups from the root datastore
+func (c *client) open() error {
+	ns, err := currentInstanceNamespace(c.rootDatastore)
+	if err != nil {
+		return err
+	}
+
+	inst, err := c.openInstance(ns)
+	if err != nil {
+		return err
+	}
+
+	if err := inst.start(); err != nil {
+		inst.close()
+		return err
+	}
+
+	c.instLock.Lock()
+	c.inst = inst
+	c.instLock.Unlock()
+
+	return nil
+}
+
+// instance returns the opened account, the returned instance is closed if
+// it is replaced in the meantime
+func (c *client) instance() *instance {
+	c.instLock.RLock()
+	defer c.instLock.RUnlock()
+
+	return c.inst
+}
+
+// replaceInstance replaces the opened account with the one written by
+// prepare. The new account is staged in a namespace of its own and is only
+// made current once it has been opened, the current account is left
+// untouched if anything fails.
+func (c *client) replaceInstance(prepare func(ds datastore.Batching) error) error {
+	c.swapLock.Lock()
+	defer c.swapLock.Unlock()
+
+	ns, err := newInstanceNamespace()
+	if err != nil {
+		return err
+	}
+
+	discard := func() {
+		if err := clearInstanceDatastore(c.rootDatastore, ns); err != nil {
+			c.logger.Warn("unable to remove the staged account", zap.Error(err))
+		}
+	}
+
+	if err := prepare(ipfsutil.NewNamespacedDatastore(c.rootDatastore, ns)); err != nil {
+		discard()
+		return err
+	}
+
+	inst, err := c.openInstance(ns)
+	if err != nil {
+		discard()
+		return err
+	}
+
+	if err := setCurrentInstanceNamespace(c.rootDatastore, ns); err != nil {
+		inst.close()
+		discard()
+		return err
+	}
+
+	c.instLock.Lock()
+	old := c.inst
+	c.inst = inst
+	c.instLock.Unlock()
+
+	c.stopDeviceLink(nil)
+	old.close()
+
+	if err := clearInstanceDatastore(c.rootDatastore, old.namespace); err != nil {
+		c.logger.Warn("unable to remove the replaced account", zap.Error(err))
+	}
+
+	// the contact requests handler is registered once the previous one has
+	// been removed
+	if err := inst.start(); err != nil {
+		c.logger.Error("unable to start the contact requests manager", zap.Error(err))
+	}
+
 	return nil
 }
 
+// closeIpfsNode closes the in-memory ipfs node created when no core api was
+// given to New
+func (c *client) closeIpfsNode() {
+	if c.ipfsNode == nil {
+		return
+	}
+
+	if err := c.ipfsNode.Close(); err != nil {
+		c.logger.Warn("unable to close the ipfs node", zap.Error(err))
+	}
+
+	c.ipfsNode = nil
+}
+
+func (c *client) Close() error {
+	c.stopDeviceLink(nil)
+
+	err := c.instance().close()
+
+	c.closeIpfsNode()
+
+	return err
+}
+
 // Status contains results of status checks
 type Status struct {
 	DB       error
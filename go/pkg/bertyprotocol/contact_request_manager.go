@@ -0,0 +1,393 @@
+package bertyprotocol
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	ggio "github.com/gogo/protobuf/io"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"go.uber.org/zap"
+
+	"berty.tech/berty/go/internal/handshake"
+	"berty.tech/berty/go/internal/orbitutil"
+	"berty.tech/berty/go/internal/tinder"
+	"berty.tech/berty/go/pkg/bertytypes"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const contactRequestV1 = "/berty/contact_req/1.0.0"
+
+const (
+	contactRequestRetryInterval = time.Minute
+	contactRequestTimeout       = time.Second * 30
+)
+
+// contactRequestsManager advertises the account on its public rendezvous
+// point while incoming contact requests are enabled, and sends the outgoing
+// contact requests to the peers found on the contacts rendezvous points
+type contactRequestsManager struct {
+	lock sync.Mutex
+
+	ctx               context.Context
+	logger            *zap.Logger
+	host              host.Host
+	disc              tinder.Driver
+	metadataStore     orbitutil.MetadataStore
+	accountSK         crypto.PrivKey
+	openContactGroup  func(pk crypto.PubKey) error
+	closeContactGroup func(pk crypto.PubKey) error
+
+	announcedSeed  []byte
+	announceCancel context.CancelFunc
+	outgoing       map[string]context.CancelFunc
+}
+
+func newContactRequestsManager(ctx context.Context, logger *zap.Logger, h host.Host, disc tinder.Driver, ms orbitutil.MetadataStore, accountSK crypto.PrivKey, openContactGroup, closeContactGroup func(pk crypto.PubKey) error) *contactRequestsManager {
+	return &contactRequestsManager{
+		ctx:               ctx,
+		logger:            logger,
+		host:              h,
+		disc:              disc,
+		metadataStore:     ms,
+		accountSK:         accountSK,
+		openContactGroup:  openContactGroup,
+		closeContactGroup: closeContactGroup,
+		outgoing:          map[string]context.CancelFunc{},
+	}
+}
+
+// start handles incoming contact requests and keeps the announces and
+// lookups in sync with the account group until the manager context is done
+func (m *contactRequestsManager) start() {
+	m.host.SetStreamHandler(contactRequestV1, m.handleIncomingRequest)
+
+	events := m.metadataStore.Subscribe(m.ctx)
+
+	m.updateAll()
+
+	go func() {
+		for evt := range events {
+			if e, ok := evt.(*bertytypes.GroupMetadataEvent); ok {
+				m.handleEvent(e)
+			}
+		}
+	}()
+}
+
+// close stops handling incoming contact requests, the announces and lookups
+// are stopped when the manager context is done
+func (m *contactRequestsManager) close() {
+	m.host.RemoveStreamHandler(contactRequestV1)
+}
+
+// handleEvent updates the announce or the contact affected by an event of
+// the account group
+func (m *contactRequestsManager) handleEvent(e *bertytypes.GroupMetadataEvent) {
+	switch e.Metadata.EventType {
+	case bertytypes.EventTypeAccountContactRequestEnabled,
+		bertytypes.EventTypeAccountContactRequestDisabled,
+		bertytypes.EventTypeAccountContactRequestReferenceReset:
+		m.updateAnnounce()
+
+	default:
+		if pk, ok := contactEventPK(e); ok {
+			m.updateContact(pk)
+		}
+	}
+}
+
+// updateAll reflects the contact requests state of the account group
+func (m *contactRequestsManager) updateAll() {
+	m.updateAnnounce()
+
+	for _, state := range []bertytypes.ContactState{bertytypes.ContactStateToRequest, bertytypes.ContactStateAdded, bertytypes.ContactStateBlocked} {
+		for _, contact := range m.metadataStore.ListContactsByStatus(state) {
+			m.updateContact(contact.PK)
+		}
+	}
+}
+
+// updateAnnounce announces the account on its public rendezvous point if
+// incoming contact requests are enabled
+func (m *contactRequestsManager) updateAnnounce() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	enabled, ref := m.metadataStore.GetIncomingContactRequestsStatus()
+	if enabled && ref != nil {
+		m.announce(ref.PublicRendezvousSeed)
+	} else {
+		m.stopAnnounce()
+	}
+}
+
+// updateContact reflects the state of a contact, the request is sent while
+// it is waiting to be requested and its group is only replicated once added
+func (m *contactRequestsManager) updateContact(pkBytes []byte) {
+	pk, err := crypto.UnmarshalEd25519PublicKey(pkBytes)
+	if err != nil {
+		m.logger.Error("invalid contact public key", zap.Error(err))
+		return
+	}
+
+	contact, state := m.metadataStore.GetContact(pk)
+	id := string(pkBytes)
+
+	m.lock.Lock()
+	cancel, sending := m.outgoing[id]
+
+	switch {
+	case state == bertytypes.ContactStateToRequest && !sending && contact != nil:
+		ctx, cancel := context.WithCancel(m.ctx)
+		m.outgoing[id] = cancel
+
+		go m.sendRequestLoop(ctx, contact, pk)
+
+	case state != bertytypes.ContactStateToRequest && sending:
+		cancel()
+		delete(m.outgoing, id)
+	}
+	m.lock.Unlock()
+
+	switch state {
+	case bertytypes.ContactStateAdded:
+		if err := m.openContactGroup(pk); err != nil {
+			m.logger.Error("unable to open contact group", zap.Error(err))
+		}
+
+	// blocked contacts groups are not replicated anymore, the contact may
+	// have been blocked by another device of the account
+	case bertytypes.ContactStateBlocked:
+		if err := m.closeContactGroup(pk); err != nil {
+			m.logger.Error("unable to close contact group", zap.Error(err))
+		}
+	}
+}
+
+func (m *contactRequestsManager) announce(seed []byte) {
+	if m.announceCancel != nil && bytes.Equal(m.announcedSeed, seed) {
+		return
+	}
+
+	m.stopAnnounce()
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	m.announcedSeed, m.announceCancel = seed, cancel
+
+	go tinder.AdvertiseRotation(ctx, m.disc, rendezvousPoint(seed), contactRequestRetryInterval, func(err error) {
+		m.logger.Warn("unable to advertise on rendezvous point", zap.Error(err))
+	})
+}
+
+func (m *contactRequestsManager) stopAnnounce() {
+	if m.announceCancel == nil {
+		return
+	}
+
+	// the current namespace is unregistered once the announce is canceled
+	m.announceCancel()
+	m.announcedSeed, m.announceCancel = nil, nil
+}
+
+func (m *contactRequestsManager) sendRequestLoop(ctx context.Context, contact *bertytypes.ShareableContact, pk crypto.PubKey) {
+	point := rendezvousPoint(contact.PublicRendezvousSeed)
+
+	for {
+		if m.lookupAndSendRequest(ctx, point, pk) {
+			if _, err := m.metadataStore.ContactRequestOutgoingSent(m.ctx, pk); err != nil {
+				m.logger.Warn("unable to mark contact request as sent", zap.Error(err))
+			}
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(contactRequestRetryInterval):
+		}
+	}
+}
+
+func (m *contactRequestsManager) lookupAndSendRequest(ctx context.Context, point *tinder.RotationPoint, pk crypto.PubKey) bool {
+	peers, err := tinder.FindPeersRotation(ctx, m.disc, point)
+	if err != nil {
+		m.logger.Warn("unable to find peers on rendezvous point", zap.Error(err))
+		return false
+	}
+
+	sent := false
+	for p := range peers {
+		// keep draining the channel so the driver is not blocked
+		if sent || p.ID == m.host.ID() {
+			continue
+		}
+
+		if err := m.sendRequest(ctx, p, pk); err != nil {
+			m.logger.Debug("unable to send contact request", zap.String("peer", p.ID.Pretty()), zap.Error(err))
+			continue
+		}
+
+		sent = true
+	}
+
+	return sent
+}
+
+func (m *contactRequestsManager) sendRequest(ctx context.Context, p peer.AddrInfo, pk crypto.PubKey) error {
+	_, ownRef := m.metadataStore.GetIncomingContactRequestsStatus()
+	if ownRef == nil {
+		return errcode.ErrMissingInput
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, contactRequestTimeout)
+	defer cancel()
+
+	// the peer has to prove it owns the contact account key, the deadline of
+	// the connection is then the one of ctx
+	conn, err := handshake.DialProtocol(ctx, m.host, p, contactRequestV1, m.accountSK, pk, nil)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	if !conn.OtherAccountPubKey().Equals(pk) {
+		return errcode.ErrHandshakeInvalidSignature
+	}
+
+	if err := ggio.NewDelimitedWriter(conn).WriteMsg(&bertytypes.ShareableContact{
+		PK:                   ownRef.PK,
+		PublicRendezvousSeed: ownRef.PublicRendezvousSeed,
+		Metadata:             m.metadataStore.GetOutgoingContactRequestMetadata(pk),
+	}); err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	// the contact acknowledges the request by sending its own reference
+	otherRef := &bertytypes.ShareableContact{}
+	if err := ggio.NewDelimitedReader(conn, network.MessageSizeMax).ReadMsg(otherRef); err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if !otherRef.IsSamePK(pk) {
+		return errcode.ErrInvalidInput
+	}
+
+	return nil
+}
+
+func (m *contactRequestsManager) handleIncomingRequest(s network.Stream) {
+	enabled, ownRef := m.metadataStore.GetIncomingContactRequestsStatus()
+	if !enabled || ownRef == nil {
+		_ = s.Reset()
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(m.ctx, contactRequestTimeout)
+	defer cancel()
+
+	conn, err := handshake.Accept(ctx, s, m.accountSK, nil)
+	if err != nil {
+		m.logger.Debug("unable to handle incoming contact request", zap.Error(err))
+		return
+	}
+
+	defer conn.Close()
+
+	if err := m.handleIncomingRequestSession(ctx, conn, ownRef); err != nil {
+		m.logger.Debug("unable to handle incoming contact request", zap.Error(err))
+		_ = conn.Reset()
+	}
+}
+
+func (m *contactRequestsManager) handleIncomingRequestSession(ctx context.Context, conn *handshake.Session, ownRef *bertytypes.ShareableContact) error {
+	otherPK := conn.OtherAccountPubKey()
+
+	if m.isContactInState(otherPK, bertytypes.ContactStateBlocked) {
+		return errcode.ErrNotAuthorized
+	}
+
+	contact := &bertytypes.ShareableContact{}
+	if err := ggio.NewDelimitedReader(conn, network.MessageSizeMax).ReadMsg(contact); err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	// the sent reference must match the key proven during the handshake
+	if !contact.IsSamePK(otherPK) {
+		return errcode.ErrInvalidInput
+	}
+
+	if _, err := m.metadataStore.ContactRequestIncomingReceived(ctx, contact); err != nil {
+		// the request has already been received, acknowledge it again
+		if !m.isContactInState(otherPK, bertytypes.ContactStateReceived, bertytypes.ContactStateAdded) {
+			return err
+		}
+	}
+
+	if err := ggio.NewDelimitedWriter(conn).WriteMsg(&bertytypes.ShareableContact{
+		PK:                   ownRef.PK,
+		PublicRendezvousSeed: ownRef.PublicRendezvousSeed,
+	}); err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	return nil
+}
+
+func (m *contactRequestsManager) isContactInState(pk crypto.PubKey, states ...bertytypes.ContactState) bool {
+	for _, state := range states {
+		for _, contact := range m.metadataStore.ListContactsByStatus(state) {
+			if contact.IsSamePK(pk) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// contactEventPK returns the public key of the contact an event of the
+// account group is about
+func contactEventPK(e *bertytypes.GroupMetadataEvent) ([]byte, bool) {
+	var event interface {
+		Unmarshal([]byte) error
+		GetContactPK() []byte
+	}
+
+	switch e.Metadata.EventType {
+	case bertytypes.EventTypeAccountContactRequestOutgoingEnqueued:
+		event = &bertytypes.AccountContactRequestEnqueued{}
+	case bertytypes.EventTypeAccountContactRequestOutgoingSent:
+		event = &bertytypes.AccountContactRequestSent{}
+	case bertytypes.EventTypeAccountContactRequestIncomingReceived:
+		event = &bertytypes.AccountContactRequestReceived{}
+	case bertytypes.EventTypeAccountContactRequestIncomingDiscarded:
+		event = &bertytypes.AccountContactRequestDiscarded{}
+	case bertytypes.EventTypeAccountContactRequestIncomingAccepted:
+		event = &bertytypes.AccountContactRequestAccepted{}
+	case bertytypes.EventTypeAccountContactBlocked:
+		event = &bertytypes.AccountContactBlocked{}
+	case bertytypes.EventTypeAccountContactUnblocked:
+		event = &bertytypes.AccountContactUnblocked{}
+	default:
+		return nil, false
+	}
+
+	if err := event.Unmarshal(e.Event); err != nil {
+		return nil, false
+	}
+
+	return event.GetContactPK(), true
+}
+
+// rendezvousPoint returns the rotation point deriving the namespaces to use
+// on the discovery drivers for a rendezvous seed
+func rendezvousPoint(seed []byte) *tinder.RotationPoint {
+	return tinder.NewRotationPoint(seed, tinder.DefaultRotationPeriod)
+}
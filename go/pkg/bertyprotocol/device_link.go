@@ -0,0 +1,294 @@
+package bertyprotocol
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"net"
+	"time"
+
+	"github.com/ipfs/go-datastore"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"go.uber.org/zap"
+
+	"berty.tech/berty/go/internal/account"
+	"berty.tech/berty/go/internal/handshake"
+	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/pkg/bertytypes"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const deviceLinkV1 = "/berty/device_link/1.0.0"
+
+const (
+	deviceLinkTimeout = time.Second * 30
+
+	// deviceLinkExpiration is the duration during which a device link
+	// reference can be used
+	deviceLinkExpiration = time.Minute * 10
+)
+
+// startDeviceLink creates a one-time reference and waits for a new device
+// to use it, a previous reference is no longer valid
+func (c *client) startDeviceLink() (*bertytypes.ShareableDeviceLink, error) {
+	if c.host == nil {
+		return nil, errcode.ErrMissingInput
+	}
+
+	inst := c.instance()
+
+	accountSK, err := inst.account.AccountPrivKey()
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	accountPK, err := accountSK.GetPublic().Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, errcode.ErrRandomGenerationFailed.Wrap(err)
+	}
+
+	ref := &bertytypes.ShareableDeviceLink{
+		AccountPK: accountPK,
+		Secret:    secret,
+		PeerID:    c.host.ID().Pretty(),
+	}
+
+	for _, addr := range c.host.Addrs() {
+		ref.Addrs = append(ref.Addrs, addr.String())
+	}
+
+	c.deviceLinkLock.Lock()
+	defer c.deviceLinkLock.Unlock()
+
+	if c.deviceLinkCancel != nil {
+		c.deviceLinkCancel()
+	}
+
+	ctx, cancel := context.WithTimeout(inst.ctx, deviceLinkExpiration)
+	c.deviceLinkSecret, c.deviceLinkCancel = secret, cancel
+
+	c.host.SetStreamHandler(deviceLinkV1, c.handleDeviceLink)
+
+	go func() {
+		<-ctx.Done()
+		c.stopDeviceLink(secret)
+	}()
+
+	return ref, nil
+}
+
+// stopDeviceLink stops waiting for a new device if the secret is still the
+// current one, any reference is stopped if secret is nil
+func (c *client) stopDeviceLink(secret []byte) {
+	c.deviceLinkLock.Lock()
+	defer c.deviceLinkLock.Unlock()
+
+	if c.deviceLinkSecret == nil || (secret != nil && !bytes.Equal(c.deviceLinkSecret, secret)) {
+		return
+	}
+
+	c.deviceLinkCancel()
+	c.deviceLinkSecret, c.deviceLinkCancel = nil, nil
+	c.host.RemoveStreamHandler(deviceLinkV1)
+}
+
+func (c *client) handleDeviceLink(s network.Stream) {
+	conn := ipfsutil.NewStreamConn(s)
+	defer conn.Close()
+
+	c.deviceLinkLock.Lock()
+	secret := c.deviceLinkSecret
+	c.deviceLinkLock.Unlock()
+
+	if secret == nil {
+		_ = s.Reset()
+		return
+	}
+
+	if err := c.handleDeviceLinkConn(conn, secret); err != nil {
+		c.logger.Debug("unable to link device", zap.Error(err))
+		_ = s.Reset()
+		return
+	}
+
+	// the reference can only be used once
+	c.stopDeviceLink(secret)
+}
+
+func (c *client) handleDeviceLinkConn(conn net.Conn, secret []byte) error {
+	inst := c.instance()
+
+	ctx, cancel := context.WithTimeout(inst.ctx, deviceLinkTimeout)
+	defer cancel()
+
+	if err := conn.SetDeadline(time.Now().Add(deviceLinkTimeout)); err != nil {
+		return errcode.TODO.Wrap(err)
+	}
+
+	accountSK, err := inst.account.AccountPrivKey()
+	if err != nil {
+		return errcode.ErrInternal.Wrap(err)
+	}
+
+	if _, err := handshake.LinkDeviceResponse(ctx, conn, accountSK, secret, inst.getDeviceLinkSecrets); err != nil {
+		return err
+	}
+
+	c.logger.Info("new device linked to the account")
+
+	return nil
+}
+
+// getDeviceLinkSecrets adds the new device to the sig chain of the account
+// and returns the secrets it needs to join the account
+func (i *instance) getDeviceLinkSecrets(devicePK crypto.PubKey) (*handshake.DeviceLinkSecrets, error) {
+	accountSK, err := i.account.AccountPrivKey()
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	accountProofSK, err := i.account.AccountProofPrivKey()
+	if err != nil {
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	if _, err := i.account.SigChainAddDevice(devicePK); err != nil && err != errcode.ErrSigChainOperationAlreadyDone {
+		return nil, err
+	}
+
+	if err := i.syncSigChain(i.ctx); err != nil {
+		return nil, err
+	}
+
+	sigChain, err := i.account.SigChain()
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := &handshake.DeviceLinkSecrets{}
+
+	if secrets.AccountKey, err = crypto.MarshalPrivateKey(accountSK); err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	if secrets.AccountProofKey, err = crypto.MarshalPrivateKey(accountProofSK); err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	if secrets.SigChain, err = sigChain.Marshal(); err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	return secrets, nil
+}
+
+// joinAccount links the current device to the account of the device which
+// created the reference, the current account is replaced once the joined one
+// has been opened and its groups are replicated afterwards
+func (c *client) joinAccount(ctx context.Context, ref *bertytypes.ShareableDeviceLink) error {
+	if c.host == nil {
+		return errcode.ErrMissingInput
+	}
+
+	accountPK, err := crypto.UnmarshalEd25519PublicKey(ref.AccountPK)
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	pi, err := shareableDeviceLinkAddrInfo(ref)
+	if err != nil {
+		return err
+	}
+
+	inst := c.instance()
+
+	deviceSK, err := inst.account.DevicePrivKey()
+	if err != nil {
+		return errcode.ErrInternal.Wrap(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deviceLinkTimeout)
+	defer cancel()
+
+	if err := c.host.Connect(ctx, pi); err != nil {
+		return errcode.TODO.Wrap(err)
+	}
+
+	s, err := c.host.NewStream(ctx, pi.ID, deviceLinkV1)
+	if err != nil {
+		return errcode.TODO.Wrap(err)
+	}
+
+	conn := ipfsutil.NewStreamConn(s)
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Now().Add(deviceLinkTimeout)); err != nil {
+		return errcode.TODO.Wrap(err)
+	}
+
+	secrets, err := handshake.LinkDeviceRequest(ctx, conn, deviceSK, accountPK, ref.Secret)
+	if err != nil {
+		return err
+	}
+
+	accountSK, err := crypto.UnmarshalPrivateKey(secrets.AccountKey)
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	accountProofSK, err := crypto.UnmarshalPrivateKey(secrets.AccountProofKey)
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	sigChain := &account.SigChain{}
+	if err := sigChain.Unmarshal(secrets.SigChain); err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if !accountSK.GetPublic().Equals(accountPK) {
+		return errcode.ErrInvalidInput
+	}
+
+	// the current data is only replaced once the secrets have been checked
+	if err := sigChain.VerifyDevice(accountPK, deviceSK.GetPublic()); err != nil {
+		return err
+	}
+
+	// the joined account is staged next to the current one, which is kept if
+	// it can't be opened
+	return c.replaceInstance(func(ds datastore.Batching) error {
+		accountDS := ipfsutil.NewNamespacedDatastore(ds, datastore.NewKey(accountNamespace))
+		_, err := account.NewLinkedDevice(ipfsutil.NewDatastoreKeystore(accountDS), accountDS, accountSK, accountProofSK, deviceSK, sigChain)
+
+		return err
+	})
+}
+
+func shareableDeviceLinkAddrInfo(ref *bertytypes.ShareableDeviceLink) (peer.AddrInfo, error) {
+	id, err := peer.IDB58Decode(ref.PeerID)
+	if err != nil {
+		return peer.AddrInfo{}, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	pi := peer.AddrInfo{ID: id}
+
+	for _, addr := range ref.Addrs {
+		maddr, err := ma.NewMultiaddr(addr)
+		if err != nil {
+			return peer.AddrInfo{}, errcode.ErrDeserialization.Wrap(err)
+		}
+
+		pi.Addrs = append(pi.Addrs, maddr)
+	}
+
+	return pi, nil
+}
@@ -0,0 +1,338 @@
+package bertyprotocol
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ggio "github.com/gogo/protobuf/io"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"go.uber.org/zap"
+
+	"berty.tech/berty/go/internal/orbitutil"
+	"berty.tech/berty/go/internal/tinder"
+	"berty.tech/berty/go/pkg/bertytypes"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const groupInvitationV1 = "/berty/group_invitation/1.0.0"
+
+const (
+	groupInvitationRetryInterval = time.Minute
+	groupInvitationTimeout       = time.Second * 30
+)
+
+// GroupInvitationsManager advertises the admin devices of the multi-member
+// groups on a rendezvous point derived from the group secret, the invitees
+// send them a request signed with the invitation and receive the group
+// secret once the invitation has been checked and its use recorded in the
+// group
+type GroupInvitationsManager struct {
+	lock sync.Mutex
+
+	ctx             context.Context
+	logger          *zap.Logger
+	host            host.Host
+	disc            tinder.Driver
+	getContextGroup func(pk []byte) (orbitutil.ContextGroup, error)
+
+	started bool
+	groups  map[string]*groupInvitationsAnnounce
+}
+
+type groupInvitationsAnnounce struct {
+	cg             orbitutil.ContextGroup
+	ctx            context.Context
+	cancel         context.CancelFunc
+	announceCancel context.CancelFunc
+}
+
+// NewGroupInvitationsManager returns a manager using the given function to
+// get the opened groups, the groups are only announced once it is started
+func NewGroupInvitationsManager(ctx context.Context, logger *zap.Logger, h host.Host, disc tinder.Driver, getContextGroup func(pk []byte) (orbitutil.ContextGroup, error)) *GroupInvitationsManager {
+	return &GroupInvitationsManager{
+		ctx:             ctx,
+		logger:          logger,
+		host:            h,
+		disc:            disc,
+		getContextGroup: getContextGroup,
+		groups:          map[string]*groupInvitationsAnnounce{},
+	}
+}
+
+// Start handles incoming join requests and announces the groups watched so
+// far, the announces are stopped when the manager context is done
+func (m *GroupInvitationsManager) Start() {
+	m.host.SetStreamHandler(groupInvitationV1, m.handleIncomingRequest)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.started = true
+
+	for _, a := range m.groups {
+		m.updateAnnounce(a)
+	}
+}
+
+// Close stops handling incoming join requests
+func (m *GroupInvitationsManager) Close() {
+	m.host.RemoveStreamHandler(groupInvitationV1)
+}
+
+// WatchGroup announces a multi-member group while the current member is one
+// of its admins
+func (m *GroupInvitationsManager) WatchGroup(cg orbitutil.ContextGroup) {
+	if cg.Group().GroupType != bertytypes.GroupTypeMultiMember {
+		return
+	}
+
+	id := string(cg.Group().PublicKey)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if _, ok := m.groups[id]; ok {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(m.ctx)
+	a := &groupInvitationsAnnounce{cg: cg, ctx: ctx, cancel: cancel}
+	m.groups[id] = a
+
+	events := cg.MetadataStore().Subscribe(ctx)
+
+	m.updateAnnounce(a)
+
+	go func() {
+		for evt := range events {
+			e, ok := evt.(*bertytypes.GroupMetadataEvent)
+			if !ok {
+				continue
+			}
+
+			switch e.Metadata.EventType {
+			case bertytypes.EventTypeMultiMemberGroupInitialMemberAnnounced,
+				bertytypes.EventTypeMultiMemberGroupAdminRoleGranted,
+				bertytypes.EventTypeMultiMemberGroupAdminRoleRevoked,
+				bertytypes.EventTypeMultiMemberGroupMemberRemoved:
+				m.lock.Lock()
+				if ctx.Err() == nil {
+					m.updateAnnounce(a)
+				}
+				m.lock.Unlock()
+			}
+		}
+	}()
+}
+
+// UnwatchGroup stops announcing a group, it does nothing if the group is
+// not watched
+func (m *GroupInvitationsManager) UnwatchGroup(pk []byte) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	a, ok := m.groups[string(pk)]
+	if !ok {
+		return
+	}
+
+	// the announce context is derived from the group one
+	a.cancel()
+	delete(m.groups, string(pk))
+}
+
+// updateAnnounce announces a group if the manager is started and the current
+// member is an admin of the group, the lock must be held
+func (m *GroupInvitationsManager) updateAnnounce(a *groupInvitationsAnnounce) {
+	isAdmin := false
+
+	for _, admin := range a.cg.MetadataStore().ListAdmins() {
+		if admin.Equals(a.cg.MemberPubKey()) {
+			isAdmin = true
+			break
+		}
+	}
+
+	switch {
+	case m.started && isAdmin && a.announceCancel == nil:
+		seed, err := a.cg.Group().GetInvitationRendezvousSeed()
+		if err != nil {
+			m.logger.Warn("unable to derive the rendezvous seed", zap.Error(err))
+			return
+		}
+
+		ctx, cancel := context.WithCancel(a.ctx)
+		a.announceCancel = cancel
+
+		go tinder.AdvertiseRotation(ctx, m.disc, groupInvitationPoint(seed), groupInvitationRetryInterval, func(err error) {
+			m.logger.Warn("unable to advertise on rendezvous point", zap.Error(err))
+		})
+
+	case !isAdmin && a.announceCancel != nil:
+		a.announceCancel()
+		a.announceCancel = nil
+	}
+}
+
+// RequestGroup sends a join request to the admins of the group found on its
+// rendezvous point until one of them sends the group or ctx is done
+func (m *GroupInvitationsManager) RequestGroup(ctx context.Context, invitation *bertytypes.GroupInvitation, memberSK crypto.PrivKey) (*bertytypes.Group, error) {
+	req, boxSK, err := bertytypes.NewGroupInvitationJoinRequest(invitation, memberSK)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invitation.RendezvousSeed) == 0 {
+		return nil, errcode.ErrMissingInput
+	}
+
+	point := groupInvitationPoint(invitation.RendezvousSeed)
+
+	for {
+		if g := m.lookupAndSendRequest(ctx, point, req, invitation, boxSK); g != nil {
+			return g, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, errcode.ErrGroupMemberUnknownGroupID.Wrap(ctx.Err())
+		case <-time.After(groupInvitationRetryInterval):
+		}
+	}
+}
+
+func (m *GroupInvitationsManager) lookupAndSendRequest(ctx context.Context, point *tinder.RotationPoint, req *bertytypes.GroupInvitationJoinRequest, invitation *bertytypes.GroupInvitation, boxSK *[32]byte) *bertytypes.Group {
+	peers, err := tinder.FindPeersRotation(ctx, m.disc, point)
+	if err != nil {
+		m.logger.Warn("unable to find peers on rendezvous point", zap.Error(err))
+		return nil
+	}
+
+	var g *bertytypes.Group
+	for p := range peers {
+		// keep draining the channel so the driver is not blocked
+		if g != nil || p.ID == m.host.ID() {
+			continue
+		}
+
+		if g, err = m.sendRequest(ctx, p, req, invitation, boxSK); err != nil {
+			m.logger.Debug("unable to send join request", zap.String("peer", p.ID.Pretty()), zap.Error(err))
+		}
+	}
+
+	return g
+}
+
+func (m *GroupInvitationsManager) sendRequest(ctx context.Context, p peer.AddrInfo, req *bertytypes.GroupInvitationJoinRequest, invitation *bertytypes.GroupInvitation, boxSK *[32]byte) (*bertytypes.Group, error) {
+	ctx, cancel := context.WithTimeout(ctx, groupInvitationTimeout)
+	defer cancel()
+
+	if len(p.Addrs) > 0 {
+		if err := m.host.Connect(ctx, p); err != nil {
+			return nil, errcode.TODO.Wrap(err)
+		}
+	}
+
+	s, err := m.host.NewStream(ctx, p.ID, groupInvitationV1)
+	if err != nil {
+		return nil, errcode.TODO.Wrap(err)
+	}
+
+	defer s.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.SetDeadline(deadline)
+	}
+
+	if err := ggio.NewDelimitedWriter(s).WriteMsg(req); err != nil {
+		_ = s.Reset()
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	res := &bertytypes.GroupInvitationJoinResponse{}
+	if err := ggio.NewDelimitedReader(s, network.MessageSizeMax).ReadMsg(res); err != nil {
+		_ = s.Reset()
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	return res.OpenGroup(invitation, boxSK)
+}
+
+func (m *GroupInvitationsManager) handleIncomingRequest(s network.Stream) {
+	ctx, cancel := context.WithTimeout(m.ctx, groupInvitationTimeout)
+	defer cancel()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = s.SetDeadline(deadline)
+	}
+
+	if err := m.handleIncomingRequestStream(ctx, s); err != nil {
+		m.logger.Debug("unable to handle incoming join request", zap.Error(err))
+		_ = s.Reset()
+		return
+	}
+
+	_ = s.Close()
+}
+
+func (m *GroupInvitationsManager) handleIncomingRequestStream(ctx context.Context, s network.Stream) error {
+	req := &bertytypes.GroupInvitationJoinRequest{}
+	if err := ggio.NewDelimitedReader(s, network.MessageSizeMax).ReadMsg(req); err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	res, err := m.acceptRequest(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	if err := ggio.NewDelimitedWriter(s).WriteMsg(res); err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	return nil
+}
+
+// acceptRequest checks a join request and records the use of its invitation,
+// the group is then encrypted for the requester
+func (m *GroupInvitationsManager) acceptRequest(ctx context.Context, req *bertytypes.GroupInvitationJoinRequest) (*bertytypes.GroupInvitationJoinResponse, error) {
+	// the request must be signed by the private key of an invitation issued
+	// by an admin of the group and by the member key it names
+	if err := req.CheckSignature(); err != nil {
+		return nil, err
+	}
+
+	cg, err := m.getContextGroup(req.Invitation.GroupPK)
+	if err != nil {
+		return nil, err
+	}
+
+	memberPK, err := req.GetMemberPubKey()
+	if err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	// the expiry, the revocation and the uses of the invitation are checked
+	// before the member is admitted, a member already admitted may request
+	// the group again as it proved it owns its member key
+	ms := cg.MetadataStore()
+	if ms.IsMemberAdmitted(memberPK) {
+		if err := ms.InvitationCheck(req.Invitation, memberPK); err != nil {
+			return nil, err
+		}
+	} else if _, err := ms.InvitationUse(ctx, req.Invitation, memberPK); err != nil {
+		return nil, err
+	}
+
+	return bertytypes.NewGroupInvitationJoinResponse(req, cg.Group())
+}
+
+// groupInvitationPoint returns the rotation point on which the admins of a
+// group can be found by its invitees, see GetInvitationRendezvousSeed
+func groupInvitationPoint(seed []byte) *tinder.RotationPoint {
+	return rendezvousPoint(seed)
+}
@@ -0,0 +1,170 @@
+package bertyprotocol
+
+import (
+	"berty.tech/go-orbit-db/events"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"go.uber.org/zap"
+
+	"berty.tech/berty/go/internal/orbitutil"
+	"berty.tech/berty/go/pkg/bertytypes"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+// activateGroup opens a group and starts exchanging device secrets with
+// its members, groups are kept open until they are left or the client is
+// closed
+func (i *instance) activateGroup(g *bertytypes.Group) (orbitutil.ContextGroup, error) {
+	if cg, err := i.odb.GetContextGroupForID(g.PublicKey); err == nil {
+		return cg, nil
+	}
+
+	cg, err := i.odb.OpenMultiMemberGroup(i.ctx, g, nil)
+	if err != nil {
+		return nil, errcode.ErrOrbitDBOpen.Wrap(err)
+	}
+
+	if err := orbitutil.ActivateGroupContext(i.ctx, cg); err != nil {
+		_ = i.odb.CloseGroup(g.PublicKey)
+		return nil, errcode.ErrInternal.Wrap(err)
+	}
+
+	if g.GroupType == bertytypes.GroupTypeMultiMember {
+		if err := i.announceGroupDevice(g); err != nil {
+			i.logger.Error("unable to announce the device key of a group", zap.String("group", g.GroupIDAsString()), zap.Error(err))
+		}
+	}
+
+	if i.groupInvitations != nil {
+		i.groupInvitations.WatchGroup(cg)
+	}
+
+	return cg, nil
+}
+
+// announceGroupDevice sends the key used by the current device in a
+// multi-member group to the other devices of the account, so they can revoke
+// it, unless it has already been sent
+func (i *instance) announceGroupDevice(g *bertytypes.Group) error {
+	deviceSK, err := i.account.DevicePrivKey()
+	if err != nil {
+		return errcode.ErrInternal.Wrap(err)
+	}
+
+	ms := i.accContextGroup.MetadataStore()
+	if _, err := ms.GetGroupDevice(g.PublicKey, deviceSK.GetPublic()); err == nil {
+		return nil
+	}
+
+	_, err = ms.GroupDeviceAnnounce(i.ctx, g)
+
+	return err
+}
+
+// deactivateGroup stops announcing a group to its invitees and closes it, it
+// does nothing if the group is not opened
+func (i *instance) deactivateGroup(pk []byte) error {
+	if i.groupInvitations != nil {
+		i.groupInvitations.UnwatchGroup(pk)
+	}
+
+	if err := i.odb.CloseGroup(pk); err != nil && err != errcode.ErrMissingMapKey {
+		return errcode.ErrInternal.Wrap(err)
+	}
+
+	return nil
+}
+
+// watchAccountGroups opens the groups joined by the other devices of the
+// account and closes the ones they have left, the sig chains they send are
+// merged with the local one
+func (i *instance) watchAccountGroups(ch <-chan events.Event) {
+	for evt := range ch {
+		e, ok := evt.(*bertytypes.GroupMetadataEvent)
+		if !ok {
+			continue
+		}
+
+		switch e.Metadata.EventType {
+		case bertytypes.EventTypeAccountGroupJoined:
+			casted := &bertytypes.AccountGroupJoined{}
+			if err := casted.Unmarshal(e.Event); err != nil {
+				i.logger.Error("unable to unmarshal joined group", zap.Error(err))
+				continue
+			}
+
+			if _, err := i.activateGroup(casted.Group); err != nil {
+				i.logger.Error("unable to open joined group", zap.Error(err))
+			}
+
+		case bertytypes.EventTypeAccountGroupLeft:
+			casted := &bertytypes.AccountGroupLeft{}
+			if err := casted.Unmarshal(e.Event); err != nil {
+				i.logger.Error("unable to unmarshal left group", zap.Error(err))
+				continue
+			}
+
+			if err := i.deactivateGroup(casted.GroupPK); err != nil {
+				i.logger.Error("unable to close left group", zap.Error(err))
+			}
+
+		case bertytypes.EventTypeAccountSigChainUpdated:
+			if err := i.syncSigChain(i.ctx); err != nil {
+				i.logger.Error("unable to merge the sig chain of the account", zap.Error(err))
+			}
+		}
+	}
+}
+
+// activateContactGroup opens the group shared with a contact
+func (i *instance) activateContactGroup(pk crypto.PubKey) (orbitutil.ContextGroup, error) {
+	g, err := i.getContactGroup(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.activateGroup(g)
+}
+
+// closeContactGroup stops replicating the group shared with a contact, it
+// does nothing if the group is not opened
+func (i *instance) closeContactGroup(pk crypto.PubKey) error {
+	g, err := i.getContactGroup(pk)
+	if err != nil {
+		return err
+	}
+
+	if err := i.odb.CloseGroup(g.PublicKey); err != nil && err != errcode.ErrMissingMapKey {
+		return errcode.ErrInternal.Wrap(err)
+	}
+
+	return nil
+}
+
+// getContactGroup returns the group shared with a contact
+func (i *instance) getContactGroup(pk crypto.PubKey) (*bertytypes.Group, error) {
+	sk, err := i.account.ContactGroupPrivKey(pk)
+	if err != nil {
+		return nil, errcode.ErrSecretKeyGenerationFailed.Wrap(err)
+	}
+
+	g, err := bertytypes.GetGroupForContact(sk)
+	if err != nil {
+		return nil, errcode.ErrOrbitDBOpen.Wrap(err)
+	}
+
+	return g, nil
+}
+
+// getContextGroupForID returns the context of an opened group
+func (i *instance) getContextGroupForID(id []byte) (orbitutil.ContextGroup, error) {
+	if len(id) == 0 {
+		return nil, errcode.ErrInvalidInput
+	}
+
+	cg, err := i.odb.GetContextGroupForID(id)
+	if err != nil {
+		return nil, errcode.ErrGroupMemberUnknownGroupID.Wrap(err)
+	}
+
+	return cg, nil
+}
@@ -0,0 +1,221 @@
+package bertyprotocol
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	orbitdb "berty.tech/go-orbit-db"
+	"github.com/ipfs/go-datastore"
+	ipfs_coreapi "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/host"
+	"go.uber.org/zap"
+
+	"berty.tech/berty/go/internal/account"
+	"berty.tech/berty/go/internal/backup"
+	"berty.tech/berty/go/internal/bertycrypto"
+	"berty.tech/berty/go/internal/ipfsutil"
+	"berty.tech/berty/go/internal/orbitutil"
+	"berty.tech/berty/go/internal/tinder"
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+// namespaces of the datastore of an instance
+const (
+	accountNamespace  = "account"
+	messagesNamespace = "messages"
+	orbitdbNamespace  = "orbitdb"
+)
+
+// instanceNamespaces are the namespaces holding the data of an instance
+var instanceNamespaces = []string{accountNamespace, messagesNamespace, orbitdbNamespace}
+
+var (
+	// currentInstanceKey holds the namespace of the current instance in the
+	// root datastore, the instance is stored at the root if it is missing
+	currentInstanceKey = datastore.NewKey("instance")
+
+	// instancesNamespace holds the instances created by replaceInstance
+	instancesNamespace = datastore.NewKey("instances")
+)
+
+// instance is an account opened by the client along with its groups, its
+// data is stored under its own namespace of the root datastore
+type instance struct {
+	logger          *zap.Logger
+	ipfsCoreAPI     ipfs_coreapi.CoreAPI
+	host            host.Host
+	tinderDriver    tinder.Driver
+	namespace       datastore.Key
+	account         *account.Account
+	odb             orbitutil.BertyOrbitDB
+	accContextGroup orbitutil.ContextGroup
+	contactRequests *contactRequestsManager
+
+	// groupInvitations is only set when a host and a tinder driver are
+	// configured, the groups are watched as soon as they are activated
+	groupInvitations *GroupInvitationsManager
+
+	// the instance context is used by the long running group routines, it
+	// is cancelled when the instance is closed
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// openInstance loads the account stored under a namespace of the root
+// datastore and opens its groups, contact requests are handled once the
+// instance is started
+func (c *client) openInstance(ns datastore.Key) (*instance, error) {
+	ds := ipfsutil.NewNamespacedDatastore(c.rootDatastore, ns)
+	accountDS := ipfsutil.NewNamespacedDatastore(ds, datastore.NewKey(accountNamespace))
+	messagesDS := ipfsutil.NewNamespacedDatastore(ds, datastore.NewKey(messagesNamespace))
+	orbitdbDS := ipfsutil.NewNamespacedDatastore(ds, datastore.NewKey(orbitdbNamespace))
+
+	inst := &instance{
+		logger:       c.logger,
+		ipfsCoreAPI:  c.ipfsCoreAPI,
+		host:         c.host,
+		tinderDriver: c.tinderDriver,
+		namespace:    ns,
+		account:      account.NewWithDatastore(ipfsutil.NewDatastoreKeystore(accountDS), accountDS),
+	}
+
+	inst.ctx, inst.cancel = context.WithCancel(c.rootCtx)
+
+	if inst.host != nil && inst.tinderDriver != nil {
+		inst.groupInvitations = NewGroupInvitationsManager(inst.ctx, c.logger.Named("groupinvitations"), inst.host, inst.tinderDriver, inst.getContextGroupForID)
+	}
+
+	var err error
+	inst.odb, err = orbitutil.NewBertyOrbitDB(inst.ctx, c.ipfsCoreAPI, inst.account, bertycrypto.NewDatastoreMessageKeys(messagesDS), &orbitdb.NewOrbitDBOptions{
+		Cache:  orbitutil.NewOrbitDatastoreCache(orbitdbDS),
+		Logger: c.logger.Named("orbitdb"),
+	})
+	if err != nil {
+		inst.cancel()
+		return nil, errcode.TODO.Wrap(err)
+	}
+
+	if err := inst.openAccountGroup(); err != nil {
+		inst.close()
+		return nil, err
+	}
+
+	return inst, nil
+}
+
+// openAccountGroup opens the account group and every multi-member group
+// previously joined by the account
+func (i *instance) openAccountGroup() error {
+	var err error
+
+	i.accContextGroup, err = i.odb.OpenAccountGroup(i.ctx, nil)
+	if err != nil {
+		return errcode.ErrOrbitDBOpen.Wrap(err)
+	}
+
+	if err := orbitutil.ActivateGroupContext(i.ctx, i.accContextGroup); err != nil {
+		return errcode.ErrInternal.Wrap(err)
+	}
+
+	if err := i.syncSigChain(i.ctx); err != nil {
+		i.logger.Error("unable to send the sig chain to the account group", zap.Error(err))
+	}
+
+	// the groups joined by the other devices of the account are opened once
+	// the account group is replicated
+	go i.watchAccountGroups(i.accContextGroup.MetadataStore().Subscribe(i.ctx))
+
+	for _, g := range i.accContextGroup.MetadataStore().ListMultiMemberGroups() {
+		if _, err := i.activateGroup(g); err != nil {
+			i.logger.Error("unable to open previously joined group", zap.String("group", g.GroupIDAsString()), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+// start sends and receives contact requests and group join requests in the
+// background
+func (i *instance) start() error {
+	if i.host == nil || i.tinderDriver == nil {
+		i.logger.Warn("no host or tinder driver configured, contact requests and group invitations are disabled")
+		return nil
+	}
+
+	i.groupInvitations.Start()
+
+	accountSK, err := i.account.AccountPrivKey()
+	if err != nil {
+		return errcode.ErrInternal.Wrap(err)
+	}
+
+	openContactGroup := func(pk crypto.PubKey) error {
+		_, err := i.activateContactGroup(pk)
+		return err
+	}
+
+	i.contactRequests = newContactRequestsManager(i.ctx, i.logger.Named("contactrequests"), i.host, i.tinderDriver, i.accContextGroup.MetadataStore(), accountSK, openContactGroup, i.closeContactGroup)
+	i.contactRequests.start()
+
+	return nil
+}
+
+// close stops the account and its groups
+func (i *instance) close() error {
+	if i.contactRequests != nil {
+		i.contactRequests.close()
+	}
+
+	if i.groupInvitations != nil {
+		i.groupInvitations.Close()
+	}
+
+	i.cancel()
+
+	return i.odb.Close()
+}
+
+// currentInstanceNamespace returns the namespace of the current instance
+func currentInstanceNamespace(root datastore.Datastore) (datastore.Key, error) {
+	value, err := root.Get(currentInstanceKey)
+	if err == datastore.ErrNotFound {
+		return datastore.NewKey("/"), nil
+	} else if err != nil {
+		return datastore.Key{}, errcode.ErrPersistenceGet.Wrap(err)
+	}
+
+	return datastore.NewKey(string(value)), nil
+}
+
+// setCurrentInstanceNamespace makes the instance stored under a namespace
+// the current one
+func setCurrentInstanceNamespace(root datastore.Datastore, ns datastore.Key) error {
+	if err := root.Put(currentInstanceKey, []byte(ns.String())); err != nil {
+		return errcode.ErrPersistencePut.Wrap(err)
+	}
+
+	return nil
+}
+
+// newInstanceNamespace returns a namespace for a new instance
+func newInstanceNamespace() (datastore.Key, error) {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return datastore.Key{}, errcode.ErrRandomGenerationFailed.Wrap(err)
+	}
+
+	return instancesNamespace.ChildString(hex.EncodeToString(id)), nil
+}
+
+// clearInstanceDatastore removes the data of an instance
+func clearInstanceDatastore(root datastore.Datastore, ns datastore.Key) error {
+	for _, name := range instanceNamespaces {
+		if err := backup.ClearDatastore(root, ns.ChildString(name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -26,6 +26,12 @@ func TestingClient(t *testing.T, opts Opts) (Client, func()) {
 		opts.IpfsCoreAPI = ipfsutil.TestingCoreAPI(ctx, t)
 	}
 
+	if opts.Host == nil {
+		if api, ok := opts.IpfsCoreAPI.(ipfsutil.CoreAPIMock); ok {
+			opts.Host = api.MockNode().PeerHost
+		}
+	}
+
 	db := protocoldb.TestingSqliteDB(t, opts.Logger)
 
 	client, err := New(db, opts)
@@ -0,0 +1,125 @@
+package bertyprotocol
+
+import "berty.tech/berty/go/pkg/bertytypes"
+
+// The types shared with the internal packages are defined in bertytypes, they
+// are aliased here so existing users of bertyprotocol keep building. Every
+// exported identifier of bertytypes must be aliased, see TestTypesAliases.
+
+type (
+	Account                             = bertytypes.Account
+	AccountContactBlocked               = bertytypes.AccountContactBlocked
+	AccountContactRequestAccepted       = bertytypes.AccountContactRequestAccepted
+	AccountContactRequestDisabled       = bertytypes.AccountContactRequestDisabled
+	AccountContactRequestDiscarded      = bertytypes.AccountContactRequestDiscarded
+	AccountContactRequestEnabled        = bertytypes.AccountContactRequestEnabled
+	AccountContactRequestEnqueued       = bertytypes.AccountContactRequestEnqueued
+	AccountContactRequestReceived       = bertytypes.AccountContactRequestReceived
+	AccountContactRequestReferenceReset = bertytypes.AccountContactRequestReferenceReset
+	AccountContactRequestSent           = bertytypes.AccountContactRequestSent
+	AccountContactUnblocked             = bertytypes.AccountContactUnblocked
+	AccountGroupDeviceAnnounced         = bertytypes.AccountGroupDeviceAnnounced
+	AccountGroupJoined                  = bertytypes.AccountGroupJoined
+	AccountGroupLeft                    = bertytypes.AccountGroupLeft
+	AccountSigChainUpdated              = bertytypes.AccountSigChainUpdated
+	AppMetadata                         = bertytypes.AppMetadata
+	ContactAddAliasKey                  = bertytypes.ContactAddAliasKey
+	ContactState                        = bertytypes.ContactState
+	DeviceSecret                        = bertytypes.DeviceSecret
+	EventContext                        = bertytypes.EventContext
+	EventDeviceSigned                   = bertytypes.EventDeviceSigned
+	EventGroupSigned                    = bertytypes.EventGroupSigned
+	EventType                           = bertytypes.EventType
+	Group                               = bertytypes.Group
+	GroupAddAdditionalRendezvousSeed    = bertytypes.GroupAddAdditionalRendezvousSeed
+	GroupAddDeviceSecret                = bertytypes.GroupAddDeviceSecret
+	GroupAddMemberDevice                = bertytypes.GroupAddMemberDevice
+	GroupEnvelope                       = bertytypes.GroupEnvelope
+	GroupInvitation                     = bertytypes.GroupInvitation
+	GroupInvitationJoinRequest          = bertytypes.GroupInvitationJoinRequest
+	GroupInvitationJoinResponse         = bertytypes.GroupInvitationJoinResponse
+	GroupMessageEvent                   = bertytypes.GroupMessageEvent
+	GroupMetadata                       = bertytypes.GroupMetadata
+	GroupMetadataEvent                  = bertytypes.GroupMetadataEvent
+	GroupRemoveAdditionalRendezvousSeed = bertytypes.GroupRemoveAdditionalRendezvousSeed
+	GroupRevokeMemberDevice             = bertytypes.GroupRevokeMemberDevice
+	GroupType                           = bertytypes.GroupType
+	MessageEnvelope                     = bertytypes.MessageEnvelope
+	MessageHeaders                      = bertytypes.MessageHeaders
+	MultiMemberGrantAdminRole           = bertytypes.MultiMemberGrantAdminRole
+	MultiMemberGroupAddAliasResolver    = bertytypes.MultiMemberGroupAddAliasResolver
+	MultiMemberInitialMember            = bertytypes.MultiMemberInitialMember
+	MultiMemberInvitationRevoked        = bertytypes.MultiMemberInvitationRevoked
+	MultiMemberInvitationUsed           = bertytypes.MultiMemberInvitationUsed
+	MultiMemberRemoveMember             = bertytypes.MultiMemberRemoveMember
+	MultiMemberRevokeAdminRole          = bertytypes.MultiMemberRevokeAdminRole
+	ShareableContact                    = bertytypes.ShareableContact
+	ShareableDeviceLink                 = bertytypes.ShareableDeviceLink
+	SigChecker                          = bertytypes.SigChecker
+)
+
+const (
+	ContactStateAdded                               = bertytypes.ContactStateAdded
+	ContactStateBlocked                             = bertytypes.ContactStateBlocked
+	ContactStateDiscarded                           = bertytypes.ContactStateDiscarded
+	ContactStateReceived                            = bertytypes.ContactStateReceived
+	ContactStateRemoved                             = bertytypes.ContactStateRemoved
+	ContactStateToRequest                           = bertytypes.ContactStateToRequest
+	ContactStateUndefined                           = bertytypes.ContactStateUndefined
+	CurrentGroupVersion                             = bertytypes.CurrentGroupVersion
+	EventTypeAccountContactBlocked                  = bertytypes.EventTypeAccountContactBlocked
+	EventTypeAccountContactRequestDisabled          = bertytypes.EventTypeAccountContactRequestDisabled
+	EventTypeAccountContactRequestEnabled           = bertytypes.EventTypeAccountContactRequestEnabled
+	EventTypeAccountContactRequestIncomingAccepted  = bertytypes.EventTypeAccountContactRequestIncomingAccepted
+	EventTypeAccountContactRequestIncomingDiscarded = bertytypes.EventTypeAccountContactRequestIncomingDiscarded
+	EventTypeAccountContactRequestIncomingReceived  = bertytypes.EventTypeAccountContactRequestIncomingReceived
+	EventTypeAccountContactRequestOutgoingEnqueued  = bertytypes.EventTypeAccountContactRequestOutgoingEnqueued
+	EventTypeAccountContactRequestOutgoingSent      = bertytypes.EventTypeAccountContactRequestOutgoingSent
+	EventTypeAccountContactRequestReferenceReset    = bertytypes.EventTypeAccountContactRequestReferenceReset
+	EventTypeAccountContactUnblocked                = bertytypes.EventTypeAccountContactUnblocked
+	EventTypeAccountGroupDeviceAnnounced            = bertytypes.EventTypeAccountGroupDeviceAnnounced
+	EventTypeAccountGroupJoined                     = bertytypes.EventTypeAccountGroupJoined
+	EventTypeAccountGroupLeft                       = bertytypes.EventTypeAccountGroupLeft
+	EventTypeAccountSigChainUpdated                 = bertytypes.EventTypeAccountSigChainUpdated
+	EventTypeContactAliasKeyAdded                   = bertytypes.EventTypeContactAliasKeyAdded
+	EventTypeGroupDeviceSecretAdded                 = bertytypes.EventTypeGroupDeviceSecretAdded
+	EventTypeGroupMemberDeviceAdded                 = bertytypes.EventTypeGroupMemberDeviceAdded
+	EventTypeGroupMemberDeviceRevoked               = bertytypes.EventTypeGroupMemberDeviceRevoked
+	EventTypeGroupMetadataPayloadSent               = bertytypes.EventTypeGroupMetadataPayloadSent
+	EventTypeMultiMemberGroupAdminRoleGranted       = bertytypes.EventTypeMultiMemberGroupAdminRoleGranted
+	EventTypeMultiMemberGroupAdminRoleRevoked       = bertytypes.EventTypeMultiMemberGroupAdminRoleRevoked
+	EventTypeMultiMemberGroupAliasResolverAdded     = bertytypes.EventTypeMultiMemberGroupAliasResolverAdded
+	EventTypeMultiMemberGroupInitialMemberAnnounced = bertytypes.EventTypeMultiMemberGroupInitialMemberAnnounced
+	EventTypeMultiMemberGroupInvitationRevoked      = bertytypes.EventTypeMultiMemberGroupInvitationRevoked
+	EventTypeMultiMemberGroupInvitationUsed         = bertytypes.EventTypeMultiMemberGroupInvitationUsed
+	EventTypeMultiMemberGroupMemberRemoved          = bertytypes.EventTypeMultiMemberGroupMemberRemoved
+	EventTypeUndefined                              = bertytypes.EventTypeUndefined
+	GroupTypeAccount                                = bertytypes.GroupTypeAccount
+	GroupTypeContact                                = bertytypes.GroupTypeContact
+	GroupTypeMultiMember                            = bertytypes.GroupTypeMultiMember
+	GroupTypeUndefined                              = bertytypes.GroupTypeUndefined
+	RendezvousSeedLength                            = bertytypes.RendezvousSeedLength
+)
+
+var (
+	ContactState_name              = bertytypes.ContactState_name
+	ContactState_value             = bertytypes.ContactState_value
+	EventType_name                 = bertytypes.EventType_name
+	EventType_value                = bertytypes.EventType_value
+	GroupType_name                 = bertytypes.GroupType_name
+	GroupType_value                = bertytypes.GroupType_value
+	GetGroupForAccount             = bertytypes.GetGroupForAccount
+	GetGroupForContact             = bertytypes.GetGroupForContact
+	NewEventContext                = bertytypes.NewEventContext
+	NewGroupInvitation             = bertytypes.NewGroupInvitation
+	NewGroupInvitationJoinRequest  = bertytypes.NewGroupInvitationJoinRequest
+	NewGroupInvitationJoinResponse = bertytypes.NewGroupInvitationJoinResponse
+	NewGroupMetadataEventFromEntry = bertytypes.NewGroupMetadataEventFromEntry
+	NewGroupMultiMember            = bertytypes.NewGroupMultiMember
+	OpenGroupEnvelope              = bertytypes.OpenGroupEnvelope
+	SealGroupEnvelope              = bertytypes.SealGroupEnvelope
+	SigCheckerDeviceSigned         = bertytypes.SigCheckerDeviceSigned
+	SigCheckerGroupSigned          = bertytypes.SigCheckerGroupSigned
+	SigCheckerMemberDeviceAdded    = bertytypes.SigCheckerMemberDeviceAdded
+	SigCheckerMissing              = bertytypes.SigCheckerMissing
+)
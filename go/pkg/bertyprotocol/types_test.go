@@ -0,0 +1,86 @@
+package bertyprotocol
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// exportedIdents returns the exported top level identifiers of the non test
+// files of a package
+func exportedIdents(t *testing.T, dir string) map[string]bool {
+	t.Helper()
+
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, 0)
+	require.NoError(t, err)
+
+	idents := map[string]bool{}
+
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			for _, decl := range f.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					if decl.Recv == nil && decl.Name.IsExported() {
+						idents[decl.Name.Name] = true
+					}
+
+				case *ast.GenDecl:
+					for _, spec := range decl.Specs {
+						switch spec := spec.(type) {
+						case *ast.TypeSpec:
+							if spec.Name.IsExported() {
+								idents[spec.Name.Name] = true
+							}
+
+						case *ast.ValueSpec:
+							for _, name := range spec.Names {
+								if name.IsExported() {
+									idents[name.Name] = true
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	return idents
+}
+
+func TestTypesAliases(t *testing.T) {
+	f, err := parser.ParseFile(token.NewFileSet(), "types.go", nil, 0)
+	require.NoError(t, err)
+
+	aliases := map[string]bool{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.TypeSpec:
+			aliases[n.Name.Name] = true
+		case *ast.ValueSpec:
+			for _, name := range n.Names {
+				aliases[name.Name] = true
+			}
+		}
+
+		return true
+	})
+
+	for ident := range exportedIdents(t, "../bertytypes") {
+		// the errors of the generated code are specific to each package
+		if strings.HasPrefix(ident, "Err") && strings.HasSuffix(ident, "Bertytypes") {
+			continue
+		}
+
+		assert.Truef(t, aliases[ident], "%s is not aliased in types.go", ident)
+	}
+}
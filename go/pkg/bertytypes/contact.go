@@ -1,4 +1,4 @@
-package bertyprotocol
+package bertytypes
 
 import (
 	"github.com/libp2p/go-libp2p-core/crypto"
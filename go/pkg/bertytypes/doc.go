@@ -0,0 +1 @@
+package bertytypes // import "berty.tech/berty/go/pkg/bertytypes"
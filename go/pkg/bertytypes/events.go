@@ -1,4 +1,4 @@
-package bertyprotocol
+package bertytypes
 
 import (
 	"crypto/rand"
@@ -17,6 +17,7 @@ var eventTypesMapper = map[EventType]struct {
 }{
 	EventTypeGroupMemberDeviceAdded:                 {Message: &GroupAddMemberDevice{}, SigChecker: SigCheckerMemberDeviceAdded},
 	EventTypeGroupDeviceSecretAdded:                 {Message: &GroupAddDeviceSecret{}, SigChecker: SigCheckerDeviceSigned},
+	EventTypeGroupMemberDeviceRevoked:               {Message: &GroupRevokeMemberDevice{}, SigChecker: SigCheckerDeviceSigned},
 	EventTypeAccountGroupJoined:                     {Message: &AccountGroupJoined{}, SigChecker: SigCheckerDeviceSigned},
 	EventTypeAccountGroupLeft:                       {Message: &AccountGroupLeft{}, SigChecker: SigCheckerDeviceSigned},
 	EventTypeAccountContactRequestDisabled:          {Message: &AccountContactRequestDisabled{}, SigChecker: SigCheckerDeviceSigned},
@@ -29,11 +30,17 @@ var eventTypesMapper = map[EventType]struct {
 	EventTypeAccountContactRequestIncomingAccepted:  {Message: &AccountContactRequestAccepted{}, SigChecker: SigCheckerDeviceSigned},
 	EventTypeAccountContactBlocked:                  {Message: &AccountContactBlocked{}, SigChecker: SigCheckerDeviceSigned},
 	EventTypeAccountContactUnblocked:                {Message: &AccountContactUnblocked{}, SigChecker: SigCheckerDeviceSigned},
+	EventTypeAccountSigChainUpdated:                 {Message: &AccountSigChainUpdated{}, SigChecker: SigCheckerDeviceSigned},
+	EventTypeAccountGroupDeviceAnnounced:            {Message: &AccountGroupDeviceAnnounced{}, SigChecker: SigCheckerDeviceSigned},
 	EventTypeContactAliasKeyAdded:                   {Message: &ContactAddAliasKey{}, SigChecker: SigCheckerDeviceSigned},
 	EventTypeMultiMemberGroupAliasResolverAdded:     {Message: &MultiMemberGroupAddAliasResolver{}, SigChecker: SigCheckerDeviceSigned},
 	EventTypeMultiMemberGroupInitialMemberAnnounced: {Message: &MultiMemberInitialMember{}, SigChecker: SigCheckerGroupSigned},
 	EventTypeMultiMemberGroupAdminRoleGranted:       {Message: &MultiMemberGrantAdminRole{}, SigChecker: SigCheckerDeviceSigned},
-	EventTypeGroupMetadataPayloadSent:               {Message: &AppMetadata{}, SigChecker: SigCheckerMissing},
+	EventTypeMultiMemberGroupAdminRoleRevoked:       {Message: &MultiMemberRevokeAdminRole{}, SigChecker: SigCheckerDeviceSigned},
+	EventTypeMultiMemberGroupMemberRemoved:          {Message: &MultiMemberRemoveMember{}, SigChecker: SigCheckerDeviceSigned},
+	EventTypeMultiMemberGroupInvitationUsed:         {Message: &MultiMemberInvitationUsed{}, SigChecker: SigCheckerDeviceSigned},
+	EventTypeMultiMemberGroupInvitationRevoked:      {Message: &MultiMemberInvitationRevoked{}, SigChecker: SigCheckerDeviceSigned},
+	EventTypeGroupMetadataPayloadSent:               {Message: &AppMetadata{}, SigChecker: SigCheckerDeviceSigned},
 }
 
 func NewEventContext(eventID cid.Cid, parentIDs []cid.Cid, g *Group) (*EventContext, error) {
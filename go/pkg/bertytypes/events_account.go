@@ -1,4 +1,4 @@
-package bertyprotocol
+package bertytypes
 
 func (m *AccountGroupJoined) SetDevicePK(pk []byte) {
 	m.DevicePK = pk
@@ -48,6 +48,14 @@ func (m *AccountContactUnblocked) SetDevicePK(pk []byte) {
 	m.DevicePK = pk
 }
 
+func (m *AccountSigChainUpdated) SetDevicePK(pk []byte) {
+	m.DevicePK = pk
+}
+
+func (m *AccountGroupDeviceAnnounced) SetDevicePK(pk []byte) {
+	m.DevicePK = pk
+}
+
 func (m *AccountContactRequestSent) SetContactPK(pk []byte) {
 	m.ContactPK = pk
 }
@@ -83,3 +91,19 @@ func (m *MultiMemberGroupAddAliasResolver) SetDevicePK(pk []byte) {
 func (m *MultiMemberGrantAdminRole) SetDevicePK(pk []byte) {
 	m.DevicePK = pk
 }
+
+func (m *MultiMemberRevokeAdminRole) SetDevicePK(pk []byte) {
+	m.DevicePK = pk
+}
+
+func (m *MultiMemberRemoveMember) SetDevicePK(pk []byte) {
+	m.DevicePK = pk
+}
+
+func (m *AppMetadata) SetDevicePK(pk []byte) {
+	m.DevicePK = pk
+}
+
+func (m *GroupRevokeMemberDevice) SetDevicePK(pk []byte) {
+	m.DevicePK = pk
+}
@@ -1,4 +1,4 @@
-package bertyprotocol
+package bertytypes
 
 import (
 	"github.com/gogo/protobuf/proto"
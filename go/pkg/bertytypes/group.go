@@ -1,4 +1,4 @@
-package bertyprotocol
+package bertytypes
 
 import (
 	"crypto/ed25519"
@@ -17,6 +17,8 @@ import (
 
 const CurrentGroupVersion = 1
 
+const groupInvitationRendezvousInfo = "berty group invitation rendezvous seed"
+
 func (m *Group) GetSigningPrivKey() (crypto.PrivKey, error) {
 	edSK := ed25519.NewKeyFromSeed(m.Secret)
 
@@ -71,6 +73,24 @@ func (m *Group) GetSharedSecret() (*[32]byte, error) {
 	return &sharedSecret, nil
 }
 
+// GetInvitationRendezvousSeed returns the seed of the rendezvous point on
+// which the admins of the group can be found by its invitees, it is derived
+// from the group secret so only the members and the invitees know it
+func (m *Group) GetInvitationRendezvousSeed() ([]byte, error) {
+	if len(m.Secret) == 0 {
+		return nil, errcode.ErrMissingInput
+	}
+
+	kdf := hkdf.New(sha256.New, m.Secret, nil, []byte(groupInvitationRendezvousInfo))
+
+	seed, err := ioutil.ReadAll(io.LimitReader(kdf, 32))
+	if err != nil {
+		return nil, errcode.ErrSecretKeyGenerationFailed.Wrap(err)
+	}
+
+	return seed, nil
+}
+
 // New creates a new Group object and an invitation to be used by
 // the first member of the group
 func NewGroupMultiMember() (*Group, crypto.PrivKey, error) {
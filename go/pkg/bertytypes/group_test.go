@@ -1,4 +1,4 @@
-package bertyprotocol
+package bertytypes
 
 import (
 	"crypto/rand"
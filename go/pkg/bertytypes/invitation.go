@@ -0,0 +1,339 @@
+package bertytypes
+
+import (
+	"bytes"
+	"crypto/rand"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"golang.org/x/crypto/nacl/box"
+
+	"berty.tech/berty/go/pkg/errcode"
+)
+
+const (
+	invitationIDSize    = 32
+	invitationNonceSize = 24
+)
+
+// NewGroupInvitation creates an invitation to a multi-member group signed by
+// the member key of an admin, a zero expiration time or max uses count
+// removes the corresponding limit, the invitation only holds the public key
+// of the group, a key pair used to sign the join requests and the seed of
+// the rendezvous point of the admins
+func NewGroupInvitation(g *Group, issuerSK crypto.PrivKey, expiresAt time.Time, maxUses uint32) (*GroupInvitation, error) {
+	if g.GroupType != GroupTypeMultiMember {
+		return nil, errcode.ErrGroupInvalidType
+	}
+
+	issuerPK, err := issuerSK.GetPublic().Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	id := make([]byte, invitationIDSize)
+	if _, err := rand.Read(id); err != nil {
+		return nil, errcode.ErrRandomGenerationFailed.Wrap(err)
+	}
+
+	invitationSK, invitationPK, err := crypto.GenerateEd25519Key(rand.Reader)
+	if err != nil {
+		return nil, errcode.ErrSecretKeyGenerationFailed.Wrap(err)
+	}
+
+	invitationPKBytes, err := invitationPK.Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	invitationSKBytes, err := invitationSK.Raw()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	rendezvousSeed, err := g.GetInvitationRendezvousSeed()
+	if err != nil {
+		return nil, err
+	}
+
+	invitation := &GroupInvitation{
+		ID:           id,
+		GroupPK:      g.PublicKey,
+		IssuerPK:     issuerPK,
+		MaxUses:      maxUses,
+		InvitationPK: invitationPKBytes,
+	}
+
+	if !expiresAt.IsZero() {
+		invitation.ExpiresAt = expiresAt.Unix()
+	}
+
+	data, err := invitation.Marshal()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	invitation.Sig, err = issuerSK.Sign(data)
+	if err != nil {
+		return nil, errcode.ErrGroupInvitationCantGenerate.Wrap(err)
+	}
+
+	invitation.InvitationSK = invitationSKBytes
+	invitation.RendezvousSeed = rendezvousSeed
+
+	return invitation, nil
+}
+
+// GetIssuerPubKey returns the member key of the admin who created the
+// invitation
+func (m *GroupInvitation) GetIssuerPubKey() (crypto.PubKey, error) {
+	return crypto.UnmarshalEd25519PublicKey(m.IssuerPK)
+}
+
+// GetInvitationPubKey returns the key used to check the join requests
+func (m *GroupInvitation) GetInvitationPubKey() (crypto.PubKey, error) {
+	return crypto.UnmarshalEd25519PublicKey(m.InvitationPK)
+}
+
+// GetInvitationPrivKey returns the key used to sign the join requests, it is
+// only known by the invitees
+func (m *GroupInvitation) GetInvitationPrivKey() (crypto.PrivKey, error) {
+	if len(m.InvitationSK) == 0 {
+		return nil, errcode.ErrMissingInput
+	}
+
+	return crypto.UnmarshalEd25519PrivateKey(m.InvitationSK)
+}
+
+// Public returns a copy of the invitation without its private key and its
+// rendezvous seed, it can be added to the group
+func (m *GroupInvitation) Public() *GroupInvitation {
+	public := *m
+	public.InvitationSK = nil
+	public.RendezvousSeed = nil
+	public.XXX_sizecache = 0
+
+	return &public
+}
+
+// CheckSignature ensures the invitation has been signed by its issuer and
+// has not been modified since
+func (m *GroupInvitation) CheckSignature() error {
+	if len(m.GroupPK) == 0 || len(m.ID) == 0 || len(m.InvitationPK) == 0 {
+		return errcode.ErrMissingInput
+	}
+
+	issuerPK, err := m.GetIssuerPubKey()
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	unsigned := m.Public()
+	unsigned.Sig = nil
+
+	data, err := unsigned.Marshal()
+	if err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	ok, err := issuerPK.Verify(data, m.Sig)
+	if err != nil {
+		return errcode.ErrSignatureVerificationFailed.Wrap(err)
+	}
+
+	if !ok {
+		return errcode.ErrSignatureVerificationFailed
+	}
+
+	return nil
+}
+
+// IsExpired returns whether the invitation can't be used anymore at the given
+// time
+func (m *GroupInvitation) IsExpired(now time.Time) bool {
+	return m.ExpiresAt != 0 && now.Unix() > m.ExpiresAt
+}
+
+// IsForGroup returns whether the invitation is for the given group
+func (m *GroupInvitation) IsForGroup(g *Group) bool {
+	return bytes.Equal(m.GroupPK, g.PublicKey)
+}
+
+// NewGroupInvitationJoinRequest creates a request sent to an admin of the
+// group to get its secret, it is signed by the private key of the invitation
+// and by the member key, the returned key is used to open the response
+func NewGroupInvitationJoinRequest(invitation *GroupInvitation, memberSK crypto.PrivKey) (*GroupInvitationJoinRequest, *[32]byte, error) {
+	invitationSK, err := invitation.GetInvitationPrivKey()
+	if err != nil {
+		return nil, nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	memberPKBytes, err := memberSK.GetPublic().Raw()
+	if err != nil {
+		return nil, nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	boxPK, boxSK, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, errcode.ErrSecretKeyGenerationFailed.Wrap(err)
+	}
+
+	req := &GroupInvitationJoinRequest{
+		Invitation: invitation.Public(),
+		MemberPK:   memberPKBytes,
+		BoxPK:      boxPK[:],
+	}
+
+	data, err := req.Marshal()
+	if err != nil {
+		return nil, nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	req.Sig, err = invitationSK.Sign(data)
+	if err != nil {
+		return nil, nil, errcode.ErrSignatureFailed.Wrap(err)
+	}
+
+	req.MemberSig, err = memberSK.Sign(data)
+	if err != nil {
+		return nil, nil, errcode.ErrSignatureFailed.Wrap(err)
+	}
+
+	return req, boxSK, nil
+}
+
+// GetMemberPubKey returns the member key of the device joining the group
+func (m *GroupInvitationJoinRequest) GetMemberPubKey() (crypto.PubKey, error) {
+	return crypto.UnmarshalEd25519PublicKey(m.MemberPK)
+}
+
+// CheckSignature ensures the request has been signed using the private key
+// of a valid invitation and the private key of the member it names, so the
+// member key can't be claimed by another invitee
+func (m *GroupInvitationJoinRequest) CheckSignature() error {
+	if m.Invitation == nil || len(m.MemberPK) == 0 || len(m.BoxPK) != 32 {
+		return errcode.ErrMissingInput
+	}
+
+	if len(m.Invitation.InvitationSK) != 0 || len(m.Invitation.RendezvousSeed) != 0 {
+		return errcode.ErrInvalidInput
+	}
+
+	if err := m.Invitation.CheckSignature(); err != nil {
+		return err
+	}
+
+	invitationPK, err := m.Invitation.GetInvitationPubKey()
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	memberPK, err := m.GetMemberPubKey()
+	if err != nil {
+		return errcode.ErrDeserialization.Wrap(err)
+	}
+
+	unsigned := *m
+	unsigned.Sig = nil
+	unsigned.MemberSig = nil
+	unsigned.XXX_sizecache = 0
+
+	data, err := unsigned.Marshal()
+	if err != nil {
+		return errcode.ErrSerialization.Wrap(err)
+	}
+
+	for _, s := range []struct {
+		pk  crypto.PubKey
+		sig []byte
+	}{{invitationPK, m.Sig}, {memberPK, m.MemberSig}} {
+		ok, err := s.pk.Verify(data, s.sig)
+		if err != nil {
+			return errcode.ErrSignatureVerificationFailed.Wrap(err)
+		}
+
+		if !ok {
+			return errcode.ErrSignatureVerificationFailed
+		}
+	}
+
+	return nil
+}
+
+// NewGroupInvitationJoinResponse encrypts the group for the device which sent
+// the request, the request must have been checked beforehand
+func NewGroupInvitationJoinResponse(req *GroupInvitationJoinRequest, g *Group) (*GroupInvitationJoinResponse, error) {
+	if !req.Invitation.IsForGroup(g) {
+		return nil, errcode.ErrInvalidInput
+	}
+
+	data, err := g.Marshal()
+	if err != nil {
+		return nil, errcode.ErrSerialization.Wrap(err)
+	}
+
+	boxPK, boxSK, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errcode.ErrSecretKeyGenerationFailed.Wrap(err)
+	}
+
+	nonce := [invitationNonceSize]byte{}
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return nil, errcode.ErrRandomGenerationFailed.Wrap(err)
+	}
+
+	peerBoxPK := [32]byte{}
+	copy(peerBoxPK[:], req.BoxPK)
+
+	return &GroupInvitationJoinResponse{
+		BoxPK: boxPK[:],
+		Nonce: nonce[:],
+		Group: box.Seal(nil, data, &nonce, &peerBoxPK, boxSK),
+	}, nil
+}
+
+// OpenGroup decrypts the group sent by an admin and ensures it matches the
+// invitation
+func (m *GroupInvitationJoinResponse) OpenGroup(invitation *GroupInvitation, boxSK *[32]byte) (*Group, error) {
+	if len(m.BoxPK) != 32 || len(m.Nonce) != invitationNonceSize {
+		return nil, errcode.ErrInvalidInput
+	}
+
+	peerBoxPK := [32]byte{}
+	copy(peerBoxPK[:], m.BoxPK)
+
+	nonce := [invitationNonceSize]byte{}
+	copy(nonce[:], m.Nonce)
+
+	data, ok := box.Open(nil, m.Group, &nonce, &peerBoxPK, boxSK)
+	if !ok {
+		return nil, errcode.ErrCryptoDecrypt
+	}
+
+	g := &Group{}
+	if err := g.Unmarshal(data); err != nil {
+		return nil, errcode.ErrDeserialization.Wrap(err)
+	}
+
+	if g.GroupType != GroupTypeMultiMember {
+		return nil, errcode.ErrGroupInvalidType
+	}
+
+	if !invitation.IsForGroup(g) {
+		return nil, errcode.ErrInvalidInput
+	}
+
+	if err := g.IsValid(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+func (m *MultiMemberInvitationUsed) SetDevicePK(pk []byte) {
+	m.DevicePK = pk
+}
+
+func (m *MultiMemberInvitationRevoked) SetDevicePK(pk []byte) {
+	m.DevicePK = pk
+}
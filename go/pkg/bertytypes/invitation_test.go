@@ -0,0 +1,140 @@
+package bertytypes
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupInvitation(t *testing.T) {
+	g, _, err := NewGroupMultiMember()
+	require.NoError(t, err)
+
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	expiresAt := time.Now().Add(time.Hour)
+
+	invitation, err := NewGroupInvitation(g, sk, expiresAt, 2)
+	require.NoError(t, err)
+	require.Len(t, invitation.ID, invitationIDSize)
+	require.True(t, invitation.IsForGroup(g))
+	require.NoError(t, invitation.CheckSignature())
+
+	require.False(t, invitation.IsExpired(time.Now()))
+	require.True(t, invitation.IsExpired(expiresAt.Add(time.Second)))
+
+	// the limits can't be changed without invalidating the signature
+	invitation.MaxUses = 3
+	require.Error(t, invitation.CheckSignature())
+
+	// the secret of the group is not part of the invitation
+	invitation.MaxUses = 2
+	data, err := invitation.Marshal()
+	require.NoError(t, err)
+	require.False(t, bytes.Contains(data, g.Secret))
+
+	// the rendezvous seed is derived from the secret of the group
+	seed, err := g.GetInvitationRendezvousSeed()
+	require.NoError(t, err)
+	require.Equal(t, seed, invitation.RendezvousSeed)
+	require.NotEqual(t, seed, g.PublicKey)
+
+	// the private key of the invitation and the rendezvous seed are not
+	// signed by the issuer
+	public := invitation.Public()
+	require.Empty(t, public.InvitationSK)
+	require.Empty(t, public.RendezvousSeed)
+	require.NoError(t, public.CheckSignature())
+
+	unlimited, err := NewGroupInvitation(g, sk, time.Time{}, 0)
+	require.NoError(t, err)
+	require.False(t, unlimited.IsExpired(time.Now().Add(time.Hour*24*365)))
+
+	contactGroup, err := GetGroupForContact(sk)
+	require.NoError(t, err)
+
+	_, err = NewGroupInvitation(contactGroup, sk, time.Time{}, 0)
+	require.Error(t, err)
+}
+
+func TestGroupInvitationJoin(t *testing.T) {
+	g, _, err := NewGroupMultiMember()
+	require.NoError(t, err)
+
+	issuerSK, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	memberSK, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	invitation, err := NewGroupInvitation(g, issuerSK, time.Time{}, 0)
+	require.NoError(t, err)
+
+	req, boxSK, err := NewGroupInvitationJoinRequest(invitation, memberSK)
+	require.NoError(t, err)
+	require.Empty(t, req.Invitation.InvitationSK)
+	require.Empty(t, req.Invitation.RendezvousSeed)
+	require.NoError(t, req.CheckSignature())
+
+	// a request can't be made without the private key of the invitation
+	_, _, err = NewGroupInvitationJoinRequest(invitation.Public(), memberSK)
+	require.Error(t, err)
+
+	// the member key is covered by the signature
+	otherSK, otherPK, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	tampered := *req
+	tampered.MemberPK, err = otherPK.Raw()
+	require.NoError(t, err)
+	require.Error(t, tampered.CheckSignature())
+
+	// the request must be signed by the member key it names, even when the
+	// private key of the invitation is known
+	claimed, _, err := NewGroupInvitationJoinRequest(invitation, otherSK)
+	require.NoError(t, err)
+
+	claimed.MemberPK = req.MemberPK
+	claimed.Sig, claimed.MemberSig = nil, nil
+
+	data, err := claimed.Marshal()
+	require.NoError(t, err)
+
+	invitationSK, err := invitation.GetInvitationPrivKey()
+	require.NoError(t, err)
+
+	claimed.Sig, err = invitationSK.Sign(data)
+	require.NoError(t, err)
+
+	claimed.MemberSig, err = otherSK.Sign(data)
+	require.NoError(t, err)
+
+	require.Error(t, claimed.CheckSignature())
+
+	res, err := NewGroupInvitationJoinResponse(req, g)
+	require.NoError(t, err)
+
+	joined, err := res.OpenGroup(invitation, boxSK)
+	require.NoError(t, err)
+	require.Equal(t, g.PublicKey, joined.PublicKey)
+	require.Equal(t, g.Secret, joined.Secret)
+
+	// only the requester can open the response
+	_, otherBoxSK, err := NewGroupInvitationJoinRequest(invitation, memberSK)
+	require.NoError(t, err)
+
+	_, err = res.OpenGroup(invitation, otherBoxSK)
+	require.Error(t, err)
+
+	// the group must match the invitation
+	other, _, err := NewGroupMultiMember()
+	require.NoError(t, err)
+
+	_, err = NewGroupInvitationJoinResponse(req, other)
+	require.Error(t, err)
+}
@@ -64,6 +64,10 @@ const (
 	ErrHandshakeSessionInvalid          ErrCode = 1013
 	ErrHandshakeKeyNotInSigChain        ErrCode = 1014
 	ErrHandshakeDecrypt                 ErrCode = 1015
+	ErrHandshakeStepTimeout             ErrCode = 1016
+	ErrHandshakeTimeout                 ErrCode = 1017
+	ErrHandshakeCanceled                ErrCode = 1018
+	ErrHandshakeUnsupportedVersion      ErrCode = 1019
 	ErrGroupMemberLogEventOpen          ErrCode = 1020
 	ErrGroupMemberLogEventSignature     ErrCode = 1021
 	ErrGroupMemberLogWrongInviter       ErrCode = 1022
@@ -74,6 +78,12 @@ const (
 	ErrGroupSecretAlreadySentToMember   ErrCode = 1032
 	ErrGroupInvitationCantGenerate      ErrCode = 1033
 	ErrGroupInvalidType                 ErrCode = 1034
+	ErrGroupInvitationExpired           ErrCode = 1035
+	ErrGroupInvitationRevoked           ErrCode = 1036
+	ErrGroupInvitationExhausted         ErrCode = 1037
+	ErrGroupLastAdmin                   ErrCode = 1038
+	ErrGroupMemberRemoved               ErrCode = 1039
+	ErrGroupMemberDeviceRevoked         ErrCode = 1040
 	ErrSecretKeyGenerationFailed        ErrCode = 1050
 	ErrPersistencePut                   ErrCode = 1060
 	ErrPersistenceGet                   ErrCode = 1061
@@ -121,6 +131,10 @@ var ErrCode_name = map[int32]string{
 	1013: "ErrHandshakeSessionInvalid",
 	1014: "ErrHandshakeKeyNotInSigChain",
 	1015: "ErrHandshakeDecrypt",
+	1016: "ErrHandshakeStepTimeout",
+	1017: "ErrHandshakeTimeout",
+	1018: "ErrHandshakeCanceled",
+	1019: "ErrHandshakeUnsupportedVersion",
 	1020: "ErrGroupMemberLogEventOpen",
 	1021: "ErrGroupMemberLogEventSignature",
 	1022: "ErrGroupMemberLogWrongInviter",
@@ -131,6 +145,12 @@ var ErrCode_name = map[int32]string{
 	1032: "ErrGroupSecretAlreadySentToMember",
 	1033: "ErrGroupInvitationCantGenerate",
 	1034: "ErrGroupInvalidType",
+	1035: "ErrGroupInvitationExpired",
+	1036: "ErrGroupInvitationRevoked",
+	1037: "ErrGroupInvitationExhausted",
+	1038: "ErrGroupLastAdmin",
+	1039: "ErrGroupMemberRemoved",
+	1040: "ErrGroupMemberDeviceRevoked",
 	1050: "ErrSecretKeyGenerationFailed",
 	1060: "ErrPersistencePut",
 	1061: "ErrPersistenceGet",
@@ -178,6 +198,10 @@ var ErrCode_value = map[string]int32{
 	"ErrHandshakeSessionInvalid":          1013,
 	"ErrHandshakeKeyNotInSigChain":        1014,
 	"ErrHandshakeDecrypt":                 1015,
+	"ErrHandshakeStepTimeout":             1016,
+	"ErrHandshakeTimeout":                 1017,
+	"ErrHandshakeCanceled":                1018,
+	"ErrHandshakeUnsupportedVersion":      1019,
 	"ErrGroupMemberLogEventOpen":          1020,
 	"ErrGroupMemberLogEventSignature":     1021,
 	"ErrGroupMemberLogWrongInviter":       1022,
@@ -188,6 +212,12 @@ var ErrCode_value = map[string]int32{
 	"ErrGroupSecretAlreadySentToMember":   1032,
 	"ErrGroupInvitationCantGenerate":      1033,
 	"ErrGroupInvalidType":                 1034,
+	"ErrGroupInvitationExpired":           1035,
+	"ErrGroupInvitationRevoked":           1036,
+	"ErrGroupInvitationExhausted":         1037,
+	"ErrGroupLastAdmin":                   1038,
+	"ErrGroupMemberRemoved":               1039,
+	"ErrGroupMemberDeviceRevoked":         1040,
 	"ErrSecretKeyGenerationFailed":        1050,
 	"ErrPersistencePut":                   1060,
 	"ErrPersistenceGet":                   1061,
@@ -210,60 +240,67 @@ func init() {
 func init() { proto.RegisterFile("errcode.proto", fileDescriptor_4240057316120df7) }
 
 var fileDescriptor_4240057316120df7 = []byte{
-	// 874 bytes of a gzipped FileDescriptorProto
+	// 983 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0x4b, 0x73, 0x1b, 0x45,
-	0x10, 0x8e, 0xaa, 0x20, 0xab, 0x0c, 0x18, 0x37, 0xe3, 0xc4, 0xc1, 0x26, 0xb1, 0x49, 0x02, 0x14,
-	0x45, 0x15, 0xf6, 0x81, 0x5f, 0x60, 0x4b, 0x6b, 0xa3, 0x12, 0x96, 0x54, 0x96, 0x0d, 0x55, 0xdc,
-	0x56, 0x9a, 0xf6, 0x6a, 0xb0, 0xd4, 0xb3, 0xf4, 0xce, 0x3a, 0xd9, 0x9c, 0x38, 0x01, 0xe1, 0xc2,
-	0x3d, 0x67, 0xf8, 0x2f, 0xbc, 0x0b, 0xf8, 0x07, 0x9c, 0x28, 0xde, 0x6f, 0xb8, 0xf0, 0xaa, 0x9d,
-	0x9d, 0x55, 0xa4, 0xd8, 0x9c, 0xa4, 0xfd, 0xbe, 0x6f, 0x7a, 0xfa, 0x3d, 0x62, 0x01, 0x99, 0x87,
-	0x46, 0xe1, 0x46, 0xc2, 0xc6, 0x1a, 0xb9, 0x30, 0x40, 0xb6, 0xf9, 0x86, 0x07, 0x57, 0x9f, 0x8b,
-	0xb5, 0x1d, 0x65, 0x83, 0x8d, 0xa1, 0x99, 0x6c, 0xc6, 0x26, 0x36, 0x9b, 0x4e, 0x35, 0xc8, 0x8e,
-	0xdc, 0x97, 0xfb, 0x70, 0xff, 0xca, 0xd3, 0xcf, 0xbe, 0xf3, 0xb0, 0x08, 0x42, 0xe6, 0x86, 0x51,
-	0x28, 0x17, 0xc4, 0x85, 0x43, 0x52, 0x78, 0xa4, 0x09, 0x15, 0x9c, 0x93, 0x17, 0xc4, 0x03, 0x07,
-	0xdd, 0x66, 0x17, 0xee, 0x3e, 0x28, 0x97, 0xc5, 0xa3, 0x21, 0x73, 0xc7, 0xd8, 0xd6, 0x24, 0x19,
-	0xe3, 0x04, 0xc9, 0xa2, 0x82, 0x3b, 0xe7, 0x25, 0x88, 0x87, 0x42, 0xe6, 0x16, 0x59, 0x64, 0x8a,
-	0xc6, 0xf0, 0x55, 0x20, 0x97, 0xc4, 0xa2, 0x43, 0x4e, 0xa2, 0xb1, 0x56, 0x2d, 0x4a, 0x32, 0x0b,
-	0xe8, 0xc1, 0x3d, 0x9d, 0xa6, 0x9a, 0xe2, 0x12, 0x3c, 0x92, 0x17, 0x05, 0x84, 0xcc, 0x7d, 0x64,
-	0x1d, 0x8d, 0xf5, 0xed, 0xc8, 0x6a, 0x43, 0x10, 0xcb, 0x65, 0x21, 0x43, 0xe6, 0x26, 0xa6, 0x73,
-	0xf8, 0xc8, 0xe3, 0x7d, 0x1d, 0x53, 0x64, 0x33, 0xc6, 0x9d, 0x48, 0x8f, 0x51, 0x81, 0x96, 0xd7,
-	0xc5, 0xda, 0x2c, 0xfe, 0x12, 0xb2, 0x3e, 0xd2, 0x43, 0x77, 0xca, 0x6b, 0x5e, 0x95, 0x57, 0xc5,
-	0x4a, 0xc8, 0xbc, 0x1f, 0x91, 0x32, 0x93, 0x5d, 0x24, 0xe4, 0x59, 0xfa, 0xd8, 0x3b, 0xd2, 0xe0,
-	0x3c, 0xb1, 0xa6, 0x89, 0xc3, 0xe2, 0x17, 0xc6, 0x73, 0x68, 0x48, 0x25, 0x3a, 0x91, 0xab, 0x62,
-	0x79, 0x8a, 0xb6, 0x31, 0x6f, 0x18, 0x3a, 0x41, 0x4e, 0x0b, 0x17, 0xc9, 0x9f, 0xf0, 0x51, 0xee,
-	0x45, 0x49, 0x1b, 0x73, 0x30, 0x1e, 0xed, 0x18, 0xbb, 0x95, 0xd9, 0x91, 0x61, 0x7d, 0x1b, 0x15,
-	0x24, 0x72, 0x45, 0x5c, 0x0a, 0x99, 0x0f, 0x29, 0xcd, 0x92, 0xc4, 0xb0, 0x45, 0xd5, 0xc6, 0xfc,
-	0x20, 0x4f, 0x10, 0x5e, 0x93, 0x4b, 0xe2, 0x91, 0x90, 0xb9, 0xcb, 0x03, 0x6d, 0x9b, 0xdb, 0x2d,
-	0xd2, 0x16, 0xde, 0xaf, 0xcd, 0x83, 0xdd, 0x04, 0x09, 0x3e, 0xa8, 0xc9, 0x4b, 0xce, 0xb4, 0x07,
-	0xb7, 0x92, 0x04, 0x49, 0xc1, 0x87, 0x35, 0x79, 0x45, 0x5c, 0xbe, 0x07, 0xcf, 0xe7, 0xf7, 0xa3,
-	0x9a, 0x5c, 0x73, 0xc9, 0xf0, 0xec, 0xfd, 0x79, 0xfe, 0xb8, 0x26, 0x1f, 0x13, 0x4b, 0x33, 0xa7,
-	0xad, 0x61, 0x6c, 0x44, 0xa9, 0x85, 0x4f, 0xee, 0x63, 0x5a, 0xa4, 0xf0, 0x96, 0x63, 0x3e, 0xad,
-	0xc9, 0x15, 0x71, 0xb1, 0x2c, 0x42, 0x63, 0x14, 0x69, 0xea, 0x98, 0x90, 0x2c, 0x6b, 0x4c, 0xe1,
-	0xeb, 0x40, 0x3e, 0x21, 0x1e, 0x9f, 0xa1, 0x7c, 0x5f, 0x14, 0x7c, 0x19, 0xee, 0x37, 0x81, 0xbc,
-	0x2e, 0xae, 0xce, 0x28, 0xb6, 0xc6, 0x8c, 0x91, 0xca, 0x8b, 0xb8, 0x9d, 0x5f, 0xa8, 0xe0, 0xdb,
-	0x40, 0xae, 0xba, 0x74, 0x55, 0x9a, 0x1e, 0xf2, 0xa4, 0xc8, 0xb2, 0x21, 0xf8, 0x2e, 0x90, 0x4f,
-	0x8a, 0xf5, 0x19, 0xae, 0x9b, 0xf8, 0xf2, 0x7a, 0x43, 0x4d, 0x43, 0x08, 0xdf, 0x57, 0x16, 0x5e,
-	0x88, 0x48, 0xa5, 0xa3, 0xe8, 0x18, 0x3b, 0xa6, 0x17, 0xe5, 0x63, 0x13, 0x29, 0xf8, 0x21, 0xf0,
-	0x09, 0x9b, 0x72, 0xde, 0xc9, 0x9d, 0xb1, 0xb9, 0x09, 0x3f, 0x06, 0xf2, 0x19, 0x71, 0xe3, 0x7f,
-	0xd8, 0xbe, 0xc5, 0xa4, 0x63, 0xec, 0x8e, 0xc9, 0x48, 0xc1, 0x4f, 0x81, 0xbc, 0xec, 0x7a, 0x74,
-	0xaa, 0xec, 0x45, 0x1c, 0x4d, 0x52, 0xf8, 0x39, 0x90, 0xeb, 0x62, 0x75, 0xfe, 0xf2, 0xa2, 0x17,
-	0xf6, 0xd1, 0x66, 0x5c, 0x4c, 0xda, 0x2f, 0xa7, 0x04, 0xfe, 0x8e, 0xaa, 0x27, 0x7e, 0x0d, 0xe4,
-	0x35, 0x71, 0xe5, 0x0c, 0xc1, 0xb4, 0xed, 0xe1, 0xb7, 0x53, 0x36, 0xfa, 0xe8, 0x32, 0xe4, 0x95,
-	0xf0, 0xfb, 0x29, 0x1b, 0x6d, 0xcc, 0x8b, 0x81, 0xa6, 0x2a, 0x73, 0xf0, 0x47, 0xe0, 0x4b, 0x3c,
-	0x95, 0x54, 0xd3, 0xf0, 0x67, 0x65, 0x7d, 0x97, 0x4d, 0x96, 0xec, 0xe1, 0x64, 0x80, 0xfc, 0xa2,
-	0x89, 0xc3, 0x13, 0x24, 0xeb, 0x9a, 0xf1, 0xaf, 0xaa, 0x0c, 0x67, 0x08, 0xee, 0x39, 0xf9, 0x77,
-	0x55, 0xec, 0x79, 0xd5, 0xcb, 0x6c, 0x8a, 0xad, 0x70, 0xa2, 0x2d, 0x32, 0xfc, 0x53, 0xf9, 0x39,
-	0xa3, 0x39, 0xa4, 0x63, 0x32, 0x37, 0xc9, 0x21, 0xad, 0x26, 0xfc, 0x7b, 0x86, 0xc4, 0x4f, 0x5d,
-	0x1f, 0x87, 0x8c, 0x36, 0x85, 0xd7, 0xeb, 0xb3, 0x37, 0x95, 0x68, 0xd7, 0x8e, 0xb0, 0x58, 0x2c,
-	0xb6, 0x3c, 0x01, 0x6f, 0xd4, 0x67, 0x7d, 0x2e, 0x35, 0xae, 0x31, 0x9b, 0x06, 0xd3, 0x8e, 0xb1,
-	0xe1, 0x2d, 0x9d, 0x5a, 0x78, 0xb3, 0x2e, 0x9f, 0x16, 0xd7, 0xe6, 0x55, 0xbe, 0xb5, 0xfa, 0x48,
-	0xf6, 0xc0, 0x78, 0x6b, 0x6f, 0xd5, 0xe5, 0x0d, 0xb7, 0x8a, 0x4a, 0x2f, 0x8b, 0x68, 0x5c, 0x1b,
-	0x36, 0x22, 0xb2, 0x7e, 0xe7, 0x20, 0xdc, 0xa9, 0xfb, 0x0c, 0x57, 0xa2, 0xa2, 0x34, 0xae, 0xc4,
-	0x6f, 0xd7, 0x7d, 0x4c, 0xe5, 0x0d, 0x6d, 0xcc, 0x4f, 0x2d, 0xaa, 0xbb, 0x75, 0xbf, 0x86, 0x7b,
-	0xc5, 0xc6, 0x49, 0x2d, 0xd2, 0x10, 0x7b, 0x99, 0x85, 0x77, 0xcf, 0xc0, 0x77, 0xd1, 0xc2, 0x7b,
-	0x75, 0x3f, 0x97, 0xdb, 0xac, 0x55, 0x8c, 0x6e, 0x49, 0x73, 0x96, 0x14, 0x9b, 0xfb, 0xf3, 0x45,
-	0xef, 0x47, 0x49, 0x75, 0x8c, 0xdd, 0xcf, 0x88, 0x34, 0xc5, 0xf0, 0xc5, 0xe2, 0xf6, 0x53, 0x9f,
-	0x7d, 0xb9, 0x76, 0xee, 0x95, 0xf5, 0xf2, 0x55, 0xb1, 0x38, 0x1c, 0x6d, 0xba, 0xbf, 0x9b, 0xc5,
-	0x53, 0x72, 0x1c, 0x6f, 0xfa, 0x77, 0x66, 0x70, 0xde, 0xbd, 0x1f, 0xcf, 0xff, 0x17, 0x00, 0x00,
-	0xff, 0xff, 0xca, 0x9c, 0x00, 0xa9, 0x8e, 0x06, 0x00, 0x00,
+	0x17, 0x8d, 0xaa, 0xbe, 0x2f, 0x33, 0x69, 0xca, 0xf1, 0xa5, 0x1d, 0x3b, 0xd8, 0x24, 0x36, 0x49,
+	0x80, 0xa2, 0xa8, 0xc2, 0x5e, 0xf0, 0x0b, 0x6c, 0x69, 0x6c, 0x54, 0x8e, 0x25, 0x97, 0x65, 0x87,
+	0x2a, 0x76, 0x2d, 0xf5, 0xb5, 0xd4, 0x58, 0xd3, 0x3d, 0xdc, 0xe9, 0x51, 0x3c, 0x59, 0xb1, 0x02,
+	0xc2, 0x7b, 0x9d, 0x35, 0xfc, 0x0e, 0xb6, 0xbc, 0x0b, 0xf8, 0x07, 0xac, 0x28, 0xde, 0xef, 0xf7,
+	0xb3, 0x66, 0xa6, 0xc7, 0x1e, 0x59, 0xce, 0x4a, 0x9a, 0x73, 0xce, 0xed, 0xee, 0x7b, 0xfa, 0xde,
+	0xbe, 0x6c, 0x0a, 0x89, 0x7a, 0x46, 0xe2, 0x72, 0x44, 0xc6, 0x1a, 0x3e, 0xd5, 0x45, 0xb2, 0xe9,
+	0xb2, 0x03, 0x17, 0x1e, 0xeb, 0x2b, 0x3b, 0x48, 0xba, 0xcb, 0x3d, 0x13, 0xae, 0xf4, 0x4d, 0xdf,
+	0xac, 0xe4, 0xaa, 0x6e, 0xb2, 0x9f, 0x7f, 0xe5, 0x1f, 0xf9, 0xbf, 0x22, 0xfa, 0xd1, 0xb7, 0xce,
+	0x33, 0x2f, 0x20, 0xaa, 0x1b, 0x89, 0x7c, 0x8a, 0x9d, 0xdb, 0xd3, 0x12, 0xf7, 0x95, 0x46, 0x09,
+	0x67, 0xf8, 0x39, 0xf6, 0xbf, 0xdd, 0x76, 0xa3, 0x0d, 0x77, 0xfe, 0xcf, 0xe7, 0xd8, 0xbd, 0x01,
+	0x51, 0xcb, 0xd8, 0x66, 0x18, 0x0d, 0x31, 0x44, 0x6d, 0x51, 0xc2, 0xed, 0xb3, 0x1c, 0xd8, 0x3d,
+	0x01, 0x51, 0x53, 0x5b, 0x24, 0x2d, 0x86, 0xf0, 0x99, 0xc7, 0x67, 0xd8, 0x74, 0x8e, 0x8c, 0xc4,
+	0x50, 0xc9, 0xa6, 0x8e, 0x12, 0x0b, 0xe8, 0xc0, 0x2d, 0x15, 0xc7, 0x4a, 0xf7, 0x0b, 0x70, 0x9f,
+	0x5f, 0x60, 0x10, 0x10, 0x75, 0x90, 0x94, 0x18, 0xaa, 0x5b, 0xc2, 0x2a, 0xa3, 0xa1, 0xcf, 0xe7,
+	0x18, 0x0f, 0x88, 0x1a, 0x18, 0x8f, 0xe1, 0x03, 0x87, 0x77, 0x54, 0x5f, 0x0b, 0x9b, 0x10, 0xae,
+	0x0b, 0x35, 0x44, 0x09, 0x8a, 0x5f, 0x65, 0x8b, 0x55, 0xfc, 0x06, 0x92, 0xda, 0x57, 0xbd, 0x3c,
+	0xca, 0x69, 0x9e, 0xe6, 0x97, 0xd9, 0x7c, 0x40, 0xb4, 0x23, 0xb4, 0x34, 0xe1, 0x06, 0x6a, 0xa4,
+	0x2a, 0x7d, 0xe0, 0x0e, 0x52, 0xa7, 0x34, 0xb2, 0xa6, 0x81, 0xbd, 0xec, 0x17, 0x86, 0x63, 0x68,
+	0xa0, 0x0b, 0x34, 0xe4, 0x0b, 0x6c, 0xee, 0x08, 0xdd, 0xc4, 0xb4, 0x6e, 0xf4, 0x08, 0x29, 0xce,
+	0x8e, 0xa8, 0x5d, 0x84, 0xcb, 0x72, 0x4b, 0x44, 0x9b, 0x98, 0x82, 0x71, 0x68, 0xcb, 0xd8, 0xd5,
+	0xc4, 0x0e, 0x0c, 0xa9, 0x5b, 0x28, 0x21, 0xe2, 0xf3, 0x6c, 0x36, 0x20, 0xda, 0xd3, 0x71, 0x12,
+	0x45, 0x86, 0x2c, 0xca, 0x4d, 0x4c, 0x77, 0xd3, 0x08, 0xe1, 0x19, 0x3e, 0xc3, 0xce, 0x07, 0x44,
+	0x6d, 0xea, 0x2a, 0xdb, 0x58, 0x6b, 0x6a, 0x65, 0xe1, 0xed, 0xda, 0x38, 0xd8, 0x8e, 0x50, 0xc3,
+	0x3b, 0x35, 0x3e, 0xcb, 0xe0, 0x18, 0x5c, 0x8d, 0x22, 0xd4, 0x12, 0xde, 0xad, 0xf1, 0x4b, 0xec,
+	0xe2, 0x31, 0x3c, 0xee, 0xef, 0x7b, 0x35, 0xbe, 0xc8, 0xe6, 0x8f, 0xd9, 0x93, 0x3e, 0xbf, 0x5f,
+	0xe3, 0xf7, 0xb1, 0x99, 0x4a, 0xb4, 0x35, 0x84, 0x75, 0x11, 0x5b, 0xf8, 0xe0, 0x04, 0xd3, 0xd4,
+	0x12, 0x0f, 0x73, 0xe6, 0xc3, 0x1a, 0x9f, 0x67, 0x17, 0x8a, 0x4b, 0xa8, 0x0f, 0x84, 0xd2, 0x2d,
+	0x13, 0x68, 0x4b, 0x0a, 0x63, 0xf8, 0xdc, 0xe3, 0x0f, 0xb0, 0xfb, 0x2b, 0x94, 0xab, 0x8b, 0x8c,
+	0x2f, 0xd2, 0xfd, 0xc2, 0xe3, 0x57, 0xd9, 0xe5, 0x8a, 0x62, 0x75, 0x48, 0x28, 0x64, 0x9a, 0xe5,
+	0x9d, 0x9f, 0x0b, 0x25, 0x7c, 0xe9, 0xf1, 0x05, 0x36, 0x5b, 0xd1, 0x6c, 0x23, 0x85, 0x99, 0xcb,
+	0x46, 0xc3, 0x57, 0x1e, 0x7f, 0x90, 0x2d, 0x55, 0xb8, 0x76, 0xe4, 0xae, 0xd7, 0x2d, 0xd4, 0x30,
+	0x1a, 0xe1, 0xeb, 0x72, 0x85, 0x27, 0x84, 0x96, 0xf1, 0x40, 0x1c, 0x60, 0xcb, 0x6c, 0x8b, 0x74,
+	0x68, 0x84, 0x84, 0x6f, 0x3c, 0x67, 0xd8, 0x11, 0xe7, 0x0e, 0xb9, 0x3e, 0x34, 0x37, 0xe1, 0x5b,
+	0x8f, 0x3f, 0xc2, 0xae, 0xdd, 0x85, 0xed, 0x58, 0x8c, 0x5a, 0xc6, 0xae, 0x9b, 0x44, 0x4b, 0xf8,
+	0xce, 0xe3, 0x17, 0x19, 0xaf, 0x2a, 0xb7, 0x05, 0x89, 0x30, 0x86, 0xef, 0x3d, 0xbe, 0xc4, 0x16,
+	0xc6, 0x37, 0xcf, 0x6a, 0x61, 0x07, 0x6d, 0x42, 0x59, 0xa7, 0xfd, 0x30, 0x21, 0x70, 0x7b, 0x94,
+	0x35, 0xf1, 0xa3, 0xc7, 0xaf, 0xb0, 0x4b, 0xa7, 0x08, 0x8e, 0xca, 0x1e, 0x7e, 0x9a, 0x58, 0xa3,
+	0x83, 0xb9, 0x43, 0x4e, 0x09, 0x3f, 0x4f, 0xac, 0xb1, 0x89, 0x69, 0xd6, 0xd0, 0xba, 0x74, 0x0e,
+	0x7e, 0xf1, 0xdc, 0x15, 0x1f, 0x49, 0xca, 0x6e, 0xf8, 0x75, 0xc2, 0xa3, 0x2c, 0xf5, 0x5d, 0x15,
+	0xa2, 0x49, 0x2c, 0xfc, 0x36, 0x11, 0x57, 0x32, 0xbf, 0x7b, 0xae, 0x34, 0x8e, 0x98, 0xba, 0xd0,
+	0x3d, 0xcc, 0xda, 0xee, 0x0f, 0x8f, 0x5f, 0x63, 0x8b, 0x55, 0xaa, 0xd2, 0x0c, 0x37, 0x5c, 0x4f,
+	0xfd, 0x59, 0x66, 0xb5, 0x41, 0x26, 0x89, 0xb6, 0x30, 0xec, 0x22, 0x5d, 0x37, 0xfd, 0x60, 0x84,
+	0xda, 0xe6, 0x4d, 0xf0, 0x57, 0x79, 0xfd, 0xa7, 0x08, 0x8e, 0xcd, 0xf9, 0xbb, 0x2c, 0xb2, 0x71,
+	0xd5, 0x93, 0x64, 0xb2, 0xd7, 0x68, 0xa4, 0x2c, 0x12, 0xfc, 0x53, 0xfa, 0x53, 0xd1, 0xec, 0xe9,
+	0x03, 0x6d, 0x6e, 0xea, 0x1c, 0x69, 0x36, 0xe0, 0xdf, 0x53, 0x24, 0xae, 0xdb, 0x3b, 0xd8, 0x23,
+	0xb4, 0x31, 0x3c, 0xeb, 0x57, 0x77, 0x2a, 0xd0, 0xb6, 0x1d, 0x60, 0xf6, 0xa0, 0xd9, 0x22, 0x02,
+	0x9e, 0xf3, 0xab, 0x67, 0x2e, 0x34, 0x79, 0x43, 0x34, 0x0c, 0xc6, 0x2d, 0x63, 0x83, 0x43, 0x15,
+	0x5b, 0x78, 0xde, 0xe7, 0x0f, 0xb3, 0x2b, 0xe3, 0x2a, 0x57, 0xd2, 0x1d, 0xd4, 0x76, 0xd7, 0xb8,
+	0xd5, 0x5e, 0xf0, 0x9d, 0x8f, 0xc5, 0x29, 0xb3, 0x6c, 0xf2, 0xf2, 0xaf, 0x0b, 0x6d, 0xdd, 0x5b,
+	0x87, 0x70, 0xdb, 0x77, 0x37, 0x54, 0x8a, 0xb2, 0x92, 0xc8, 0x4b, 0xeb, 0x45, 0xdf, 0x3d, 0x08,
+	0x27, 0xc2, 0x83, 0xc3, 0x48, 0x11, 0x4a, 0x78, 0xe9, 0x2e, 0xfc, 0x0e, 0x8e, 0xcc, 0x01, 0x4a,
+	0x78, 0xd9, 0x77, 0x1d, 0x3e, 0x11, 0x3f, 0x10, 0x49, 0x9c, 0x4d, 0x89, 0x57, 0x7c, 0x37, 0x3d,
+	0x72, 0xc5, 0x75, 0x11, 0xdb, 0x55, 0x19, 0x2a, 0x0d, 0xaf, 0xfa, 0xae, 0x27, 0x2b, 0x6e, 0xee,
+	0x60, 0x68, 0x46, 0x28, 0xe1, 0xb5, 0xb1, 0x55, 0x0b, 0xae, 0x81, 0x23, 0xd5, 0xc3, 0x72, 0xdf,
+	0xd7, 0x7d, 0x77, 0x17, 0x85, 0x33, 0x9b, 0x98, 0x4e, 0x3c, 0xec, 0x77, 0xca, 0x8d, 0xb7, 0xb3,
+	0x6a, 0x8a, 0x2d, 0xea, 0x1e, 0x6e, 0x27, 0x16, 0xde, 0x38, 0x05, 0xdf, 0x40, 0x0b, 0x6f, 0xfa,
+	0xae, 0x58, 0xd7, 0x48, 0xc9, 0x3e, 0xe6, 0x43, 0x8d, 0x92, 0x28, 0xcb, 0xe1, 0xe3, 0x69, 0xe7,
+	0x5f, 0x41, 0xb5, 0x8c, 0xdd, 0x49, 0xb4, 0x56, 0xba, 0x0f, 0x9f, 0x4c, 0xaf, 0x3d, 0xf4, 0xd1,
+	0xa7, 0x8b, 0x67, 0x9e, 0x5a, 0x2a, 0xa6, 0xb0, 0xc5, 0xde, 0x60, 0x25, 0xff, 0xbb, 0x92, 0x8d,
+	0xde, 0x83, 0xfe, 0x8a, 0x9b, 0xcb, 0xdd, 0xb3, 0xf9, 0xbc, 0x7d, 0xfc, 0xbf, 0x01, 0x00, 0xb3,
+	0x45, 0x23, 0x96, 0xbe, 0x07, 0x00, 0x00,
 }
@@ -0,0 +1,5 @@
+module github.com/cloudflare/circl
+
+go 1.13
+
+require golang.org/x/sys v0.0.0-20200212091648-12a6c2dcc1e4